@@ -2,86 +2,233 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
-	"time"
+	"os/signal"
+	"syscall"
 
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/gateway"
 	"github.com/abu-umair/be-lms-go/internal/grpcmiddleware"
 	"github.com/abu-umair/be-lms-go/internal/handler"
+	"github.com/abu-umair/be-lms-go/internal/health"
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/internal/metrics"
 	"github.com/abu-umair/be-lms-go/internal/repository"
 	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/tracing"
+	"github.com/abu-umair/be-lms-go/pb/assignment"
 	"github.com/abu-umair/be-lms-go/pb/auth"
+	"github.com/abu-umair/be-lms-go/pb/catalog"
 	"github.com/abu-umair/be-lms-go/pb/chapter_lesson"
 	"github.com/abu-umair/be-lms-go/pb/course"
 	"github.com/abu-umair/be-lms-go/pb/course_chapter"
+	"github.com/abu-umair/be-lms-go/pb/quiz"
+	"github.com/abu-umair/be-lms-go/pb/trash"
 	"github.com/abu-umair/be-lms-go/pkg/database"
-	"github.com/joho/godotenv"
 	gocache "github.com/patrickmn/go-cache"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 func main() {
-	godotenv.Load()
+	cfg := config.MustLoad()
+	logger.Setup(cfg.Environment)
 	ctx := context.Background()
 
-	lis, err := net.Listen("tcp", ":50051")
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing, "be-lms-grpc", cfg.Environment)
 	if err != nil {
-		log.Panicf("Error when listening %v", err)
+		slog.Error("failed to setup tracing", "error", err)
+		os.Exit(1)
 	}
 
-	db := database.ConnectDB(ctx, os.Getenv("DB_URI"))
+	lis, err := net.Listen("tcp", cfg.GRPC.Addr())
+	if err != nil {
+		slog.Error("failed to listen", "addr", cfg.GRPC.Addr(), "error", err)
+		os.Exit(1)
+	}
 
-	log.Println("Connected to DB")
+	db := database.ConnectDB(ctx, cfg.Database.URI)
+	metrics.RegisterDBStats(db.DB)
 
-	cacheService := gocache.New(time.Hour*244, time.Hour*244)
+	slog.Info("connected to database")
 
-	authMiddleware := grpcmiddleware.NewAuthMiddleware(cacheService)
+	cacheService := gocache.New(cfg.Cache.DefaultExpiration, cfg.Cache.CleanupInterval)
 
-	emailService := service.NewEmailSender(
-		"sandbox.smtp.mailtrap.io",
-		2525,
-		"37f5838d8d81ab",
-		"041576603c2bd7",
-	)
+	authMiddleware := grpcmiddleware.NewAuthMiddleware(cacheService, cfg.JWT.Secret)
+	errorMiddleware := grpcmiddleware.NewErrorMiddleware(cfg.GRPC.LegacyBaseResponseErrors)
+	rateLimitMiddleware := grpcmiddleware.NewRateLimitMiddleware("/catalog.CatalogService/", cfg.Public.RateLimit, cfg.Public.RateBurst)
+	validationMiddleware, err := grpcmiddleware.NewValidationMiddleware()
+	if err != nil {
+		slog.Error("failed to create validator", "error", err)
+		os.Exit(1)
+	}
+
+	emailService := service.NewTracedSender(service.NewInstrumentedSender(service.NewEmailSender(cfg.SMTP)))
 
 	authRepository := repository.NewAuthRepository(db)
-	authService := service.NewAuthService(authRepository, cacheService, emailService)
+	authService := service.NewAuthService(authRepository, cacheService, emailService, cfg.JWT)
 	authHandler := handler.NewAuthHandler(authService)
 
 	courseRepository := repository.NewCourseRepository(db)
-	courseService := service.NewCourseService(db, courseRepository)
+	chapterLessonRepository := repository.NewChapterLessonRepository(db)
+	courseChapterRepository := repository.NewCourseChapterRepository(db)
+	storageResolver := storage.MustNewResolver(cfg.Storage.Root)
+	courseService := service.NewCourseService(db, courseRepository, courseChapterRepository, chapterLessonRepository, storageResolver, cfg.Storage)
 	courseHandler := handler.NewCourseHandler(courseService)
 
-	courseChapterRepository := repository.NewCourseChapterRepository(db)
-	courseChapterService := service.NewCourseChapterService(db, courseChapterRepository)
+	enrollmentRepository := repository.NewEnrollmentRepository(db)
+	quizRepository := repository.NewQuizRepository(db)
+	quizAttemptRepository := repository.NewQuizAttemptRepository(db)
+
+	//* aturan rilis (drip & prerequisite) dipakai bersama oleh chapter, lesson, quiz & assignment
+	releaseRuleRepository := repository.NewReleaseRuleRepository(db)
+	lessonCompletionRepository := repository.NewLessonCompletionRepository(db)
+	releaseService := service.NewReleaseService(courseRepository, chapterLessonRepository, enrollmentRepository, releaseRuleRepository, lessonCompletionRepository, quizRepository, quizAttemptRepository)
+
+	courseChapterService := service.NewCourseChapterService(db, courseChapterRepository, chapterLessonRepository, courseRepository, releaseRuleRepository, releaseService)
 	courseChapterHandler := handler.NewCourseChapterHandler(courseChapterService)
 
-	chapterLessonRepository := repository.NewChapterLessonRepository(db)
-	chapterLessonService := service.NewChapterLessonService(db, chapterLessonRepository)
+	lessonTranscriptRepository := repository.NewLessonTranscriptRepository(db)
+	chapterLessonService := service.NewChapterLessonService(db, chapterLessonRepository, courseChapterRepository, courseRepository, enrollmentRepository, releaseRuleRepository, lessonCompletionRepository, lessonTranscriptRepository, releaseService, storageResolver, cfg.Storage)
 	chapterLessonHandler := handler.NewChapterLessonHandler(chapterLessonService)
 
+	quizService := service.NewQuizService(db, quizRepository, quizAttemptRepository, chapterLessonRepository, courseRepository, enrollmentRepository, releaseService)
+	quizHandler := handler.NewQuizHandler(quizService)
+
+	assignmentRepository := repository.NewAssignmentRepository(db)
+	assignmentSubmissionRepository := repository.NewAssignmentSubmissionRepository(db)
+	assignmentService := service.NewAssignmentService(db, assignmentRepository, assignmentSubmissionRepository, chapterLessonRepository, courseRepository, enrollmentRepository, releaseService, emailService, storageResolver, cfg.Storage)
+	assignmentHandler := handler.NewAssignmentHandler(assignmentService)
+
+	catalogService := service.NewCatalogService(courseRepository, courseChapterRepository, chapterLessonRepository, cfg.Storage)
+	catalogHandler := handler.NewCatalogHandler(catalogService)
+
+	//* trash: restore / purge permanen setelah masa retensi
+	trashService := service.NewTrashService(db, courseRepository, courseChapterRepository, chapterLessonRepository, storageResolver, cfg.Trash.Retention)
+	trashHandler := handler.NewTrashHandler(trashService)
+
 	serv := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			grpcmiddleware.ErrorMiddleware,
-			authMiddleware.Middleware,
-		),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()), //? span per RPC, trace context dari metadata traceparent (gRPC, gRPC-Web & gateway)
+		grpcmiddleware.UnaryChain(errorMiddleware, rateLimitMiddleware, authMiddleware, validationMiddleware),
 	)
 
 	auth.RegisterAuthServiceServer(serv, authHandler)
 	course.RegisterCourseServiceServer(serv, courseHandler)
 	course_chapter.RegisterCourseChapterServiceServer(serv, courseChapterHandler)
 	chapter_lesson.RegisterChapterLessonServiceServer(serv, chapterLessonHandler)
+	quiz.RegisterQuizServiceServer(serv, quizHandler)
+	assignment.RegisterAssignmentServiceServer(serv, assignmentHandler)
+	catalog.RegisterCatalogServiceServer(serv, catalogHandler)
+	trash.RegisterTrashServiceServer(serv, trashHandler)
+
+	//* grpc.health.v1, status per service ikut status koneksi DB
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(serv, healthServer)
+	dbChecker := health.NewDBChecker(db, healthServer, cfg.Database.PingInterval, cfg.Database.PingTimeout,
+		auth.AuthService_ServiceDesc.ServiceName,
+		course.CourseService_ServiceDesc.ServiceName,
+		course_chapter.CourseChapterService_ServiceDesc.ServiceName,
+		chapter_lesson.ChapterLessonService_ServiceDesc.ServiceName,
+		quiz.QuizService_ServiceDesc.ServiceName,
+		assignment.AssignmentService_ServiceDesc.ServiceName,
+		catalog.CatalogService_ServiceDesc.ServiceName,
+		trash.TrashService_ServiceDesc.ServiceName,
+	)
+	checkerCtx, stopChecker := context.WithCancel(ctx)
+	go dbChecker.Run(checkerCtx)
+
+	purgeCtx, stopPurge := context.WithCancel(ctx)
+	go trashService.Run(purgeCtx, cfg.Trash.PurgeInterval)
 
-	if os.Getenv("ENVIRONMENT") == "dev" {
+	if cfg.GRPC.EnableReflection {
 		reflection.Register(serv)
-		log.Printf("Reflection is Registered.")
+		slog.Info("grpc reflection registered")
+	}
+
+	//* gRPC-Web & REST/JSON (google.api.http) di port gateway, tidak perlu grpcwebproxy lagi
+	gatewayHandler, err := gateway.NewHandler(ctx, serv, fmt.Sprintf("localhost:%d", cfg.GRPC.Port))
+	if err != nil {
+		slog.Error("failed to create gateway", "error", err)
+		os.Exit(1)
+	}
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/metrics", metrics.Handler()) //? Prometheus scrape
+	httpMux.Handle("/", gatewayHandler)
+	gatewayServer := &http.Server{
+		Addr:    cfg.Gateway.Addr(),
+		Handler: httpMux,
+	}
+	go func() {
+		slog.Info("gateway server is running", "addr", cfg.Gateway.Addr())
+		if err := gatewayServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("gateway server stopped", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	go func() {
+		slog.Info("grpc server is running", "addr", cfg.GRPC.Addr())
+		if err := serv.Serve(lis); err != nil {
+			slog.Error("grpc server stopped", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	//* graceful shutdown: tunggu SIGTERM (deploy) / SIGINT (ctrl+c)
+	signalCtx, stopSignal := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignal()
+	<-signalCtx.Done()
+	slog.Info("shutting down", "timeout", cfg.GRPC.ShutdownTimeout.String())
+
+	//? NOT_SERVING lebih dulu agar load balancer berhenti mengirim request baru
+	stopChecker()
+	stopPurge()
+	healthServer.Shutdown()
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.GRPC.ShutdownTimeout)
+	defer cancel()
+
+	if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("gateway server shutdown failed", "error", err)
+	}
+	gracefulStop(shutdownCtx, serv)
 
+	//? hapus file / kirim email yang masih di-retry jangan sampai hilang
+	if err := database.WaitPostCommitActions(shutdownCtx); err != nil {
+		slog.Error("post-commit actions not finished", "error", err)
+	}
+	if err := db.Close(); err != nil {
+		slog.Error("failed to close database", "error", err)
 	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
+	}
+
+	slog.Info("server stopped")
+}
 
-	log.Println("Server is running on :50051 port")
-	if err := serv.Serve(lis); err != nil {
-		log.Panicf("Server is error %v", err)
+// gracefulStop menunggu request yang sedang berjalan selesai, jika melewati deadline koneksi diputus paksa
+func gracefulStop(ctx context.Context, serv *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		serv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		slog.Warn("graceful stop timed out, forcing stop")
+		serv.Stop()
+		<-done
 	}
 }
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/abu-umair/be-lms-go/pkg/database/migrations"
+)
+
+// migrate menjalankan migration schema DB (file SQL di pkg/database/migrations/sql ikut di-embed), contoh:
+//
+//	go run cmd/migrate/main.go up
+//	go run cmd/migrate/main.go down 2
+//	go run cmd/migrate/main.go status
+//	go run cmd/migrate/main.go create add_quiz_table
+func main() {
+	dir := flag.String("dir", migrations.SourceDir, "folder file migration (utk perintah create)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: migrate [-dir folder] up | down [steps] | status | create <name>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	//* create tidak butuh koneksi DB
+	if flag.Arg(0) == "create" {
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+
+		upPath, downPath, err := migrations.Create(*dir, flag.Arg(1))
+		if err != nil {
+			slog.Error("create migration failed", "error", err)
+			os.Exit(1)
+		}
+
+		slog.Info("created migration", "up", upPath, "down", downPath)
+		return
+	}
+
+	//? migration cukup butuh DB_URI (tanpa JWT / SMTP / storage secret)
+	cfg := config.MustLoadWith((*config.Config).ValidateDatabase)
+	logger.Setup(cfg.Environment)
+	ctx := context.Background()
+
+	db := database.ConnectDB(ctx, cfg.Database.URI)
+	defer db.Close()
+
+	files, err := migrations.Embedded()
+	if err != nil {
+		slog.Error("load migrations failed", "error", err)
+		os.Exit(1)
+	}
+
+	migrator := migrations.NewMigrator(db, files)
+
+	switch flag.Arg(0) {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			slog.Error("migrate up failed", "error", err)
+			os.Exit(1)
+		}
+		if len(applied) == 0 {
+			slog.Info("no pending migrations")
+		}
+
+	case "down":
+		steps := 1
+		if flag.NArg() > 1 {
+			steps, err = strconv.Atoi(flag.Arg(1))
+			if err != nil || steps < 1 {
+				slog.Error("invalid steps", "steps", flag.Arg(1))
+				os.Exit(1)
+			}
+		}
+
+		rolledBack, err := migrator.Down(ctx, steps)
+		if err != nil {
+			slog.Error("migrate down failed", "error", err)
+			os.Exit(1)
+		}
+		if len(rolledBack) == 0 {
+			slog.Info("no applied migrations")
+		}
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			slog.Error("migrate status failed", "error", err)
+			os.Exit(1)
+		}
+
+		for _, s := range statuses {
+			state := "pending"
+			if s.AppliedAt != nil {
+				state = "applied " + s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			if s.Missing {
+				state += " (file missing)"
+			}
+			fmt.Printf("%06d  %-45s %s\n", s.Version, s.Name, state)
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
@@ -1,53 +1,126 @@
 package main
 
 import (
-	"log"
-	"mime"
-	"net/http"
+	"context"
+	"log/slog"
 	"os"
-	"path"
+	"os/signal"
+	"syscall"
 
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/fibermiddleware"
 	"github.com/abu-umair/be-lms-go/internal/handler"
+	"github.com/abu-umair/be-lms-go/internal/health"
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/internal/metrics"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/tracing"
+	"github.com/abu-umair/be-lms-go/pkg/database"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 )
 
-func handleGetFileName(c *fiber.Ctx) error {
-	courseID := c.Params("course_id")
-	fileNameParam := c.Params("filename")
-	filePath := path.Join("storage", courseID, "course", fileNameParam)
-	if _, err := os.Stat(filePath); err != nil {
-		if os.IsNotExist(err) {
-			return c.Status(http.StatusNotFound).SendString("Not Found")
-		}
-		log.Println(err)
-		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
-	}
+func main() {
+	cfg := config.MustLoad()
+	logger.Setup(cfg.Environment)
+	ctx := context.Background()
 
-	//? membuka file
-	file, err := os.Open(filePath)
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing, "be-lms-rest", cfg.Environment)
 	if err != nil {
-		log.Println(err)
-		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+		slog.Error("failed to setup tracing", "error", err)
+		os.Exit(1)
 	}
 
-	//? mengirim file sbg response
-	ext := path.Ext(filePath)
-	mimeType := mime.TypeByExtension(ext)
+	db := database.ConnectDB(ctx, cfg.Database.URI)
+	metrics.RegisterDBStats(db.DB)
 
-	c.Set("Content-Type", mimeType) //?konversi agar tampilan gambar sesuai (dinamis)
-	// c.Set("Content-Type", "image/png") //?konversi agar tampilan gambar sesuai (blm dinamis)
-	return c.SendStream(file)
-}
+	slog.Info("connected to database")
 
-func main() {
-	app := fiber.New()
-	app.Use(cors.New())
+	//* semua path file storage disusun lewat resolver (anti path traversal)
+	storageResolver := storage.MustNewResolver(cfg.Storage.Root)
+
+	//* sweeper utk file upload yang tidak pernah dipakai course/lesson (orphan)
+	//? opt-in: dgn beberapa replica, hanya 1 instance (atau cron storage-gc) yang menghapus
+	sweeperCtx, stopSweeper := context.WithCancel(ctx)
+	if cfg.Storage.SweepEnabled {
+		sweeperService := service.NewStorageSweeperService(
+			storageResolver,
+			cfg.Storage.SweepGracePeriod,
+			repository.NewCourseRepository(db),
+			repository.NewChapterLessonRepository(db),
+			repository.NewAssignmentSubmissionRepository(db),
+		)
+		go sweeperService.Run(sweeperCtx, cfg.Storage.SweepInterval, cfg.Storage.SweepDryRun)
+		slog.Info("storage sweeper enabled", "interval", cfg.Storage.SweepInterval.String(), "dry_run", cfg.Storage.SweepDryRun)
+	}
+
+	app := fiber.New(fiber.Config{
+		BodyLimit: cfg.REST.BodyLimitBytes(), //? file lesson (video) bisa jauh lebih besar dari default 4MB
+	})
+	app.Use(fibermiddleware.LoggingMiddleware()) //? paling luar: request id & access log
+	app.Use(fibermiddleware.MetricsMiddleware())
+	app.Use(fibermiddleware.TracingMiddleware())
+	app.Use(cors.New(cors.Config{
+		ExposeHeaders: "Accept-Ranges, Content-Range, Content-Length, ETag, Last-Modified, X-Request-Id",
+	}))
+
+	app.Get("/metrics", adaptor.HTTPHandler(metrics.Handler())) //? Prometheus scrape
+
+	//? readiness probe: 503 jika DB tidak bisa di-ping
+	app.Get("/healthz", func(c *fiber.Ctx) error {
+		if err := health.Ping(c.UserContext(), db, cfg.Database.PingTimeout); err != nil {
+			logger.FromContext(c.UserContext()).Warn("database ping failed", "error", err)
+			return c.Status(fiber.StatusServiceUnavailable).SendString("NOT_SERVING")
+		}
+		return c.SendString("SERVING")
+	})
 
-	app.Get("/storage/:course_id/course/:filename", handleGetFileName)
+	storageHandler := handler.NewStorageHandler(storageResolver, cfg.Storage.SigningSecret, cfg.JWT.Secret, repository.NewCourseRepository(db))
 
-	app.Post("/course/upload", handler.UploadCourseImageHandler)
+	app.Get("/storage/:course_id/course/:filename", storageHandler.GetCourseImage)        //? cover course tetap public
+	app.Get("/storage/:course_id/lesson/:filename", storageHandler.GetLessonFile)         //? wajib signed URL
+	app.Get("/storage/:course_id/submission/:filename", storageHandler.GetSubmissionFile) //? wajib signed URL
 
-	app.Listen(":3000")
+	app.Post("/course/upload", fibermiddleware.UploadMetricsMiddleware("course"), storageHandler.UploadCourseImage)
+	app.Post("/lesson/upload", fibermiddleware.UploadMetricsMiddleware("lesson"), storageHandler.UploadLessonFile)
+	app.Post("/lesson/caption/upload", fibermiddleware.UploadMetricsMiddleware("caption"), storageHandler.UploadLessonCaption)
+	app.Post("/assignment/upload", fibermiddleware.UploadMetricsMiddleware("submission"), storageHandler.UploadSubmissionFile)
+
+	go func() {
+		slog.Info("rest server is running", "addr", cfg.REST.Addr())
+		if err := app.Listen(cfg.REST.Addr()); err != nil {
+			slog.Error("rest server stopped", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	//* graceful shutdown: tunggu SIGTERM (deploy) / SIGINT (ctrl+c)
+	signalCtx, stopSignal := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignal()
+	<-signalCtx.Done()
+	slog.Info("shutting down", "timeout", cfg.REST.ShutdownTimeout.String())
+
+	stopSweeper()
+
+	//? upload / download yang sedang berjalan ditunggu sampai timeout
+	if err := app.ShutdownWithTimeout(cfg.REST.ShutdownTimeout); err != nil {
+		slog.Error("rest server shutdown failed", "error", err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.REST.ShutdownTimeout)
+	defer cancel()
+	if err := database.WaitPostCommitActions(shutdownCtx); err != nil {
+		slog.Error("post-commit actions not finished", "error", err)
+	}
+	if err := db.Close(); err != nil {
+		slog.Error("failed to close database", "error", err)
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
+	}
 
+	slog.Info("server stopped")
 }
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+)
+
+// storage-gc menjalankan storage sweeper satu kali (one-shot), contoh:
+//
+//	go run cmd/storage-gc/main.go -dry-run
+//	go run cmd/storage-gc/main.go -grace=48h
+func main() {
+	cfg := config.MustLoadWith((*config.Config).ValidateStorageSweep) //? tanpa JWT / SMTP / signing secret
+	logger.Setup(cfg.Environment)
+
+	dryRun := flag.Bool("dry-run", false, "hanya laporkan file orphan tanpa menghapus")
+	gracePeriod := flag.Duration("grace", cfg.Storage.SweepGracePeriod, "umur minimal file orphan sebelum dihapus")
+	storageRoot := flag.String("storage", cfg.Storage.Root, "folder root storage")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	db := database.ConnectDB(ctx, cfg.Database.URI)
+	defer db.Close()
+
+	storageResolver, err := storage.NewResolver(*storageRoot)
+	if err != nil {
+		slog.Error("invalid storage root", "root", *storageRoot, "error", err)
+		os.Exit(1)
+	}
+
+	sweeper := service.NewStorageSweeperService(
+		storageResolver,
+		*gracePeriod,
+		repository.NewCourseRepository(db),
+		repository.NewChapterLessonRepository(db),
+		repository.NewAssignmentSubmissionRepository(db),
+	)
+
+	report, err := sweeper.Sweep(ctx, *dryRun)
+	if err != nil {
+		slog.Error("storage sweep failed", "error", err)
+		os.Exit(1)
+	}
+
+	service.LogSweepReport(report)
+
+	if len(report.Errors) > 0 {
+		os.Exit(1)
+	}
+}
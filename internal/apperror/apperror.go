@@ -0,0 +1,131 @@
+package apperror
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error adalah error domain (bisnis) yang dikembalikan service, diterjemahkan ke gRPC status
+// oleh ErrorMiddleware. Error lain (DB, IO, dll) tetap dianggap Internal.
+type Error struct {
+	code            codes.Code
+	message         string
+	fieldViolations []FieldViolation
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+	RuleId      string //? opsional, id rule protovalidate (dikirim sbg reason)
+}
+
+func newError(code codes.Code, message string) *Error {
+	return &Error{
+		code:    code,
+		message: message,
+	}
+}
+
+func InvalidArgument(message string) *Error {
+	return newError(codes.InvalidArgument, message)
+}
+
+func NotFound(message string) *Error {
+	return newError(codes.NotFound, message)
+}
+
+func AlreadyExists(message string) *Error {
+	return newError(codes.AlreadyExists, message)
+}
+
+func PermissionDenied(message string) *Error {
+	return newError(codes.PermissionDenied, message)
+}
+
+func FailedPrecondition(message string) *Error {
+	return newError(codes.FailedPrecondition, message)
+}
+
+func ResourceExhausted(message string) *Error {
+	return newError(codes.ResourceExhausted, message)
+}
+
+// WithFieldViolation menambahkan detail field yang salah (dikirim sbg errdetails.BadRequest)
+func (e *Error) WithFieldViolation(field string, description string) *Error {
+	e.fieldViolations = append(e.fieldViolations, FieldViolation{Field: field, Description: description})
+	return e
+}
+
+func (e *Error) WithFieldViolations(violations []FieldViolation) *Error {
+	e.fieldViolations = append(e.fieldViolations, violations...)
+	return e
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Code() codes.Code {
+	return e.code
+}
+
+func (e *Error) Message() string {
+	return e.message
+}
+
+func (e *Error) FieldViolations() []FieldViolation {
+	return e.fieldViolations
+}
+
+// HTTPStatusCode dipakai utk mengisi BaseResponse.status_code (mode legacy)
+func (e *Error) HTTPStatusCode() int64 {
+	switch e.code {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	default:
+		//? AlreadyExists & FailedPrecondition dulunya juga dikirim sbg 400
+		return http.StatusBadRequest
+	}
+}
+
+// GRPCStatus membuat error ini dikenali status.FromError / status.Code
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.code, e.message)
+	if len(e.fieldViolations) == 0 {
+		return st
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range e.fieldViolations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+			Reason:      v.RuleId,
+		})
+	}
+
+	withDetails, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// As mengambil *Error dari rantai error (errors.As)
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+
+	return nil, false
+}
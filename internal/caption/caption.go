@@ -0,0 +1,275 @@
+// Package caption membaca file caption WebVTT / SRT, mengecek timing cue dan menulis ulang sbg WebVTT
+// (format yang dipakai player <track>). Teks cue juga dipakai utk index pencarian transcript.
+package caption
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatVTT = ".vtt"
+	FormatSRT = ".srt"
+)
+
+// Cue: satu potongan caption, Settings hanya ada di WebVTT (position, align, dll)
+type Cue struct {
+	Id       string
+	Start    time.Duration
+	End      time.Duration
+	Settings string
+	Text     string
+}
+
+// Error menunjuk baris file caption yang tidak valid
+type Error struct {
+	Line    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// timestamp WebVTT: [hh:]mm:ss.ttt, SRT: hh:mm:ss,ttt (koma & titik sama-sama diterima)
+var timestampPattern = regexp.MustCompile(`^(?:(\d+):)?([0-5]\d):([0-5]\d)[.,](\d{3})$`)
+
+// maxHours: batas jam timestamp agar tidak overflow time.Duration (video lesson maksimal 24 jam)
+const maxHours = 999
+
+// tag WebVTT (<i>, <v Name>, <00:01.000>) & override SSA di SRT ({\an8})
+var markupPattern = regexp.MustCompile(`<[^>]*>|\{\\[^}]*\}`)
+
+// Parse membaca caption sesuai format (ekstensi file), cue sudah divalidasi
+func Parse(data []byte, format string) ([]Cue, error) {
+	switch strings.ToLower(format) {
+	case FormatVTT:
+		return ParseVTT(data)
+	case FormatSRT:
+		return ParseSRT(data)
+	}
+
+	return nil, fmt.Errorf("unsupported caption format %q", format)
+}
+
+// ParseVTT: blok NOTE, STYLE & REGION dilewati
+func ParseVTT(data []byte) ([]Cue, error) {
+	blocks := splitBlocks(data)
+	if len(blocks) == 0 || !isVTTHeader(blocks[0].lines[0]) {
+		return nil, &Error{Line: 1, Message: "missing WEBVTT header"}
+	}
+
+	var cues []Cue
+	var lines []int
+	for _, b := range blocks[1:] {
+		first := b.lines[0]
+		if first == "NOTE" || strings.HasPrefix(first, "NOTE ") || strings.HasPrefix(first, "NOTE\t") || first == "STYLE" || first == "REGION" {
+			continue
+		}
+
+		cue, line, err := parseCueBlock(b, false)
+		if err != nil {
+			return nil, err
+		}
+		cues = append(cues, cue)
+		lines = append(lines, line)
+	}
+
+	if err := validate(cues, lines); err != nil {
+		return nil, err
+	}
+
+	return cues, nil
+}
+
+// ParseSRT: nomor urut cue opsional (banyak tool menulisnya tidak berurutan)
+func ParseSRT(data []byte) ([]Cue, error) {
+	blocks := splitBlocks(data)
+
+	cues := make([]Cue, 0, len(blocks))
+	lines := make([]int, 0, len(blocks))
+	for _, b := range blocks {
+		cue, line, err := parseCueBlock(b, true)
+		if err != nil {
+			return nil, err
+		}
+		cues = append(cues, cue)
+		lines = append(lines, line)
+	}
+
+	if err := validate(cues, lines); err != nil {
+		return nil, err
+	}
+
+	return cues, nil
+}
+
+// WriteVTT menulis cue sbg file WebVTT
+func WriteVTT(cues []Cue) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("WEBVTT\n")
+
+	for _, cue := range cues {
+		buf.WriteString("\n")
+		if cue.Id != "" {
+			buf.WriteString(cue.Id + "\n")
+		}
+		buf.WriteString(formatTimestamp(cue.Start) + " --> " + formatTimestamp(cue.End))
+		if cue.Settings != "" {
+			buf.WriteString(" " + cue.Settings)
+		}
+		buf.WriteString("\n")
+		//? "-->" di teks membuat cue salah dibaca player
+		buf.WriteString(strings.ReplaceAll(cue.Text, "-->", "->") + "\n")
+	}
+
+	return buf.Bytes()
+}
+
+// PlainText: isi cue tanpa tag & entity HTML, baris digabung dgn spasi (utk transcript / pencarian)
+func PlainText(cue Cue) string {
+	text := html.UnescapeString(markupPattern.ReplaceAllString(cue.Text, ""))
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+type block struct {
+	line  int //? nomor baris pertama blok (1-based)
+	lines []string
+}
+
+// splitBlocks memisahkan file per baris kosong (BOM & CRLF dinormalisasi)
+func splitBlocks(data []byte) []block {
+	content := strings.TrimPrefix(string(data), "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	var blocks []block
+	var current *block
+	for i, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			blocks = append(blocks, block{line: i + 1})
+			current = &blocks[len(blocks)-1]
+		}
+		current.lines = append(current.lines, strings.TrimRight(line, " \t"))
+	}
+
+	return blocks
+}
+
+func isVTTHeader(line string) bool {
+	return line == "WEBVTT" || strings.HasPrefix(line, "WEBVTT ") || strings.HasPrefix(line, "WEBVTT\t")
+}
+
+// parseCueBlock mengembalikan cue beserta nomor baris timing-nya
+func parseCueBlock(b block, srt bool) (Cue, int, error) {
+	var cue Cue
+
+	timingIndex := 0
+	if !strings.Contains(b.lines[0], "-->") {
+		if len(b.lines) < 2 || !strings.Contains(b.lines[1], "-->") {
+			return cue, 0, &Error{Line: b.line, Message: "missing cue timing"}
+		}
+		timingIndex = 1
+		if !srt {
+			cue.Id = b.lines[0]
+		}
+	}
+
+	lineNo := b.line + timingIndex
+	start, end, settings, err := parseTiming(b.lines[timingIndex])
+	if err != nil {
+		return cue, 0, &Error{Line: lineNo, Message: err.Error()}
+	}
+	if srt && settings != "" {
+		settings = "" //? koordinat SRT (X1: Y1:) tidak punya padanan di WebVTT
+	}
+
+	cue.Start = start
+	cue.End = end
+	cue.Settings = settings
+	cue.Text = strings.Join(b.lines[timingIndex+1:], "\n")
+	if strings.TrimSpace(cue.Text) == "" {
+		return cue, 0, &Error{Line: lineNo, Message: "cue has no text"}
+	}
+
+	return cue, lineNo, nil
+}
+
+func parseTiming(line string) (time.Duration, time.Duration, string, error) {
+	left, right, ok := strings.Cut(line, "-->")
+	if !ok {
+		return 0, 0, "", fmt.Errorf("invalid cue timing %q", line)
+	}
+
+	start, err := parseTimestamp(strings.TrimSpace(left))
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	fields := strings.Fields(right)
+	if len(fields) == 0 {
+		return 0, 0, "", fmt.Errorf("missing cue end time")
+	}
+	end, err := parseTimestamp(fields[0])
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	return start, end, strings.Join(fields[1:], " "), nil
+}
+
+func parseTimestamp(value string) (time.Duration, error) {
+	match := timestampPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("invalid timestamp %q", value)
+	}
+
+	var hours int64
+	if match[1] != "" {
+		h, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || h > maxHours {
+			return 0, fmt.Errorf("invalid timestamp %q", value)
+		}
+		hours = h
+	}
+	minutes, _ := strconv.ParseInt(match[2], 10, 64)
+	seconds, _ := strconv.ParseInt(match[3], 10, 64)
+	millis, _ := strconv.ParseInt(match[4], 10, 64)
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second + time.Duration(millis)*time.Millisecond, nil
+}
+
+func formatTimestamp(d time.Duration) string {
+	millis := d.Milliseconds()
+
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", millis/3600000, millis/60000%60, millis/1000%60, millis%1000)
+}
+
+// validate: minimal satu cue, end setelah start, dan cue urut berdasarkan start (aturan WebVTT)
+func validate(cues []Cue, lines []int) error {
+	if len(cues) == 0 {
+		return &Error{Line: 1, Message: "caption has no cues"}
+	}
+
+	for i, cue := range cues {
+		if cue.End <= cue.Start {
+			return &Error{Line: lines[i], Message: "cue end time must be after start time"}
+		}
+		if i > 0 && cue.Start < cues[i-1].Start {
+			return &Error{Line: lines[i], Message: "cues must be ordered by start time"}
+		}
+	}
+
+	return nil
+}
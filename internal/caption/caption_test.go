@@ -0,0 +1,124 @@
+package caption
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSRTConvertsToVTT(t *testing.T) {
+	srt := "\ufeff1\r\n00:00:01,000 --> 00:00:03,500\r\nHalo <i>semua</i>\r\n\r\n" +
+		"2\r\n00:00:04,000 --> 00:00:06,000 X1:10 X2:20\r\nBaris satu\r\nbaris dua\r\n"
+
+	cues, err := ParseSRT([]byte(srt))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "WEBVTT\n\n" +
+		"00:00:01.000 --> 00:00:03.500\nHalo <i>semua</i>\n\n" +
+		"00:00:04.000 --> 00:00:06.000\nBaris satu\nbaris dua\n"
+	if got := string(WriteVTT(cues)); got != want {
+		t.Errorf("vtt =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := PlainText(cues[0]); got != "Halo semua" {
+		t.Errorf("plain text = %q, want %q", got, "Halo semua")
+	}
+	if got := PlainText(cues[1]); got != "Baris satu baris dua" {
+		t.Errorf("plain text = %q, want %q", got, "Baris satu baris dua")
+	}
+}
+
+func TestParseVTT(t *testing.T) {
+	vtt := "WEBVTT - lesson 1\n\nNOTE dibuat manual\n\nSTYLE\n::cue { color: yellow }\n\n" +
+		"intro\n01:02.500 --> 01:04.000 align:start\n<v Budi>Selamat &amp; datang</v>\n"
+
+	cues, err := ParseVTT([]byte(vtt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cues) != 1 {
+		t.Fatalf("cues = %d, want 1", len(cues))
+	}
+
+	cue := cues[0]
+	if cue.Id != "intro" || cue.Start != 62500*time.Millisecond || cue.End != 64*time.Second || cue.Settings != "align:start" {
+		t.Errorf("cue = %+v", cue)
+	}
+	if got := PlainText(cue); got != "Selamat & datang" {
+		t.Errorf("plain text = %q", got)
+	}
+}
+
+func TestParseRejectsInvalidTiming(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		data     string
+		wantLine int
+	}{
+		{
+			name:     "missing header",
+			format:   FormatVTT,
+			data:     "00:01.000 --> 00:02.000\nhalo\n",
+			wantLine: 1,
+		},
+		{
+			name:     "end before start",
+			format:   FormatSRT,
+			data:     "1\n00:00:05,000 --> 00:00:04,000\nhalo\n",
+			wantLine: 2,
+		},
+		{
+			name:     "end equals start",
+			format:   FormatVTT,
+			data:     "WEBVTT\n\n00:01.000 --> 00:01.000\nhalo\n",
+			wantLine: 3,
+		},
+		{
+			name:     "cues out of order",
+			format:   FormatSRT,
+			data:     "1\n00:00:05,000 --> 00:00:06,000\nsatu\n\n2\n00:00:01,000 --> 00:00:02,000\ndua\n",
+			wantLine: 6,
+		},
+		{
+			name:     "invalid timestamp",
+			format:   FormatSRT,
+			data:     "1\n00:00:61,000 --> 00:00:62,000\nhalo\n",
+			wantLine: 2,
+		},
+		{
+			name:     "missing timing",
+			format:   FormatSRT,
+			data:     "1\nhalo\n",
+			wantLine: 1,
+		},
+		{
+			name:     "cue without text",
+			format:   FormatVTT,
+			data:     "WEBVTT\n\n00:01.000 --> 00:02.000\n",
+			wantLine: 3,
+		},
+		{
+			name:     "no cues",
+			format:   FormatVTT,
+			data:     "WEBVTT\n",
+			wantLine: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data), tt.format)
+
+			var captionErr *Error
+			if !errors.As(err, &captionErr) {
+				t.Fatalf("err = %v, want *caption.Error", err)
+			}
+			if captionErr.Line != tt.wantLine {
+				t.Errorf("line = %d, want %d (%v)", captionErr.Line, tt.wantLine, err)
+			}
+		})
+	}
+}
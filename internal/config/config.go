@@ -0,0 +1,425 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	EnvironmentDev  = "dev"
+	EnvironmentStag = "stag"
+	EnvironmentProd = "prod"
+)
+
+// defaultConfigFile dibaca jika ada (opsional), lokasi lain bisa lewat env CONFIG_FILE
+const defaultConfigFile = "config.yaml"
+
+// minimal panjang secret di prod (HS256 butuh key >= 256 bit)
+const minProdSecretLength = 32
+
+type Config struct {
+	Environment string         `yaml:"environment"`
+	GRPC        GRPCConfig     `yaml:"grpc"`
+	REST        RESTConfig     `yaml:"rest"`
+	Gateway     GatewayConfig  `yaml:"gateway"`
+	Database    DatabaseConfig `yaml:"database"`
+	JWT         JWTConfig      `yaml:"jwt"`
+	Cache       CacheConfig    `yaml:"cache"`
+	SMTP        SMTPConfig     `yaml:"smtp"`
+	Storage     StorageConfig  `yaml:"storage"`
+	Tracing     TracingConfig  `yaml:"tracing"`
+	Public      PublicConfig   `yaml:"public"`
+	Trash       TrashConfig    `yaml:"trash"`
+}
+
+type GRPCConfig struct {
+	Port             int  `yaml:"port"`
+	EnableReflection bool `yaml:"enable_reflection"`
+	// LegacyBaseResponseErrors: flag transisi, error domain tetap dikirim di BaseResponse (gRPC OK)
+	// dan bukan sbg gRPC status code. Matikan setelah semua client membaca status code.
+	LegacyBaseResponseErrors bool `yaml:"legacy_base_response_errors"`
+	// ShutdownTimeout: batas waktu GracefulStop menunggu request yang sedang berjalan, lewat dari itu dipaksa Stop
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+type RESTConfig struct {
+	Port            int           `yaml:"port"`
+	BodyLimitMB     int           `yaml:"body_limit_mb"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// GatewayConfig: port HTTP utk gRPC-Web & REST/JSON (grpc-gateway), dilayani oleh proses gRPC yang sama
+type GatewayConfig struct {
+	Port int `yaml:"port"`
+}
+
+type DatabaseConfig struct {
+	URI string `yaml:"uri"`
+	// PingInterval & PingTimeout dipakai health check (readiness) utk memantau koneksi DB
+	PingInterval time.Duration `yaml:"ping_interval"`
+	PingTimeout  time.Duration `yaml:"ping_timeout"`
+}
+
+type JWTConfig struct {
+	Secret string        `yaml:"secret"`
+	TTL    time.Duration `yaml:"ttl"`
+}
+
+// CacheConfig: cache in-memory (token yang sudah logout)
+type CacheConfig struct {
+	DefaultExpiration time.Duration `yaml:"default_expiration"`
+	CleanupInterval   time.Duration `yaml:"cleanup_interval"`
+}
+
+type SMTPConfig struct {
+	Host               string `yaml:"host"`
+	Port               int    `yaml:"port"`
+	Username           string `yaml:"username"`
+	Password           string `yaml:"password"`
+	From               string `yaml:"from"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+type StorageConfig struct {
+	Root             string        `yaml:"root"`
+	ServiceURL       string        `yaml:"service_url"`
+	SigningSecret    string        `yaml:"signing_secret"`
+	SignedURLTTL     time.Duration `yaml:"signed_url_ttl"`
+	SweepEnabled     bool          `yaml:"sweep_enabled"` //? hanya 1 instance REST (atau cron storage-gc) yang boleh menghapus
+	SweepInterval    time.Duration `yaml:"sweep_interval"`
+	SweepGracePeriod time.Duration `yaml:"sweep_grace_period"`
+	SweepDryRun      bool          `yaml:"sweep_dry_run"`
+}
+
+// exporter tracing OpenTelemetry
+const (
+	TracingExporterNone   = "none"
+	TracingExporterStdout = "stdout"
+	TracingExporterOTLP   = "otlp"
+)
+
+type TracingConfig struct {
+	Exporter string `yaml:"exporter"`
+	// OTLPEndpoint host:port collector (gRPC), kosong = default exporter / env OTEL_EXPORTER_OTLP_ENDPOINT
+	OTLPEndpoint string  `yaml:"otlp_endpoint"`
+	OTLPInsecure bool    `yaml:"otlp_insecure"`
+	SampleRatio  float64 `yaml:"sample_ratio"`
+}
+
+// PublicConfig: katalog publik tanpa token, rate limit per IP (request per detik & burst)
+type PublicConfig struct {
+	RateLimit float64 `yaml:"rate_limit"`
+	RateBurst int     `yaml:"rate_burst"`
+}
+
+// TrashConfig: course / chapter / lesson yang di-soft delete dihapus permanen setelah Retention, dicek setiap PurgeInterval
+type TrashConfig struct {
+	Retention     time.Duration `yaml:"retention"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+func (c GRPCConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func (c RESTConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func (c GatewayConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func (c RESTConfig) BodyLimitBytes() int {
+	return c.BodyLimitMB * 1024 * 1024
+}
+
+func (c *Config) IsDev() bool {
+	return c.Environment == EnvironmentDev
+}
+
+// Load menyusun config dgn urutan prioritas: env (termasuk .env) > file YAML > default per environment,
+// lalu memvalidasi seluruh config (dipakai server)
+func Load() (*Config, error) {
+	return LoadWith((*Config).Validate)
+}
+
+// LoadWith sama dgn Load, tetapi validasinya dipilih pemanggil.
+// CLI (migrate, storage-gc) cukup memvalidasi bagian config yang dipakai, tanpa JWT / SMTP / signing secret.
+func LoadWith(validate func(*Config) error) (*Config, error) {
+	//? .env tidak menimpa env yang sudah di-set (misal dari docker / CI)
+	err := godotenv.Load()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("load .env: %w", err)
+	}
+
+	fileContent, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	//* 1. tentukan environment dulu, karena default-nya berbeda per environment
+	environment := os.Getenv("ENVIRONMENT")
+	if environment == "" && fileContent != nil {
+		var peek struct {
+			Environment string `yaml:"environment"`
+		}
+		if err := yaml.Unmarshal(fileContent, &peek); err != nil {
+			return nil, fmt.Errorf("parse config file: %w", err)
+		}
+		environment = peek.Environment
+	}
+	if environment == "" {
+		environment = EnvironmentDev
+	}
+
+	cfg, err := defaults(environment)
+	if err != nil {
+		return nil, err
+	}
+
+	//* 2. file YAML menimpa default
+	if fileContent != nil {
+		if err := yaml.Unmarshal(fileContent, cfg); err != nil {
+			return nil, fmt.Errorf("parse config file: %w", err)
+		}
+		cfg.Environment = environment
+	}
+
+	//* 3. env menimpa file YAML
+	if err := applyEnv(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := validate(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// MustLoad dipakai di cmd, aplikasi tidak boleh jalan dgn config yang tidak valid
+func MustLoad() *Config {
+	return MustLoadWith((*Config).Validate)
+}
+
+// MustLoadWith: MustLoad dgn validasi pilihan (lihat LoadWith)
+func MustLoadWith(validate func(*Config) error) *Config {
+	cfg, err := LoadWith(validate)
+	if err != nil {
+		log.Fatalf("invalid config: %v", err)
+	}
+
+	return cfg
+}
+
+func readConfigFile() ([]byte, error) {
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		content, err := os.ReadFile(defaultConfigFile)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return content, err
+	}
+
+	//? jika CONFIG_FILE di-set, file-nya wajib ada
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+
+	return content, nil
+}
+
+func defaults(environment string) (*Config, error) {
+	cfg := &Config{
+		Environment: environment,
+		GRPC: GRPCConfig{
+			Port:                     50051,
+			LegacyBaseResponseErrors: true,
+			ShutdownTimeout:          30 * time.Second,
+		},
+		REST: RESTConfig{
+			Port:            3000,
+			BodyLimitMB:     512, //? file lesson (video) bisa jauh lebih besar dari default 4MB
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Gateway: GatewayConfig{
+			Port: 8080, //? port yang dulu dipakai grpcwebproxy
+		},
+		Database: DatabaseConfig{
+			PingInterval: 10 * time.Second,
+			PingTimeout:  2 * time.Second,
+		},
+		JWT: JWTConfig{
+			TTL: 244 * time.Hour,
+		},
+		Cache: CacheConfig{
+			DefaultExpiration: 244 * time.Hour,
+			CleanupInterval:   244 * time.Hour,
+		},
+		SMTP: SMTPConfig{
+			Port: 587,
+			From: "admin@myapp.dev",
+		},
+		Storage: StorageConfig{
+			Root:             "storage",
+			SignedURLTTL:     time.Hour,
+			SweepInterval:    6 * time.Hour,
+			SweepGracePeriod: 24 * time.Hour,
+		},
+		Tracing: TracingConfig{
+			Exporter:    TracingExporterOTLP,
+			SampleRatio: 1,
+		},
+		Public: PublicConfig{
+			RateLimit: 5,
+			RateBurst: 20, //? 1 halaman marketing bisa memuat beberapa preview sekaligus
+		},
+		Trash: TrashConfig{
+			Retention:     30 * 24 * time.Hour,
+			PurgeInterval: time.Hour,
+		},
+	}
+
+	switch environment {
+	case EnvironmentDev:
+		cfg.GRPC.EnableReflection = true
+		cfg.SMTP.Host = "sandbox.smtp.mailtrap.io"
+		cfg.SMTP.Port = 2525
+		cfg.SMTP.InsecureSkipVerify = true //? Mailtrap biasanya bekerja dengan InsecureSkipVerify: true di tahap dev
+		cfg.Storage.ServiceURL = "http://localhost:3000/storage"
+		cfg.Storage.SweepDryRun = true
+		cfg.Tracing.Exporter = TracingExporterStdout
+	case EnvironmentStag, EnvironmentProd:
+		//? tanpa default: host SMTP & URL storage wajib di-set per environment
+	default:
+		return nil, fmt.Errorf("invalid ENVIRONMENT %q (must be %s, %s or %s)", environment, EnvironmentDev, EnvironmentStag, EnvironmentProd)
+	}
+
+	return cfg, nil
+}
+
+// Validate mengumpulkan semua kesalahan config sekaligus, agar tidak perlu restart berkali-kali
+func (c *Config) Validate() error {
+	var errs []error
+
+	required := map[string]string{
+		"DB_URI":                 c.Database.URI,
+		"JWT_SECRET":             c.JWT.Secret,
+		"SMTP_HOST":              c.SMTP.Host,
+		"SMTP_USERNAME":          c.SMTP.Username,
+		"SMTP_PASSWORD":          c.SMTP.Password,
+		"SMTP_FROM":              c.SMTP.From,
+		"STORAGE_ROOT":           c.Storage.Root,
+		"STORAGE_SERVICE_URL":    c.Storage.ServiceURL,
+		"STORAGE_SIGNING_SECRET": c.Storage.SigningSecret,
+	}
+	for _, key := range sortedKeys(required) {
+		if required[key] == "" {
+			errs = append(errs, fmt.Errorf("%s is required", key))
+		}
+	}
+
+	ports := map[string]int{
+		"GRPC_PORT":    c.GRPC.Port,
+		"GATEWAY_PORT": c.Gateway.Port,
+		"REST_PORT":    c.REST.Port,
+		"SMTP_PORT":    c.SMTP.Port,
+	}
+	for _, key := range sortedKeys(ports) {
+		if ports[key] < 1 || ports[key] > 65535 {
+			errs = append(errs, fmt.Errorf("%s must be between 1 and 65535", key))
+		}
+	}
+
+	durations := map[string]time.Duration{
+		"GRPC_SHUTDOWN_TIMEOUT":      c.GRPC.ShutdownTimeout,
+		"REST_SHUTDOWN_TIMEOUT":      c.REST.ShutdownTimeout,
+		"DB_PING_INTERVAL":           c.Database.PingInterval,
+		"DB_PING_TIMEOUT":            c.Database.PingTimeout,
+		"JWT_TTL":                    c.JWT.TTL,
+		"CACHE_DEFAULT_EXPIRATION":   c.Cache.DefaultExpiration,
+		"CACHE_CLEANUP_INTERVAL":     c.Cache.CleanupInterval,
+		"STORAGE_SIGNED_URL_TTL":     c.Storage.SignedURLTTL,
+		"STORAGE_SWEEP_INTERVAL":     c.Storage.SweepInterval,
+		"STORAGE_SWEEP_GRACE_PERIOD": c.Storage.SweepGracePeriod,
+		"TRASH_RETENTION":            c.Trash.Retention,
+		"TRASH_PURGE_INTERVAL":       c.Trash.PurgeInterval,
+	}
+	for _, key := range sortedKeys(durations) {
+		if durations[key] <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be positive", key))
+		}
+	}
+
+	switch c.Tracing.Exporter {
+	case TracingExporterNone, TracingExporterStdout, TracingExporterOTLP:
+	default:
+		errs = append(errs, fmt.Errorf("TRACING_EXPORTER must be %s, %s or %s", TracingExporterNone, TracingExporterStdout, TracingExporterOTLP))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("TRACING_SAMPLE_RATIO must be between 0 and 1"))
+	}
+
+	if c.Public.RateLimit <= 0 {
+		errs = append(errs, errors.New("PUBLIC_RATE_LIMIT must be positive"))
+	}
+	if c.Public.RateBurst < 1 {
+		errs = append(errs, errors.New("PUBLIC_RATE_BURST must be positive"))
+	}
+
+	if c.REST.BodyLimitMB < 1 {
+		errs = append(errs, errors.New("REST_BODY_LIMIT_MB must be positive"))
+	}
+
+	if c.Environment == EnvironmentProd {
+		if len(c.JWT.Secret) < minProdSecretLength {
+			errs = append(errs, fmt.Errorf("JWT_SECRET must be at least %d characters in prod", minProdSecretLength))
+		}
+		if len(c.Storage.SigningSecret) < minProdSecretLength {
+			errs = append(errs, fmt.Errorf("STORAGE_SIGNING_SECRET must be at least %d characters in prod", minProdSecretLength))
+		}
+		if c.SMTP.InsecureSkipVerify {
+			errs = append(errs, errors.New("SMTP_INSECURE_SKIP_VERIFY is not allowed in prod"))
+		}
+		if c.GRPC.EnableReflection {
+			errs = append(errs, errors.New("GRPC_REFLECTION is not allowed in prod"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateDatabase: validasi minimal utk CLI yang hanya butuh koneksi DB (migrate)
+func (c *Config) ValidateDatabase() error {
+	if c.Database.URI == "" {
+		return errors.New("DB_URI is required")
+	}
+
+	return nil
+}
+
+// ValidateStorageSweep: validasi utk CLI storage-gc (DB & folder storage)
+func (c *Config) ValidateStorageSweep() error {
+	var errs []error
+
+	if err := c.ValidateDatabase(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Storage.Root == "" {
+		errs = append(errs, errors.New("STORAGE_ROOT is required"))
+	}
+	if c.Storage.SweepGracePeriod <= 0 {
+		errs = append(errs, errors.New("STORAGE_SWEEP_GRACE_PERIOD must be positive"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// envReader membaca env ke field config, error parsing dikumpulkan agar dilaporkan sekaligus
+type envReader struct {
+	errs []error
+}
+
+func (r *envReader) string(dest *string, key string) {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		*dest = value
+	}
+}
+
+func (r *envReader) int(dest *int, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid integer %q", key, value))
+		return
+	}
+	*dest = n
+}
+
+func (r *envReader) bool(dest *bool, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid boolean %q", key, value))
+		return
+	}
+	*dest = b
+}
+
+func (r *envReader) float(dest *float64, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid number %q", key, value))
+		return
+	}
+	*dest = f
+}
+
+// duration memakai format durasi Go: 30m, 6h, 24h
+func (r *envReader) duration(dest *time.Duration, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid duration %q", key, value))
+		return
+	}
+	*dest = d
+}
+
+func applyEnv(cfg *Config) error {
+	r := &envReader{}
+
+	r.int(&cfg.GRPC.Port, "GRPC_PORT")
+	r.bool(&cfg.GRPC.EnableReflection, "GRPC_REFLECTION")
+	r.bool(&cfg.GRPC.LegacyBaseResponseErrors, "GRPC_LEGACY_BASE_RESPONSE_ERRORS")
+	r.duration(&cfg.GRPC.ShutdownTimeout, "GRPC_SHUTDOWN_TIMEOUT")
+
+	r.int(&cfg.Gateway.Port, "GATEWAY_PORT")
+
+	r.int(&cfg.REST.Port, "REST_PORT")
+	r.int(&cfg.REST.BodyLimitMB, "REST_BODY_LIMIT_MB")
+	r.duration(&cfg.REST.ShutdownTimeout, "REST_SHUTDOWN_TIMEOUT")
+
+	r.string(&cfg.Database.URI, "DB_URI")
+	r.duration(&cfg.Database.PingInterval, "DB_PING_INTERVAL")
+	r.duration(&cfg.Database.PingTimeout, "DB_PING_TIMEOUT")
+
+	r.string(&cfg.JWT.Secret, "JWT_SECRET")
+	r.duration(&cfg.JWT.TTL, "JWT_TTL")
+
+	r.duration(&cfg.Cache.DefaultExpiration, "CACHE_DEFAULT_EXPIRATION")
+	r.duration(&cfg.Cache.CleanupInterval, "CACHE_CLEANUP_INTERVAL")
+
+	r.string(&cfg.SMTP.Host, "SMTP_HOST")
+	r.int(&cfg.SMTP.Port, "SMTP_PORT")
+	r.string(&cfg.SMTP.Username, "SMTP_USERNAME")
+	r.string(&cfg.SMTP.Password, "SMTP_PASSWORD")
+	r.string(&cfg.SMTP.From, "SMTP_FROM")
+	r.bool(&cfg.SMTP.InsecureSkipVerify, "SMTP_INSECURE_SKIP_VERIFY")
+
+	r.string(&cfg.Storage.Root, "STORAGE_ROOT")
+	r.string(&cfg.Storage.ServiceURL, "STORAGE_SERVICE_URL")
+	r.string(&cfg.Storage.SigningSecret, "STORAGE_SIGNING_SECRET")
+	r.duration(&cfg.Storage.SignedURLTTL, "STORAGE_SIGNED_URL_TTL")
+	r.bool(&cfg.Storage.SweepEnabled, "STORAGE_SWEEP_ENABLED")
+	r.duration(&cfg.Storage.SweepInterval, "STORAGE_SWEEP_INTERVAL")
+	r.duration(&cfg.Storage.SweepGracePeriod, "STORAGE_SWEEP_GRACE_PERIOD")
+	r.bool(&cfg.Storage.SweepDryRun, "STORAGE_SWEEP_DRY_RUN")
+
+	r.string(&cfg.Tracing.Exporter, "TRACING_EXPORTER")
+	r.string(&cfg.Tracing.OTLPEndpoint, "TRACING_OTLP_ENDPOINT")
+	r.bool(&cfg.Tracing.OTLPInsecure, "TRACING_OTLP_INSECURE")
+	r.float(&cfg.Tracing.SampleRatio, "TRACING_SAMPLE_RATIO")
+
+	r.float(&cfg.Public.RateLimit, "PUBLIC_RATE_LIMIT")
+	r.int(&cfg.Public.RateBurst, "PUBLIC_RATE_BURST")
+
+	r.duration(&cfg.Trash.Retention, "TRASH_RETENTION")
+	r.duration(&cfg.Trash.PurgeInterval, "TRASH_PURGE_INTERVAL")
+
+	return errors.Join(r.errs...)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type Assignment struct {
+	Id                   string     `db:"id"`
+	LessonId             string     `db:"lesson_id"`
+	CourseId             string     `db:"course_id"`
+	Title                string     `db:"title"`
+	Instructions         string     `db:"instructions"`
+	DueAt                *time.Time `db:"due_at"`
+	MaxScore             int64      `db:"max_score"`
+	AllowLateSubmissions bool       `db:"allow_late_submissions"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	CreatedBy string     `db:"created_by"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	UpdatedBy *string    `db:"updated_by"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	DeletedBy *string    `db:"deleted_by"`
+}
+
+type AssignmentSubmission struct {
+	Id           string         `db:"id"`
+	AssignmentId string         `db:"assignment_id"`
+	CourseId     string         `db:"course_id"`
+	UserId       string         `db:"user_id"`
+	UserName     string         `db:"user_name"`
+	UserEmail    string         `db:"user_email"` //? tujuan notifikasi nilai
+	FileNames    pq.StringArray `db:"file_names"` //? file di storage/<course_id>/submission
+	Comment      *string        `db:"comment"`
+	SubmittedAt  time.Time      `db:"submitted_at"`
+	Late         bool           `db:"late"`
+	Score        *int64         `db:"score"`
+	Feedback     *string        `db:"feedback"`
+	GradedAt     *time.Time     `db:"graded_at"`
+	GradedBy     *string        `db:"graded_by"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
@@ -2,6 +2,23 @@ package entity
 
 import "time"
 
+const (
+	LessonStorageUpload   = "upload"   //? file lesson disimpan di storage/<course_id>/lesson (private, butuh signed URL)
+	LessonStorageExternal = "external" //? url di luar storage (youtube, vimeo, dll)
+
+	LessonKindVideo       = "video"
+	LessonKindArticle     = "article"
+	LessonKindFile        = "file"
+	LessonKindLink        = "link"
+	LessonKindLiveSession = "live_session"
+	LessonKindQuiz        = "quiz"
+	LessonKindAssignment  = "assignment"
+
+	LessonIsPreview = 1
+
+	LessonStatusActive = "active" //? hanya lesson aktif yang tampil di katalog publik
+)
+
 type ChapterLesson struct {
 	Id            string  `db:"id"`
 	InstructorId  *string `db:"instructor_id"`
@@ -20,6 +37,8 @@ type ChapterLesson struct {
 	Downloadable  *string `db:"downloadable"`
 	IsPreview     *int64  `db:"is_preview"`
 	Status        *string `db:"status"`
+	Kind          *string `db:"kind"`
+	Content       *string `db:"content"` //? jsonb, payload sesuai kind (lihat chapter_lesson.LessonContent)
 
 	CreatedAt time.Time  `db:"created_at"`
 	CreatedBy string     `db:"created_by"`
@@ -27,4 +46,6 @@ type ChapterLesson struct {
 	UpdatedBy *string    `db:"updated_by"`
 	DeletedAt *time.Time `db:"deleted_at"`
 	DeletedBy *string    `db:"deleted_by"`
+	//? id course / chapter / lesson yang dihapus langsung sehingga lesson ini ikut terhapus (cascade)
+	DeletedWithId *string `db:"deleted_with_id"`
 }
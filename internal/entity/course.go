@@ -6,6 +6,12 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// course yang tampil di katalog publik: status published (active) & sudah di-approve reviewer
+const (
+	CourseStatusActive = "active"
+	CourseApproved     = "approved"
+)
+
 type Course struct {
 	Id                 string           `db:"id"`
 	Name               string           `db:"name"`
@@ -17,6 +23,7 @@ type Course struct {
 	UpdatedBy          *string          `db:"updated_by"`
 	DeletedAt          *time.Time       `db:"deleted_at"`
 	DeletedBy          *string          `db:"deleted_by"`
+	DeletedWithId      *string          `db:"deleted_with_id"` //? id root delete (cascade), lihat migration 000013
 	Slug               *string          `db:"slug"`
 	InstructorId       *string          `db:"instructor_id"`
 	CategoryId         *string          `db:"category_id"`
@@ -2,6 +2,8 @@ package entity
 
 import "time"
 
+const ChapterStatusActive = "active"
+
 type CourseChapter struct {
 	Id           string `db:"id"`
 	InstructorId string `db:"instructor_id"`
@@ -16,4 +18,6 @@ type CourseChapter struct {
 	UpdatedBy *string    `db:"updated_by"`
 	DeletedAt *time.Time `db:"deleted_at"`
 	DeletedBy *string    `db:"deleted_by"`
+	//? id course / chapter yang dihapus langsung sehingga chapter ini ikut terhapus (cascade)
+	DeletedWithId *string `db:"deleted_with_id"`
 }
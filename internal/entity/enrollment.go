@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type Enrollment struct {
+	Id         string    `db:"id"`
+	UserId     string    `db:"user_id"`
+	CourseId   string    `db:"course_id"`
+	EnrolledAt time.Time `db:"enrolled_at"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	CreatedBy string     `db:"created_by"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	DeletedBy *string    `db:"deleted_by"`
+}
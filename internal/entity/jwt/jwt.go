@@ -3,7 +3,6 @@ package jwt
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/golang-jwt/jwt/v5"
@@ -25,7 +24,7 @@ func (jc *JwtClaims) SetToContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, JwtEntityContextKeyValue, jc)
 }
 
-func GetClaimsFromToken(token string) (*JwtClaims, error) {
+func GetClaimsFromToken(token string, secret string) (*JwtClaims, error) {
 
 	//? kembalikan token tadi hingga menjadi entity jwt
 	tokenClaims, err := jwt.ParseWithClaims(token, &JwtClaims{}, func(t *jwt.Token) (interface{}, error) {
@@ -33,7 +32,7 @@ func GetClaimsFromToken(token string) (*JwtClaims, error) {
 			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
 		}
 
-		return []byte(os.Getenv("JWT_SECRET")), nil
+		return []byte(secret), nil
 	})
 
 	if err != nil {
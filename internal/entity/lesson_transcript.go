@@ -0,0 +1,23 @@
+package entity
+
+// LessonTranscriptCue: satu cue caption video lesson, diturunkan dari file caption saat lesson disimpan
+type LessonTranscriptCue struct {
+	Id       string `db:"id"`
+	LessonId string `db:"lesson_id"`
+	Language string `db:"language"`
+	CueIndex int64  `db:"cue_index"`
+	StartMs  int64  `db:"start_ms"`
+	EndMs    int64  `db:"end_ms"`
+	Text     string `db:"text"`
+}
+
+// LessonTranscriptHit: hasil pencarian transcript, satu cue beserta info lesson-nya
+type LessonTranscriptHit struct {
+	LessonId    string  `db:"lesson_id"`
+	LessonTitle string  `db:"lesson_title"`
+	ChapterId   *string `db:"chapter_id"`
+	Language    string  `db:"language"`
+	StartMs     int64   `db:"start_ms"`
+	EndMs       int64   `db:"end_ms"`
+	Text        string  `db:"text"`
+}
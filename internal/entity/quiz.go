@@ -0,0 +1,65 @@
+package entity
+
+import "time"
+
+const (
+	QuestionTypeSingleChoice   = "single_choice"
+	QuestionTypeMultipleChoice = "multiple_choice"
+	QuestionTypeTrueFalse      = "true_false"
+	QuestionTypeShortAnswer    = "short_answer"
+	QuestionTypeOrdering       = "ordering"
+)
+
+type Quiz struct {
+	Id                  string  `db:"id"`
+	LessonId            string  `db:"lesson_id"`
+	CourseId            string  `db:"course_id"`
+	Title               string  `db:"title"`
+	Description         *string `db:"description"`
+	TimeLimitSeconds    *int64  `db:"time_limit_seconds"`
+	MaxAttempts         *int64  `db:"max_attempts"`
+	PassMarkPercent     int64   `db:"pass_mark_percent"`
+	QuestionsPerAttempt *int64  `db:"questions_per_attempt"`
+	ShuffleQuestions    bool    `db:"shuffle_questions"`
+	ShuffleOptions      bool    `db:"shuffle_options"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	CreatedBy string     `db:"created_by"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	UpdatedBy *string    `db:"updated_by"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	DeletedBy *string    `db:"deleted_by"`
+}
+
+type QuizQuestion struct {
+	Id            string `db:"id"`
+	QuizId        string `db:"quiz_id"`
+	QuestionType  string `db:"question_type"`
+	Prompt        string `db:"prompt"`
+	Points        int64  `db:"points"`
+	OrderQuestion int64  `db:"order_question"`
+	Content       string `db:"content"` //? jsonb, jenis soal & kunci jawaban (lihat quiz.QuestionContent)
+
+	CreatedAt time.Time  `db:"created_at"`
+	CreatedBy string     `db:"created_by"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	UpdatedBy *string    `db:"updated_by"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	DeletedBy *string    `db:"deleted_by"`
+}
+
+type QuizAttempt struct {
+	Id            string     `db:"id"`
+	QuizId        string     `db:"quiz_id"`
+	CourseId      string     `db:"course_id"`
+	UserId        string     `db:"user_id"`
+	AttemptNumber int64      `db:"attempt_number"`
+	Sheet         string     `db:"sheet"` //? jsonb, snapshot soal & jawaban (lihat quiz.QuizAttemptSheet)
+	StartedAt     time.Time  `db:"started_at"`
+	ExpiresAt     *time.Time `db:"expires_at"`
+	SubmittedAt   *time.Time `db:"submitted_at"`
+	Score         *int64     `db:"score"`
+	MaxScore      int64      `db:"max_score"`
+	Passed        bool       `db:"passed"`
+	Expired       bool       `db:"expired"`
+}
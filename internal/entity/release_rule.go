@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+const (
+	ReleaseReasonReleaseAt           = "release_at"
+	ReleaseReasonDaysAfterEnrollment = "days_after_enrollment"
+	ReleaseReasonPrerequisite        = "prerequisite"
+)
+
+// ReleaseRule: aturan rilis chapter / lesson, tepat satu dari ChapterId / LessonId dan satu jenis aturan yang diisi
+type ReleaseRule struct {
+	Id                   string     `db:"id"`
+	ChapterId            *string    `db:"chapter_id"`
+	LessonId             *string    `db:"lesson_id"`
+	ReleaseAt            *time.Time `db:"release_at"` //? waktu lokal (tanpa zona), dibaca sesuai Course.Timezone
+	DaysAfterEnrollment  *int64     `db:"days_after_enrollment"`
+	PrerequisiteLessonId *string    `db:"prerequisite_lesson_id"`
+	PrerequisiteQuizId   *string    `db:"prerequisite_quiz_id"`
+
+	CreatedAt time.Time `db:"created_at"`
+	CreatedBy string    `db:"created_by"`
+}
+
+type LessonCompletion struct {
+	Id          string    `db:"id"`
+	LessonId    string    `db:"lesson_id"`
+	UserId      string    `db:"user_id"`
+	CompletedAt time.Time `db:"completed_at"`
+}
@@ -0,0 +1,118 @@
+package fake
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// AssignmentRepository menyimpan assignment (key: id) di memory
+type AssignmentRepository struct {
+	mu          sync.Mutex
+	assignments map[string]entity.Assignment
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.IAssignmentRepository = (*AssignmentRepository)(nil)
+
+func NewAssignmentRepository() *AssignmentRepository {
+	return &AssignmentRepository{
+		assignments: map[string]entity.Assignment{},
+	}
+}
+
+func (r *AssignmentRepository) WithTransaction(tx *sqlx.Tx) repository.IAssignmentRepository {
+	return r
+}
+
+func (r *AssignmentRepository) AddAssignment(assignment entity.Assignment) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.assignments[assignment.Id] = assignment
+}
+
+// Assignment mengembalikan assignment apa adanya (termasuk yang sudah di-soft delete)
+func (r *AssignmentRepository) Assignment(id string) (entity.Assignment, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	assignment, ok := r.assignments[id]
+	return assignment, ok
+}
+
+func (r *AssignmentRepository) CreateNewAssignment(ctx context.Context, assignment *entity.Assignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+	for _, existing := range r.assignments {
+		if existing.LessonId == assignment.LessonId && existing.DeletedAt == nil {
+			return &pq.Error{Code: "23505", Constraint: repository.AssignmentLessonUniqueIndex}
+		}
+	}
+
+	r.assignments[assignment.Id] = *assignment
+	return nil
+}
+
+func (r *AssignmentRepository) GetAssignmentById(ctx context.Context, assignmentId string) (*entity.Assignment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	assignment, ok := r.assignments[assignmentId]
+	if !ok || assignment.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &assignment, nil
+}
+
+func (r *AssignmentRepository) UpdateAssignment(ctx context.Context, assignment *entity.Assignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	existing, ok := r.assignments[assignment.Id]
+	if !ok || existing.DeletedAt != nil {
+		return nil
+	}
+
+	r.assignments[assignment.Id] = *assignment
+	return nil
+}
+
+func (r *AssignmentRepository) DeleteAssignment(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	assignment, ok := r.assignments[id]
+	if !ok {
+		return nil
+	}
+	assignment.DeletedAt = &deletedAt
+	assignment.DeletedBy = &deletedBy
+	r.assignments[id] = assignment
+
+	return nil
+}
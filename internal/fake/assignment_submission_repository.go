@@ -0,0 +1,226 @@
+package fake
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// AssignmentSubmissionRepository menyimpan submission (key: id) di memory, unique index assignment + user ikut dicek
+type AssignmentSubmissionRepository struct {
+	mu          sync.Mutex
+	submissions map[string]entity.AssignmentSubmission
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.IAssignmentSubmissionRepository = (*AssignmentSubmissionRepository)(nil)
+
+func NewAssignmentSubmissionRepository() *AssignmentSubmissionRepository {
+	return &AssignmentSubmissionRepository{
+		submissions: map[string]entity.AssignmentSubmission{},
+	}
+}
+
+func (r *AssignmentSubmissionRepository) WithTransaction(tx *sqlx.Tx) repository.IAssignmentSubmissionRepository {
+	return r
+}
+
+func (r *AssignmentSubmissionRepository) AddSubmission(submission entity.AssignmentSubmission) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.submissions[submission.Id] = submission
+}
+
+// Submission mengembalikan submission apa adanya
+func (r *AssignmentSubmissionRepository) Submission(id string) (entity.AssignmentSubmission, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	submission, ok := r.submissions[id]
+	return submission, ok
+}
+
+func (r *AssignmentSubmissionRepository) CreateNewSubmission(ctx context.Context, submission *entity.AssignmentSubmission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+	for _, existing := range r.submissions {
+		if existing.AssignmentId == submission.AssignmentId && existing.UserId == submission.UserId {
+			return &pq.Error{Code: "23505", Constraint: repository.AssignmentSubmissionUniqueIndex}
+		}
+	}
+
+	r.submissions[submission.Id] = cloneSubmission(*submission)
+	return nil
+}
+
+func (r *AssignmentSubmissionRepository) GetSubmissionById(ctx context.Context, submissionId string) (*entity.AssignmentSubmission, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	submission, ok := r.submissions[submissionId]
+	if !ok {
+		return nil, nil
+	}
+
+	submission = cloneSubmission(submission)
+	return &submission, nil
+}
+
+func (r *AssignmentSubmissionRepository) GetSubmission(ctx context.Context, assignmentId string, userId string) (*entity.AssignmentSubmission, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	for _, submission := range r.submissions {
+		if submission.AssignmentId == assignmentId && submission.UserId == userId {
+			submission = cloneSubmission(submission)
+			return &submission, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *AssignmentSubmissionRepository) ResubmitSubmission(ctx context.Context, submission *entity.AssignmentSubmission) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return false, r.WriteErr
+	}
+
+	existing, ok := r.submissions[submission.Id]
+	if !ok || existing.GradedAt != nil {
+		return false, nil
+	}
+
+	existing.UserName = submission.UserName
+	existing.UserEmail = submission.UserEmail
+	existing.FileNames = slices.Clone(submission.FileNames)
+	existing.Comment = submission.Comment
+	existing.SubmittedAt = submission.SubmittedAt
+	existing.Late = submission.Late
+	existing.UpdatedAt = submission.UpdatedAt
+	r.submissions[submission.Id] = existing
+
+	return true, nil
+}
+
+func (r *AssignmentSubmissionRepository) GradeSubmission(ctx context.Context, submission *entity.AssignmentSubmission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	existing, ok := r.submissions[submission.Id]
+	if !ok {
+		return nil
+	}
+
+	existing.Score = submission.Score
+	existing.Feedback = submission.Feedback
+	existing.GradedAt = submission.GradedAt
+	existing.GradedBy = submission.GradedBy
+	existing.UpdatedAt = submission.UpdatedAt
+	r.submissions[submission.Id] = existing
+
+	return nil
+}
+
+func (r *AssignmentSubmissionRepository) GetSubmissionsByAssignmentId(ctx context.Context, assignmentId string, userId *string) ([]*entity.AssignmentSubmission, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var submissions []*entity.AssignmentSubmission
+	for _, submission := range r.submissions {
+		if submission.AssignmentId == assignmentId && (userId == nil || submission.UserId == *userId) {
+			submission = cloneSubmission(submission)
+			submissions = append(submissions, &submission)
+		}
+	}
+	sort.Slice(submissions, func(i, j int) bool {
+		if !submissions[i].SubmittedAt.Equal(submissions[j].SubmittedAt) {
+			return submissions[i].SubmittedAt.Before(submissions[j].SubmittedAt)
+		}
+		return submissions[i].Id < submissions[j].Id
+	})
+
+	return submissions, nil
+}
+
+func (r *AssignmentSubmissionRepository) GetAllSubmissionFiles(ctx context.Context) ([]*entity.AssignmentSubmission, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var submissions []*entity.AssignmentSubmission
+	for _, submission := range r.submissions {
+		submissions = append(submissions, &entity.AssignmentSubmission{
+			Id:        submission.Id,
+			CourseId:  submission.CourseId,
+			FileNames: slices.Clone(submission.FileNames),
+		})
+	}
+
+	return submissions, nil
+}
+
+func (r *AssignmentSubmissionRepository) GetSubmissionsByFileNames(ctx context.Context, courseId string, fileNames []string) ([]*entity.AssignmentSubmission, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var submissions []*entity.AssignmentSubmission
+	for _, submission := range r.submissions {
+		if submission.CourseId != courseId || !slices.ContainsFunc(submission.FileNames, func(fileName string) bool { return slices.Contains(fileNames, fileName) }) {
+			continue
+		}
+		submissions = append(submissions, &entity.AssignmentSubmission{
+			Id:        submission.Id,
+			CourseId:  submission.CourseId,
+			UserId:    submission.UserId,
+			FileNames: slices.Clone(submission.FileNames),
+		})
+	}
+	sort.Slice(submissions, func(i, j int) bool { return submissions[i].Id < submissions[j].Id })
+
+	return submissions, nil
+}
+
+// cloneSubmission: file_names disalin agar perubahan di service tidak mengubah isi "DB"
+func cloneSubmission(submission entity.AssignmentSubmission) entity.AssignmentSubmission {
+	submission.FileNames = slices.Clone(submission.FileNames)
+	return submission
+}
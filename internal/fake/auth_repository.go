@@ -0,0 +1,165 @@
+// Package fake berisi implementasi in-memory dari repository & sender, dipakai oleh test service dan handler.
+package fake
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+)
+
+// AuthRepository menyimpan user (key: email) & OTP (key: email) di memory
+type AuthRepository struct {
+	mu    sync.Mutex
+	users map[string]entity.Users
+	otps  map[string]entity.UserOTP
+
+	ReadErr  error //? jika diisi, semua method baca mengembalikan error ini (simulasi DB down)
+	WriteErr error //? jika diisi, semua method tulis mengembalikan error ini
+}
+
+var _ repository.IAuthRepository = (*AuthRepository)(nil)
+
+func NewAuthRepository() *AuthRepository {
+	return &AuthRepository{
+		users: map[string]entity.Users{},
+		otps:  map[string]entity.UserOTP{},
+	}
+}
+
+// AddUser menambahkan user langsung (seed data test)
+func (r *AuthRepository) AddUser(user entity.Users) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[user.Email] = user
+}
+
+// User mengembalikan user apa adanya (termasuk yang sudah di-soft delete)
+func (r *AuthRepository) User(email string) (entity.Users, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[email]
+	return user, ok
+}
+
+// OTP mengembalikan OTP yang tersimpan utk email
+func (r *AuthRepository) OTP(email string) (entity.UserOTP, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	otp, ok := r.otps[email]
+	return otp, ok
+}
+
+func (r *AuthRepository) GetUserByEmail(ctx context.Context, email string) (*entity.Users, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	user, ok := r.users[email]
+	if !ok || user.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &user, nil
+}
+
+func (r *AuthRepository) InsertUser(ctx context.Context, user *entity.Users) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	r.users[user.Email] = *user
+	return nil
+}
+
+func (r *AuthRepository) UpdateUserPassword(ctx context.Context, userId string, hashedPassword string, updatedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	for email, user := range r.users {
+		if user.Id == userId {
+			user.Password = hashedPassword
+			user.UpdatedAt = time.Now()
+			user.UpdatedBy = &updatedBy
+			r.users[email] = user
+		}
+	}
+
+	return nil
+}
+
+func (r *AuthRepository) MarkAsVerified(ctx context.Context, userId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	for email, user := range r.users {
+		if user.Id == userId {
+			now := time.Now()
+			user.VerifiedAt = &now
+			r.users[email] = user
+		}
+	}
+
+	return nil
+}
+
+func (r *AuthRepository) UpsertOTP(ctx context.Context, otp *entity.UserOTP) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	r.otps[otp.Email] = *otp
+	return nil
+}
+
+// GetOTPByEmail sama dgn repository asli: sql.ErrNoRows jika OTP tidak ada
+func (r *AuthRepository) GetOTPByEmail(ctx context.Context, email string) (*entity.UserOTP, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	otp, ok := r.otps[email]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &otp, nil
+}
+
+func (r *AuthRepository) DeleteOTP(ctx context.Context, email string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	delete(r.otps, email)
+	return nil
+}
@@ -0,0 +1,480 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ChapterLessonRepository menyimpan lesson (key: id) di memory. Field mask diabaikan.
+type ChapterLessonRepository struct {
+	mu      sync.Mutex
+	lessons map[string]entity.ChapterLesson
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.IChapterLessonRepository = (*ChapterLessonRepository)(nil)
+
+func NewChapterLessonRepository() *ChapterLessonRepository {
+	return &ChapterLessonRepository{
+		lessons: map[string]entity.ChapterLesson{},
+	}
+}
+
+func (r *ChapterLessonRepository) WithTransaction(tx *sqlx.Tx) repository.IChapterLessonRepository {
+	return r
+}
+
+func (r *ChapterLessonRepository) AddChapterLesson(lesson entity.ChapterLesson) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lessons[lesson.Id] = lesson
+}
+
+// ChapterLesson mengembalikan lesson apa adanya (termasuk yang sudah di-soft delete)
+func (r *ChapterLessonRepository) ChapterLesson(id string) (entity.ChapterLesson, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lesson, ok := r.lessons[id]
+	return lesson, ok
+}
+
+// ChapterLessons mengembalikan semua lesson yang tersimpan (id dibuat oleh service)
+func (r *ChapterLessonRepository) ChapterLessons() []entity.ChapterLesson {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lessons := make([]entity.ChapterLesson, 0, len(r.lessons))
+	for _, lesson := range r.lessons {
+		lessons = append(lessons, lesson)
+	}
+
+	return lessons
+}
+
+func (r *ChapterLessonRepository) CreateNewChapterLesson(ctx context.Context, chapterLesson *entity.ChapterLesson) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+	if r.orderTaken(chapterLesson.ChapterId, chapterLesson.OrderLesson, chapterLesson.Id) {
+		return &pq.Error{Code: "23505", Constraint: repository.ChapterLessonOrderUniqueIndex}
+	}
+
+	r.lessons[chapterLesson.Id] = *chapterLesson
+	return nil
+}
+
+func (r *ChapterLessonRepository) GetChapterLessonById(ctx context.Context, chapterLessonId string) (*entity.ChapterLesson, error) {
+	return r.get(chapterLessonId)
+}
+
+func (r *ChapterLessonRepository) GetChapterLessonByIdFieldMask(ctx context.Context, chapterLessonId string, paths []string) (*entity.ChapterLesson, error) {
+	return r.get(chapterLessonId)
+}
+
+func (r *ChapterLessonRepository) UpdateChapterLesson(ctx context.Context, chapterLesson *entity.ChapterLesson) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	old, ok := r.lessons[chapterLesson.Id]
+	if !ok {
+		return nil
+	}
+	if r.orderTaken(chapterLesson.ChapterId, chapterLesson.OrderLesson, chapterLesson.Id) {
+		return &pq.Error{Code: "23505", Constraint: repository.ChapterLessonOrderUniqueIndex}
+	}
+
+	updated := *chapterLesson
+	updated.CreatedAt = old.CreatedAt
+	updated.CreatedBy = old.CreatedBy
+	updated.DeletedAt = old.DeletedAt
+	updated.DeletedWithId = old.DeletedWithId
+	updated.DeletedBy = old.DeletedBy
+	r.lessons[chapterLesson.Id] = updated
+
+	return nil
+}
+
+func (r *ChapterLessonRepository) DeleteChapterLesson(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	lesson, ok := r.lessons[id]
+	if !ok {
+		return nil
+	}
+
+	lesson.DeletedAt = &deletedAt
+	lesson.DeletedBy = &deletedBy
+	lesson.DeletedWithId = &lesson.Id
+	r.lessons[id] = lesson
+
+	return nil
+}
+
+// DeleteChapterLessonsByCourseId: fake hanya mencocokkan course_id lesson
+func (r *ChapterLessonRepository) DeleteChapterLessonsByCourseId(ctx context.Context, courseId string, deletedAt time.Time, deletedBy string) (int64, error) {
+	return r.deleteWhere(func(lesson entity.ChapterLesson) bool {
+		return lesson.CourseId != nil && *lesson.CourseId == courseId
+	}, courseId, deletedAt, deletedBy)
+}
+
+func (r *ChapterLessonRepository) DeleteChapterLessonsByChapterId(ctx context.Context, chapterId string, deletedAt time.Time, deletedBy string) (int64, error) {
+	return r.deleteWhere(func(lesson entity.ChapterLesson) bool {
+		return lesson.ChapterId != nil && *lesson.ChapterId == chapterId
+	}, chapterId, deletedAt, deletedBy)
+}
+
+func (r *ChapterLessonRepository) deleteWhere(match func(lesson entity.ChapterLesson) bool, deletedWithId string, deletedAt time.Time, deletedBy string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return 0, r.WriteErr
+	}
+
+	var count int64
+	for id, lesson := range r.lessons {
+		if lesson.DeletedAt != nil || !match(lesson) {
+			continue
+		}
+		lesson.DeletedAt = &deletedAt
+		lesson.DeletedBy = &deletedBy
+		lesson.DeletedWithId = &deletedWithId
+		r.lessons[id] = lesson
+		count++
+	}
+
+	return count, nil
+}
+
+func (r *ChapterLessonRepository) GetDeletedChapterLessonById(ctx context.Context, chapterLessonId string) (*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	lesson, ok := r.lessons[chapterLessonId]
+	if !ok || lesson.DeletedAt == nil {
+		return nil, nil
+	}
+
+	return &lesson, nil
+}
+
+func (r *ChapterLessonRepository) RestoreChapterLessons(ctx context.Context, deletedWithId string, updatedAt time.Time, updatedBy string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return 0, r.WriteErr
+	}
+
+	var count int64
+	for id, lesson := range r.lessons {
+		if lesson.DeletedWithId == nil || *lesson.DeletedWithId != deletedWithId {
+			continue
+		}
+		//? posisi lesson root yang sudah dipakai dipindah ke urutan terakhir
+		if id == deletedWithId && r.orderTaken(lesson.ChapterId, lesson.OrderLesson, id) {
+			lesson.OrderLesson = r.lastOrder(*lesson.ChapterId) + 1
+		}
+		lesson.DeletedAt = nil
+		lesson.DeletedBy = nil
+		lesson.DeletedWithId = nil
+		lesson.UpdatedAt = updatedAt
+		lesson.UpdatedBy = &updatedBy
+		r.lessons[id] = lesson
+		count++
+	}
+
+	return count, nil
+}
+
+func (r *ChapterLessonRepository) GetDeletedChapterLessons(ctx context.Context) ([]*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var lessons []*entity.ChapterLesson
+	for _, lesson := range r.lessons {
+		if lesson.DeletedAt == nil {
+			continue
+		}
+		lesson := lesson
+		lessons = append(lessons, &lesson)
+	}
+	sort.Slice(lessons, func(i, j int) bool { return lessons[i].Id < lessons[j].Id })
+
+	return lessons, nil
+}
+
+func (r *ChapterLessonRepository) PurgeChapterLessons(ctx context.Context, deletedBefore time.Time) ([]*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return nil, r.WriteErr
+	}
+
+	var purged []*entity.ChapterLesson
+	for id, lesson := range r.lessons {
+		if lesson.DeletedAt == nil || !lesson.DeletedAt.Before(deletedBefore) {
+			continue
+		}
+		lesson := lesson
+		purged = append(purged, &lesson)
+		delete(r.lessons, id)
+	}
+	sort.Slice(purged, func(i, j int) bool { return purged[i].Id < purged[j].Id })
+
+	return purged, nil
+}
+
+func (r *ChapterLessonRepository) GetAllLessonFiles(ctx context.Context) ([]*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var lessons []*entity.ChapterLesson
+	for _, lesson := range r.lessons {
+		if lesson.FilePath == nil && lesson.Content == nil {
+			continue
+		}
+		lessons = append(lessons, &entity.ChapterLesson{Id: lesson.Id, CourseId: lesson.CourseId, FilePath: lesson.FilePath, Kind: lesson.Kind, Content: lesson.Content})
+	}
+
+	return lessons, nil
+}
+
+func (r *ChapterLessonRepository) GetChapterLessonIdsForUpdate(ctx context.Context, chapterId string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var lessons []entity.ChapterLesson
+	for _, lesson := range r.lessons {
+		if lesson.ChapterId != nil && *lesson.ChapterId == chapterId && lesson.DeletedAt == nil {
+			lessons = append(lessons, lesson)
+		}
+	}
+	sort.Slice(lessons, func(i, j int) bool { return lessons[i].OrderLesson < lessons[j].OrderLesson })
+
+	ids := make([]string, 0, len(lessons))
+	for _, lesson := range lessons {
+		ids = append(ids, lesson.Id)
+	}
+
+	return ids, nil
+}
+
+func (r *ChapterLessonRepository) ReorderChapterLessons(ctx context.Context, chapterId string, orderedIds []string, updatedAt time.Time, updatedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	for i, id := range orderedIds {
+		lesson, ok := r.lessons[id]
+		if !ok || lesson.ChapterId == nil || *lesson.ChapterId != chapterId || lesson.DeletedAt != nil {
+			continue
+		}
+
+		lesson.OrderLesson = int64(i + 1)
+		lesson.UpdatedAt = updatedAt
+		lesson.UpdatedBy = &updatedBy
+		r.lessons[id] = lesson
+	}
+
+	return nil
+}
+
+func (r *ChapterLessonRepository) GetLastOrderLesson(ctx context.Context, chapterId string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return 0, r.ReadErr
+	}
+
+	return r.lastOrder(chapterId), nil
+}
+
+func (r *ChapterLessonRepository) lastOrder(chapterId string) int64 {
+	var lastOrder int64
+	for _, lesson := range r.lessons {
+		if lesson.ChapterId != nil && *lesson.ChapterId == chapterId && lesson.DeletedAt == nil && lesson.OrderLesson > lastOrder {
+			lastOrder = lesson.OrderLesson
+		}
+	}
+
+	return lastOrder
+}
+
+func (r *ChapterLessonRepository) MoveChapterLesson(ctx context.Context, chapterLesson *entity.ChapterLesson) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	lesson, ok := r.lessons[chapterLesson.Id]
+	if !ok || lesson.DeletedAt != nil {
+		return nil
+	}
+	if r.orderTaken(chapterLesson.ChapterId, chapterLesson.OrderLesson, chapterLesson.Id) {
+		return &pq.Error{Code: "23505", Constraint: repository.ChapterLessonOrderUniqueIndex}
+	}
+
+	lesson.CourseId = chapterLesson.CourseId
+	lesson.ChapterId = chapterLesson.ChapterId
+	lesson.OrderLesson = chapterLesson.OrderLesson
+	lesson.FilePath = chapterLesson.FilePath
+	lesson.Content = chapterLesson.Content
+	lesson.UpdatedAt = chapterLesson.UpdatedAt
+	lesson.UpdatedBy = chapterLesson.UpdatedBy
+	r.lessons[chapterLesson.Id] = lesson
+
+	return nil
+}
+
+// GetChapterLessonsByChapterId: field mask diabaikan (semua kolom dikembalikan)
+func (r *ChapterLessonRepository) GetChapterLessonsByChapterId(ctx context.Context, chapterId string, status *string, paths []string) ([]*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var lessons []*entity.ChapterLesson
+	for _, lesson := range r.lessons {
+		if lesson.ChapterId == nil || *lesson.ChapterId != chapterId || lesson.DeletedAt != nil {
+			continue
+		}
+		if status != nil && (lesson.Status == nil || *lesson.Status != *status) {
+			continue
+		}
+		lesson := lesson
+		lessons = append(lessons, &lesson)
+	}
+	sort.Slice(lessons, func(i, j int) bool { return lessons[i].OrderLesson < lessons[j].OrderLesson })
+
+	return lessons, nil
+}
+
+func (r *ChapterLessonRepository) GetLessonDurationsByChapterIds(ctx context.Context, chapterIds []string) ([]*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	wanted := make(map[string]bool, len(chapterIds))
+	for _, id := range chapterIds {
+		wanted[id] = true
+	}
+
+	var lessons []*entity.ChapterLesson
+	for _, lesson := range r.lessons {
+		if lesson.ChapterId == nil || !wanted[*lesson.ChapterId] || lesson.DeletedAt != nil {
+			continue
+		}
+		lessons = append(lessons, &entity.ChapterLesson{Id: lesson.Id, ChapterId: lesson.ChapterId, Duration: lesson.Duration})
+	}
+
+	return lessons, nil
+}
+
+func (r *ChapterLessonRepository) GetCurriculumLessonsByCourseId(ctx context.Context, courseId string) ([]*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var lessons []*entity.ChapterLesson
+	for _, lesson := range r.lessons {
+		if lesson.CourseId == nil || *lesson.CourseId != courseId || lesson.DeletedAt != nil {
+			continue
+		}
+		lesson := lesson
+		lessons = append(lessons, &lesson)
+	}
+	sort.Slice(lessons, func(i, j int) bool {
+		if lessons[i].OrderLesson != lessons[j].OrderLesson {
+			return lessons[i].OrderLesson < lessons[j].OrderLesson
+		}
+		return lessons[i].Id < lessons[j].Id
+	})
+
+	return lessons, nil
+}
+
+// orderTaken meniru unique index course_chapter_lessons_chapter_order_live_key (NULL chapter_id tidak pernah bentrok)
+func (r *ChapterLessonRepository) orderTaken(chapterId *string, order int64, exceptId string) bool {
+	if chapterId == nil {
+		return false
+	}
+
+	for _, lesson := range r.lessons {
+		if lesson.Id != exceptId && lesson.ChapterId != nil && *lesson.ChapterId == *chapterId && lesson.OrderLesson == order && lesson.DeletedAt == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *ChapterLessonRepository) get(id string) (*entity.ChapterLesson, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	lesson, ok := r.lessons[id]
+	if !ok || lesson.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &lesson, nil
+}
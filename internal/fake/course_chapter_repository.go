@@ -0,0 +1,369 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// CourseChapterRepository menyimpan chapter (key: id) di memory. Field mask diabaikan.
+type CourseChapterRepository struct {
+	mu       sync.Mutex
+	chapters map[string]entity.CourseChapter
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.ICourseChapterRepository = (*CourseChapterRepository)(nil)
+
+func NewCourseChapterRepository() *CourseChapterRepository {
+	return &CourseChapterRepository{
+		chapters: map[string]entity.CourseChapter{},
+	}
+}
+
+func (r *CourseChapterRepository) WithTransaction(tx *sqlx.Tx) repository.ICourseChapterRepository {
+	return r
+}
+
+func (r *CourseChapterRepository) AddCourseChapter(chapter entity.CourseChapter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.chapters[chapter.Id] = chapter
+}
+
+// CourseChapter mengembalikan chapter apa adanya (termasuk yang sudah di-soft delete)
+func (r *CourseChapterRepository) CourseChapter(id string) (entity.CourseChapter, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	chapter, ok := r.chapters[id]
+	return chapter, ok
+}
+
+// CourseChapters mengembalikan semua chapter yang tersimpan (id dibuat oleh service)
+func (r *CourseChapterRepository) CourseChapters() []entity.CourseChapter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	chapters := make([]entity.CourseChapter, 0, len(r.chapters))
+	for _, chapter := range r.chapters {
+		chapters = append(chapters, chapter)
+	}
+
+	return chapters
+}
+
+func (r *CourseChapterRepository) CreateNewCourseChapter(ctx context.Context, courseChapter *entity.CourseChapter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+	if r.orderTaken(courseChapter.CourseId, courseChapter.OrderChapter, courseChapter.Id) {
+		return &pq.Error{Code: "23505", Constraint: repository.CourseChapterOrderUniqueIndex}
+	}
+
+	r.chapters[courseChapter.Id] = *courseChapter
+	return nil
+}
+
+func (r *CourseChapterRepository) GetCourseChapterById(ctx context.Context, courseChapterId string) (*entity.CourseChapter, error) {
+	return r.get(courseChapterId)
+}
+
+func (r *CourseChapterRepository) GetCourseChapterByIdFieldMask(ctx context.Context, courseChapterId string, paths []string) (*entity.CourseChapter, error) {
+	return r.get(courseChapterId)
+}
+
+func (r *CourseChapterRepository) UpdateCourseChapter(ctx context.Context, courseChapter *entity.CourseChapter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	old, ok := r.chapters[courseChapter.Id]
+	if !ok {
+		return nil
+	}
+	if r.orderTaken(courseChapter.CourseId, courseChapter.OrderChapter, courseChapter.Id) {
+		return &pq.Error{Code: "23505", Constraint: repository.CourseChapterOrderUniqueIndex}
+	}
+
+	updated := *courseChapter
+	updated.CreatedAt = old.CreatedAt
+	updated.CreatedBy = old.CreatedBy
+	updated.DeletedAt = old.DeletedAt
+	updated.DeletedWithId = old.DeletedWithId
+	updated.DeletedBy = old.DeletedBy
+	r.chapters[courseChapter.Id] = updated
+
+	return nil
+}
+
+func (r *CourseChapterRepository) DeleteCourseChapter(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	chapter, ok := r.chapters[id]
+	if !ok {
+		return nil
+	}
+
+	chapter.DeletedAt = &deletedAt
+	chapter.DeletedBy = &deletedBy
+	chapter.DeletedWithId = &chapter.Id
+	r.chapters[id] = chapter
+
+	return nil
+}
+
+func (r *CourseChapterRepository) DeleteCourseChaptersByCourseId(ctx context.Context, courseId string, deletedAt time.Time, deletedBy string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return 0, r.WriteErr
+	}
+
+	var count int64
+	for id, chapter := range r.chapters {
+		if chapter.CourseId != courseId || chapter.DeletedAt != nil {
+			continue
+		}
+		chapter.DeletedAt = &deletedAt
+		chapter.DeletedBy = &deletedBy
+		chapter.DeletedWithId = &courseId
+		r.chapters[id] = chapter
+		count++
+	}
+
+	return count, nil
+}
+
+func (r *CourseChapterRepository) GetDeletedCourseChapterById(ctx context.Context, courseChapterId string) (*entity.CourseChapter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	chapter, ok := r.chapters[courseChapterId]
+	if !ok || chapter.DeletedAt == nil {
+		return nil, nil
+	}
+
+	return &chapter, nil
+}
+
+func (r *CourseChapterRepository) RestoreCourseChapters(ctx context.Context, deletedWithId string, updatedAt time.Time, updatedBy string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return 0, r.WriteErr
+	}
+
+	var restored []entity.CourseChapter
+	for _, chapter := range r.chapters {
+		if chapter.DeletedWithId != nil && *chapter.DeletedWithId == deletedWithId {
+			restored = append(restored, chapter)
+		}
+	}
+	sort.Slice(restored, func(i, j int) bool {
+		if restored[i].OrderChapter != restored[j].OrderChapter {
+			return restored[i].OrderChapter < restored[j].OrderChapter
+		}
+		return restored[i].Id < restored[j].Id
+	})
+
+	//? sama dgn query: posisi yang sudah dipakai chapter aktif dipindah setelah posisi terbesar (aktif & yang dikembalikan)
+	last := map[string]int64{}
+	taken := map[string]bool{}
+	for _, chapter := range restored {
+		if _, ok := last[chapter.CourseId]; !ok {
+			last[chapter.CourseId] = r.lastOrder(chapter.CourseId)
+		}
+		last[chapter.CourseId] = max(last[chapter.CourseId], chapter.OrderChapter)
+		taken[chapter.Id] = r.orderTaken(chapter.CourseId, chapter.OrderChapter, chapter.Id)
+	}
+
+	for _, chapter := range restored {
+		if taken[chapter.Id] {
+			last[chapter.CourseId]++
+			chapter.OrderChapter = last[chapter.CourseId]
+		}
+		chapter.DeletedAt = nil
+		chapter.DeletedBy = nil
+		chapter.DeletedWithId = nil
+		chapter.UpdatedAt = updatedAt
+		chapter.UpdatedBy = &updatedBy
+		r.chapters[chapter.Id] = chapter
+	}
+
+	return int64(len(restored)), nil
+}
+
+func (r *CourseChapterRepository) GetDeletedCourseChapters(ctx context.Context) ([]*entity.CourseChapter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var chapters []*entity.CourseChapter
+	for _, chapter := range r.chapters {
+		if chapter.DeletedAt == nil {
+			continue
+		}
+		chapter := chapter
+		chapters = append(chapters, &chapter)
+	}
+	sort.Slice(chapters, func(i, j int) bool { return chapters[i].Id < chapters[j].Id })
+
+	return chapters, nil
+}
+
+func (r *CourseChapterRepository) PurgeCourseChapters(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return 0, r.WriteErr
+	}
+
+	var count int64
+	for id, chapter := range r.chapters {
+		if chapter.DeletedAt == nil || !chapter.DeletedAt.Before(deletedBefore) {
+			continue
+		}
+		delete(r.chapters, id)
+		count++
+	}
+
+	return count, nil
+}
+
+func (r *CourseChapterRepository) GetCourseChapterIdsForUpdate(ctx context.Context, courseId string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var chapters []entity.CourseChapter
+	for _, chapter := range r.chapters {
+		if chapter.CourseId == courseId && chapter.DeletedAt == nil {
+			chapters = append(chapters, chapter)
+		}
+	}
+	sort.Slice(chapters, func(i, j int) bool { return chapters[i].OrderChapter < chapters[j].OrderChapter })
+
+	ids := make([]string, 0, len(chapters))
+	for _, chapter := range chapters {
+		ids = append(ids, chapter.Id)
+	}
+
+	return ids, nil
+}
+
+func (r *CourseChapterRepository) ReorderCourseChapters(ctx context.Context, courseId string, orderedIds []string, updatedAt time.Time, updatedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	for i, id := range orderedIds {
+		chapter, ok := r.chapters[id]
+		if !ok || chapter.CourseId != courseId || chapter.DeletedAt != nil {
+			continue
+		}
+
+		chapter.OrderChapter = int64(i + 1)
+		chapter.UpdatedAt = updatedAt
+		chapter.UpdatedBy = &updatedBy
+		r.chapters[id] = chapter
+	}
+
+	return nil
+}
+
+// GetCourseChaptersByCourseId: field mask diabaikan (semua kolom dikembalikan)
+func (r *CourseChapterRepository) GetCourseChaptersByCourseId(ctx context.Context, courseId string, status *string, paths []string) ([]*entity.CourseChapter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var chapters []*entity.CourseChapter
+	for _, chapter := range r.chapters {
+		if chapter.CourseId != courseId || chapter.DeletedAt != nil || (status != nil && chapter.Status != *status) {
+			continue
+		}
+		chapter := chapter
+		chapters = append(chapters, &chapter)
+	}
+	sort.Slice(chapters, func(i, j int) bool { return chapters[i].OrderChapter < chapters[j].OrderChapter })
+
+	return chapters, nil
+}
+
+// orderTaken meniru unique index course_chapters_course_order_live_key
+func (r *CourseChapterRepository) orderTaken(courseId string, order int64, exceptId string) bool {
+	for _, chapter := range r.chapters {
+		if chapter.Id != exceptId && chapter.CourseId == courseId && chapter.OrderChapter == order && chapter.DeletedAt == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *CourseChapterRepository) lastOrder(courseId string) int64 {
+	var last int64
+	for _, chapter := range r.chapters {
+		if chapter.CourseId == courseId && chapter.DeletedAt == nil && chapter.OrderChapter > last {
+			last = chapter.OrderChapter
+		}
+	}
+
+	return last
+}
+
+func (r *CourseChapterRepository) get(id string) (*entity.CourseChapter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	chapter, ok := r.chapters[id]
+	if !ok || chapter.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &chapter, nil
+}
@@ -0,0 +1,230 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+// CourseRepository menyimpan course (key: id) di memory. Field mask diabaikan, semua kolom selalu dikembalikan.
+type CourseRepository struct {
+	mu      sync.Mutex
+	courses map[string]entity.Course
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.ICourseRepository = (*CourseRepository)(nil)
+
+func NewCourseRepository() *CourseRepository {
+	return &CourseRepository{
+		courses: map[string]entity.Course{},
+	}
+}
+
+// WithTransaction mengembalikan repository yang sama, commit/rollback diuji lewat sqlmock di sisi *sqlx.DB
+func (r *CourseRepository) WithTransaction(tx *sqlx.Tx) repository.ICourseRepository {
+	return r
+}
+
+func (r *CourseRepository) AddCourse(course entity.Course) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.courses[course.Id] = course
+}
+
+// Course mengembalikan course apa adanya (termasuk yang sudah di-soft delete)
+func (r *CourseRepository) Course(id string) (entity.Course, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	course, ok := r.courses[id]
+	return course, ok
+}
+
+func (r *CourseRepository) CreateNewCourse(ctx context.Context, course *entity.Course) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	r.courses[course.Id] = *course
+	return nil
+}
+
+func (r *CourseRepository) GetCourseById(ctx context.Context, courseId string) (*entity.Course, error) {
+	return r.get(courseId)
+}
+
+func (r *CourseRepository) GetCourseByIdFieldMask(ctx context.Context, courseId string, paths []string) (*entity.Course, error) {
+	return r.get(courseId)
+}
+
+func (r *CourseRepository) UpdateCourse(ctx context.Context, course *entity.Course) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	old, ok := r.courses[course.Id]
+	if !ok {
+		return nil //? sama dgn UPDATE tanpa baris yang cocok
+	}
+
+	updated := *course
+	updated.InstructorId = old.InstructorId //? sama dgn UPDATE yang tidak menulis instructor_id
+	updated.CreatedAt = old.CreatedAt
+	updated.CreatedBy = old.CreatedBy
+	updated.DeletedAt = old.DeletedAt
+	updated.DeletedBy = old.DeletedBy
+	updated.DeletedWithId = old.DeletedWithId
+	r.courses[course.Id] = updated
+
+	return nil
+}
+
+func (r *CourseRepository) DeleteCourse(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	course, ok := r.courses[id]
+	if !ok {
+		return nil
+	}
+
+	course.DeletedAt = &deletedAt
+	course.DeletedBy = &deletedBy
+	course.DeletedWithId = &course.Id
+	r.courses[id] = course
+
+	return nil
+}
+
+func (r *CourseRepository) GetDeletedCourseById(ctx context.Context, courseId string) (*entity.Course, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	course, ok := r.courses[courseId]
+	if !ok || course.DeletedAt == nil {
+		return nil, nil
+	}
+
+	return &course, nil
+}
+
+func (r *CourseRepository) RestoreCourse(ctx context.Context, id string, updatedAt time.Time, updatedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	course, ok := r.courses[id]
+	if !ok || course.DeletedWithId == nil || *course.DeletedWithId != id {
+		return nil
+	}
+
+	course.DeletedAt = nil
+	course.DeletedBy = nil
+	course.DeletedWithId = nil
+	course.UpdatedAt = updatedAt
+	course.UpdatedBy = &updatedBy
+	r.courses[id] = course
+
+	return nil
+}
+
+func (r *CourseRepository) GetDeletedCourses(ctx context.Context) ([]*entity.Course, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var courses []*entity.Course
+	for _, course := range r.courses {
+		if course.DeletedAt == nil {
+			continue
+		}
+		course := course
+		courses = append(courses, &course)
+	}
+	sort.Slice(courses, func(i, j int) bool { return courses[i].Id < courses[j].Id })
+
+	return courses, nil
+}
+
+// PurgeCourses hanya menghapus course di fake ini (FK cascade ke tabel lain tidak ditiru)
+func (r *CourseRepository) PurgeCourses(ctx context.Context, deletedBefore time.Time) ([]*entity.Course, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return nil, r.WriteErr
+	}
+
+	var purged []*entity.Course
+	for id, course := range r.courses {
+		if course.DeletedAt == nil || !course.DeletedAt.Before(deletedBefore) {
+			continue
+		}
+		purged = append(purged, &entity.Course{Id: course.Id, ImageFileName: course.ImageFileName})
+		delete(r.courses, id)
+	}
+	sort.Slice(purged, func(i, j int) bool { return purged[i].Id < purged[j].Id })
+
+	return purged, nil
+}
+
+func (r *CourseRepository) GetAllCourseImages(ctx context.Context) ([]*entity.Course, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var courses []*entity.Course
+	for _, course := range r.courses {
+		courses = append(courses, &entity.Course{Id: course.Id, ImageFileName: course.ImageFileName})
+	}
+
+	return courses, nil
+}
+
+func (r *CourseRepository) get(id string) (*entity.Course, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	course, ok := r.courses[id]
+	if !ok || course.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &course, nil
+}
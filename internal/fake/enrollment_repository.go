@@ -0,0 +1,52 @@
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+)
+
+// EnrollmentRepository menyimpan enrollment (key: course id + user id) di memory
+type EnrollmentRepository struct {
+	mu          sync.Mutex
+	enrollments map[string]entity.Enrollment
+
+	ReadErr error
+}
+
+var _ repository.IEnrollmentRepository = (*EnrollmentRepository)(nil)
+
+func NewEnrollmentRepository() *EnrollmentRepository {
+	return &EnrollmentRepository{
+		enrollments: map[string]entity.Enrollment{},
+	}
+}
+
+func (r *EnrollmentRepository) AddEnrollment(enrollment entity.Enrollment) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.enrollments[enrollmentKey(enrollment.CourseId, enrollment.UserId)] = enrollment
+}
+
+func (r *EnrollmentRepository) GetEnrollment(ctx context.Context, courseId string, userId string) (*entity.Enrollment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	enrollment, ok := r.enrollments[enrollmentKey(courseId, userId)]
+	if !ok || enrollment.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &enrollment, nil
+}
+
+func enrollmentKey(courseId string, userId string) string {
+	return courseId + "/" + userId
+}
@@ -0,0 +1,100 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+// LessonCompletionRepository menyimpan lesson yang sudah diselesaikan (key: lesson_id + user_id) di memory.
+// Course lesson dicari lewat Lessons (repository fake yang sama dgn service).
+type LessonCompletionRepository struct {
+	mu          sync.Mutex
+	completions map[string]entity.LessonCompletion
+
+	Lessons *ChapterLessonRepository
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.ILessonCompletionRepository = (*LessonCompletionRepository)(nil)
+
+func NewLessonCompletionRepository(lessons *ChapterLessonRepository) *LessonCompletionRepository {
+	return &LessonCompletionRepository{
+		completions: map[string]entity.LessonCompletion{},
+		Lessons:     lessons,
+	}
+}
+
+func (r *LessonCompletionRepository) WithTransaction(tx *sqlx.Tx) repository.ILessonCompletionRepository {
+	return r
+}
+
+func (r *LessonCompletionRepository) AddLessonCompletion(completion entity.LessonCompletion) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.completions[completionKey(completion.LessonId, completion.UserId)] = completion
+}
+
+// LessonCompletion mengembalikan data selesai milik user utk lesson tsb
+func (r *LessonCompletionRepository) LessonCompletion(lessonId string, userId string) (entity.LessonCompletion, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	completion, ok := r.completions[completionKey(lessonId, userId)]
+	return completion, ok
+}
+
+func (r *LessonCompletionRepository) CompleteLesson(ctx context.Context, completion *entity.LessonCompletion) (*entity.LessonCompletion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return nil, r.WriteErr
+	}
+
+	key := completionKey(completion.LessonId, completion.UserId)
+	if existing, ok := r.completions[key]; ok {
+		return &existing, nil
+	}
+
+	r.completions[key] = *completion
+	stored := *completion
+	return &stored, nil
+}
+
+func (r *LessonCompletionRepository) GetCompletedLessonIds(ctx context.Context, courseId string, userId string) ([]string, error) {
+	r.mu.Lock()
+	if r.ReadErr != nil {
+		r.mu.Unlock()
+		return nil, r.ReadErr
+	}
+	var candidates []string
+	for _, completion := range r.completions {
+		if completion.UserId == userId {
+			candidates = append(candidates, completion.LessonId)
+		}
+	}
+	r.mu.Unlock()
+
+	var lessonIds []string
+	for _, lessonId := range candidates {
+		lesson, ok := r.Lessons.ChapterLesson(lessonId)
+		if ok && lesson.DeletedAt == nil && lesson.CourseId != nil && *lesson.CourseId == courseId {
+			lessonIds = append(lessonIds, lessonId)
+		}
+	}
+	sort.Strings(lessonIds)
+
+	return lessonIds, nil
+}
+
+func completionKey(lessonId string, userId string) string {
+	return lessonId + "/" + userId
+}
@@ -0,0 +1,137 @@
+package fake
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+// LessonTranscriptRepository menyimpan cue transcript per lesson di memory.
+// Pencarian meniru to_tsvector('simple'): semua kata query harus ada di teks cue (tanpa memperhatikan huruf besar).
+type LessonTranscriptRepository struct {
+	mu   sync.Mutex
+	cues map[string][]entity.LessonTranscriptCue //? key: lesson_id
+
+	Lessons *ChapterLessonRepository
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.ILessonTranscriptRepository = (*LessonTranscriptRepository)(nil)
+
+func NewLessonTranscriptRepository(lessons *ChapterLessonRepository) *LessonTranscriptRepository {
+	return &LessonTranscriptRepository{
+		cues:    map[string][]entity.LessonTranscriptCue{},
+		Lessons: lessons,
+	}
+}
+
+func (r *LessonTranscriptRepository) WithTransaction(tx *sqlx.Tx) repository.ILessonTranscriptRepository {
+	return r
+}
+
+// LessonTranscript mengembalikan cue lesson sesuai urutan penyimpanan
+func (r *LessonTranscriptRepository) LessonTranscript(lessonId string) []entity.LessonTranscriptCue {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]entity.LessonTranscriptCue(nil), r.cues[lessonId]...)
+}
+
+func (r *LessonTranscriptRepository) ReplaceLessonTranscript(ctx context.Context, lessonId string, cues []*entity.LessonTranscriptCue) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	stored := make([]entity.LessonTranscriptCue, 0, len(cues))
+	for _, cue := range cues {
+		stored = append(stored, *cue)
+	}
+	if len(stored) == 0 {
+		delete(r.cues, lessonId)
+		return nil
+	}
+	r.cues[lessonId] = stored
+
+	return nil
+}
+
+func (r *LessonTranscriptRepository) SearchLessonTranscripts(ctx context.Context, courseId string, query string, language *string, lessonIds []string, limit int64) ([]*entity.LessonTranscriptHit, error) {
+	r.mu.Lock()
+	if r.ReadErr != nil {
+		r.mu.Unlock()
+		return nil, r.ReadErr
+	}
+	candidates := make(map[string][]entity.LessonTranscriptCue, len(r.cues))
+	for lessonId, cues := range r.cues {
+		candidates[lessonId] = cues
+	}
+	r.mu.Unlock()
+
+	terms := strings.Fields(strings.ToLower(query))
+
+	var hits []*entity.LessonTranscriptHit
+	for lessonId, cues := range candidates {
+		lesson, ok := r.Lessons.ChapterLesson(lessonId)
+		if !ok || lesson.DeletedAt != nil || lesson.CourseId == nil || *lesson.CourseId != courseId {
+			continue
+		}
+		if lessonIds != nil && !slices.Contains(lessonIds, lessonId) {
+			continue
+		}
+
+		for _, cue := range cues {
+			if (language != nil && cue.Language != *language) || !containsAll(strings.ToLower(cue.Text), terms) {
+				continue
+			}
+			hits = append(hits, &entity.LessonTranscriptHit{
+				LessonId:    lessonId,
+				LessonTitle: lesson.Title,
+				ChapterId:   lesson.ChapterId,
+				Language:    cue.Language,
+				StartMs:     cue.StartMs,
+				EndMs:       cue.EndMs,
+				Text:        cue.Text,
+			})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].LessonId != hits[j].LessonId {
+			return hits[i].LessonId < hits[j].LessonId
+		}
+		return hits[i].StartMs < hits[j].StartMs
+	})
+	if int64(len(hits)) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits, nil
+}
+
+func containsAll(text string, terms []string) bool {
+	words := strings.Fields(text)
+	for _, term := range terms {
+		found := false
+		for _, word := range words {
+			if strings.Trim(word, ".,!?;:\"'()") == term {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return len(terms) > 0
+}
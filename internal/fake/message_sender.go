@@ -0,0 +1,63 @@
+package fake
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Message adalah satu pesan yang "dikirim" lewat MessageSender
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// MessageSender mencatat semua pesan (implementasi service.IMessageSender) tanpa benar-benar mengirim
+type MessageSender struct {
+	mu       sync.Mutex
+	messages []Message
+	sent     chan Message
+
+	Err error //? jika diisi, Send tetap mencatat pesan tapi mengembalikan error ini
+}
+
+func NewMessageSender() *MessageSender {
+	return &MessageSender{
+		sent: make(chan Message, 100),
+	}
+}
+
+func (s *MessageSender) Send(ctx context.Context, to string, subject string, body string) error {
+	message := Message{To: to, Subject: subject, Body: body}
+
+	s.mu.Lock()
+	s.messages = append(s.messages, message)
+	err := s.Err
+	s.mu.Unlock()
+
+	select {
+	case s.sent <- message:
+	default: //? buffer penuh, cukup tercatat di messages
+	}
+
+	return err
+}
+
+// Messages mengembalikan salinan semua pesan yang sudah dikirim
+func (s *MessageSender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+// WaitMessage menunggu pesan berikutnya, dipakai karena pengiriman OTP berjalan di goroutine
+func (s *MessageSender) WaitMessage(timeout time.Duration) (Message, bool) {
+	select {
+	case message := <-s.sent:
+		return message, true
+	case <-time.After(timeout):
+		return Message{}, false
+	}
+}
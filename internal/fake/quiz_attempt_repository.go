@@ -0,0 +1,186 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// QuizAttemptRepository menyimpan attempt (key: id) di memory, unique index attempt berjalan & nomor attempt ikut dicek
+type QuizAttemptRepository struct {
+	mu       sync.Mutex
+	attempts map[string]entity.QuizAttempt
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.IQuizAttemptRepository = (*QuizAttemptRepository)(nil)
+
+func NewQuizAttemptRepository() *QuizAttemptRepository {
+	return &QuizAttemptRepository{
+		attempts: map[string]entity.QuizAttempt{},
+	}
+}
+
+func (r *QuizAttemptRepository) WithTransaction(tx *sqlx.Tx) repository.IQuizAttemptRepository {
+	return r
+}
+
+func (r *QuizAttemptRepository) AddQuizAttempt(attempt entity.QuizAttempt) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attempts[attempt.Id] = attempt
+}
+
+// QuizAttempt mengembalikan attempt apa adanya
+func (r *QuizAttemptRepository) QuizAttempt(id string) (entity.QuizAttempt, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempt, ok := r.attempts[id]
+	return attempt, ok
+}
+
+func (r *QuizAttemptRepository) CreateNewQuizAttempt(ctx context.Context, attempt *entity.QuizAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+	for _, existing := range r.attempts {
+		if existing.QuizId != attempt.QuizId || existing.UserId != attempt.UserId {
+			continue
+		}
+		if existing.SubmittedAt == nil {
+			return &pq.Error{Code: "23505", Constraint: repository.QuizAttemptOpenUniqueIndex}
+		}
+		if existing.AttemptNumber == attempt.AttemptNumber {
+			return &pq.Error{Code: "23505", Constraint: repository.QuizAttemptNumberUniqueIndex}
+		}
+	}
+
+	r.attempts[attempt.Id] = *attempt
+	return nil
+}
+
+func (r *QuizAttemptRepository) GetQuizAttemptById(ctx context.Context, attemptId string) (*entity.QuizAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	attempt, ok := r.attempts[attemptId]
+	if !ok {
+		return nil, nil
+	}
+
+	return &attempt, nil
+}
+
+func (r *QuizAttemptRepository) GetOpenQuizAttempt(ctx context.Context, quizId string, userId string) (*entity.QuizAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	for _, attempt := range r.attempts {
+		if attempt.QuizId == quizId && attempt.UserId == userId && attempt.SubmittedAt == nil {
+			return &attempt, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *QuizAttemptRepository) CountQuizAttempts(ctx context.Context, quizId string, userId string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return 0, r.ReadErr
+	}
+
+	var count int64
+	for _, attempt := range r.attempts {
+		if attempt.QuizId == quizId && attempt.UserId == userId {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *QuizAttemptRepository) SubmitQuizAttempt(ctx context.Context, attempt *entity.QuizAttempt) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return false, r.WriteErr
+	}
+
+	existing, ok := r.attempts[attempt.Id]
+	if !ok || existing.SubmittedAt != nil {
+		return false, nil
+	}
+
+	existing.Sheet = attempt.Sheet
+	existing.SubmittedAt = attempt.SubmittedAt
+	existing.Score = attempt.Score
+	existing.Passed = attempt.Passed
+	existing.Expired = attempt.Expired
+	r.attempts[attempt.Id] = existing
+
+	return true, nil
+}
+
+func (r *QuizAttemptRepository) GetQuizAttempts(ctx context.Context, quizId string, userId *string) ([]*entity.QuizAttempt, error) {
+	return r.selectAttempts(func(attempt entity.QuizAttempt) bool {
+		return attempt.QuizId == quizId && (userId == nil || attempt.UserId == *userId)
+	})
+}
+
+func (r *QuizAttemptRepository) GetQuizAttemptsByCourse(ctx context.Context, courseId string, userId string) ([]*entity.QuizAttempt, error) {
+	return r.selectAttempts(func(attempt entity.QuizAttempt) bool {
+		return attempt.CourseId == courseId && attempt.UserId == userId
+	})
+}
+
+// selectAttempts: attempt yang cocok, urut user / quiz lalu nomor attempt (sheet ikut dikembalikan)
+func (r *QuizAttemptRepository) selectAttempts(match func(attempt entity.QuizAttempt) bool) ([]*entity.QuizAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var attempts []*entity.QuizAttempt
+	for _, attempt := range r.attempts {
+		if match(attempt) {
+			attempts = append(attempts, &attempt)
+		}
+	}
+	sort.Slice(attempts, func(i, j int) bool {
+		if attempts[i].UserId != attempts[j].UserId {
+			return attempts[i].UserId < attempts[j].UserId
+		}
+		if attempts[i].QuizId != attempts[j].QuizId {
+			return attempts[i].QuizId < attempts[j].QuizId
+		}
+		return attempts[i].AttemptNumber < attempts[j].AttemptNumber
+	})
+
+	return attempts, nil
+}
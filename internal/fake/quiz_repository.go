@@ -0,0 +1,267 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// QuizRepository menyimpan quiz & soal (key: id) di memory. Status lesson tidak dicek di GetQuizzesByCourseId.
+type QuizRepository struct {
+	mu        sync.Mutex
+	quizzes   map[string]entity.Quiz
+	questions map[string]entity.QuizQuestion
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.IQuizRepository = (*QuizRepository)(nil)
+
+func NewQuizRepository() *QuizRepository {
+	return &QuizRepository{
+		quizzes:   map[string]entity.Quiz{},
+		questions: map[string]entity.QuizQuestion{},
+	}
+}
+
+func (r *QuizRepository) WithTransaction(tx *sqlx.Tx) repository.IQuizRepository {
+	return r
+}
+
+func (r *QuizRepository) AddQuiz(quiz entity.Quiz) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.quizzes[quiz.Id] = quiz
+}
+
+func (r *QuizRepository) AddQuizQuestion(question entity.QuizQuestion) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.questions[question.Id] = question
+}
+
+// Quiz mengembalikan quiz apa adanya (termasuk yang sudah di-soft delete)
+func (r *QuizRepository) Quiz(id string) (entity.Quiz, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	quiz, ok := r.quizzes[id]
+	return quiz, ok
+}
+
+// QuizQuestion mengembalikan soal apa adanya (termasuk yang sudah di-soft delete)
+func (r *QuizRepository) QuizQuestion(id string) (entity.QuizQuestion, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	question, ok := r.questions[id]
+	return question, ok
+}
+
+func (r *QuizRepository) CreateNewQuiz(ctx context.Context, quiz *entity.Quiz) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+	for _, existing := range r.quizzes {
+		if existing.LessonId == quiz.LessonId && existing.DeletedAt == nil {
+			return &pq.Error{Code: "23505", Constraint: repository.QuizLessonUniqueIndex}
+		}
+	}
+
+	r.quizzes[quiz.Id] = *quiz
+	return nil
+}
+
+func (r *QuizRepository) GetQuizById(ctx context.Context, quizId string) (*entity.Quiz, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	quiz, ok := r.quizzes[quizId]
+	if !ok || quiz.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &quiz, nil
+}
+
+func (r *QuizRepository) UpdateQuiz(ctx context.Context, quiz *entity.Quiz) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	existing, ok := r.quizzes[quiz.Id]
+	if !ok || existing.DeletedAt != nil {
+		return nil
+	}
+
+	r.quizzes[quiz.Id] = *quiz
+	return nil
+}
+
+func (r *QuizRepository) DeleteQuiz(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	quiz, ok := r.quizzes[id]
+	if !ok {
+		return nil
+	}
+	quiz.DeletedAt = &deletedAt
+	quiz.DeletedBy = &deletedBy
+	r.quizzes[id] = quiz
+
+	return nil
+}
+
+func (r *QuizRepository) GetQuizzesByCourseId(ctx context.Context, courseId string) ([]*entity.Quiz, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var quizzes []*entity.Quiz
+	for _, quiz := range r.quizzes {
+		if quiz.CourseId == courseId && quiz.DeletedAt == nil {
+			quizzes = append(quizzes, &quiz)
+		}
+	}
+	sort.Slice(quizzes, func(i, j int) bool {
+		if !quizzes[i].CreatedAt.Equal(quizzes[j].CreatedAt) {
+			return quizzes[i].CreatedAt.Before(quizzes[j].CreatedAt)
+		}
+		return quizzes[i].Id < quizzes[j].Id
+	})
+
+	return quizzes, nil
+}
+
+func (r *QuizRepository) CreateNewQuizQuestion(ctx context.Context, question *entity.QuizQuestion) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	r.questions[question.Id] = *question
+	return nil
+}
+
+func (r *QuizRepository) GetQuizQuestionById(ctx context.Context, questionId string) (*entity.QuizQuestion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	question, ok := r.questions[questionId]
+	if !ok || question.DeletedAt != nil {
+		return nil, nil
+	}
+
+	return &question, nil
+}
+
+func (r *QuizRepository) UpdateQuizQuestion(ctx context.Context, question *entity.QuizQuestion) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	existing, ok := r.questions[question.Id]
+	if !ok || existing.DeletedAt != nil {
+		return nil
+	}
+
+	r.questions[question.Id] = *question
+	return nil
+}
+
+func (r *QuizRepository) DeleteQuizQuestion(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	question, ok := r.questions[id]
+	if !ok {
+		return nil
+	}
+	question.DeletedAt = &deletedAt
+	question.DeletedBy = &deletedBy
+	r.questions[id] = question
+
+	return nil
+}
+
+func (r *QuizRepository) GetQuizQuestionsByQuizId(ctx context.Context, quizId string) ([]*entity.QuizQuestion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return nil, r.ReadErr
+	}
+
+	var questions []*entity.QuizQuestion
+	for _, question := range r.questions {
+		if question.QuizId == quizId && question.DeletedAt == nil {
+			questions = append(questions, &question)
+		}
+	}
+	sort.Slice(questions, func(i, j int) bool {
+		if questions[i].OrderQuestion != questions[j].OrderQuestion {
+			return questions[i].OrderQuestion < questions[j].OrderQuestion
+		}
+		return questions[i].Id < questions[j].Id
+	})
+
+	return questions, nil
+}
+
+func (r *QuizRepository) GetLastOrderQuestion(ctx context.Context, quizId string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ReadErr != nil {
+		return 0, r.ReadErr
+	}
+
+	var lastOrder int64
+	for _, question := range r.questions {
+		if question.QuizId == quizId && question.DeletedAt == nil && question.OrderQuestion > lastOrder {
+			lastOrder = question.OrderQuestion
+		}
+	}
+
+	return lastOrder, nil
+}
@@ -0,0 +1,137 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+// ReleaseRuleRepository menyimpan aturan rilis (key: id) di memory. Course aturan dicari lewat
+// chapter / lesson di repository fake yang sama dgn service (Chapters & Lessons harus diisi).
+type ReleaseRuleRepository struct {
+	mu    sync.Mutex
+	rules map[string]entity.ReleaseRule
+
+	Chapters *CourseChapterRepository
+	Lessons  *ChapterLessonRepository
+
+	ReadErr  error
+	WriteErr error
+}
+
+var _ repository.IReleaseRuleRepository = (*ReleaseRuleRepository)(nil)
+
+func NewReleaseRuleRepository(chapters *CourseChapterRepository, lessons *ChapterLessonRepository) *ReleaseRuleRepository {
+	return &ReleaseRuleRepository{
+		rules:    map[string]entity.ReleaseRule{},
+		Chapters: chapters,
+		Lessons:  lessons,
+	}
+}
+
+func (r *ReleaseRuleRepository) WithTransaction(tx *sqlx.Tx) repository.IReleaseRuleRepository {
+	return r
+}
+
+func (r *ReleaseRuleRepository) AddReleaseRule(rule entity.ReleaseRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rules[rule.Id] = rule
+}
+
+// ReleaseRules mengembalikan semua aturan, urut id
+func (r *ReleaseRuleRepository) ReleaseRules() []entity.ReleaseRule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rules := make([]entity.ReleaseRule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Id < rules[j].Id })
+
+	return rules
+}
+
+func (r *ReleaseRuleRepository) CreateNewReleaseRule(ctx context.Context, rule *entity.ReleaseRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+
+	r.rules[rule.Id] = *rule
+	return nil
+}
+
+func (r *ReleaseRuleRepository) DeleteChapterReleaseRule(ctx context.Context, chapterId string) error {
+	return r.delete(func(rule entity.ReleaseRule) bool {
+		return rule.ChapterId != nil && *rule.ChapterId == chapterId
+	})
+}
+
+func (r *ReleaseRuleRepository) DeleteLessonReleaseRule(ctx context.Context, lessonId string) error {
+	return r.delete(func(rule entity.ReleaseRule) bool {
+		return rule.LessonId != nil && *rule.LessonId == lessonId
+	})
+}
+
+func (r *ReleaseRuleRepository) GetReleaseRulesByCourseId(ctx context.Context, courseId string) ([]*entity.ReleaseRule, error) {
+	r.mu.Lock()
+	if r.ReadErr != nil {
+		r.mu.Unlock()
+		return nil, r.ReadErr
+	}
+	rules := make([]entity.ReleaseRule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		rules = append(rules, rule)
+	}
+	r.mu.Unlock()
+
+	var result []*entity.ReleaseRule
+	for _, rule := range rules {
+		if r.inCourse(rule, courseId) {
+			rule := rule
+			result = append(result, &rule)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Id < result[j].Id })
+
+	return result, nil
+}
+
+func (r *ReleaseRuleRepository) delete(match func(rule entity.ReleaseRule) bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.WriteErr != nil {
+		return r.WriteErr
+	}
+	for id, rule := range r.rules {
+		if match(rule) {
+			delete(r.rules, id)
+		}
+	}
+
+	return nil
+}
+
+// inCourse meniru join ke chapter / lesson aktif
+func (r *ReleaseRuleRepository) inCourse(rule entity.ReleaseRule, courseId string) bool {
+	if rule.ChapterId != nil {
+		chapter, ok := r.Chapters.CourseChapter(*rule.ChapterId)
+		return ok && chapter.DeletedAt == nil && chapter.CourseId == courseId
+	}
+	if rule.LessonId != nil {
+		lesson, ok := r.Lessons.ChapterLesson(*rule.LessonId)
+		return ok && lesson.DeletedAt == nil && lesson.CourseId != nil && *lesson.CourseId == courseId
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package fibermiddleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// LoggingMiddleware menentukan X-Request-Id (dari client atau baru), menyimpannya di c.UserContext()
+// lalu menulis satu access log per request
+func LoggingMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		requestId := logger.ResolveRequestId(c.Get(logger.RequestIdHeader))
+		ctx := logger.NewRequestContext(c.UserContext(), requestId, c.Method())
+
+		c.SetUserContext(ctx)
+		c.Set(logger.RequestIdHeader, requestId)
+
+		err := c.Next()
+
+		statusCode := responseStatusCode(c, err)
+
+		//? jangan pakai c.Response().Body(), untuk SendStream itu akan membaca seluruh file ke memory
+		attrs := []any{
+			"path", c.Path(),
+			"route", c.Route().Path,
+			"status_code", statusCode,
+			"latency_ms", time.Since(start).Milliseconds(),
+			"content_length", c.Response().Header.ContentLength(),
+		}
+		if err != nil {
+			attrs = append(attrs, "error", err)
+		}
+
+		logger.FromContext(ctx).Log(ctx, accessLogLevel(statusCode), "http request", attrs...)
+
+		return err
+	}
+}
+
+// responseStatusCode: error yang dikembalikan handler baru diubah jadi response oleh ErrorHandler fiber
+// setelah semua middleware selesai, jadi status code-nya ditentukan dari error tsb
+func responseStatusCode(c *fiber.Ctx, err error) int {
+	if err == nil {
+		return c.Response().StatusCode()
+	}
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return fiberErr.Code
+	}
+
+	return http.StatusInternalServerError
+}
+
+func accessLogLevel(statusCode int) slog.Level {
+	switch {
+	case statusCode >= http.StatusInternalServerError:
+		return slog.LevelError
+	case statusCode >= http.StatusBadRequest:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
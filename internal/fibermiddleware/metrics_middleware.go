@@ -0,0 +1,56 @@
+package fibermiddleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/metrics"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// MetricsMiddleware mencatat jumlah & latency request per route (pola route, bukan path asli agar label tidak meledak)
+func MetricsMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		middlewareRoute := c.Route()
+
+		err := c.Next()
+
+		//? c.Route() baru berisi route handler setelah Next(), jika masih route middleware ini berarti tidak ada route yang cocok
+		route := c.Route().Path
+		if c.Route() == middlewareRoute {
+			route = "unmatched"
+		}
+
+		//? string dari fiber hanya valid selama request, label prometheus disimpan permanen jadi harus di-copy
+		method := utils.CopyString(c.Method())
+		statusCode := responseStatusCode(c, err)
+
+		metrics.HTTPRequestsTotal.WithLabelValues(method, route, strconv.Itoa(statusCode)).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// UploadMetricsMiddleware dipasang di route upload, kind: jenis file (course / lesson / caption / submission)
+func UploadMetricsMiddleware(kind string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		err := c.Next()
+
+		statusCode := responseStatusCode(c, err)
+		failed := statusCode >= fiber.StatusBadRequest
+
+		metrics.UploadsTotal.WithLabelValues(kind, metrics.Result(failed)).Inc()
+		metrics.UploadDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
+		if !failed {
+			//? Content-Length request multipart (file + field form), cukup akurat utk ukuran upload
+			metrics.UploadBytes.WithLabelValues(kind).Observe(float64(c.Request().Header.ContentLength()))
+		}
+
+		return err
+	}
+}
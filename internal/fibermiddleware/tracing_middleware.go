@@ -0,0 +1,61 @@
+package fibermiddleware
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/abu-umair/be-lms-go/internal/fibermiddleware"
+
+// TracingMiddleware membuat span server per request, melanjutkan trace dari header traceparent client (W3C)
+func TracingMiddleware() fiber.Handler {
+	tracer := otel.Tracer(tracerName)
+
+	return func(c *fiber.Ctx) error {
+		carrier := propagation.MapCarrier{}
+		c.Request().Header.VisitAll(func(key []byte, value []byte) {
+			carrier[strings.ToLower(string(key))] = string(value)
+		})
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), carrier)
+
+		method := utils.CopyString(c.Method())
+		ctx, span := tracer.Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(method),
+				semconv.URLPath(utils.CopyString(c.Path())),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		middlewareRoute := c.Route()
+
+		err := c.Next()
+
+		//? nama span memakai pola route (misal "POST /lesson/upload") agar mudah dikelompokkan
+		if c.Route() != middlewareRoute {
+			span.SetName(fmt.Sprintf("%s %s", method, c.Route().Path))
+			span.SetAttributes(semconv.HTTPRoute(c.Route().Path))
+		}
+
+		statusCode := responseStatusCode(c, err)
+		span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+		if statusCode >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, "")
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/pb/assignment"
+	"github.com/abu-umair/be-lms-go/pb/auth"
+	"github.com/abu-umair/be-lms-go/pb/catalog"
+	"github.com/abu-umair/be-lms-go/pb/chapter_lesson"
+	"github.com/abu-umair/be-lms-go/pb/course"
+	"github.com/abu-umair/be-lms-go/pb/course_chapter"
+	"github.com/abu-umair/be-lms-go/pb/quiz"
+	"github.com/abu-umair/be-lms-go/pb/trash"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/rs/cors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// registerFunc adalah signature Register<Service>HandlerFromEndpoint hasil protoc-gen-grpc-gateway
+type registerFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
+
+var services = []registerFunc{
+	auth.RegisterAuthServiceHandlerFromEndpoint,
+	course.RegisterCourseServiceHandlerFromEndpoint,
+	course_chapter.RegisterCourseChapterServiceHandlerFromEndpoint,
+	chapter_lesson.RegisterChapterLessonServiceHandlerFromEndpoint,
+	quiz.RegisterQuizServiceHandlerFromEndpoint,
+	assignment.RegisterAssignmentServiceHandlerFromEndpoint,
+	catalog.RegisterCatalogServiceHandlerFromEndpoint,
+	trash.RegisterTrashServiceHandlerFromEndpoint,
+}
+
+// NewHandler melayani gRPC-Web (pengganti grpcwebproxy) dan REST/JSON hasil google.api.http di satu port.
+// gRPC-Web langsung ke grpcServer, REST/JSON lewat koneksi ke grpcEndpoint sehingga interceptor (auth, validasi, dll) tetap jalan.
+func NewHandler(ctx context.Context, grpcServer *grpc.Server, grpcEndpoint string) (http.Handler, error) {
+	grpcWebServer := grpcweb.WrapServer(grpcServer,
+		grpcweb.WithOriginFunc(func(origin string) bool { return true }), //? sama dgn --allow_all_origins di grpcwebproxy
+	)
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true, //? status_code, is_error, dst sama dgn nama field di proto
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	for _, register := range services {
+		if err := register(ctx, mux, grpcEndpoint, dialOptions); err != nil {
+			return nil, fmt.Errorf("register gateway handler: %w", err)
+		}
+	}
+
+	restHandler := cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete},
+		AllowedHeaders: []string{"*"},
+		ExposedHeaders: []string{logger.RequestIdHeader},
+	}).Handler(mux)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if grpcWebServer.IsGrpcWebRequest(r) || grpcWebServer.IsAcceptableGrpcCorsRequest(r) {
+			grpcWebServer.ServeHTTP(w, r)
+			return
+		}
+
+		restHandler.ServeHTTP(w, r)
+	}), nil
+}
+
+// forwardedHeaders diteruskan apa adanya (tanpa prefix grpcgateway-): request id & trace context W3C
+var forwardedHeaders = []string{logger.RequestIdHeader, "traceparent", "tracestate", "baggage"}
+
+// incomingHeaderMatcher: selain header bawaan (Authorization, dll), forwardedHeaders juga diteruskan ke metadata gRPC
+func incomingHeaderMatcher(key string) (string, bool) {
+	for _, header := range forwardedHeaders {
+		if strings.EqualFold(key, header) {
+			return header, true
+		}
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher: x-request-id dikirim balik tanpa prefix Grpc-Metadata-
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == logger.RequestIdHeader {
+		return logger.RequestIdHeader, true
+	}
+
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
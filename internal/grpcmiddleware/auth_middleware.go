@@ -2,9 +2,10 @@ package grpcmiddleware
 
 import (
 	"context"
-	"log"
+	"strings"
 
 	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/logger"
 	"github.com/abu-umair/be-lms-go/internal/utils"
 	gocache "github.com/patrickmn/go-cache"
 	"google.golang.org/grpc"
@@ -12,13 +13,19 @@ import (
 
 type authMiddleware struct {
 	cacheService *gocache.Cache
+	jwtSecret    string
 }
 
 func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-	log.Println(info.FullMethod)
 	if info.FullMethod == "/auth.AuthService/Login" || info.FullMethod == "/auth.AuthService/Register" { //?whitelist (boleh diakses tanpa token)
 		return handler(ctx, req)
 	}
+	if strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") { //? health check dari load balancer / k8s tanpa token
+		return handler(ctx, req)
+	}
+	if strings.HasPrefix(info.FullMethod, "/catalog.CatalogService/") { //? katalog publik utk halaman marketing (dibatasi rateLimitMiddleware)
+		return handler(ctx, req)
+	}
 
 	// Ambil token  dari metadata
 	tokenStr, err := jwtentity.ParseTokenFromContext(ctx)
@@ -34,7 +41,7 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
 	}
 
 	// Parse jwt nya hingga jadi entity
-	claims, err := jwtentity.GetClaimsFromToken(tokenStr)
+	claims, err := jwtentity.GetClaimsFromToken(tokenStr, am.jwtSecret)
 
 	if err != nil {
 		return nil, err
@@ -42,14 +49,16 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
 
 	// Sematkan entity ke context
 	ctx = claims.SetToContext(ctx)
+	logger.SetUserId(ctx, claims.Subject)
 
 	res, err := handler(ctx, req)
 
 	return res, err
 }
 
-func NewAuthMiddleware(cacheService *gocache.Cache) *authMiddleware {
+func NewAuthMiddleware(cacheService *gocache.Cache, jwtSecret string) *authMiddleware {
 	return &authMiddleware{
 		cacheService: cacheService,
+		jwtSecret:    jwtSecret,
 	}
 }
@@ -0,0 +1,15 @@
+package grpcmiddleware
+
+import "google.golang.org/grpc"
+
+// UnaryChain adalah urutan interceptor server gRPC, dipakai cmd/grpc maupun test (bufconn) agar selalu sama
+func UnaryChain(errorMiddleware *errorMiddleware, rateLimitMiddleware *rateLimitMiddleware, authMiddleware *authMiddleware, validationMiddleware *validationMiddleware) grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(
+		LoggingMiddleware, //? paling luar: request id & access log
+		MetricsMiddleware,
+		errorMiddleware.Middleware,
+		rateLimitMiddleware.Middleware, //? sebelum auth: endpoint publik dibatasi walau tanpa token
+		authMiddleware.Middleware,
+		validationMiddleware.Middleware, //? setelah auth: request tanpa token tidak perlu divalidasi
+	)
+}
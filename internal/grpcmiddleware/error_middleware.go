@@ -2,27 +2,42 @@ package grpcmiddleware
 
 import (
 	"context"
-	"log"
 	"runtime/debug"
+	"strings"
 
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/pb/common"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
-func ErrorMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+type errorMiddleware struct {
+	//? masa transisi: error domain dikirim di BaseResponse (gRPC OK) seperti sebelumnya
+	legacyBaseResponse bool
+}
+
+func (em *errorMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Println(r)
-			debug.PrintStack()                                           //? menampilkan stack trace (menampilkan error di console)
+			//? stack trace ikut dicatat (menampilkan error di console)
+			logger.FromContext(ctx).Error("panic recovered", "panic", r, "stack", string(debug.Stack()))
 			err = status.Errorf(codes.Internal, "Internal Server Error") //? memasang status internal
 		}
 	}()
 	res, err := handler(ctx, req) //?memanggil handler disertai dengan context dan requestnya
-	log.Println(err)
 
 	if err != nil {
-		log.Println(err)
+		//? error domain (NotFound, InvalidArgument, dll) diterjemahkan ke status code-nya
+		if appErr, ok := apperror.As(err); ok {
+			if em.legacyBaseResponse {
+				return em.legacyResponse(ctx, info.FullMethod, appErr)
+			}
+			return nil, appErr.GRPCStatus().Err()
+		}
 
 		//? pengecekan
 		if st, ok := status.FromError(err); ok {
@@ -31,9 +46,87 @@ func ErrorMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, h
 			}
 		}
 
+		//? error asli hanya dicatat di log, client cukup tahu Internal Server Error
+		logger.FromContext(ctx).Error("request failed", "error", err)
+
 		return nil, status.Error(codes.Internal, "Internal Server Error")
 		// return nil, err //? jika ingin melihat errornya di postman
 	}
 
 	return res, err
 }
+
+// legacyResponse membuat response kosong milik method tsb lalu mengisi field base-nya,
+// sehingga client lama tetap menerima error lewat BaseResponse
+func (em *errorMiddleware) legacyResponse(ctx context.Context, fullMethod string, appErr *apperror.Error) (any, error) {
+	//? dulu role yang salah dikirim sbg Unauthenticated
+	if appErr.Code() == codes.PermissionDenied {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+
+	res, ok := newResponseMessage(fullMethod)
+	if !ok {
+		logger.FromContext(ctx).Warn("cannot render legacy base response, falling back to grpc status", "error", appErr)
+		return nil, appErr.GRPCStatus().Err()
+	}
+
+	base := &common.BaseResponse{
+		StatusCode: appErr.HTTPStatusCode(),
+		Message:    appErr.Message(),
+		IsError:    true,
+	}
+	for _, v := range appErr.FieldViolations() {
+		base.ValidationErrors = append(base.ValidationErrors, &common.ValidationError{
+			Field:   v.Field,
+			Message: v.Description,
+			RuleId:  v.RuleId,
+		})
+	}
+
+	baseField := res.Descriptor().Fields().ByName("base")
+	res.Set(baseField, protoreflect.ValueOfMessage(base.ProtoReflect()))
+
+	return res.Interface(), nil
+}
+
+// newResponseMessage: "/course.CourseService/DetailCourse" -> *course.DetailCourseResponse kosong
+func newResponseMessage(fullMethod string) (protoreflect.Message, bool) {
+	serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return nil, false
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, false
+	}
+
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, false
+	}
+
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(methodName))
+	if methodDesc == nil {
+		return nil, false
+	}
+
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Output().FullName())
+	if err != nil {
+		return nil, false
+	}
+
+	res := msgType.New()
+	baseField := res.Descriptor().Fields().ByName("base")
+	if baseField == nil || baseField.Message() == nil || baseField.Message().FullName() != (&common.BaseResponse{}).ProtoReflect().Descriptor().FullName() {
+		return nil, false
+	}
+
+	return res, true
+}
+
+func NewErrorMiddleware(legacyBaseResponse bool) *errorMiddleware {
+	return &errorMiddleware{
+		legacyBaseResponse: legacyBaseResponse,
+	}
+}
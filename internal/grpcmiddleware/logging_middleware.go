@@ -0,0 +1,66 @@
+package grpcmiddleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/pb/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type baseResponseGetter interface {
+	GetBase() *common.BaseResponse
+}
+
+// LoggingMiddleware harus dipasang paling luar: menentukan x-request-id lalu menulis satu access log per call
+func LoggingMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	start := time.Now()
+
+	var incoming string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logger.RequestIdHeader); len(values) > 0 {
+			incoming = values[0]
+		}
+	}
+
+	requestId := logger.ResolveRequestId(incoming)
+	ctx = logger.NewRequestContext(ctx, requestId, info.FullMethod)
+
+	//? kembalikan request id ke client agar mudah dilacak
+	if err := grpc.SetHeader(ctx, metadata.Pairs(logger.RequestIdHeader, requestId)); err != nil {
+		logger.FromContext(ctx).Warn("failed to set request id header", "error", err)
+	}
+
+	resp, err = handler(ctx, req)
+
+	code := status.Code(err)
+	attrs := []any{
+		"code", code.String(),
+		"latency_ms", time.Since(start).Milliseconds(),
+	}
+
+	//? error bisnis dikirim lewat BaseResponse (gRPC OK), status code-nya ikut dicatat
+	if base, ok := resp.(baseResponseGetter); ok && base.GetBase() != nil {
+		attrs = append(attrs, "status_code", base.GetBase().StatusCode)
+	}
+
+	logger.FromContext(ctx).Log(ctx, accessLogLevel(code), "grpc request", attrs...)
+
+	return resp, err
+}
+
+func accessLogLevel(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
@@ -0,0 +1,44 @@
+package grpcmiddleware
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// otpEvents: method auth yang juga dihitung sbg metrik OTP
+var otpEvents = map[string]string{
+	"/auth.AuthService/RequestOTP": metrics.OTPEventRequest,
+	"/auth.AuthService/Verify":     metrics.OTPEventVerify,
+}
+
+// MetricsMiddleware dipasang setelah LoggingMiddleware (di luar ErrorMiddleware) agar code yang dicatat
+// sama dengan yang diterima client
+func MetricsMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	start := time.Now()
+
+	resp, err = handler(ctx, req)
+
+	code := status.Code(err).String()
+	failed := err != nil
+
+	//? mode legacy: error bisnis dikirim lewat BaseResponse dengan gRPC OK
+	statusCode := ""
+	if base, ok := resp.(baseResponseGetter); ok && base.GetBase() != nil {
+		statusCode = strconv.FormatInt(base.GetBase().StatusCode, 10)
+		failed = failed || base.GetBase().IsError
+	}
+
+	metrics.GRPCRequestsTotal.WithLabelValues(info.FullMethod, code, statusCode).Inc()
+	metrics.GRPCRequestDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+
+	if event, ok := otpEvents[info.FullMethod]; ok {
+		metrics.OTPTotal.WithLabelValues(event, metrics.Result(failed)).Inc()
+	}
+
+	return resp, err
+}
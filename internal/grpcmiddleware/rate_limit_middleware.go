@@ -0,0 +1,95 @@
+package grpcmiddleware
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	gocache "github.com/patrickmn/go-cache"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// rateLimitIdleTTL: limiter per IP dibuang kalau IP tsb tidak request selama ini (map tidak tumbuh terus)
+const rateLimitIdleTTL = 10 * time.Minute
+
+// rateLimitMiddleware membatasi request per IP, hanya utk method dgn prefix tertentu (katalog publik tanpa token)
+type rateLimitMiddleware struct {
+	prefix   string
+	limit    rate.Limit
+	burst    int
+	limiters *gocache.Cache
+}
+
+func (rm *rateLimitMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	if !strings.HasPrefix(info.FullMethod, rm.prefix) {
+		return handler(ctx, req)
+	}
+
+	if !rm.limiter(clientIP(ctx)).Allow() {
+		return nil, apperror.ResourceExhausted("Too many requests, please try again later")
+	}
+
+	return handler(ctx, req)
+}
+
+func (rm *rateLimitMiddleware) limiter(ip string) *rate.Limiter {
+	if cached, ok := rm.limiters.Get(ip); ok {
+		limiter := cached.(*rate.Limiter)
+		rm.limiters.SetDefault(ip, limiter) //? perpanjang TTL selama IP masih aktif
+		return limiter
+	}
+
+	limiter := rate.NewLimiter(rm.limit, rm.burst)
+	//? Add gagal kalau request paralel dari IP yg sama sudah lebih dulu membuat limiter
+	if err := rm.limiters.Add(ip, limiter, gocache.DefaultExpiration); err != nil {
+		if cached, ok := rm.limiters.Get(ip); ok {
+			return cached.(*rate.Limiter)
+		}
+	}
+
+	return limiter
+}
+
+// clientIP: request dari gateway (loopback) memakai x-forwarded-for, selain itu alamat peer langsung
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	//? entry terakhir = ditambahkan proxy terdekat, entry awal bisa dipalsukan client
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-forwarded-for"); len(values) > 0 {
+			forwarded := strings.Split(values[len(values)-1], ",")
+			if last := strings.TrimSpace(forwarded[len(forwarded)-1]); last != "" {
+				return last
+			}
+		}
+	}
+
+	return host
+}
+
+func NewRateLimitMiddleware(prefix string, requestsPerSecond float64, burst int) *rateLimitMiddleware {
+	return &rateLimitMiddleware{
+		prefix:   prefix,
+		limit:    rate.Limit(requestsPerSecond),
+		burst:    burst,
+		limiters: gocache.New(rateLimitIdleTTL, rateLimitIdleTTL),
+	}
+}
@@ -0,0 +1,60 @@
+package grpcmiddleware
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/go/protovalidate"
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type validationMiddleware struct {
+	validator protovalidate.Validator
+}
+
+// Middleware menjalankan protovalidate sekali utk setiap request, sehingga handler tidak perlu validasi lagi
+func (vm *validationMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	if err := vm.validator.Validate(msg); err != nil {
+		var validationError *protovalidate.ValidationError
+		if !errors.As(err, &validationError) {
+			//? CompilationError / RuntimeError: rule di proto yang salah, bukan salah client -> Internal
+			return nil, err
+		}
+
+		return nil, validationErrorToAppError(validationError)
+	}
+
+	return handler(ctx, req)
+}
+
+// validationErrorToAppError: field dikirim dgn path lengkap (misal "lessons[0].title"), bukan hanya elemen pertama
+func validationErrorToAppError(validationError *protovalidate.ValidationError) *apperror.Error {
+	violations := make([]apperror.FieldViolation, 0, len(validationError.Violations))
+	for _, violation := range validationError.Violations {
+		violations = append(violations, apperror.FieldViolation{
+			Field:       protovalidate.FieldPathString(violation.Proto.GetField()), //? kosong utk rule level message
+			Description: violation.Proto.GetMessage(),
+			RuleId:      violation.Proto.GetRuleId(),
+		})
+	}
+
+	return apperror.InvalidArgument("Validation error").WithFieldViolations(violations)
+}
+
+func NewValidationMiddleware() (*validationMiddleware, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, err
+	}
+
+	return &validationMiddleware{
+		validator: validator,
+	}, nil
+}
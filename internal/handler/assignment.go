@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/pb/assignment"
+)
+
+type assignmentHandler struct {
+	assignment.UnimplementedAssignmentServiceServer
+
+	assignmentService service.IAssignmentService //? layer service
+}
+
+func (ah *assignmentHandler) CreateAssignment(ctx context.Context, request *assignment.CreateAssignmentRequest) (*assignment.CreateAssignmentResponse, error) {
+	res, err := ah.assignmentService.CreateAssignment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ah *assignmentHandler) DetailAssignment(ctx context.Context, request *assignment.DetailAssignmentRequest) (*assignment.DetailAssignmentResponse, error) {
+	res, err := ah.assignmentService.DetailAssignment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ah *assignmentHandler) EditAssignment(ctx context.Context, request *assignment.EditAssignmentRequest) (*assignment.EditAssignmentResponse, error) {
+	res, err := ah.assignmentService.EditAssignment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ah *assignmentHandler) DeleteAssignment(ctx context.Context, request *assignment.DeleteAssignmentRequest) (*assignment.DeleteAssignmentResponse, error) {
+	res, err := ah.assignmentService.DeleteAssignment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ah *assignmentHandler) SubmitAssignment(ctx context.Context, request *assignment.SubmitAssignmentRequest) (*assignment.SubmitAssignmentResponse, error) {
+	res, err := ah.assignmentService.SubmitAssignment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ah *assignmentHandler) ListAssignmentSubmissions(ctx context.Context, request *assignment.ListAssignmentSubmissionsRequest) (*assignment.ListAssignmentSubmissionsResponse, error) {
+	res, err := ah.assignmentService.ListAssignmentSubmissions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ah *assignmentHandler) DetailAssignmentSubmission(ctx context.Context, request *assignment.DetailAssignmentSubmissionRequest) (*assignment.DetailAssignmentSubmissionResponse, error) {
+	res, err := ah.assignmentService.DetailAssignmentSubmission(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ah *assignmentHandler) GradeAssignmentSubmission(ctx context.Context, request *assignment.GradeAssignmentSubmissionRequest) (*assignment.GradeAssignmentSubmissionResponse, error) {
+	res, err := ah.assignmentService.GradeAssignmentSubmission(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewAssignmentHandler(assignmentService service.IAssignmentService) *assignmentHandler {
+	return &assignmentHandler{
+		assignmentService: assignmentService,
+	}
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/pb/catalog"
+)
+
+type catalogHandler struct {
+	catalog.UnimplementedCatalogServiceServer
+
+	catalogService service.ICatalogService //? layer service
+}
+
+func (ch *catalogHandler) GetPublicCourse(ctx context.Context, request *catalog.GetPublicCourseRequest) (*catalog.GetPublicCourseResponse, error) {
+	res, err := ch.catalogService.GetPublicCourse(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *catalogHandler) GetPreviewLesson(ctx context.Context, request *catalog.GetPreviewLessonRequest) (*catalog.GetPreviewLessonResponse, error) {
+	res, err := ch.catalogService.GetPreviewLesson(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewCatalogHandler(catalogService service.ICatalogService) *catalogHandler {
+	return &catalogHandler{
+		catalogService: catalogService,
+	}
+}
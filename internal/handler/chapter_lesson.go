@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/abu-umair/be-lms-go/internal/service"
-	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/abu-umair/be-lms-go/pb/chapter_lesson"
 )
 
@@ -15,19 +14,25 @@ type chapterLessonHandler struct {
 }
 
 func (lh *chapterLessonHandler) CreateChapterLesson(ctx context.Context, request *chapter_lesson.CreateChapterLessonRequest) (*chapter_lesson.CreateChapterLessonResponse, error) {
-	//? validasi request
-	validationErrors, err := utils.CheckValidation(request)
+	res, err := lh.chapterLessonService.CreateChapterLesson(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &chapter_lesson.CreateChapterLessonResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
+	return res, nil
+}
+
+func (lh *chapterLessonHandler) DetailChapterLesson(ctx context.Context, request *chapter_lesson.DetailChapterLessonRequest) (*chapter_lesson.DetailChapterLessonResponse, error) {
+	res, err := lh.chapterLessonService.DetailChapterLesson(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := lh.chapterLessonService.CreateChapterLesson(ctx, request)
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) EditChapterLesson(ctx context.Context, request *chapter_lesson.EditChapterLessonRequest) (*chapter_lesson.EditChapterLessonResponse, error) {
+	res, err := ch.chapterLessonService.EditChapterLesson(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -35,20 +40,26 @@ func (lh *chapterLessonHandler) CreateChapterLesson(ctx context.Context, request
 	return res, nil
 }
 
-func (lh *chapterLessonHandler) DetailChapterLesson(ctx context.Context, request *chapter_lesson.DetailChapterLessonRequest) (*chapter_lesson.DetailChapterLessonResponse, error) {
-	//? validasi request
-	validationErrors, err := utils.CheckValidation(request)
+func (ch *chapterLessonHandler) DeleteChapterLesson(ctx context.Context, request *chapter_lesson.DeleteChapterLessonRequest) (*chapter_lesson.DeleteChapterLessonResponse, error) {
+	res, err := ch.chapterLessonService.DeleteChapterLesson(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &chapter_lesson.DetailChapterLessonResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) ReorderLessons(ctx context.Context, request *chapter_lesson.ReorderLessonsRequest) (*chapter_lesson.ReorderLessonsResponse, error) {
+	res, err := ch.chapterLessonService.ReorderLessons(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := lh.chapterLessonService.DetailChapterLesson(ctx, request)
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) MoveLesson(ctx context.Context, request *chapter_lesson.MoveLessonRequest) (*chapter_lesson.MoveLessonResponse, error) {
+	res, err := ch.chapterLessonService.MoveLesson(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -56,19 +67,26 @@ func (lh *chapterLessonHandler) DetailChapterLesson(ctx context.Context, request
 	return res, nil
 }
 
-func (ch *chapterLessonHandler) EditChapterLesson(ctx context.Context, request *chapter_lesson.EditChapterLessonRequest) (*chapter_lesson.EditChapterLessonResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (ch *chapterLessonHandler) CopyLesson(ctx context.Context, request *chapter_lesson.CopyLessonRequest) (*chapter_lesson.CopyLessonResponse, error) {
+	res, err := ch.chapterLessonService.CopyLesson(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &chapter_lesson.EditChapterLessonResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) ListChapterLessons(ctx context.Context, request *chapter_lesson.ListChapterLessonsRequest) (*chapter_lesson.ListChapterLessonsResponse, error) {
+	res, err := ch.chapterLessonService.ListChapterLessons(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := ch.chapterLessonService.EditChapterLesson(ctx, request)
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) SetLessonReleaseRule(ctx context.Context, request *chapter_lesson.SetLessonReleaseRuleRequest) (*chapter_lesson.SetLessonReleaseRuleResponse, error) {
+	res, err := ch.chapterLessonService.SetLessonReleaseRule(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -76,19 +94,35 @@ func (ch *chapterLessonHandler) EditChapterLesson(ctx context.Context, request *
 	return res, nil
 }
 
-func (ch *chapterLessonHandler) DeleteChapterLesson(ctx context.Context, request *chapter_lesson.DeleteChapterLessonRequest) (*chapter_lesson.DeleteChapterLessonResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (ch *chapterLessonHandler) CompleteLesson(ctx context.Context, request *chapter_lesson.CompleteLessonRequest) (*chapter_lesson.CompleteLessonResponse, error) {
+	res, err := ch.chapterLessonService.CompleteLesson(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) GetCourseCurriculum(ctx context.Context, request *chapter_lesson.GetCourseCurriculumRequest) (*chapter_lesson.GetCourseCurriculumResponse, error) {
+	res, err := ch.chapterLessonService.GetCourseCurriculum(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &chapter_lesson.DeleteChapterLessonResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) SearchLessonTranscripts(ctx context.Context, request *chapter_lesson.SearchLessonTranscriptsRequest) (*chapter_lesson.SearchLessonTranscriptsResponse, error) {
+	res, err := ch.chapterLessonService.SearchLessonTranscripts(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := ch.chapterLessonService.DeleteChapterLesson(ctx, request)
+	return res, nil
+}
+
+func (ch *chapterLessonHandler) RestoreChapterLesson(ctx context.Context, request *chapter_lesson.RestoreChapterLessonRequest) (*chapter_lesson.RestoreChapterLessonResponse, error) {
+	res, err := ch.chapterLessonService.RestoreChapterLesson(ctx, request)
 	if err != nil {
 		return nil, err
 	}
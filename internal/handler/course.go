@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/abu-umair/be-lms-go/internal/service"
-	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/abu-umair/be-lms-go/pb/course"
 )
 
@@ -15,18 +14,6 @@ type courseHandler struct {
 }
 
 func (sh *courseHandler) CreateCourse(ctx context.Context, request *course.CreateCourseRequest) (*course.CreateCourseResponse, error) {
-	//? validasi request
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-
-	if validationErrors != nil {
-		return &course.CreateCourseResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := sh.courseService.CreateCourse(ctx, request)
 	if err != nil {
 		return nil, err
@@ -36,18 +23,6 @@ func (sh *courseHandler) CreateCourse(ctx context.Context, request *course.Creat
 }
 
 func (sh *courseHandler) DetailCourse(ctx context.Context, request *course.DetailCourseRequest) (*course.DetailCourseResponse, error) {
-	//? validasi request
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-
-	if validationErrors != nil {
-		return &course.DetailCourseResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := sh.courseService.DetailCourse(ctx, request)
 	if err != nil {
 		return nil, err
@@ -57,17 +32,6 @@ func (sh *courseHandler) DetailCourse(ctx context.Context, request *course.Detai
 }
 
 func (sh *courseHandler) EditCourse(ctx context.Context, request *course.EditCourseRequest) (*course.EditCourseResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-
-	if validationErrors != nil {
-		return &course.EditCourseResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := sh.courseService.EditCourse(ctx, request)
 	if err != nil {
 		return nil, err
@@ -77,18 +41,16 @@ func (sh *courseHandler) EditCourse(ctx context.Context, request *course.EditCou
 }
 
 func (sh *courseHandler) DeleteCourse(ctx context.Context, request *course.DeleteCourseRequest) (*course.DeleteCourseResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+	res, err := sh.courseService.DeleteCourse(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &course.DeleteCourseResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
+	return res, nil
+}
 
-	res, err := sh.courseService.DeleteCourse(ctx, request)
+func (sh *courseHandler) RestoreCourse(ctx context.Context, request *course.RestoreCourseRequest) (*course.RestoreCourseResponse, error) {
+	res, err := sh.courseService.RestoreCourse(ctx, request)
 	if err != nil {
 		return nil, err
 	}
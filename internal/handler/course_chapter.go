@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/abu-umair/be-lms-go/internal/service"
-	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/abu-umair/be-lms-go/pb/course_chapter"
 )
 
@@ -15,19 +14,16 @@ type courseChapterHandler struct {
 }
 
 func (ch *courseChapterHandler) CreateCourseChapter(ctx context.Context, request *course_chapter.CreateCourseChapterRequest) (*course_chapter.CreateCourseChapterResponse, error) {
-	//? validasi request
-	validationErrors, err := utils.CheckValidation(request)
+	res, err := ch.courseChapterService.CreateCourseChapter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &course_chapter.CreateCourseChapterResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
+	return res, nil
+}
 
-	res, err := ch.courseChapterService.CreateCourseChapter(ctx, request)
+func (ch *courseChapterHandler) DetailCourseChapter(ctx context.Context, request *course_chapter.DetailCourseChapterRequest) (*course_chapter.DetailCourseChapterResponse, error) {
+	res, err := ch.courseChapterService.DetailCourseChapter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -35,20 +31,17 @@ func (ch *courseChapterHandler) CreateCourseChapter(ctx context.Context, request
 	return res, nil
 }
 
-func (ch *courseChapterHandler) DetailCourseChapter(ctx context.Context, request *course_chapter.DetailCourseChapterRequest) (*course_chapter.DetailCourseChapterResponse, error) {
-	//? validasi request
-	validationErrors, err := utils.CheckValidation(request)
+func (ch *courseChapterHandler) EditCourseChapter(ctx context.Context, request *course_chapter.EditCourseChapterRequest) (*course_chapter.EditCourseChapterResponse, error) {
+	res, err := ch.courseChapterService.EditCourseChapter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &course_chapter.DetailCourseChapterResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
+	return res, nil
+}
 
-	res, err := ch.courseChapterService.DetailCourseChapter(ctx, request)
+func (ch *courseChapterHandler) DeleteCourseChapter(ctx context.Context, request *course_chapter.DeleteCourseChapterRequest) (*course_chapter.DeleteCourseChapterResponse, error) {
+	res, err := ch.courseChapterService.DeleteCourseChapter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -56,19 +49,17 @@ func (ch *courseChapterHandler) DetailCourseChapter(ctx context.Context, request
 	return res, nil
 }
 
-func (ch *courseChapterHandler) EditCourseChapter(ctx context.Context, request *course_chapter.EditCourseChapterRequest) (*course_chapter.EditCourseChapterResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (ch *courseChapterHandler) ReorderChapters(ctx context.Context, request *course_chapter.ReorderChaptersRequest) (*course_chapter.ReorderChaptersResponse, error) {
+	res, err := ch.courseChapterService.ReorderChapters(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &course_chapter.EditCourseChapterResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
+	return res, nil
+}
 
-	res, err := ch.courseChapterService.EditCourseChapter(ctx, request)
+func (ch *courseChapterHandler) ListCourseChapters(ctx context.Context, request *course_chapter.ListCourseChaptersRequest) (*course_chapter.ListCourseChaptersResponse, error) {
+	res, err := ch.courseChapterService.ListCourseChapters(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -76,19 +67,17 @@ func (ch *courseChapterHandler) EditCourseChapter(ctx context.Context, request *
 	return res, nil
 }
 
-func (ch *courseChapterHandler) DeleteCourseChapter(ctx context.Context, request *course_chapter.DeleteCourseChapterRequest) (*course_chapter.DeleteCourseChapterResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (ch *courseChapterHandler) SetChapterReleaseRule(ctx context.Context, request *course_chapter.SetChapterReleaseRuleRequest) (*course_chapter.SetChapterReleaseRuleResponse, error) {
+	res, err := ch.courseChapterService.SetChapterReleaseRule(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	if validationErrors != nil {
-		return &course_chapter.DeleteCourseChapterResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
+	return res, nil
+}
 
-	res, err := ch.courseChapterService.DeleteCourseChapter(ctx, request)
+func (ch *courseChapterHandler) RestoreCourseChapter(ctx context.Context, request *course_chapter.RestoreCourseChapterRequest) (*course_chapter.RestoreCourseChapterResponse, error) {
+	res, err := ch.courseChapterService.RestoreCourseChapter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -8,11 +8,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/internal/storage"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
 
-func UploadCourseImageHandler(c *fiber.Ctx) error {
+func (sh *storageHandler) UploadCourseImage(c *fiber.Ctx) error {
 	//* 1. Generate UUID / cek course_id
 	var courseID string
 	isUpdate := false
@@ -37,6 +39,14 @@ func UploadCourseImageHandler(c *fiber.Ctx) error {
 		})
 	}
 
+	//? course_id dipakai sbg nama folder, wajib UUID agar tidak bisa keluar dari storage
+	if err := storage.ValidateId(courseID); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "course_id must be a valid UUID",
+		})
+	}
+
 	//* 2. Ambil File
 	file, err := c.FormFile("image")
 	if err != nil {
@@ -48,7 +58,13 @@ func UploadCourseImageHandler(c *fiber.Ctx) error {
 
 	//* 3. Susun Path Folder
 	//? ./storage/1234444/course/
-	folderPath := fmt.Sprintf("./storage/%s/course", courseID)
+	folderPath, err := sh.storageResolver.CourseFolder(courseID, storage.FolderCourse)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "invalid storage path",
+		})
+	}
 
 	//* 4. CEK & BUAT FOLDER (os.MkdirAll)
 	if !isUpdate { //? buat folder utk CREATE saja
@@ -101,13 +117,13 @@ func UploadCourseImageHandler(c *fiber.Ctx) error {
 	timestamp := time.Now().UnixNano()
 	fileName := fmt.Sprintf("course_%d%s", timestamp, filepath.Ext(file.Filename))
 
-	uploadPath := folderPath + "/" + fileName
+	uploadPath := filepath.Join(folderPath, fileName)
 	// c.SaveFile(file, "./storage/course/course.jpeg")
 	err = c.SaveFile(file, uploadPath)
 
 	//return error jika ada
 	if err != nil {
-		fmt.Println(err)
+		logger.FromContext(c.UserContext()).Error("failed to save course image", "error", err)
 
 		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
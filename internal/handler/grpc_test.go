@@ -0,0 +1,392 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/grpcmiddleware"
+	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/auth"
+	"github.com/abu-umair/be-lms-go/pb/catalog"
+	"github.com/abu-umair/be-lms-go/pb/course_chapter"
+	"github.com/jmoiron/sqlx"
+	gocache "github.com/patrickmn/go-cache"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	testCourseId = "1cc9d5f2-49af-4b7d-914a-c9d497007eed"
+	testPassword = "secret123"
+	// testPublicRateBurst: rate limit katalog di test sangat kecil, request ke-(burst+1) pasti ditolak
+	testPublicRateBurst = 3
+)
+
+// testServer adalah server gRPC lengkap (interceptor, handler, service) di atas bufconn, repository-nya in-memory
+type testServer struct {
+	authRepository          *fake.AuthRepository
+	courseChapterRepository *fake.CourseChapterRepository
+	courseRepository        *fake.CourseRepository
+	sqlMock                 sqlmock.Sqlmock
+
+	authClient          auth.AuthServiceClient
+	courseChapterClient course_chapter.CourseChapterServiceClient
+	catalogClient       catalog.CatalogServiceClient
+}
+
+func newTestServer(t *testing.T, legacyBaseResponse bool) *testServer {
+	t.Helper()
+
+	mockDB, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := sqlx.NewDb(mockDB, "sqlmock")
+
+	ts := &testServer{
+		authRepository:          fake.NewAuthRepository(),
+		courseChapterRepository: fake.NewCourseChapterRepository(),
+		courseRepository:        fake.NewCourseRepository(),
+		sqlMock:                 sqlMock,
+	}
+
+	jwtConfig := config.JWTConfig{Secret: "test-secret", TTL: time.Hour}
+	cacheService := gocache.New(time.Hour, time.Hour)
+
+	validationMiddleware, err := grpcmiddleware.NewValidationMiddleware()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serv := grpc.NewServer(grpcmiddleware.UnaryChain(
+		grpcmiddleware.NewErrorMiddleware(legacyBaseResponse),
+		grpcmiddleware.NewRateLimitMiddleware("/catalog.CatalogService/", 0.001, testPublicRateBurst),
+		grpcmiddleware.NewAuthMiddleware(cacheService, jwtConfig.Secret),
+		validationMiddleware,
+	))
+
+	authService := service.NewAuthService(ts.authRepository, cacheService, fake.NewMessageSender(), jwtConfig)
+	auth.RegisterAuthServiceServer(serv, NewAuthHandler(authService))
+	chapterLessonRepository := fake.NewChapterLessonRepository()
+	courseRepository := ts.courseRepository
+	releaseRuleRepository := fake.NewReleaseRuleRepository(ts.courseChapterRepository, chapterLessonRepository)
+	releaseService := service.NewReleaseService(courseRepository, chapterLessonRepository, fake.NewEnrollmentRepository(), releaseRuleRepository, fake.NewLessonCompletionRepository(chapterLessonRepository), fake.NewQuizRepository(), fake.NewQuizAttemptRepository())
+	courseChapterService := service.NewCourseChapterService(db, ts.courseChapterRepository, chapterLessonRepository, courseRepository, releaseRuleRepository, releaseService)
+	course_chapter.RegisterCourseChapterServiceServer(serv, NewCourseChapterHandler(courseChapterService))
+	catalogService := service.NewCatalogService(courseRepository, ts.courseChapterRepository, chapterLessonRepository, config.StorageConfig{ServiceURL: "http://localhost/storage"})
+	catalog.RegisterCatalogServiceServer(serv, NewCatalogHandler(catalogService))
+
+	lis := bufconn.Listen(1024 * 1024)
+	go serv.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		serv.Stop()
+		if err := sqlMock.ExpectationsWereMet(); err != nil {
+			t.Errorf("transaction expectations: %v", err)
+		}
+		mockDB.Close()
+	})
+
+	ts.authClient = auth.NewAuthServiceClient(conn)
+	ts.courseChapterClient = course_chapter.NewCourseChapterServiceClient(conn)
+	ts.catalogClient = catalog.NewCatalogServiceClient(conn)
+
+	return ts
+}
+
+// login menyimpan user dgn role tsb lalu login lewat RPC, mengembalikan context berisi token
+func (ts *testServer) login(t *testing.T, role string) context.Context {
+	t.Helper()
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	email := role + "@example.com"
+	ts.authRepository.AddUser(entity.Users{
+		Id:        "user-" + role,
+		FullName:  "Test " + role,
+		Email:     email,
+		Password:  string(hashed),
+		RoleCode:  role,
+		CreatedAt: time.Now(),
+	})
+
+	res, err := ts.authClient.Login(context.Background(), &auth.LoginRequest{Email: email, Password: testPassword})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+res.AccessToken)
+}
+
+func validCreateCourseChapterRequest() *course_chapter.CreateCourseChapterRequest {
+	return &course_chapter.CreateCourseChapterRequest{
+		InstructorId: "user-instructor",
+		CourseId:     testCourseId,
+		Title:        "Introduction",
+		OrderChapter: 1,
+	}
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("code = %s, want %s (err: %v)", got, want, err)
+	}
+}
+
+func TestGRPCAuthentication(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      func(t *testing.T, ts *testServer) context.Context
+		wantCode codes.Code
+	}{
+		{
+			name:     "without token",
+			ctx:      func(t *testing.T, ts *testServer) context.Context { return context.Background() },
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "malformed token",
+			ctx: func(t *testing.T, ts *testServer) context.Context {
+				return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer not-a-jwt")
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "token after logout",
+			ctx: func(t *testing.T, ts *testServer) context.Context {
+				ctx := ts.login(t, entity.UserRoleUser)
+				if _, err := ts.authClient.Logout(ctx, &auth.LogoutRequest{}); err != nil {
+					t.Fatalf("logout: %v", err)
+				}
+				return ctx
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "valid token",
+			ctx:      func(t *testing.T, ts *testServer) context.Context { return ts.login(t, entity.UserRoleUser) },
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t, false)
+
+			res, err := ts.authClient.GetProfile(tt.ctx(t, ts), &auth.GetProfileRequest{})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && res.Email != "user@example.com" {
+				t.Errorf("email = %q, want user@example.com", res.Email)
+			}
+		})
+	}
+}
+
+func TestGRPCLoginIsWhitelisted(t *testing.T) {
+	ts := newTestServer(t, false)
+
+	//? tanpa token, tapi tetap melewati validasi & service (user belum terdaftar)
+	_, err := ts.authClient.Login(context.Background(), &auth.LoginRequest{Email: "nobody@example.com", Password: testPassword})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestGRPCCatalogIsPublic(t *testing.T) {
+	ts := newTestServer(t, false)
+	ts.courseRepository.AddCourse(entity.Course{
+		Id:         testCourseId,
+		Name:       "Golang Dasar",
+		Status:     utils.StringToPtr(entity.CourseStatusActive),
+		IsApproved: utils.StringToPtr(entity.CourseApproved),
+	})
+
+	//? tanpa token
+	res, err := ts.catalogClient.GetPublicCourse(context.Background(), &catalog.GetPublicCourseRequest{Id: testCourseId})
+	assertCode(t, err, codes.OK)
+	if res.Name != "Golang Dasar" {
+		t.Errorf("name = %q, want Golang Dasar", res.Name)
+	}
+}
+
+func TestGRPCCatalogRateLimit(t *testing.T) {
+	ts := newTestServer(t, false)
+
+	for i := 0; i < testPublicRateBurst; i++ {
+		_, err := ts.catalogClient.GetPublicCourse(context.Background(), &catalog.GetPublicCourseRequest{Id: testCourseId})
+		assertCode(t, err, codes.NotFound)
+	}
+
+	_, err := ts.catalogClient.GetPublicCourse(context.Background(), &catalog.GetPublicCourseRequest{Id: testCourseId})
+	assertCode(t, err, codes.ResourceExhausted)
+
+	//? RPC lain tidak ikut dibatasi
+	_, err = ts.authClient.Login(context.Background(), &auth.LoginRequest{Email: "nobody@example.com", Password: testPassword})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestGRPCValidationError(t *testing.T) {
+	ts := newTestServer(t, false)
+	ctx := ts.login(t, entity.UserRoleInstructor)
+
+	request := validCreateCourseChapterRequest()
+	request.CourseId = "not-a-uuid"
+	request.Title = ""
+
+	_, err := ts.courseChapterClient.CreateCourseChapter(ctx, request)
+	assertCode(t, err, codes.InvalidArgument)
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if badRequest == nil {
+		t.Fatal("status has no BadRequest detail")
+	}
+
+	got := map[string]string{}
+	for _, violation := range badRequest.FieldViolations {
+		got[violation.Field] = violation.Reason
+	}
+	want := map[string]string{
+		"course_id": "string.uuid",
+		"title":     "string.min_len",
+	}
+	for field, ruleId := range want {
+		if got[field] != ruleId {
+			t.Errorf("violation %s = %q, want rule %q (all: %v)", field, got[field], ruleId, got)
+		}
+	}
+}
+
+func TestGRPCLegacyBaseResponse(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		request        func() *course_chapter.CreateCourseChapterRequest
+		wantCode       codes.Code
+		wantStatusCode int64
+	}{
+		{
+			name: "validation error in base response",
+			role: entity.UserRoleInstructor,
+			request: func() *course_chapter.CreateCourseChapterRequest {
+				r := validCreateCourseChapterRequest()
+				r.Title = ""
+				return r
+			},
+			wantCode:       codes.OK,
+			wantStatusCode: 400,
+		},
+		{
+			name:     "permission denied stays unauthenticated",
+			role:     entity.UserRoleUser,
+			request:  validCreateCourseChapterRequest,
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t, true)
+			ctx := ts.login(t, tt.role)
+
+			res, err := ts.courseChapterClient.CreateCourseChapter(ctx, tt.request())
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			base := res.GetBase()
+			if !base.GetIsError() || base.GetStatusCode() != tt.wantStatusCode {
+				t.Fatalf("base = %+v, want error with status_code %d", base, tt.wantStatusCode)
+			}
+			if len(base.ValidationErrors) != 1 || base.ValidationErrors[0].Field != "title" || base.ValidationErrors[0].RuleId != "string.min_len" {
+				t.Errorf("validation_errors = %v, want title string.min_len", base.ValidationErrors)
+			}
+		})
+	}
+}
+
+func TestGRPCCreateCourseChapter(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		writeErr error
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			role:     entity.UserRoleUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "repository error is internal",
+			role:     entity.UserRoleInstructor,
+			writeErr: context.DeadlineExceeded,
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "instructor",
+			role:     entity.UserRoleInstructor,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t, false)
+			ctx := ts.login(t, tt.role)
+			ts.courseRepository.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr("user-instructor")})
+			ts.courseChapterRepository.WriteErr = tt.writeErr
+			if tt.role == entity.UserRoleInstructor {
+				ts.sqlMock.ExpectBegin()
+				if tt.wantCode == codes.OK {
+					ts.sqlMock.ExpectCommit()
+				} else {
+					ts.sqlMock.ExpectRollback()
+				}
+			}
+
+			res, err := ts.courseChapterClient.CreateCourseChapter(ctx, validCreateCourseChapterRequest())
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.GetBase().GetStatusCode() != 200 {
+				t.Errorf("status_code = %d, want 200", res.GetBase().GetStatusCode())
+			}
+			if chapters := ts.courseChapterRepository.CourseChapters(); len(chapters) != 1 || chapters[0].CreatedBy != "Test instructor" {
+				t.Errorf("chapters = %+v, want one chapter created by the instructor", chapters)
+			}
+		})
+	}
+}
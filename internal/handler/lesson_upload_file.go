@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/caption"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// privateUpload: aturan upload file private di storage/<course_id>/<folder>
+type privateUpload struct {
+	folder      string   //? storage.FolderLesson / storage.FolderSubmission
+	prefix      string   //? awalan nama file, misal lesson_1623232.mp4
+	allowedExts []string //? lowercase, dengan titik
+	// convert (opsional) memvalidasi & mengubah isi file sebelum disimpan, mengembalikan isi & ekstensi baru
+	convert  func(data []byte, ext string) ([]byte, string, error)
+	maxBytes int64 //? hanya dicek jika convert diisi (file dibaca ke memory)
+	// ownedByUser: id uploader masuk ke nama file (<prefix>_<user_id>_<unixnano>)
+	ownedByUser bool
+	// courseOwnerOnly: hanya instructor pemilik course_id yang boleh upload (materi lesson)
+	courseOwnerOnly bool
+}
+
+var (
+	lessonUpload = privateUpload{
+		folder:          storage.FolderLesson,
+		prefix:          "lesson",
+		allowedExts:     []string{".mp4", ".webm", ".mp3", ".pdf", ".zip", ".pptx", ".docx"},
+		courseOwnerOnly: true,
+	}
+	//? folder submission dipakai bersama satu course, jadi file dicatat pemiliknya agar tidak bisa dipakai learner lain
+	submissionUpload = privateUpload{
+		folder:      storage.FolderSubmission,
+		prefix:      storage.SubmissionFilePrefix,
+		allowedExts: []string{".pdf", ".zip", ".docx", ".pptx", ".xlsx", ".txt", ".jpg", ".jpeg", ".png"},
+		ownedByUser: true,
+	}
+	//? SRT dikonversi ke WebVTT, jadi file caption di storage selalu .vtt
+	captionUpload = privateUpload{
+		folder:          storage.FolderLesson,
+		prefix:          "caption",
+		allowedExts:     []string{caption.FormatVTT, caption.FormatSRT},
+		convert:         convertCaption,
+		maxBytes:        2 * 1024 * 1024,
+		courseOwnerOnly: true,
+	}
+)
+
+// UploadLessonFile menyimpan file materi lesson di storage/<course_id>/lesson (wajib instructor pemilik course).
+// File ini private, hanya bisa diunduh lewat signed URL dari DetailChapterLesson.
+func (sh *storageHandler) UploadLessonFile(c *fiber.Ctx) error {
+	return sh.savePrivateUpload(c, lessonUpload)
+}
+
+// UploadLessonCaption menyimpan caption video (WebVTT / SRT) di storage/<course_id>/lesson sbg WebVTT (wajib instructor pemilik course).
+// Timing cue divalidasi, nama file yang dikembalikan dipakai di content.video.captions.
+func (sh *storageHandler) UploadLessonCaption(c *fiber.Ctx) error {
+	return sh.savePrivateUpload(c, captionUpload)
+}
+
+// UploadSubmissionFile menyimpan file jawaban assignment di storage/<course_id>/submission (wajib login).
+// Nama file yang dikembalikan dikirim lewat SubmitAssignment oleh user yang sama, file hanya bisa diunduh lewat signed URL.
+func (sh *storageHandler) UploadSubmissionFile(c *fiber.Ctx) error {
+	return sh.savePrivateUpload(c, submissionUpload)
+}
+
+func (sh *storageHandler) savePrivateUpload(c *fiber.Ctx, upload privateUpload) error {
+	//* 0. semua upload private wajib login (Bearer token)
+	claims, err := sh.claimsFromRequest(c)
+	if err != nil {
+		return c.Status(http.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "unauthenticated",
+		})
+	}
+
+	//? file milik user: id uploader masuk ke nama file
+	prefix := upload.prefix + "_"
+	if upload.ownedByUser {
+		prefix = storage.UserFilePrefix(upload.prefix, claims.Subject)
+	}
+
+	//* 1. course_id wajib ada (lesson / assignment selalu milik course yang sudah dibuat)
+	courseID := c.FormValue("course_id")
+	if courseID == "" {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "course_id is required",
+		})
+	}
+
+	//? course_id dipakai sbg nama folder, wajib UUID agar tidak bisa keluar dari storage
+	if err := storage.ValidateId(courseID); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "course_id must be a valid UUID",
+		})
+	}
+
+	//? materi lesson hanya boleh ditulis instructor pemilik course (sama seperti ensureCourseOwner di service)
+	if upload.courseOwnerOnly {
+		status, message, err := sh.checkCourseOwner(c, claims, courseID)
+		if err != nil {
+			logger.FromContext(c.UserContext()).Error("failed to get course", "course_id", courseID, "error", err)
+
+			return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "internal server error",
+			})
+		}
+		if status != http.StatusOK {
+			return c.Status(status).JSON(fiber.Map{
+				"success": false,
+				"message": message,
+			})
+		}
+	}
+
+	//* 2. Ambil File
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "file data not found",
+		})
+	}
+
+	//? validasi ekstensi file
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+
+	if !slices.Contains(upload.allowedExts, ext) {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": fmt.Sprintf("file extention not allowed (%s)", strings.ReplaceAll(strings.Join(upload.allowedExts, ", "), ".", "")),
+		})
+	}
+
+	//* 3. Susun Path Folder & buat jika belum ada
+	//? ./storage/1234444/lesson/
+	folderPath, err := sh.storageResolver.CourseFolder(courseID, upload.folder)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "invalid storage path",
+		})
+	}
+
+	err = os.MkdirAll(folderPath, 0755)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "failed to create directory",
+		})
+	}
+
+	var content []byte
+	if upload.convert != nil {
+		if file.Size > upload.maxBytes {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": fmt.Sprintf("file is too large (max %d KB)", upload.maxBytes/1024),
+			})
+		}
+
+		content, err = readUploadedFile(file)
+		if err != nil {
+			logger.FromContext(c.UserContext()).Error("failed to read uploaded file", "folder", upload.folder, "error", err)
+
+			return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "internal server error",
+			})
+		}
+
+		content, ext, err = upload.convert(content, ext)
+		if err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": err.Error(),
+			})
+		}
+	}
+
+	//* lesson_1623232.mp4 / submission_<user_id>_1623232.pdf (membuat format file name)
+	timestamp := time.Now().UnixNano()
+	fileName := fmt.Sprintf("%s%d%s", prefix, timestamp, ext)
+
+	if content != nil {
+		err = os.WriteFile(filepath.Join(folderPath, fileName), content, 0644)
+	} else {
+		err = c.SaveFile(file, filepath.Join(folderPath, fileName))
+	}
+	if err != nil {
+		logger.FromContext(c.UserContext()).Error("failed to save uploaded file", "folder", upload.folder, "error", err)
+
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "internal server error",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success":   true,
+		"message":   "Upload success",
+		"course_id": courseID,
+		"file_name": fileName,
+	})
+}
+
+// checkCourseOwner: status http & pesan jika user bukan instructor pemilik course (http.StatusOK jika boleh)
+func (sh *storageHandler) checkCourseOwner(c *fiber.Ctx, claims *jwtentity.JwtClaims, courseID string) (int, string, error) {
+	if claims.Role != entity.UserRoleInstructor {
+		return http.StatusForbidden, "Only instructor can access this resource", nil
+	}
+
+	courseEntity, err := sh.courseRepository.GetCourseById(c.UserContext(), courseID)
+	if err != nil {
+		return 0, "", err
+	}
+	if courseEntity == nil {
+		return http.StatusNotFound, "Course not found", nil
+	}
+	if courseEntity.InstructorId == nil || *courseEntity.InstructorId != claims.Subject {
+		return http.StatusForbidden, "This resource can only be managed within your own courses", nil
+	}
+
+	return http.StatusOK, "", nil
+}
+
+// claimsFromRequest membaca header Authorization: Bearer <token> (token yang sama dgn gRPC)
+func (sh *storageHandler) claimsFromRequest(c *fiber.Ctx) (*jwtentity.JwtClaims, error) {
+	token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if !ok || token == "" {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	claims, err := jwtentity.GetClaimsFromToken(token, sh.jwtSecret)
+	if err != nil {
+		return nil, err
+	}
+	//? id user dipakai di nama file, wajib UUID
+	if storage.ValidateId(claims.Subject) != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	return claims, nil
+}
+
+func readUploadedFile(file *multipart.FileHeader) ([]byte, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
+
+// convertCaption: caption yang tidak valid ditolak dgn nomor baris-nya, hasil selalu WebVTT
+func convertCaption(data []byte, ext string) ([]byte, string, error) {
+	cues, err := caption.Parse(data, ext)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid caption file: %w", err)
+	}
+
+	return caption.WriteVTT(cues), caption.FormatVTT, nil
+}
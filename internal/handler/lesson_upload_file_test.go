@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testUploadSecret       = "test-secret"
+	testUploadInstructorId = "8f2b7c1e-3d4a-4e5f-9a6b-7c8d9e0f1a2b"
+	testUploadOtherUserId  = "6c1a8d2f-8e4a-4a47-8d66-4a8a1f4cab22"
+)
+
+// uploadToken: token login (HS256) dgn role & subject tsb
+func uploadToken(t *testing.T, role string, userId string) string {
+	t.Helper()
+
+	claims := jwtentity.JwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userId,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Role: role,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testUploadSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestStorageHandlerUploadLessonFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      func(t *testing.T) string
+		courseId   string
+		wantStatus int
+	}{
+		{
+			name:       "without token",
+			token:      func(t *testing.T) string { return "" },
+			courseId:   testCourseId,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid token",
+			token:      func(t *testing.T) string { return "not-a-token" },
+			courseId:   testCourseId,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "user role",
+			token:      func(t *testing.T) string { return uploadToken(t, entity.UserRoleUser, testUploadInstructorId) },
+			courseId:   testCourseId,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "other instructor's course",
+			token:      func(t *testing.T) string { return uploadToken(t, entity.UserRoleInstructor, testUploadOtherUserId) },
+			courseId:   testCourseId,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "course not found",
+			token:      func(t *testing.T) string { return uploadToken(t, entity.UserRoleInstructor, testUploadInstructorId) },
+			courseId:   "2b1f6a4e-5c3d-4e2f-8a1b-9c0d1e2f3a4b",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "course owner",
+			token:      func(t *testing.T) string { return uploadToken(t, entity.UserRoleInstructor, testUploadInstructorId) },
+			courseId:   testCourseId,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			resolver, err := storage.NewResolver(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			courses := fake.NewCourseRepository()
+			courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUploadInstructorId)})
+
+			app := fiber.New()
+			app.Post("/lesson/upload", NewStorageHandler(resolver, "signing-secret", testUploadSecret, courses).UploadLessonFile)
+
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			writer.WriteField("course_id", tt.courseId)
+			part, err := writer.CreateFormFile("file", "video.mp4")
+			if err != nil {
+				t.Fatal(err)
+			}
+			part.Write([]byte("video"))
+			writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/lesson/upload", &body)
+			req.Header.Set(fiber.HeaderContentType, writer.FormDataContentType())
+			if token := tt.token(t); token != "" {
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+			}
+
+			res, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+
+			//? file hanya ditulis jika upload diterima
+			entries, _ := os.ReadDir(filepath.Join(root, tt.courseId, storage.FolderLesson))
+			if gotFile := len(entries) > 0; gotFile != (tt.wantStatus == http.StatusOK) {
+				t.Errorf("files written = %d, want written only on success", len(entries))
+			}
+		})
+	}
+}
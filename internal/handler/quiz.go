@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/pb/quiz"
+)
+
+type quizHandler struct {
+	quiz.UnimplementedQuizServiceServer
+
+	quizService service.IQuizService //? layer service
+}
+
+func (qh *quizHandler) CreateQuiz(ctx context.Context, request *quiz.CreateQuizRequest) (*quiz.CreateQuizResponse, error) {
+	res, err := qh.quizService.CreateQuiz(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) DetailQuiz(ctx context.Context, request *quiz.DetailQuizRequest) (*quiz.DetailQuizResponse, error) {
+	res, err := qh.quizService.DetailQuiz(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) EditQuiz(ctx context.Context, request *quiz.EditQuizRequest) (*quiz.EditQuizResponse, error) {
+	res, err := qh.quizService.EditQuiz(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) DeleteQuiz(ctx context.Context, request *quiz.DeleteQuizRequest) (*quiz.DeleteQuizResponse, error) {
+	res, err := qh.quizService.DeleteQuiz(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) CreateQuizQuestion(ctx context.Context, request *quiz.CreateQuizQuestionRequest) (*quiz.CreateQuizQuestionResponse, error) {
+	res, err := qh.quizService.CreateQuizQuestion(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) EditQuizQuestion(ctx context.Context, request *quiz.EditQuizQuestionRequest) (*quiz.EditQuizQuestionResponse, error) {
+	res, err := qh.quizService.EditQuizQuestion(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) DeleteQuizQuestion(ctx context.Context, request *quiz.DeleteQuizQuestionRequest) (*quiz.DeleteQuizQuestionResponse, error) {
+	res, err := qh.quizService.DeleteQuizQuestion(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) StartQuizAttempt(ctx context.Context, request *quiz.StartQuizAttemptRequest) (*quiz.StartQuizAttemptResponse, error) {
+	res, err := qh.quizService.StartQuizAttempt(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) SubmitQuizAttempt(ctx context.Context, request *quiz.SubmitQuizAttemptRequest) (*quiz.SubmitQuizAttemptResponse, error) {
+	res, err := qh.quizService.SubmitQuizAttempt(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) ListQuizAttempts(ctx context.Context, request *quiz.ListQuizAttemptsRequest) (*quiz.ListQuizAttemptsResponse, error) {
+	res, err := qh.quizService.ListQuizAttempts(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) DetailQuizAttempt(ctx context.Context, request *quiz.DetailQuizAttemptRequest) (*quiz.DetailQuizAttemptResponse, error) {
+	res, err := qh.quizService.DetailQuizAttempt(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (qh *quizHandler) GetCourseQuizProgress(ctx context.Context, request *quiz.GetCourseQuizProgressRequest) (*quiz.GetCourseQuizProgressResponse, error) {
+	res, err := qh.quizService.GetCourseQuizProgress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewQuizHandler(quizService service.IQuizService) *quizHandler {
+	return &quizHandler{
+		quizService: quizService,
+	}
+}
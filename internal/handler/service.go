@@ -13,18 +13,6 @@ type serviceHandler struct {
 }
 
 func (sh *serviceHandler) HelloWorld(ctx context.Context, request *service.HelloWorldRequest) (*service.HelloWorldResponse, error) {
-	//? validasi request
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-
-	if validationErrors != nil {
-		return &service.HelloWorldResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	// panic(errors.New("pointer nil"))
 	return &service.HelloWorldResponse{
 		Message: fmt.Sprintf("Hello %s", request.Name),
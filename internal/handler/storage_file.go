@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/logger"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// immutableCacheControl dipakai utk file yang namanya unik per upload (course_<unixnano>.jpg),
+// isi file tidak pernah berubah sehingga browser boleh menyimpan selamanya.
+const immutableCacheControl = "public, max-age=31536000, immutable"
+
+// privateCacheControl dipakai utk file lesson (signed URL), tidak boleh disimpan di shared cache/CDN.
+const privateCacheControl = "private, no-cache"
+
+var errUnsatisfiableRange = errors.New("unsatisfiable range")
+
+type byteRange struct {
+	start  int64
+	length int64
+}
+
+// fileWithSection membungkus potongan file agar file tetap ditutup oleh fasthttp setelah dikirim
+type fileWithSection struct {
+	*io.SectionReader
+	io.Closer
+}
+
+type storageHandler struct {
+	storageResolver *storage.Resolver
+	signingSecret   string
+	jwtSecret       string //? upload private wajib login
+	//? upload materi lesson dicek pemilik course-nya
+	courseRepository repository.ICourseRepository
+}
+
+func (sh *storageHandler) GetCourseImage(c *fiber.Ctx) error {
+	filePath, err := sh.storageResolver.CourseFile(c.Params("course_id"), storage.FolderCourse, c.Params("filename"))
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString("Not Found")
+	}
+
+	return serveStorageFile(c, filePath, immutableCacheControl)
+}
+
+// GetLessonFile hanya mengirim file lesson jika signed URL (expires, uid, sig) valid
+func (sh *storageHandler) GetLessonFile(c *fiber.Ctx) error {
+	return sh.serveSignedFile(c, storage.FolderLesson)
+}
+
+// GetSubmissionFile hanya mengirim file jawaban assignment jika signed URL valid
+func (sh *storageHandler) GetSubmissionFile(c *fiber.Ctx) error {
+	return sh.serveSignedFile(c, storage.FolderSubmission)
+}
+
+func (sh *storageHandler) serveSignedFile(c *fiber.Ctx, folder string) error {
+	courseID := c.Params("course_id")
+	fileNameParam := c.Params("filename")
+
+	filePath, err := sh.storageResolver.CourseFile(courseID, folder, fileNameParam)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString("Not Found")
+	}
+
+	storagePath := path.Join(courseID, folder, fileNameParam)
+	err = utils.VerifyStorageSignature(
+		storagePath,
+		c.Query("uid"),
+		c.Query("expires"),
+		c.Query("sig"),
+		sh.signingSecret,
+	)
+	if err != nil {
+		if errors.Is(err, utils.ErrSigningSecretEmpty) {
+			logger.FromContext(c.UserContext()).Error("storage signing secret is not configured", "error", err)
+			return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+		}
+		return c.Status(http.StatusForbidden).SendString("Forbidden")
+	}
+	logger.SetUserId(c.UserContext(), c.Query("uid")) //? uid sudah terbukti lewat signature
+
+	return serveStorageFile(c, filePath, privateCacheControl)
+}
+
+// serveStorageFile mengirim file dari storage dengan dukungan Range (206 Partial Content),
+// revalidasi If-None-Match / If-Modified-Since (304 Not Modified) dan header Cache-Control.
+func serveStorageFile(c *fiber.Ctx, filePath string, cacheControl string) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c.Status(http.StatusNotFound).SendString("Not Found")
+		}
+		logger.FromContext(c.UserContext()).Error("failed to stat storage file", "error", err)
+		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+	}
+	if info.IsDir() {
+		return c.Status(http.StatusNotFound).SendString("Not Found")
+	}
+
+	size := info.Size()
+	modTime := info.ModTime().UTC().Truncate(time.Second) //? header Last-Modified hanya presisi detik
+	etag := fmt.Sprintf(`"%x-%x"`, size, info.ModTime().UnixNano())
+
+	//* header yang selalu dikirim (termasuk pada 304)
+	c.Set(fiber.HeaderAcceptRanges, "bytes")
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderLastModified, modTime.Format(http.TimeFormat))
+	c.Set(fiber.HeaderCacheControl, cacheControl)
+
+	//* revalidasi (conditional request)
+	if isNotModified(c, etag, modTime) {
+		return c.SendStatus(http.StatusNotModified)
+	}
+
+	mimeType := mime.TypeByExtension(filepath.Ext(filePath))
+	if mimeType == "" {
+		mimeType = fiber.MIMEOctetStream
+	}
+	c.Set(fiber.HeaderContentType, mimeType)
+
+	//* Range hanya dipakai jika If-Range (bila ada) masih cocok dengan versi file
+	rangeHeader := c.Get(fiber.HeaderRange)
+	if rangeHeader != "" && !ifRangeMatches(c.Get(fiber.HeaderIfRange), etag, modTime) {
+		rangeHeader = ""
+	}
+
+	ranges, err := parseByteRanges(rangeHeader, size)
+	if err != nil {
+		c.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", size))
+		return c.SendStatus(http.StatusRequestedRangeNotSatisfiable)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		logger.FromContext(c.UserContext()).Error("failed to open storage file", "error", err)
+		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+	}
+
+	//? multi-range (multipart/byteranges) tidak didukung, RFC 7233 membolehkan server mengirim file utuh
+	if len(ranges) != 1 {
+		return c.Status(http.StatusOK).SendStream(file, int(size))
+	}
+
+	r := ranges[0]
+	c.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", r.start, r.start+r.length-1, size))
+	return c.Status(http.StatusPartialContent).SendStream(&fileWithSection{
+		SectionReader: io.NewSectionReader(file, r.start, r.length),
+		Closer:        file,
+	}, int(r.length))
+}
+
+// isNotModified mengikuti urutan evaluasi RFC 7232: If-None-Match lebih diutamakan dari If-Modified-Since
+func isNotModified(c *fiber.Ctx, etag string, modTime time.Time) bool {
+	if c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead {
+		return false
+	}
+
+	if inm := c.Get(fiber.HeaderIfNoneMatch); inm != "" {
+		return etagMatches(inm, etag)
+	}
+
+	ims := c.Get(fiber.HeaderIfModifiedSince)
+	if ims == "" {
+		return false
+	}
+	t, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+
+	return !modTime.After(t)
+}
+
+// etagMatches membandingkan daftar ETag dari header dengan weak comparison
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ifRangeMatches(ifRange string, etag string, modTime time.Time) bool {
+	if ifRange == "" {
+		return true
+	}
+
+	//? If-Range berupa ETag harus strong comparison
+	if strings.HasPrefix(ifRange, `"`) {
+		return ifRange == etag
+	}
+
+	t, err := http.ParseTime(ifRange)
+	if err != nil {
+		return false
+	}
+
+	return modTime.Equal(t)
+}
+
+// parseByteRanges mem-parsing header "Range: bytes=0-499,-500,9500-".
+// Mengembalikan nil jika header kosong/tidak dikenali (file dikirim utuh).
+func parseByteRanges(header string, size int64) ([]byteRange, error) {
+	const prefix = "bytes="
+	if header == "" || !strings.HasPrefix(header, prefix) {
+		return nil, nil
+	}
+
+	var ranges []byteRange
+	for _, spec := range strings.Split(header[len(prefix):], ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+
+		startStr, endStr, ok := strings.Cut(spec, "-")
+		if !ok {
+			return nil, errUnsatisfiableRange
+		}
+		startStr, endStr = strings.TrimSpace(startStr), strings.TrimSpace(endStr)
+
+		var r byteRange
+		if startStr == "" {
+			//? suffix range: "-500" berarti 500 byte terakhir
+			n, err := strconv.ParseInt(endStr, 10, 64)
+			if err != nil || n <= 0 {
+				return nil, errUnsatisfiableRange
+			}
+			if n > size {
+				n = size
+			}
+			r.start = size - n
+			r.length = n
+		} else {
+			start, err := strconv.ParseInt(startStr, 10, 64)
+			if err != nil || start < 0 || start >= size {
+				return nil, errUnsatisfiableRange
+			}
+
+			end := size - 1
+			if endStr != "" {
+				end, err = strconv.ParseInt(endStr, 10, 64)
+				if err != nil || end < start {
+					return nil, errUnsatisfiableRange
+				}
+				if end >= size {
+					end = size - 1
+				}
+			}
+			r.start = start
+			r.length = end - start + 1
+		}
+
+		if r.length > 0 {
+			ranges = append(ranges, r)
+		}
+	}
+
+	if len(ranges) == 0 {
+		return nil, errUnsatisfiableRange
+	}
+
+	return ranges, nil
+}
+
+func NewStorageHandler(storageResolver *storage.Resolver, signingSecret string, jwtSecret string, courseRepository repository.ICourseRepository) *storageHandler {
+	return &storageHandler{
+		storageResolver:  storageResolver,
+		signingSecret:    signingSecret,
+		jwtSecret:        jwtSecret,
+		courseRepository: courseRepository,
+	}
+}
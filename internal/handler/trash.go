@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/service"
+	"github.com/abu-umair/be-lms-go/pb/trash"
+)
+
+type trashHandler struct {
+	trash.UnimplementedTrashServiceServer
+
+	trashService service.ITrashService //? layer service
+}
+
+func (th *trashHandler) ListTrash(ctx context.Context, request *trash.ListTrashRequest) (*trash.ListTrashResponse, error) {
+	res, err := th.trashService.ListTrash(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (th *trashHandler) PurgeTrash(ctx context.Context, request *trash.PurgeTrashRequest) (*trash.PurgeTrashResponse, error) {
+	res, err := th.trashService.PurgeTrash(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewTrashHandler(trashService service.ITrashService) *trashHandler {
+	return &trashHandler{
+		trashService: trashService,
+	}
+}
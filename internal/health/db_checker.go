@@ -0,0 +1,78 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// DBChecker memantau koneksi DB secara berkala dan menyetel status grpc.health.v1:
+// NOT_SERVING jika ping gagal, SERVING lagi setelah pulih
+type DBChecker struct {
+	db           *sqlx.DB
+	healthServer *health.Server
+	services     []string
+	interval     time.Duration
+	timeout      time.Duration
+}
+
+// NewDBChecker: services adalah nama service gRPC (misal "course.CourseService") yang bergantung ke DB,
+// status "" (keseluruhan server) selalu ikut disetel
+func NewDBChecker(db *sqlx.DB, healthServer *health.Server, interval time.Duration, timeout time.Duration, services ...string) *DBChecker {
+	return &DBChecker{
+		db:           db,
+		healthServer: healthServer,
+		services:     append([]string{""}, services...),
+		interval:     interval,
+		timeout:      timeout,
+	}
+}
+
+// Run mengecek sekali di awal lalu setiap interval sampai ctx selesai
+func (c *DBChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	lastStatus := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		status := c.check(ctx)
+		if status != lastStatus {
+			//? hanya perubahan status yang dicatat, agar log tidak penuh tiap interval
+			slog.Info("health status changed", "status", status.String())
+			lastStatus = status
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *DBChecker) check(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err := Ping(ctx, c.db, c.timeout); err != nil {
+		slog.Warn("database ping failed", "error", err)
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	//? setelah healthServer.Shutdown() status tidak bisa diubah lagi (tetap NOT_SERVING)
+	for _, service := range c.services {
+		c.healthServer.SetServingStatus(service, status)
+	}
+
+	return status
+}
+
+// Ping juga dipakai readiness probe HTTP (server gofiber)
+func Ping(ctx context.Context, db *sqlx.DB, timeout time.Duration) error {
+	pingCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return db.PingContext(pingCtx)
+}
@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIdHeader dipakai di metadata gRPC maupun header HTTP
+const RequestIdHeader = "x-request-id"
+
+// maxRequestIdLength: request id dari client yang terlalu panjang / aneh diganti yang baru
+const maxRequestIdLength = 128
+
+type requestInfoContextKey struct{}
+
+// requestInfo disimpan sbg pointer di context agar middleware dalam (misal auth)
+// bisa menambahkan user id yang ikut terbaca oleh access log di middleware luar
+type requestInfo struct {
+	mu        sync.RWMutex
+	requestId string
+	method    string
+	userId    string
+}
+
+// Setup memasang logger JSON sbg default slog (dan package log standar)
+func Setup(environment string) *slog.Logger {
+	level := slog.LevelInfo
+	if environment == config.EnvironmentDev {
+		level = slog.LevelDebug
+	}
+
+	l := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})).
+		With("environment", environment)
+	slog.SetDefault(l)
+
+	return l
+}
+
+// NewRequestContext menyematkan request id & method ke context, dipanggil sekali per request
+func NewRequestContext(ctx context.Context, requestId string, method string) context.Context {
+	return context.WithValue(ctx, requestInfoContextKey{}, &requestInfo{
+		requestId: requestId,
+		method:    method,
+	})
+}
+
+// SetUserId menambahkan user id ke request yang sedang berjalan (setelah token diverifikasi)
+func SetUserId(ctx context.Context, userId string) {
+	info, ok := ctx.Value(requestInfoContextKey{}).(*requestInfo)
+	if !ok {
+		return
+	}
+
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.userId = userId
+}
+
+func RequestId(ctx context.Context) string {
+	info, ok := ctx.Value(requestInfoContextKey{}).(*requestInfo)
+	if !ok {
+		return ""
+	}
+
+	return info.requestId
+}
+
+func UserId(ctx context.Context) string {
+	info, ok := ctx.Value(requestInfoContextKey{}).(*requestInfo)
+	if !ok {
+		return ""
+	}
+
+	info.mu.RLock()
+	defer info.mu.RUnlock()
+
+	return info.userId
+}
+
+// FromContext mengembalikan logger yang sudah berisi request_id, method & user_id (jika ada)
+func FromContext(ctx context.Context) *slog.Logger {
+	info, ok := ctx.Value(requestInfoContextKey{}).(*requestInfo)
+	if !ok {
+		return slog.Default()
+	}
+
+	info.mu.RLock()
+	defer info.mu.RUnlock()
+
+	l := slog.Default().With("request_id", info.requestId, "method", info.method)
+	if info.userId != "" {
+		l = l.With("user_id", info.userId)
+	}
+	//? trace_id agar log bisa dicocokkan dgn trace di OpenTelemetry
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		l = l.With("trace_id", spanContext.TraceID().String())
+	}
+
+	return l
+}
+
+// ResolveRequestId memakai request id dari client jika aman, selain itu membuat yang baru
+func ResolveRequestId(incoming string) string {
+	if incoming == "" || len(incoming) > maxRequestIdLength {
+		return uuid.NewString()
+	}
+
+	for _, r := range incoming {
+		//? hanya karakter yang aman ditulis ke header & log
+		if r < '!' || r > '~' {
+			return uuid.NewString()
+		}
+	}
+
+	return incoming
+}
@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "be_lms"
+
+// label result utk counter sukses/gagal
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// label event OTP
+const (
+	OTPEventRequest = "request"
+	OTPEventVerify  = "verify"
+)
+
+var (
+	//* gRPC (diisi oleh grpcmiddleware.MetricsMiddleware)
+	GRPCRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Jumlah request gRPC per method, code & status_code BaseResponse (mode legacy).",
+	}, []string{"method", "code", "status_code"})
+
+	GRPCRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Latency request gRPC per method & code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	//* HTTP gofiber (diisi oleh fibermiddleware.MetricsMiddleware)
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Jumlah request HTTP per method, route & status.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency request HTTP per method & route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	//* upload file (cover course / file lesson)
+	UploadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "uploads_total",
+		Help:      "Jumlah upload file per jenis & hasil.",
+	}, []string{"kind", "result"})
+
+	UploadBytes = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "upload_bytes",
+		Help:      "Ukuran body upload (byte).",
+		Buckets:   prometheus.ExponentialBuckets(64*1024, 4, 9), //? 64KB .. 4GB
+	}, []string{"kind"})
+
+	UploadDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "upload_duration_seconds",
+		Help:      "Durasi upload file.",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"kind"})
+
+	//* email & OTP
+	EmailSendTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "email_send_total",
+		Help:      "Jumlah pengiriman email per hasil.",
+	}, []string{"result"})
+
+	EmailSendDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "email_send_duration_seconds",
+		Help:      "Durasi kirim email ke SMTP.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	})
+
+	OTPTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "otp_total",
+		Help:      "Jumlah request & verifikasi OTP per hasil.",
+	}, []string{"event", "result"})
+)
+
+// RegisterDBStats menambahkan statistik connection pool (open, in use, idle, wait, dll)
+func RegisterDBStats(db *sql.DB) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, "postgres"))
+}
+
+// Handler dipasang di GET /metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+func Result(failed bool) string {
+	if failed {
+		return ResultFailure
+	}
+
+	return ResultSuccess
+}
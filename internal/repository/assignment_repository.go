@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type IAssignmentRepository interface {
+	WithTransaction(tx *sqlx.Tx) IAssignmentRepository
+	CreateNewAssignment(ctx context.Context, assignment *entity.Assignment) error
+	GetAssignmentById(ctx context.Context, assignmentId string) (*entity.Assignment, error)
+	UpdateAssignment(ctx context.Context, assignment *entity.Assignment) error
+	DeleteAssignment(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+}
+
+// AssignmentLessonUniqueIndex: satu assignment aktif per lesson (migration 000010)
+const AssignmentLessonUniqueIndex = "assignments_lesson_live_key"
+
+type assignmentRepository struct {
+	db database.DatabaseQuery
+}
+
+func (ar *assignmentRepository) WithTransaction(tx *sqlx.Tx) IAssignmentRepository {
+	return &assignmentRepository{
+		db: database.NewTracedQuery(tx),
+	}
+}
+
+func (ar *assignmentRepository) CreateNewAssignment(ctx context.Context, assignment *entity.Assignment) error {
+	query := `
+        INSERT INTO assignments (
+            id, lesson_id, course_id, title, instructions, due_at, max_score, allow_late_submissions,
+            created_at, created_by, updated_at, updated_by
+        )
+        VALUES (
+            :id, :lesson_id, :course_id, :title, :instructions, :due_at, :max_score, :allow_late_submissions,
+            :created_at, :created_by, :created_at, :updated_by
+        )`
+
+	_, err := ar.db.NamedExecContext(ctx, query, assignment)
+	return err
+}
+
+func (ar *assignmentRepository) GetAssignmentById(ctx context.Context, assignmentId string) (*entity.Assignment, error) {
+	var assignmentEntity entity.Assignment
+
+	query := `SELECT * FROM assignments WHERE id = $1 AND deleted_at IS NULL`
+
+	err := ar.db.GetContext(ctx, &assignmentEntity, query, assignmentId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &assignmentEntity, nil
+}
+
+func (ar *assignmentRepository) UpdateAssignment(ctx context.Context, assignment *entity.Assignment) error {
+	query := `
+		UPDATE assignments
+		SET
+			title = :title,
+			instructions = :instructions,
+			due_at = :due_at,
+			max_score = :max_score,
+			allow_late_submissions = :allow_late_submissions,
+
+			updated_at = :updated_at,
+			updated_by = :updated_by
+		WHERE id = :id AND deleted_at IS NULL`
+
+	_, err := ar.db.NamedExecContext(ctx, query, assignment)
+	return err
+}
+
+func (ar *assignmentRepository) DeleteAssignment(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	query := `UPDATE assignments SET deleted_at = :deleted_at, deleted_by = :deleted_by WHERE id = :id`
+
+	data := map[string]any{
+		"deleted_at": deletedAt,
+		"deleted_by": deletedBy,
+		"id":         id,
+	}
+
+	_, err := ar.db.NamedExecContext(ctx, query, data)
+	return err
+}
+
+func NewAssignmentRepository(db database.DatabaseQuery) IAssignmentRepository {
+	return &assignmentRepository{db: database.NewTracedQuery(db)}
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type IAssignmentSubmissionRepository interface {
+	WithTransaction(tx *sqlx.Tx) IAssignmentSubmissionRepository
+	CreateNewSubmission(ctx context.Context, submission *entity.AssignmentSubmission) error
+	GetSubmissionById(ctx context.Context, submissionId string) (*entity.AssignmentSubmission, error)
+	GetSubmission(ctx context.Context, assignmentId string, userId string) (*entity.AssignmentSubmission, error)
+	ResubmitSubmission(ctx context.Context, submission *entity.AssignmentSubmission) (bool, error)
+	GradeSubmission(ctx context.Context, submission *entity.AssignmentSubmission) error
+	GetSubmissionsByAssignmentId(ctx context.Context, assignmentId string, userId *string) ([]*entity.AssignmentSubmission, error)
+	GetAllSubmissionFiles(ctx context.Context) ([]*entity.AssignmentSubmission, error)
+	GetSubmissionsByFileNames(ctx context.Context, courseId string, fileNames []string) ([]*entity.AssignmentSubmission, error)
+}
+
+// AssignmentSubmissionUniqueIndex: satu submission per learner per assignment (migration 000010)
+const AssignmentSubmissionUniqueIndex = "assignment_submissions_assignment_user_key"
+
+type assignmentSubmissionRepository struct {
+	db database.DatabaseQuery
+}
+
+func (sr *assignmentSubmissionRepository) WithTransaction(tx *sqlx.Tx) IAssignmentSubmissionRepository {
+	return &assignmentSubmissionRepository{
+		db: database.NewTracedQuery(tx),
+	}
+}
+
+func (sr *assignmentSubmissionRepository) CreateNewSubmission(ctx context.Context, submission *entity.AssignmentSubmission) error {
+	query := `
+        INSERT INTO assignment_submissions (
+            id, assignment_id, course_id, user_id, user_name, user_email, file_names, comment, submitted_at, late,
+            created_at, updated_at
+        )
+        VALUES (
+            :id, :assignment_id, :course_id, :user_id, :user_name, :user_email, :file_names, :comment, :submitted_at, :late,
+            :created_at, :created_at
+        )`
+
+	_, err := sr.db.NamedExecContext(ctx, query, submission)
+	return err
+}
+
+func (sr *assignmentSubmissionRepository) GetSubmissionById(ctx context.Context, submissionId string) (*entity.AssignmentSubmission, error) {
+	var submissionEntity entity.AssignmentSubmission
+
+	query := `SELECT * FROM assignment_submissions WHERE id = $1`
+
+	err := sr.db.GetContext(ctx, &submissionEntity, query, submissionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &submissionEntity, nil
+}
+
+// GetSubmission mengambil submission learner utk assignment (nil jika belum submit)
+func (sr *assignmentSubmissionRepository) GetSubmission(ctx context.Context, assignmentId string, userId string) (*entity.AssignmentSubmission, error) {
+	var submissionEntity entity.AssignmentSubmission
+
+	query := `SELECT * FROM assignment_submissions WHERE assignment_id = $1 AND user_id = $2`
+
+	err := sr.db.GetContext(ctx, &submissionEntity, query, assignmentId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &submissionEntity, nil
+}
+
+// ResubmitSubmission mengganti file & komentar submission, false jika sudah dinilai lebih dulu
+func (sr *assignmentSubmissionRepository) ResubmitSubmission(ctx context.Context, submission *entity.AssignmentSubmission) (bool, error) {
+	query := `
+		UPDATE assignment_submissions
+		SET
+			user_name = :user_name,
+			user_email = :user_email,
+			file_names = :file_names,
+			comment = :comment,
+			submitted_at = :submitted_at,
+			late = :late,
+
+			updated_at = :updated_at
+		WHERE id = :id AND graded_at IS NULL`
+
+	result, err := sr.db.NamedExecContext(ctx, query, submission)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (sr *assignmentSubmissionRepository) GradeSubmission(ctx context.Context, submission *entity.AssignmentSubmission) error {
+	query := `
+		UPDATE assignment_submissions
+		SET
+			score = :score,
+			feedback = :feedback,
+			graded_at = :graded_at,
+			graded_by = :graded_by,
+
+			updated_at = :updated_at
+		WHERE id = :id`
+
+	_, err := sr.db.NamedExecContext(ctx, query, submission)
+	return err
+}
+
+// GetSubmissionsByAssignmentId mengambil submission assignment, userId nil = semua learner
+func (sr *assignmentSubmissionRepository) GetSubmissionsByAssignmentId(ctx context.Context, assignmentId string, userId *string) ([]*entity.AssignmentSubmission, error) {
+	var submissions []*entity.AssignmentSubmission
+
+	query := `SELECT * FROM assignment_submissions
+	          WHERE assignment_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+	          ORDER BY submitted_at, id`
+
+	err := sr.db.SelectContext(ctx, &submissions, query, assignmentId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return submissions, nil
+}
+
+// GetAllSubmissionFiles dipakai storage sweeper, submission milik assignment yang sudah dihapus tetap direferensikan (riwayat nilai)
+func (sr *assignmentSubmissionRepository) GetAllSubmissionFiles(ctx context.Context) ([]*entity.AssignmentSubmission, error) {
+	var submissions []*entity.AssignmentSubmission
+
+	query := `SELECT id, course_id, file_names FROM assignment_submissions`
+
+	err := sr.db.SelectContext(ctx, &submissions, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return submissions, nil
+}
+
+// GetSubmissionsByFileNames: submission di course yang sudah memakai salah satu file (satu file hanya boleh dipakai satu submission)
+func (sr *assignmentSubmissionRepository) GetSubmissionsByFileNames(ctx context.Context, courseId string, fileNames []string) ([]*entity.AssignmentSubmission, error) {
+	var submissions []*entity.AssignmentSubmission
+
+	query := `SELECT id, course_id, user_id, file_names FROM assignment_submissions
+	          WHERE course_id = $1 AND file_names && $2`
+
+	err := sr.db.SelectContext(ctx, &submissions, query, courseId, pq.Array(fileNames))
+	if err != nil {
+		return nil, err
+	}
+
+	return submissions, nil
+}
+
+func NewAssignmentSubmissionRepository(db database.DatabaseQuery) IAssignmentSubmissionRepository {
+	return &assignmentSubmissionRepository{db: database.NewTracedQuery(db)}
+}
@@ -7,7 +7,7 @@ import (
 	"time"
 
 	"github.com/abu-umair/be-lms-go/internal/entity"
-	"github.com/jmoiron/sqlx"
+	"github.com/abu-umair/be-lms-go/pkg/database"
 )
 
 type IAuthRepository interface {
@@ -23,7 +23,7 @@ type IAuthRepository interface {
 }
 
 type authRepository struct {
-	db *sqlx.DB //?Menyimpan koneksi database
+	db database.DatabaseQuery //?Menyimpan koneksi database
 }
 
 func (ar *authRepository) GetUserByEmail(ctx context.Context, email string) (*entity.Users, error) {
@@ -132,6 +132,6 @@ func (ar *authRepository) DeleteOTP(ctx context.Context, email string) error {
 	return err
 }
 
-func NewAuthRepository(db *sqlx.DB) IAuthRepository {
-	return &authRepository{db: db}
+func NewAuthRepository(db database.DatabaseQuery) IAuthRepository {
+	return &authRepository{db: database.NewTracedQuery(db)}
 }
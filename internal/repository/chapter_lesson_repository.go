@@ -5,12 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/abu-umair/be-lms-go/internal/entity"
 	"github.com/abu-umair/be-lms-go/pkg/database"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type IChapterLessonRepository interface {
@@ -20,8 +20,25 @@ type IChapterLessonRepository interface {
 	GetChapterLessonByIdFieldMask(ctx context.Context, chapterLessonId string, paths []string) (*entity.ChapterLesson, error)
 	UpdateChapterLesson(ctx context.Context, chapterLesson *entity.ChapterLesson) error
 	DeleteChapterLesson(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	DeleteChapterLessonsByCourseId(ctx context.Context, courseId string, deletedAt time.Time, deletedBy string) (int64, error)
+	DeleteChapterLessonsByChapterId(ctx context.Context, chapterId string, deletedAt time.Time, deletedBy string) (int64, error)
+	GetDeletedChapterLessonById(ctx context.Context, chapterLessonId string) (*entity.ChapterLesson, error)
+	RestoreChapterLessons(ctx context.Context, deletedWithId string, updatedAt time.Time, updatedBy string) (int64, error)
+	GetDeletedChapterLessons(ctx context.Context) ([]*entity.ChapterLesson, error)
+	PurgeChapterLessons(ctx context.Context, deletedBefore time.Time) ([]*entity.ChapterLesson, error)
+	GetAllLessonFiles(ctx context.Context) ([]*entity.ChapterLesson, error)
+	GetChapterLessonIdsForUpdate(ctx context.Context, chapterId string) ([]string, error)
+	ReorderChapterLessons(ctx context.Context, chapterId string, orderedIds []string, updatedAt time.Time, updatedBy string) error
+	GetLastOrderLesson(ctx context.Context, chapterId string) (int64, error)
+	MoveChapterLesson(ctx context.Context, chapterLesson *entity.ChapterLesson) error
+	GetChapterLessonsByChapterId(ctx context.Context, chapterId string, status *string, paths []string) ([]*entity.ChapterLesson, error)
+	GetLessonDurationsByChapterIds(ctx context.Context, chapterIds []string) ([]*entity.ChapterLesson, error)
+	GetCurriculumLessonsByCourseId(ctx context.Context, courseId string) ([]*entity.ChapterLesson, error)
 }
 
+// ChapterLessonOrderUniqueIndex: satu order_lesson per chapter (migration 000007)
+const ChapterLessonOrderUniqueIndex = "course_chapter_lessons_chapter_order_live_key"
+
 type chapterLessonRepository struct {
 	db database.DatabaseQuery
 	// Kita simpan di sini agar tidak perlu buat map berulang-ulang di setiap request
@@ -30,17 +47,18 @@ type chapterLessonRepository struct {
 
 func (cs *chapterLessonRepository) WithTransaction(tx *sqlx.Tx) IChapterLessonRepository {
 	return &chapterLessonRepository{
-		db: tx,
+		db:        database.NewTracedQuery(tx),
+		whitelist: cs.whitelist,
 	}
 }
 
 func (cr *chapterLessonRepository) CreateNewChapterLesson(ctx context.Context, chapterLesson *entity.ChapterLesson) error {
 	query := `
         INSERT INTO course_chapter_lessons (
-			instructor_id,course_id,title,order_lesson,chapter_id,slug,description,file_path,storage_lesson,lesson_type,volume,duration,file_type,downloadable,is_preview,status, created_at, created_by, updated_at, updated_by, deleted_by
+			id,instructor_id,course_id,title,order_lesson,chapter_id,slug,description,file_path,storage_lesson,lesson_type,volume,duration,file_type,downloadable,is_preview,status,kind,content, created_at, created_by, updated_at, updated_by, deleted_by
         )
         VALUES (
-            :instructor_id,:course_id,:title,:order_lesson,:chapter_id,:slug,:description,:file_path,:storage_lesson,:lesson_type,:volume,:duration,:file_type,:downloadable,:is_preview,:status, :created_at, :created_by, :updated_at, :updated_by, :deleted_by
+            :id,:instructor_id,:course_id,:title,:order_lesson,:chapter_id,:slug,:description,:file_path,:storage_lesson,:lesson_type,:volume,:duration,:file_type,:downloadable,:is_preview,:status,:kind,:content, :created_at, :created_by, :updated_at, :updated_by, :deleted_by
         )`
 
 	// NamedExecContext akan otomatis mencocokkan :id dengan field di struct
@@ -57,7 +75,7 @@ func (cr *chapterLessonRepository) GetChapterLessonById(ctx context.Context, cha
 	var chapterLessonEntity entity.ChapterLesson
 
 	// 1. Tentukan query
-	query := `SELECT id
+	query := `SELECT id, course_id, chapter_id, is_preview, file_path, storage_lesson, kind, content
 	          FROM course_chapter_lessons
 	          WHERE id = $1 AND deleted_at IS NULL`
 
@@ -80,20 +98,7 @@ func (cr *chapterLessonRepository) GetChapterLessonByIdFieldMask(ctx context.Con
 	var chapterLessonEntity entity.ChapterLesson
 
 	// 1. Tentukan kolom yang akan di-select
-	selectedColumns := "*" // Default jika paths kosong
-	if len(paths) > 0 {
-		var validColumns []string
-		for _, p := range paths {
-			// Cek apakah kolom yang diminta ada di whitelist kita
-			if cr.whitelist[p] {
-				validColumns = append(validColumns, p)
-			}
-		}
-
-		if len(validColumns) > 0 {
-			selectedColumns = strings.Join(validColumns, ", ")
-		}
-	}
+	selectedColumns := selectColumns(cr.whitelist, paths)
 
 	// 2. Tentukan query dengan kolom dinamis
 	query := fmt.Sprintf(`SELECT %s FROM course_chapter_lessons WHERE id = $1 AND deleted_at IS NULL`, selectedColumns)
@@ -104,7 +109,7 @@ func (cr *chapterLessonRepository) GetChapterLessonByIdFieldMask(ctx context.Con
 	if err != nil {
 		// 3. Tangani jika data tidak ditemukan
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, err
+			return nil, nil
 		}
 		return nil, err
 	}
@@ -133,6 +138,8 @@ func (sr *chapterLessonRepository) UpdateChapterLesson(ctx context.Context, chap
 			downloadable= :downloadable,
 			is_preview= :is_preview,
 			status= :status,
+			kind= :kind,
+			content= :content,
 
 			updated_at = :updated_at,
 			updated_by = :updated_by
@@ -142,8 +149,9 @@ func (sr *chapterLessonRepository) UpdateChapterLesson(ctx context.Context, chap
 	return err
 }
 
+// DeleteChapterLesson: lesson menjadi root delete-nya sendiri (deleted_with_id = id)
 func (sr *chapterLessonRepository) DeleteChapterLesson(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
-	query := `UPDATE course_chapter_lessons SET deleted_at = :deleted_at, deleted_by = :deleted_by WHERE id = :id`
+	query := `UPDATE course_chapter_lessons SET deleted_at = :deleted_at, deleted_by = :deleted_by, deleted_with_id = id WHERE id = :id`
 
 	// Kita bungkus data ke dalam map agar bisa dibaca oleh NamedExecContext
 	data := map[string]any{
@@ -161,9 +169,252 @@ func (sr *chapterLessonRepository) DeleteChapterLesson(ctx context.Context, id s
 	return nil
 }
 
+// DeleteChapterLessonsByCourseId: cascade delete course (termasuk lesson yang hanya terhubung lewat chapter)
+func (cr *chapterLessonRepository) DeleteChapterLessonsByCourseId(ctx context.Context, courseId string, deletedAt time.Time, deletedBy string) (int64, error) {
+	query := `UPDATE course_chapter_lessons
+	          SET deleted_at = $2, deleted_by = $3, deleted_with_id = $1
+	          WHERE deleted_at IS NULL
+	            AND (course_id = $1 OR chapter_id IN (SELECT id FROM course_chapters WHERE course_id = $1))`
+
+	result, err := cr.db.ExecContext(ctx, query, courseId, deletedAt, deletedBy)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// DeleteChapterLessonsByChapterId: cascade delete chapter, lesson yang sudah dihapus sebelumnya tetap milik root-nya sendiri
+func (cr *chapterLessonRepository) DeleteChapterLessonsByChapterId(ctx context.Context, chapterId string, deletedAt time.Time, deletedBy string) (int64, error) {
+	query := `UPDATE course_chapter_lessons
+	          SET deleted_at = $2, deleted_by = $3, deleted_with_id = $1
+	          WHERE chapter_id = $1 AND deleted_at IS NULL`
+
+	result, err := cr.db.ExecContext(ctx, query, chapterId, deletedAt, deletedBy)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// GetDeletedChapterLessonById: lesson yang ada di trash (belum di-purge)
+func (cr *chapterLessonRepository) GetDeletedChapterLessonById(ctx context.Context, chapterLessonId string) (*entity.ChapterLesson, error) {
+	var chapterLessonEntity entity.ChapterLesson
+
+	query := `SELECT id, instructor_id, course_id, chapter_id, title, order_lesson, deleted_at, deleted_by, deleted_with_id
+	          FROM course_chapter_lessons
+	          WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	err := cr.db.GetContext(ctx, &chapterLessonEntity, query, chapterLessonId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &chapterLessonEntity, nil
+}
+
+// RestoreChapterLessons mengembalikan lesson yang terhapus oleh delete deletedWithId.
+// Jika posisi lesson root sudah dipakai lesson lain, lesson root dipindah ke urutan terakhir.
+func (cr *chapterLessonRepository) RestoreChapterLessons(ctx context.Context, deletedWithId string, updatedAt time.Time, updatedBy string) (int64, error) {
+	query := `UPDATE course_chapter_lessons l
+	          SET deleted_at = NULL, deleted_by = NULL, deleted_with_id = NULL, updated_at = $2, updated_by = $3,
+	              order_lesson = CASE
+	                  WHEN l.id = $1 AND EXISTS (
+	                      SELECT 1 FROM course_chapter_lessons o
+	                      WHERE o.chapter_id = l.chapter_id AND o.order_lesson = l.order_lesson AND o.deleted_at IS NULL
+	                  )
+	                  THEN (SELECT COALESCE(MAX(o.order_lesson), 0) + 1 FROM course_chapter_lessons o WHERE o.chapter_id = l.chapter_id AND o.deleted_at IS NULL)
+	                  ELSE l.order_lesson
+	              END
+	          WHERE l.deleted_with_id = $1`
+
+	result, err := cr.db.ExecContext(ctx, query, deletedWithId, updatedAt, updatedBy)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// GetDeletedChapterLessons: semua lesson di trash (dipakai listing trash admin)
+func (cr *chapterLessonRepository) GetDeletedChapterLessons(ctx context.Context) ([]*entity.ChapterLesson, error) {
+	var lessons []*entity.ChapterLesson
+
+	query := `SELECT id, course_id, chapter_id, title, deleted_at, deleted_by, deleted_with_id
+	          FROM course_chapter_lessons
+	          WHERE deleted_at IS NOT NULL
+	          ORDER BY deleted_at DESC, id`
+
+	err := cr.db.SelectContext(ctx, &lessons, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return lessons, nil
+}
+
+// PurgeChapterLessons menghapus permanen lesson di trash yang dihapus sebelum deletedBefore,
+// file_path & content dikembalikan agar file upload-nya bisa ikut dihapus
+func (cr *chapterLessonRepository) PurgeChapterLessons(ctx context.Context, deletedBefore time.Time) ([]*entity.ChapterLesson, error) {
+	var lessons []*entity.ChapterLesson
+
+	query := `DELETE FROM course_chapter_lessons
+	          WHERE deleted_at IS NOT NULL AND deleted_at < $1
+	          RETURNING id, course_id, file_path, storage_lesson, content`
+
+	err := cr.db.SelectContext(ctx, &lessons, query, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	return lessons, nil
+}
+
+// GetAllLessonFiles mengambil file_path & content (file caption) dari semua lesson, termasuk yang ada di trash (dipakai oleh storage sweeper)
+func (cr *chapterLessonRepository) GetAllLessonFiles(ctx context.Context) ([]*entity.ChapterLesson, error) {
+	var lessons []*entity.ChapterLesson
+
+	query := `SELECT id, course_id, file_path, kind, content
+	          FROM course_chapter_lessons
+	          WHERE file_path IS NOT NULL OR content IS NOT NULL`
+
+	err := cr.db.SelectContext(ctx, &lessons, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return lessons, nil
+}
+
+// GetChapterLessonIdsForUpdate mengambil id lesson aktif milik chapter sekaligus mengunci barisnya (dipakai di dalam transaksi)
+func (cr *chapterLessonRepository) GetChapterLessonIdsForUpdate(ctx context.Context, chapterId string) ([]string, error) {
+	var ids []string
+
+	query := `SELECT id FROM course_chapter_lessons
+	          WHERE chapter_id = $1 AND deleted_at IS NULL
+	          ORDER BY order_lesson
+	          FOR UPDATE`
+
+	err := cr.db.SelectContext(ctx, &ids, query, chapterId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ReorderChapterLessons menomori ulang order_lesson sesuai posisi di orderedIds (mulai 1)
+func (cr *chapterLessonRepository) ReorderChapterLessons(ctx context.Context, chapterId string, orderedIds []string, updatedAt time.Time, updatedBy string) error {
+	//? unique index dicek per baris, jadi semua dipindah dulu ke nilai negatif agar tidak bentrok saat ditukar
+	_, err := cr.db.ExecContext(ctx,
+		`UPDATE course_chapter_lessons SET order_lesson = -order_lesson - 1 WHERE chapter_id = $1 AND deleted_at IS NULL`,
+		chapterId,
+	)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE course_chapter_lessons l
+	          SET order_lesson = o.position, updated_at = $3, updated_by = $4
+	          FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+	          WHERE l.id = o.id AND l.chapter_id = $1 AND l.deleted_at IS NULL`
+
+	_, err = cr.db.ExecContext(ctx, query, chapterId, pq.Array(orderedIds), updatedAt, updatedBy)
+	return err
+}
+
+// GetLastOrderLesson mengembalikan order_lesson terbesar di chapter (0 jika belum ada lesson)
+func (cr *chapterLessonRepository) GetLastOrderLesson(ctx context.Context, chapterId string) (int64, error) {
+	var lastOrder int64
+
+	query := `SELECT COALESCE(MAX(order_lesson), 0)
+	          FROM course_chapter_lessons
+	          WHERE chapter_id = $1 AND deleted_at IS NULL`
+
+	err := cr.db.GetContext(ctx, &lastOrder, query, chapterId)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastOrder, nil
+}
+
+// MoveChapterLesson memindah lesson ke chapter/course lain (hanya kolom parent, urutan & file / content yang diubah)
+func (cr *chapterLessonRepository) MoveChapterLesson(ctx context.Context, chapterLesson *entity.ChapterLesson) error {
+	query := `
+		UPDATE course_chapter_lessons
+		SET
+			course_id= :course_id,
+			chapter_id= :chapter_id,
+			order_lesson= :order_lesson,
+			file_path= :file_path,
+			content= :content,
+
+			updated_at = :updated_at,
+			updated_by = :updated_by
+		WHERE id = :id AND deleted_at IS NULL`
+
+	_, err := cr.db.NamedExecContext(ctx, query, chapterLesson)
+	return err
+}
+
+// GetChapterLessonsByChapterId mengambil lesson aktif milik chapter urut order_lesson, status nil = semua status
+func (cr *chapterLessonRepository) GetChapterLessonsByChapterId(ctx context.Context, chapterId string, status *string, paths []string) ([]*entity.ChapterLesson, error) {
+	var chapterLessons []*entity.ChapterLesson
+
+	selectedColumns := selectColumns(cr.whitelist, paths)
+	query := fmt.Sprintf(`SELECT %s FROM course_chapter_lessons
+	          WHERE chapter_id = $1 AND deleted_at IS NULL AND ($2::text IS NULL OR status = $2)
+	          ORDER BY order_lesson, id`, selectedColumns)
+
+	err := cr.db.SelectContext(ctx, &chapterLessons, query, chapterId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return chapterLessons, nil
+}
+
+// GetLessonDurationsByChapterIds mengambil id, chapter_id & duration semua lesson aktif di chapter-chapter tsb (utk ringkasan list chapter)
+func (cr *chapterLessonRepository) GetLessonDurationsByChapterIds(ctx context.Context, chapterIds []string) ([]*entity.ChapterLesson, error) {
+	var chapterLessons []*entity.ChapterLesson
+
+	query := `SELECT id, chapter_id, duration
+	          FROM course_chapter_lessons
+	          WHERE chapter_id = ANY($1::uuid[]) AND deleted_at IS NULL`
+
+	err := cr.db.SelectContext(ctx, &chapterLessons, query, pq.Array(chapterIds))
+	if err != nil {
+		return nil, err
+	}
+
+	return chapterLessons, nil
+}
+
+// GetCurriculumLessonsByCourseId: ringkasan lesson aktif di course (utk kurikulum, aturan rilis & katalog publik), urut order_lesson
+func (cr *chapterLessonRepository) GetCurriculumLessonsByCourseId(ctx context.Context, courseId string) ([]*entity.ChapterLesson, error) {
+	var chapterLessons []*entity.ChapterLesson
+
+	query := `SELECT id, course_id, chapter_id, title, order_lesson, kind, duration, is_preview, status
+	          FROM course_chapter_lessons
+	          WHERE course_id = $1 AND deleted_at IS NULL
+	          ORDER BY order_lesson, id`
+
+	err := cr.db.SelectContext(ctx, &chapterLessons, query, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return chapterLessons, nil
+}
+
 func NewChapterLessonRepository(db database.DatabaseQuery) IChapterLessonRepository {
 	return &chapterLessonRepository{
-		db: db,
+		db: database.NewTracedQuery(db),
 		whitelist: map[string]bool{
 			"id":             true,
 			"title":          true,
@@ -181,6 +432,9 @@ func NewChapterLessonRepository(db database.DatabaseQuery) IChapterLessonReposit
 			"status":         true,
 			"instructor_id":  true,
 			"course_id":      true,
+			"chapter_id":     true,
+			"kind":           true,
+			"content":        true,
 
 			"created_at": true, "created_by": true, "updated_at": true,
 			"updated_by": true, "deleted_at": true, "deleted_by": true,
@@ -5,12 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/abu-umair/be-lms-go/internal/entity"
 	"github.com/abu-umair/be-lms-go/pkg/database"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type ICourseChapterRepository interface {
@@ -20,8 +20,19 @@ type ICourseChapterRepository interface {
 	GetCourseChapterByIdFieldMask(ctx context.Context, courseChapterId string, paths []string) (*entity.CourseChapter, error)
 	UpdateCourseChapter(ctx context.Context, courseChapter *entity.CourseChapter) error
 	DeleteCourseChapter(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	DeleteCourseChaptersByCourseId(ctx context.Context, courseId string, deletedAt time.Time, deletedBy string) (int64, error)
+	GetDeletedCourseChapterById(ctx context.Context, courseChapterId string) (*entity.CourseChapter, error)
+	RestoreCourseChapters(ctx context.Context, deletedWithId string, updatedAt time.Time, updatedBy string) (int64, error)
+	GetDeletedCourseChapters(ctx context.Context) ([]*entity.CourseChapter, error)
+	PurgeCourseChapters(ctx context.Context, deletedBefore time.Time) (int64, error)
+	GetCourseChapterIdsForUpdate(ctx context.Context, courseId string) ([]string, error)
+	ReorderCourseChapters(ctx context.Context, courseId string, orderedIds []string, updatedAt time.Time, updatedBy string) error
+	GetCourseChaptersByCourseId(ctx context.Context, courseId string, status *string, paths []string) ([]*entity.CourseChapter, error)
 }
 
+// CourseChapterOrderUniqueIndex: satu order_chapter per course (migration 000007)
+const CourseChapterOrderUniqueIndex = "course_chapters_course_order_live_key"
+
 type courseChapterRepository struct {
 	db database.DatabaseQuery
 	// Kita simpan di sini agar tidak perlu buat map berulang-ulang di setiap request
@@ -30,7 +41,8 @@ type courseChapterRepository struct {
 
 func (cs *courseChapterRepository) WithTransaction(tx *sqlx.Tx) ICourseChapterRepository {
 	return &courseChapterRepository{
-		db: tx,
+		db:        database.NewTracedQuery(tx),
+		whitelist: cs.whitelist,
 	}
 }
 
@@ -57,7 +69,7 @@ func (cr *courseChapterRepository) GetCourseChapterById(ctx context.Context, cou
 	var courseChapterEntity entity.CourseChapter
 
 	// 1. Tentukan query
-	query := `SELECT id
+	query := `SELECT id, course_id, instructor_id
 	          FROM course_chapters
 	          WHERE id = $1 AND deleted_at IS NULL`
 
@@ -80,20 +92,7 @@ func (cr *courseChapterRepository) GetCourseChapterByIdFieldMask(ctx context.Con
 	var courseChapterEntity entity.CourseChapter
 
 	// 1. Tentukan kolom yang akan di-select
-	selectedColumns := "*" // Default jika paths kosong
-	if len(paths) > 0 {
-		var validColumns []string
-		for _, p := range paths {
-			// Cek apakah kolom yang diminta ada di whitelist kita
-			if cr.whitelist[p] {
-				validColumns = append(validColumns, p)
-			}
-		}
-
-		if len(validColumns) > 0 {
-			selectedColumns = strings.Join(validColumns, ", ")
-		}
-	}
+	selectedColumns := selectColumns(cr.whitelist, paths)
 
 	// 2. Tentukan query dengan kolom dinamis
 	query := fmt.Sprintf(`SELECT %s FROM course_chapters WHERE id = $1 AND deleted_at IS NULL`, selectedColumns)
@@ -104,7 +103,7 @@ func (cr *courseChapterRepository) GetCourseChapterByIdFieldMask(ctx context.Con
 	if err != nil {
 		// 3. Tangani jika data tidak ditemukan
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, err
+			return nil, nil
 		}
 		return nil, err
 	}
@@ -131,8 +130,9 @@ func (sr *courseChapterRepository) UpdateCourseChapter(ctx context.Context, cour
 	return err
 }
 
+// DeleteCourseChapter: chapter menjadi root cascade (deleted_with_id = id), lesson di-cascade oleh service
 func (sr *courseChapterRepository) DeleteCourseChapter(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
-	query := `UPDATE course_chapters SET deleted_at = :deleted_at, deleted_by = :deleted_by WHERE id = :id`
+	query := `UPDATE course_chapters SET deleted_at = :deleted_at, deleted_by = :deleted_by, deleted_with_id = id WHERE id = :id`
 
 	// Kita bungkus data ke dalam map agar bisa dibaca oleh NamedExecContext
 	data := map[string]any{
@@ -150,9 +150,162 @@ func (sr *courseChapterRepository) DeleteCourseChapter(ctx context.Context, id s
 	return nil
 }
 
+// DeleteCourseChaptersByCourseId: cascade delete course, chapter yang sudah dihapus sebelumnya tetap milik root-nya sendiri
+func (sr *courseChapterRepository) DeleteCourseChaptersByCourseId(ctx context.Context, courseId string, deletedAt time.Time, deletedBy string) (int64, error) {
+	query := `UPDATE course_chapters
+	          SET deleted_at = $2, deleted_by = $3, deleted_with_id = $1
+	          WHERE course_id = $1 AND deleted_at IS NULL`
+
+	result, err := sr.db.ExecContext(ctx, query, courseId, deletedAt, deletedBy)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// GetDeletedCourseChapterById: chapter yang ada di trash (belum di-purge)
+func (sr *courseChapterRepository) GetDeletedCourseChapterById(ctx context.Context, courseChapterId string) (*entity.CourseChapter, error) {
+	var courseChapterEntity entity.CourseChapter
+
+	query := `SELECT id, instructor_id, course_id, title, order_chapter, deleted_at, deleted_by, deleted_with_id
+	          FROM course_chapters
+	          WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	err := sr.db.GetContext(ctx, &courseChapterEntity, query, courseChapterId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &courseChapterEntity, nil
+}
+
+// RestoreCourseChapters mengembalikan chapter yang terhapus oleh delete deletedWithId.
+// Setiap chapter yang dikembalikan (bukan hanya root) yang posisinya sudah dipakai chapter aktif
+// dipindah ke urutan terakhir, urut posisi lamanya, agar tidak bentrok dgn unique index order_chapter.
+func (sr *courseChapterRepository) RestoreCourseChapters(ctx context.Context, deletedWithId string, updatedAt time.Time, updatedBy string) (int64, error) {
+	query := `WITH restored AS (
+	              SELECT c.id, c.course_id, c.order_chapter,
+	                     EXISTS (
+	                         SELECT 1 FROM course_chapters o
+	                         WHERE o.course_id = c.course_id AND o.order_chapter = c.order_chapter AND o.deleted_at IS NULL
+	                     ) AS taken
+	              FROM course_chapters c
+	              WHERE c.deleted_with_id = $1
+	          ), renumbered AS (
+	              SELECT r.id,
+	                     CASE
+	                         WHEN r.taken THEN GREATEST(
+	                             (SELECT COALESCE(MAX(o.order_chapter), 0) FROM course_chapters o WHERE o.course_id = r.course_id AND o.deleted_at IS NULL),
+	                             (SELECT MAX(x.order_chapter) FROM restored x WHERE x.course_id = r.course_id)
+	                         ) + ROW_NUMBER() OVER (PARTITION BY r.course_id, r.taken ORDER BY r.order_chapter, r.id)
+	                         ELSE r.order_chapter
+	                     END AS order_chapter
+	              FROM restored r
+	          )
+	          UPDATE course_chapters c
+	          SET deleted_at = NULL, deleted_by = NULL, deleted_with_id = NULL, updated_at = $2, updated_by = $3,
+	              order_chapter = n.order_chapter
+	          FROM renumbered n
+	          WHERE c.id = n.id`
+
+	result, err := sr.db.ExecContext(ctx, query, deletedWithId, updatedAt, updatedBy)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// GetDeletedCourseChapters: semua chapter di trash (dipakai listing trash admin)
+func (sr *courseChapterRepository) GetDeletedCourseChapters(ctx context.Context) ([]*entity.CourseChapter, error) {
+	var chapters []*entity.CourseChapter
+
+	query := `SELECT id, course_id, title, deleted_at, deleted_by, deleted_with_id
+	          FROM course_chapters
+	          WHERE deleted_at IS NOT NULL
+	          ORDER BY deleted_at DESC, id`
+
+	err := sr.db.SelectContext(ctx, &chapters, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return chapters, nil
+}
+
+// PurgeCourseChapters menghapus permanen chapter di trash yang dihapus sebelum deletedBefore
+func (sr *courseChapterRepository) PurgeCourseChapters(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `DELETE FROM course_chapters WHERE deleted_at IS NOT NULL AND deleted_at < $1`
+
+	result, err := sr.db.ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// GetCourseChapterIdsForUpdate mengambil id chapter aktif milik course sekaligus mengunci barisnya (dipakai di dalam transaksi)
+func (cr *courseChapterRepository) GetCourseChapterIdsForUpdate(ctx context.Context, courseId string) ([]string, error) {
+	var ids []string
+
+	query := `SELECT id FROM course_chapters
+	          WHERE course_id = $1 AND deleted_at IS NULL
+	          ORDER BY order_chapter
+	          FOR UPDATE`
+
+	err := cr.db.SelectContext(ctx, &ids, query, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ReorderCourseChapters menomori ulang order_chapter sesuai posisi di orderedIds (mulai 1)
+func (cr *courseChapterRepository) ReorderCourseChapters(ctx context.Context, courseId string, orderedIds []string, updatedAt time.Time, updatedBy string) error {
+	//? unique index dicek per baris, jadi semua dipindah dulu ke nilai negatif agar tidak bentrok saat ditukar
+	_, err := cr.db.ExecContext(ctx,
+		`UPDATE course_chapters SET order_chapter = -order_chapter - 1 WHERE course_id = $1 AND deleted_at IS NULL`,
+		courseId,
+	)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE course_chapters c
+	          SET order_chapter = o.position, updated_at = $3, updated_by = $4
+	          FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+	          WHERE c.id = o.id AND c.course_id = $1 AND c.deleted_at IS NULL`
+
+	_, err = cr.db.ExecContext(ctx, query, courseId, pq.Array(orderedIds), updatedAt, updatedBy)
+	return err
+}
+
+// GetCourseChaptersByCourseId mengambil chapter aktif milik course urut order_chapter, status nil = semua status
+func (cr *courseChapterRepository) GetCourseChaptersByCourseId(ctx context.Context, courseId string, status *string, paths []string) ([]*entity.CourseChapter, error) {
+	var courseChapters []*entity.CourseChapter
+
+	selectedColumns := selectColumns(cr.whitelist, paths)
+	query := fmt.Sprintf(`SELECT %s FROM course_chapters
+	          WHERE course_id = $1 AND deleted_at IS NULL AND ($2::text IS NULL OR status = $2)
+	          ORDER BY order_chapter, id`, selectedColumns)
+
+	err := cr.db.SelectContext(ctx, &courseChapters, query, courseId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return courseChapters, nil
+}
+
 func NewCourseChapterRepository(db database.DatabaseQuery) ICourseChapterRepository {
 	return &courseChapterRepository{
-		db: db,
+		db: database.NewTracedQuery(db),
 		whitelist: map[string]bool{
 			"id": true, "instructor_id": true, "course_id": true, "title": true,
 			"order_chapter": true, "status": true,
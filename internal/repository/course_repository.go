@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/abu-umair/be-lms-go/internal/entity"
@@ -20,6 +19,11 @@ type ICourseRepository interface {
 	GetCourseByIdFieldMask(ctx context.Context, courseId string, paths []string) (*entity.Course, error)
 	UpdateCourse(ctx context.Context, course *entity.Course) error
 	DeleteCourse(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	GetDeletedCourseById(ctx context.Context, courseId string) (*entity.Course, error)
+	RestoreCourse(ctx context.Context, id string, updatedAt time.Time, updatedBy string) error
+	GetDeletedCourses(ctx context.Context) ([]*entity.Course, error)
+	PurgeCourses(ctx context.Context, deletedBefore time.Time) ([]*entity.Course, error)
+	GetAllCourseImages(ctx context.Context) ([]*entity.Course, error)
 }
 
 type courseRepository struct {
@@ -30,7 +34,8 @@ type courseRepository struct {
 
 func (ss *courseRepository) WithTransaction(tx *sqlx.Tx) ICourseRepository {
 	return &courseRepository{
-		db: tx,
+		db:        database.NewTracedQuery(tx),
+		whitelist: ss.whitelist,
 	}
 }
 
@@ -65,7 +70,7 @@ func (sr *courseRepository) GetCourseById(ctx context.Context, courseId string)
 	var courseEntity entity.Course
 
 	// 1. Tentukan query
-	query := `SELECT id, image_file_name 
+	query := `SELECT id, image_file_name, instructor_id
 	          FROM courses 
 	          WHERE id = $1 AND deleted_at IS NULL`
 
@@ -88,20 +93,7 @@ func (sr *courseRepository) GetCourseByIdFieldMask(ctx context.Context, courseId
 	var courseEntity entity.Course
 
 	// 1. Tentukan kolom yang akan di-select
-	selectedColumns := "*" // Default jika paths kosong
-	if len(paths) > 0 {
-		var validColumns []string
-		for _, p := range paths {
-			// Cek apakah kolom yang diminta ada di whitelist kita
-			if sr.whitelist[p] {
-				validColumns = append(validColumns, p)
-			}
-		}
-
-		if len(validColumns) > 0 {
-			selectedColumns = strings.Join(validColumns, ", ")
-		}
-	}
+	selectedColumns := selectColumns(sr.whitelist, paths)
 
 	// 2. Tentukan query dengan kolom dinamis
 	query := fmt.Sprintf(`SELECT %s FROM courses WHERE id = $1 AND deleted_at IS NULL`, selectedColumns)
@@ -112,7 +104,7 @@ func (sr *courseRepository) GetCourseByIdFieldMask(ctx context.Context, courseId
 	if err != nil {
 		// 3. Tangani jika data tidak ditemukan
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, err
+			return nil, nil
 		}
 		return nil, err
 	}
@@ -120,6 +112,7 @@ func (sr *courseRepository) GetCourseByIdFieldMask(ctx context.Context, courseId
 	return &courseEntity, nil
 }
 
+// UpdateCourse: instructor_id tidak ikut diubah (pemilik course hanya diisi saat create)
 func (sr *courseRepository) UpdateCourse(ctx context.Context, course *entity.Course) error {
 	// Menggunakan Named Query (:field) yang merujuk pada tag db di struct entity
 	query := `
@@ -129,7 +122,6 @@ func (sr *courseRepository) UpdateCourse(ctx context.Context, course *entity.Cou
 			address = :address, 
 			image_file_name = :image_file_name, 
 			slug = :slug,
-			instructor_id = :instructor_id,
 			category_id = :category_id,
 			course_type = :course_type,
 			seo_description = :seo_description,
@@ -159,8 +151,9 @@ func (sr *courseRepository) UpdateCourse(ctx context.Context, course *entity.Cou
 	return err
 }
 
+// DeleteCourse: course menjadi root cascade (deleted_with_id = id), chapter & lesson di-cascade oleh service
 func (sr *courseRepository) DeleteCourse(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
-	query := `UPDATE courses SET deleted_at = :deleted_at, deleted_by = :deleted_by WHERE id = :id`
+	query := `UPDATE courses SET deleted_at = :deleted_at, deleted_by = :deleted_by, deleted_with_id = id WHERE id = :id`
 
 	// Kita bungkus data ke dalam map agar bisa dibaca oleh NamedExecContext
 	data := map[string]any{
@@ -178,9 +171,85 @@ func (sr *courseRepository) DeleteCourse(ctx context.Context, id string, deleted
 	return nil
 }
 
+// GetDeletedCourseById: course yang ada di trash (belum di-purge)
+func (sr *courseRepository) GetDeletedCourseById(ctx context.Context, courseId string) (*entity.Course, error) {
+	var courseEntity entity.Course
+
+	query := `SELECT id, name, image_file_name, instructor_id, deleted_at, deleted_by, deleted_with_id
+	          FROM courses
+	          WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	err := sr.db.GetContext(ctx, &courseEntity, query, courseId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &courseEntity, nil
+}
+
+func (sr *courseRepository) RestoreCourse(ctx context.Context, id string, updatedAt time.Time, updatedBy string) error {
+	query := `UPDATE courses
+	          SET deleted_at = NULL, deleted_by = NULL, deleted_with_id = NULL, updated_at = $2, updated_by = $3
+	          WHERE id = $1 AND deleted_with_id = id`
+
+	_, err := sr.db.ExecContext(ctx, query, id, updatedAt, updatedBy)
+	return err
+}
+
+// GetDeletedCourses: semua course di trash (dipakai listing trash admin)
+func (sr *courseRepository) GetDeletedCourses(ctx context.Context) ([]*entity.Course, error) {
+	var courses []*entity.Course
+
+	query := `SELECT id, name, instructor_id, deleted_at, deleted_by, deleted_with_id
+	          FROM courses
+	          WHERE deleted_at IS NOT NULL
+	          ORDER BY deleted_at DESC, id`
+
+	err := sr.db.SelectContext(ctx, &courses, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return courses, nil
+}
+
+// PurgeCourses menghapus permanen course di trash yang dihapus sebelum deletedBefore (data turunan ikut terhapus via FK cascade)
+func (sr *courseRepository) PurgeCourses(ctx context.Context, deletedBefore time.Time) ([]*entity.Course, error) {
+	var courses []*entity.Course
+
+	query := `DELETE FROM courses
+	          WHERE deleted_at IS NOT NULL AND deleted_at < $1
+	          RETURNING id, image_file_name`
+
+	err := sr.db.SelectContext(ctx, &courses, query, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	return courses, nil
+}
+
+// GetAllCourseImages mengambil image_file_name dari semua course, termasuk yang ada di trash (dipakai oleh storage sweeper)
+func (sr *courseRepository) GetAllCourseImages(ctx context.Context) ([]*entity.Course, error) {
+	var courses []*entity.Course
+
+	//? course di trash masih bisa di-restore, file-nya baru boleh dihapus setelah di-purge
+	query := `SELECT id, image_file_name FROM courses`
+
+	err := sr.db.SelectContext(ctx, &courses, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return courses, nil
+}
+
 func NewCourseRepository(db database.DatabaseQuery) ICourseRepository {
 	return &courseRepository{
-		db: db,
+		db: database.NewTracedQuery(db),
 		whitelist: map[string]bool{
 			"id": true, "name": true, "address": true, "image_file_name": true,
 			"created_at": true, "created_by": true, "updated_at": true,
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+)
+
+type IEnrollmentRepository interface {
+	GetEnrollment(ctx context.Context, courseId string, userId string) (*entity.Enrollment, error)
+}
+
+type enrollmentRepository struct {
+	db database.DatabaseQuery
+}
+
+func (er *enrollmentRepository) GetEnrollment(ctx context.Context, courseId string, userId string) (*entity.Enrollment, error) {
+	var enrollmentEntity entity.Enrollment
+
+	query := `SELECT id, user_id, course_id, enrolled_at
+	          FROM enrollments
+	          WHERE course_id = $1 AND user_id = $2 AND deleted_at IS NULL`
+
+	err := er.db.GetContext(ctx, &enrollmentEntity, query, courseId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &enrollmentEntity, nil
+}
+
+func NewEnrollmentRepository(db database.DatabaseQuery) IEnrollmentRepository {
+	return &enrollmentRepository{db: database.NewTracedQuery(db)}
+}
@@ -0,0 +1,21 @@
+package repository
+
+import "strings"
+
+// selectColumns menyusun kolom SELECT dari paths field mask.
+// Hanya kolom yang ada di whitelist yang dipakai, default "*" jika paths kosong / tidak ada yang valid.
+func selectColumns(whitelist map[string]bool, paths []string) string {
+	var validColumns []string
+	for _, p := range paths {
+		// Cek apakah kolom yang diminta ada di whitelist kita
+		if whitelist[p] {
+			validColumns = append(validColumns, p)
+		}
+	}
+
+	if len(validColumns) == 0 {
+		return "*"
+	}
+
+	return strings.Join(validColumns, ", ")
+}
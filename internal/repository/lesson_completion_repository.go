@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type ILessonCompletionRepository interface {
+	WithTransaction(tx *sqlx.Tx) ILessonCompletionRepository
+	CompleteLesson(ctx context.Context, completion *entity.LessonCompletion) (*entity.LessonCompletion, error)
+	GetCompletedLessonIds(ctx context.Context, courseId string, userId string) ([]string, error)
+}
+
+type lessonCompletionRepository struct {
+	db database.DatabaseQuery
+}
+
+func (lr *lessonCompletionRepository) WithTransaction(tx *sqlx.Tx) ILessonCompletionRepository {
+	return &lessonCompletionRepository{
+		db: database.NewTracedQuery(tx),
+	}
+}
+
+// CompleteLesson idempotent: jika lesson sudah pernah diselesaikan, data lama (completed_at pertama) yang dikembalikan
+func (lr *lessonCompletionRepository) CompleteLesson(ctx context.Context, completion *entity.LessonCompletion) (*entity.LessonCompletion, error) {
+	var completionEntity entity.LessonCompletion
+
+	query := `INSERT INTO lesson_completions (id, lesson_id, user_id, completed_at)
+	          VALUES ($1, $2, $3, $4)
+	          ON CONFLICT (lesson_id, user_id) DO UPDATE SET completed_at = lesson_completions.completed_at
+	          RETURNING *`
+
+	err := lr.db.GetContext(ctx, &completionEntity, query, completion.Id, completion.LessonId, completion.UserId, completion.CompletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &completionEntity, nil
+}
+
+// GetCompletedLessonIds: lesson aktif di course yang sudah diselesaikan user (tanpa lesson quiz, lihat quiz_attempts)
+func (lr *lessonCompletionRepository) GetCompletedLessonIds(ctx context.Context, courseId string, userId string) ([]string, error) {
+	var lessonIds []string
+
+	query := `SELECT lc.lesson_id
+	          FROM lesson_completions lc
+	          JOIN course_chapter_lessons l ON l.id = lc.lesson_id AND l.deleted_at IS NULL
+	          WHERE l.course_id = $1 AND lc.user_id = $2`
+
+	err := lr.db.SelectContext(ctx, &lessonIds, query, courseId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return lessonIds, nil
+}
+
+func NewLessonCompletionRepository(db database.DatabaseQuery) ILessonCompletionRepository {
+	return &lessonCompletionRepository{db: database.NewTracedQuery(db)}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type ILessonTranscriptRepository interface {
+	WithTransaction(tx *sqlx.Tx) ILessonTranscriptRepository
+	ReplaceLessonTranscript(ctx context.Context, lessonId string, cues []*entity.LessonTranscriptCue) error
+	SearchLessonTranscripts(ctx context.Context, courseId string, query string, language *string, lessonIds []string, limit int64) ([]*entity.LessonTranscriptHit, error)
+}
+
+type lessonTranscriptRepository struct {
+	db database.DatabaseQuery
+}
+
+func (lr *lessonTranscriptRepository) WithTransaction(tx *sqlx.Tx) ILessonTranscriptRepository {
+	return &lessonTranscriptRepository{
+		db: database.NewTracedQuery(tx),
+	}
+}
+
+// ReplaceLessonTranscript menghapus semua cue lama lesson lalu menyimpan cues (kosong = lesson tanpa caption)
+func (lr *lessonTranscriptRepository) ReplaceLessonTranscript(ctx context.Context, lessonId string, cues []*entity.LessonTranscriptCue) error {
+	_, err := lr.db.ExecContext(ctx, `DELETE FROM lesson_transcript_cues WHERE lesson_id = $1`, lessonId)
+	if err != nil {
+		return err
+	}
+	if len(cues) == 0 {
+		return nil
+	}
+
+	//? batch insert: sqlx mengulang VALUES utk setiap elemen slice
+	query := `INSERT INTO lesson_transcript_cues (id, lesson_id, language, cue_index, start_ms, end_ms, text)
+	          VALUES (:id, :lesson_id, :language, :cue_index, :start_ms, :end_ms, :text)`
+
+	_, err = lr.db.NamedExecContext(ctx, query, cues)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SearchLessonTranscripts: cue yang cocok dgn query (format websearch: "kata persis", -kecuali, or) di lesson course tsb.
+// lessonIds nil = semua lesson, selain itu hanya lesson tsb (filter dilakukan sebelum LIMIT)
+func (lr *lessonTranscriptRepository) SearchLessonTranscripts(ctx context.Context, courseId string, query string, language *string, lessonIds []string, limit int64) ([]*entity.LessonTranscriptHit, error) {
+	var hits []*entity.LessonTranscriptHit
+
+	sqlQuery := `SELECT t.lesson_id, l.title AS lesson_title, l.chapter_id, t.language, t.start_ms, t.end_ms, t.text
+	             FROM lesson_transcript_cues t
+	             JOIN course_chapter_lessons l ON l.id = t.lesson_id AND l.deleted_at IS NULL
+	             CROSS JOIN websearch_to_tsquery('simple', $2) q
+	             WHERE l.course_id = $1 AND t.search @@ q AND ($3::text IS NULL OR t.language = $3)
+	               AND ($5::uuid[] IS NULL OR t.lesson_id = ANY($5))
+	             ORDER BY ts_rank(t.search, q) DESC, l.order_lesson, t.start_ms
+	             LIMIT $4`
+
+	err := lr.db.SelectContext(ctx, &hits, sqlQuery, courseId, query, language, limit, pq.Array(lessonIds))
+	if err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+func NewLessonTranscriptRepository(db database.DatabaseQuery) ILessonTranscriptRepository {
+	return &lessonTranscriptRepository{db: database.NewTracedQuery(db)}
+}
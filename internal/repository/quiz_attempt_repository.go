@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type IQuizAttemptRepository interface {
+	WithTransaction(tx *sqlx.Tx) IQuizAttemptRepository
+	CreateNewQuizAttempt(ctx context.Context, attempt *entity.QuizAttempt) error
+	GetQuizAttemptById(ctx context.Context, attemptId string) (*entity.QuizAttempt, error)
+	GetOpenQuizAttempt(ctx context.Context, quizId string, userId string) (*entity.QuizAttempt, error)
+	CountQuizAttempts(ctx context.Context, quizId string, userId string) (int64, error)
+	SubmitQuizAttempt(ctx context.Context, attempt *entity.QuizAttempt) (bool, error)
+	GetQuizAttempts(ctx context.Context, quizId string, userId *string) ([]*entity.QuizAttempt, error)
+	GetQuizAttemptsByCourse(ctx context.Context, courseId string, userId string) ([]*entity.QuizAttempt, error)
+}
+
+// QuizAttemptOpenUniqueIndex & QuizAttemptNumberUniqueIndex: satu attempt berjalan & nomor attempt unik per learner (migration 000009)
+const (
+	QuizAttemptOpenUniqueIndex   = "quiz_attempts_quiz_user_open_key"
+	QuizAttemptNumberUniqueIndex = "quiz_attempts_quiz_user_number_key"
+)
+
+// quizAttemptSummaryColumns: kolom ringkasan attempt (tanpa sheet yang bisa besar), dipakai utk list & progress
+const quizAttemptSummaryColumns = `id, quiz_id, course_id, user_id, attempt_number, started_at, expires_at, submitted_at, score, max_score, passed, expired`
+
+type quizAttemptRepository struct {
+	db database.DatabaseQuery
+}
+
+func (ar *quizAttemptRepository) WithTransaction(tx *sqlx.Tx) IQuizAttemptRepository {
+	return &quizAttemptRepository{
+		db: database.NewTracedQuery(tx),
+	}
+}
+
+func (ar *quizAttemptRepository) CreateNewQuizAttempt(ctx context.Context, attempt *entity.QuizAttempt) error {
+	query := `
+        INSERT INTO quiz_attempts (
+            id, quiz_id, course_id, user_id, attempt_number, sheet, started_at, expires_at, max_score
+        )
+        VALUES (
+            :id, :quiz_id, :course_id, :user_id, :attempt_number, :sheet, :started_at, :expires_at, :max_score
+        )`
+
+	_, err := ar.db.NamedExecContext(ctx, query, attempt)
+	return err
+}
+
+func (ar *quizAttemptRepository) GetQuizAttemptById(ctx context.Context, attemptId string) (*entity.QuizAttempt, error) {
+	var attemptEntity entity.QuizAttempt
+
+	query := `SELECT * FROM quiz_attempts WHERE id = $1`
+
+	err := ar.db.GetContext(ctx, &attemptEntity, query, attemptId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &attemptEntity, nil
+}
+
+// GetOpenQuizAttempt mengambil attempt learner yang belum dikumpulkan (nil jika tidak ada)
+func (ar *quizAttemptRepository) GetOpenQuizAttempt(ctx context.Context, quizId string, userId string) (*entity.QuizAttempt, error) {
+	var attemptEntity entity.QuizAttempt
+
+	query := `SELECT * FROM quiz_attempts WHERE quiz_id = $1 AND user_id = $2 AND submitted_at IS NULL`
+
+	err := ar.db.GetContext(ctx, &attemptEntity, query, quizId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &attemptEntity, nil
+}
+
+func (ar *quizAttemptRepository) CountQuizAttempts(ctx context.Context, quizId string, userId string) (int64, error) {
+	var count int64
+
+	query := `SELECT COUNT(*) FROM quiz_attempts WHERE quiz_id = $1 AND user_id = $2`
+
+	err := ar.db.GetContext(ctx, &count, query, quizId, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SubmitQuizAttempt menyimpan hasil penilaian, false jika attempt sudah dikumpulkan lebih dulu (request ganda)
+func (ar *quizAttemptRepository) SubmitQuizAttempt(ctx context.Context, attempt *entity.QuizAttempt) (bool, error) {
+	query := `
+		UPDATE quiz_attempts
+		SET
+			sheet = :sheet,
+			submitted_at = :submitted_at,
+			score = :score,
+			passed = :passed,
+			expired = :expired
+		WHERE id = :id AND submitted_at IS NULL`
+
+	result, err := ar.db.NamedExecContext(ctx, query, attempt)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetQuizAttempts mengambil ringkasan attempt quiz, userId nil = semua learner
+func (ar *quizAttemptRepository) GetQuizAttempts(ctx context.Context, quizId string, userId *string) ([]*entity.QuizAttempt, error) {
+	var attempts []*entity.QuizAttempt
+
+	query := `SELECT ` + quizAttemptSummaryColumns + ` FROM quiz_attempts
+	          WHERE quiz_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+	          ORDER BY user_id, attempt_number`
+
+	err := ar.db.SelectContext(ctx, &attempts, query, quizId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// GetQuizAttemptsByCourse mengambil ringkasan semua attempt learner (termasuk yang masih berjalan) di semua quiz course
+func (ar *quizAttemptRepository) GetQuizAttemptsByCourse(ctx context.Context, courseId string, userId string) ([]*entity.QuizAttempt, error) {
+	var attempts []*entity.QuizAttempt
+
+	query := `SELECT ` + quizAttemptSummaryColumns + ` FROM quiz_attempts
+	          WHERE course_id = $1 AND user_id = $2
+	          ORDER BY quiz_id, attempt_number`
+
+	err := ar.db.SelectContext(ctx, &attempts, query, courseId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+func NewQuizAttemptRepository(db database.DatabaseQuery) IQuizAttemptRepository {
+	return &quizAttemptRepository{db: database.NewTracedQuery(db)}
+}
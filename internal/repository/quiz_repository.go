@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type IQuizRepository interface {
+	WithTransaction(tx *sqlx.Tx) IQuizRepository
+	CreateNewQuiz(ctx context.Context, quiz *entity.Quiz) error
+	GetQuizById(ctx context.Context, quizId string) (*entity.Quiz, error)
+	UpdateQuiz(ctx context.Context, quiz *entity.Quiz) error
+	DeleteQuiz(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	GetQuizzesByCourseId(ctx context.Context, courseId string) ([]*entity.Quiz, error)
+	CreateNewQuizQuestion(ctx context.Context, question *entity.QuizQuestion) error
+	GetQuizQuestionById(ctx context.Context, questionId string) (*entity.QuizQuestion, error)
+	UpdateQuizQuestion(ctx context.Context, question *entity.QuizQuestion) error
+	DeleteQuizQuestion(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	GetQuizQuestionsByQuizId(ctx context.Context, quizId string) ([]*entity.QuizQuestion, error)
+	GetLastOrderQuestion(ctx context.Context, quizId string) (int64, error)
+}
+
+// QuizLessonUniqueIndex: satu quiz aktif per lesson (migration 000009)
+const QuizLessonUniqueIndex = "quizzes_lesson_live_key"
+
+type quizRepository struct {
+	db database.DatabaseQuery
+}
+
+func (qr *quizRepository) WithTransaction(tx *sqlx.Tx) IQuizRepository {
+	return &quizRepository{
+		db: database.NewTracedQuery(tx),
+	}
+}
+
+func (qr *quizRepository) CreateNewQuiz(ctx context.Context, quiz *entity.Quiz) error {
+	query := `
+        INSERT INTO quizzes (
+            id, lesson_id, course_id, title, description, time_limit_seconds, max_attempts, pass_mark_percent,
+            questions_per_attempt, shuffle_questions, shuffle_options, created_at, created_by, updated_at, updated_by
+        )
+        VALUES (
+            :id, :lesson_id, :course_id, :title, :description, :time_limit_seconds, :max_attempts, :pass_mark_percent,
+            :questions_per_attempt, :shuffle_questions, :shuffle_options, :created_at, :created_by, :created_at, :updated_by
+        )`
+
+	_, err := qr.db.NamedExecContext(ctx, query, quiz)
+	return err
+}
+
+func (qr *quizRepository) GetQuizById(ctx context.Context, quizId string) (*entity.Quiz, error) {
+	var quizEntity entity.Quiz
+
+	query := `SELECT * FROM quizzes WHERE id = $1 AND deleted_at IS NULL`
+
+	err := qr.db.GetContext(ctx, &quizEntity, query, quizId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &quizEntity, nil
+}
+
+func (qr *quizRepository) UpdateQuiz(ctx context.Context, quiz *entity.Quiz) error {
+	query := `
+		UPDATE quizzes
+		SET
+			title = :title,
+			description = :description,
+			time_limit_seconds = :time_limit_seconds,
+			max_attempts = :max_attempts,
+			pass_mark_percent = :pass_mark_percent,
+			questions_per_attempt = :questions_per_attempt,
+			shuffle_questions = :shuffle_questions,
+			shuffle_options = :shuffle_options,
+
+			updated_at = :updated_at,
+			updated_by = :updated_by
+		WHERE id = :id AND deleted_at IS NULL`
+
+	_, err := qr.db.NamedExecContext(ctx, query, quiz)
+	return err
+}
+
+func (qr *quizRepository) DeleteQuiz(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	query := `UPDATE quizzes SET deleted_at = :deleted_at, deleted_by = :deleted_by WHERE id = :id`
+
+	data := map[string]any{
+		"deleted_at": deletedAt,
+		"deleted_by": deletedBy,
+		"id":         id,
+	}
+
+	_, err := qr.db.NamedExecContext(ctx, query, data)
+	return err
+}
+
+// GetQuizzesByCourseId mengambil quiz aktif milik course yang lesson-nya juga masih aktif (dipakai utk progress / syarat selesai course)
+func (qr *quizRepository) GetQuizzesByCourseId(ctx context.Context, courseId string) ([]*entity.Quiz, error) {
+	var quizzes []*entity.Quiz
+
+	query := `SELECT q.* FROM quizzes q
+	          JOIN course_chapter_lessons l ON l.id = q.lesson_id AND l.deleted_at IS NULL
+	          WHERE q.course_id = $1 AND q.deleted_at IS NULL
+	          ORDER BY q.created_at, q.id`
+
+	err := qr.db.SelectContext(ctx, &quizzes, query, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return quizzes, nil
+}
+
+func (qr *quizRepository) CreateNewQuizQuestion(ctx context.Context, question *entity.QuizQuestion) error {
+	query := `
+        INSERT INTO quiz_questions (
+            id, quiz_id, question_type, prompt, points, order_question, content, created_at, created_by, updated_at
+        )
+        VALUES (
+            :id, :quiz_id, :question_type, :prompt, :points, :order_question, :content, :created_at, :created_by, :created_at
+        )`
+
+	_, err := qr.db.NamedExecContext(ctx, query, question)
+	return err
+}
+
+func (qr *quizRepository) GetQuizQuestionById(ctx context.Context, questionId string) (*entity.QuizQuestion, error) {
+	var questionEntity entity.QuizQuestion
+
+	query := `SELECT * FROM quiz_questions WHERE id = $1 AND deleted_at IS NULL`
+
+	err := qr.db.GetContext(ctx, &questionEntity, query, questionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &questionEntity, nil
+}
+
+func (qr *quizRepository) UpdateQuizQuestion(ctx context.Context, question *entity.QuizQuestion) error {
+	query := `
+		UPDATE quiz_questions
+		SET
+			question_type = :question_type,
+			prompt = :prompt,
+			points = :points,
+			content = :content,
+
+			updated_at = :updated_at,
+			updated_by = :updated_by
+		WHERE id = :id AND deleted_at IS NULL`
+
+	_, err := qr.db.NamedExecContext(ctx, query, question)
+	return err
+}
+
+func (qr *quizRepository) DeleteQuizQuestion(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	query := `UPDATE quiz_questions SET deleted_at = :deleted_at, deleted_by = :deleted_by WHERE id = :id`
+
+	data := map[string]any{
+		"deleted_at": deletedAt,
+		"deleted_by": deletedBy,
+		"id":         id,
+	}
+
+	_, err := qr.db.NamedExecContext(ctx, query, data)
+	return err
+}
+
+// GetQuizQuestionsByQuizId mengambil bank soal aktif milik quiz, urut order_question
+func (qr *quizRepository) GetQuizQuestionsByQuizId(ctx context.Context, quizId string) ([]*entity.QuizQuestion, error) {
+	var questions []*entity.QuizQuestion
+
+	query := `SELECT * FROM quiz_questions
+	          WHERE quiz_id = $1 AND deleted_at IS NULL
+	          ORDER BY order_question, id`
+
+	err := qr.db.SelectContext(ctx, &questions, query, quizId)
+	if err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+// GetLastOrderQuestion mengembalikan order_question terbesar di quiz (0 jika belum ada soal)
+func (qr *quizRepository) GetLastOrderQuestion(ctx context.Context, quizId string) (int64, error) {
+	var lastOrder int64
+
+	query := `SELECT COALESCE(MAX(order_question), 0)
+	          FROM quiz_questions
+	          WHERE quiz_id = $1 AND deleted_at IS NULL`
+
+	err := qr.db.GetContext(ctx, &lastOrder, query, quizId)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastOrder, nil
+}
+
+func NewQuizRepository(db database.DatabaseQuery) IQuizRepository {
+	return &quizRepository{db: database.NewTracedQuery(db)}
+}
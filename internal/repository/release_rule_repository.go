@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type IReleaseRuleRepository interface {
+	WithTransaction(tx *sqlx.Tx) IReleaseRuleRepository
+	CreateNewReleaseRule(ctx context.Context, rule *entity.ReleaseRule) error
+	DeleteChapterReleaseRule(ctx context.Context, chapterId string) error
+	DeleteLessonReleaseRule(ctx context.Context, lessonId string) error
+	GetReleaseRulesByCourseId(ctx context.Context, courseId string) ([]*entity.ReleaseRule, error)
+}
+
+type releaseRuleRepository struct {
+	db database.DatabaseQuery
+}
+
+func (rr *releaseRuleRepository) WithTransaction(tx *sqlx.Tx) IReleaseRuleRepository {
+	return &releaseRuleRepository{
+		db: database.NewTracedQuery(tx),
+	}
+}
+
+func (rr *releaseRuleRepository) CreateNewReleaseRule(ctx context.Context, rule *entity.ReleaseRule) error {
+	query := `
+        INSERT INTO release_rules (
+            id, chapter_id, lesson_id, release_at, days_after_enrollment, prerequisite_lesson_id, prerequisite_quiz_id,
+            created_at, created_by
+        )
+        VALUES (
+            :id, :chapter_id, :lesson_id, :release_at, :days_after_enrollment, :prerequisite_lesson_id, :prerequisite_quiz_id,
+            :created_at, :created_by
+        )`
+
+	_, err := rr.db.NamedExecContext(ctx, query, rule)
+	return err
+}
+
+func (rr *releaseRuleRepository) DeleteChapterReleaseRule(ctx context.Context, chapterId string) error {
+	_, err := rr.db.ExecContext(ctx, `DELETE FROM release_rules WHERE chapter_id = $1`, chapterId)
+	return err
+}
+
+func (rr *releaseRuleRepository) DeleteLessonReleaseRule(ctx context.Context, lessonId string) error {
+	_, err := rr.db.ExecContext(ctx, `DELETE FROM release_rules WHERE lesson_id = $1`, lessonId)
+	return err
+}
+
+// GetReleaseRulesByCourseId: aturan milik chapter / lesson aktif di course (lesson yang dipindah ikut course barunya)
+func (rr *releaseRuleRepository) GetReleaseRulesByCourseId(ctx context.Context, courseId string) ([]*entity.ReleaseRule, error) {
+	var rules []*entity.ReleaseRule
+
+	query := `SELECT r.*
+	          FROM release_rules r
+	          LEFT JOIN course_chapters c ON c.id = r.chapter_id AND c.deleted_at IS NULL
+	          LEFT JOIN course_chapter_lessons l ON l.id = r.lesson_id AND l.deleted_at IS NULL
+	          WHERE c.course_id = $1 OR l.course_id = $1`
+
+	err := rr.db.SelectContext(ctx, &rules, query, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func NewReleaseRuleRepository(db database.DatabaseQuery) IReleaseRuleRepository {
+	return &releaseRuleRepository{db: database.NewTracedQuery(db)}
+}
@@ -0,0 +1,725 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/assignment"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type IAssignmentService interface {
+	CreateAssignment(ctx context.Context, request *assignment.CreateAssignmentRequest) (*assignment.CreateAssignmentResponse, error)
+	DetailAssignment(ctx context.Context, request *assignment.DetailAssignmentRequest) (*assignment.DetailAssignmentResponse, error)
+	EditAssignment(ctx context.Context, request *assignment.EditAssignmentRequest) (*assignment.EditAssignmentResponse, error)
+	DeleteAssignment(ctx context.Context, request *assignment.DeleteAssignmentRequest) (*assignment.DeleteAssignmentResponse, error)
+	SubmitAssignment(ctx context.Context, request *assignment.SubmitAssignmentRequest) (*assignment.SubmitAssignmentResponse, error)
+	ListAssignmentSubmissions(ctx context.Context, request *assignment.ListAssignmentSubmissionsRequest) (*assignment.ListAssignmentSubmissionsResponse, error)
+	DetailAssignmentSubmission(ctx context.Context, request *assignment.DetailAssignmentSubmissionRequest) (*assignment.DetailAssignmentSubmissionResponse, error)
+	GradeAssignmentSubmission(ctx context.Context, request *assignment.GradeAssignmentSubmissionRequest) (*assignment.GradeAssignmentSubmissionResponse, error)
+}
+
+type assignmentService struct {
+	db                      *sqlx.DB
+	assignmentRepository    repository.IAssignmentRepository
+	submissionRepository    repository.IAssignmentSubmissionRepository
+	chapterLessonRepository repository.IChapterLessonRepository
+	courseRepository        repository.ICourseRepository
+	enrollmentRepository    repository.IEnrollmentRepository
+	releaseService          IReleaseService
+	messageSender           IMessageSender //? notifikasi nilai ke learner
+	storageResolver         *storage.Resolver
+	storageConfig           config.StorageConfig
+}
+
+func (as *assignmentService) CreateAssignment(ctx context.Context, request *assignment.CreateAssignmentRequest) (*assignment.CreateAssignmentResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	//* assignment hanya bisa dipasang di lesson berjenis assignment
+	lesson, err := as.chapterLessonRepository.GetChapterLessonById(ctx, request.LessonId)
+	if err != nil {
+		return nil, err
+	}
+	if lesson == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
+	}
+	if lesson.Kind == nil || *lesson.Kind != entity.LessonKindAssignment || lesson.CourseId == nil {
+		return nil, apperror.InvalidArgument("Lesson is not an assignment lesson").WithFieldViolation("lesson_id", "must be a lesson of kind assignment")
+	}
+
+	err = ensureCourseOwner(ctx, as.courseRepository, claims, *lesson.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, as.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	assignmentRepo := as.assignmentRepository.WithTransaction(tx.Tx)
+
+	// *insert ke DB
+	assignmentEntity := entity.Assignment{
+		Id:       uuid.NewString(),
+		LessonId: lesson.Id,
+		CourseId: *lesson.CourseId,
+
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+	applyAssignmentSettings(&assignmentEntity, request.Settings)
+
+	err = assignmentRepo.CreateNewAssignment(ctx, &assignmentEntity)
+	if err != nil {
+		if database.IsUniqueViolation(err, repository.AssignmentLessonUniqueIndex) {
+			err = apperror.AlreadyExists("Lesson already has an assignment").WithFieldViolation("lesson_id", "delete the existing assignment first")
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &assignment.CreateAssignmentResponse{
+		Base: utils.SuccessResponse("Assignment successfully created"),
+		Id:   assignmentEntity.Id,
+	}, nil
+}
+
+func (as *assignmentService) DetailAssignment(ctx context.Context, request *assignment.DetailAssignmentRequest) (*assignment.DetailAssignmentResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentEntity, err := as.assignmentRepository.GetAssignmentById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if assignmentEntity == nil {
+		return nil, apperror.NotFound("Assignment not found")
+	}
+
+	canManage, err := courseAccess(ctx, as.courseRepository, as.enrollmentRepository, claims, assignmentEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* instruksi lesson yang masih terkunci (drip / prerequisite) tidak dikirim ke learner
+	if !canManage {
+		err = as.releaseService.EnsureLessonReleased(ctx, claims, assignmentEntity.LessonId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &assignment.DetailAssignmentResponse{
+		Base:                 utils.SuccessResponse("Assignment Detail Success"),
+		Id:                   assignmentEntity.Id,
+		LessonId:             assignmentEntity.LessonId,
+		CourseId:             assignmentEntity.CourseId,
+		Title:                assignmentEntity.Title,
+		Instructions:         assignmentEntity.Instructions,
+		DueAt:                utils.PtrTimeToPtr(assignmentEntity.DueAt),
+		MaxScore:             assignmentEntity.MaxScore,
+		AllowLateSubmissions: assignmentEntity.AllowLateSubmissions,
+	}
+
+	//? learner melihat status submission miliknya sendiri
+	if !canManage {
+		submission, err := as.submissionRepository.GetSubmission(ctx, assignmentEntity.Id, claims.Subject)
+		if err != nil {
+			return nil, err
+		}
+		if submission != nil {
+			res.MySubmission = assignmentSubmissionSummary(submission, assignmentEntity)
+		}
+	}
+
+	return res, nil
+}
+
+func (as *assignmentService) EditAssignment(ctx context.Context, request *assignment.EditAssignmentRequest) (*assignment.EditAssignmentResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	assignmentEntity, err := as.assignmentRepository.GetAssignmentById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if assignmentEntity == nil {
+		return nil, apperror.NotFound("Assignment not found")
+	}
+
+	err = ensureCourseOwner(ctx, as.courseRepository, claims, assignmentEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, as.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	assignmentRepo := as.assignmentRepository.WithTransaction(tx.Tx)
+
+	//? flag late & nilai submission yang sudah ada tidak dihitung ulang
+	applyAssignmentSettings(assignmentEntity, request.Settings)
+	assignmentEntity.UpdatedAt = time.Now()
+	assignmentEntity.UpdatedBy = &claims.FullName
+
+	err = assignmentRepo.UpdateAssignment(ctx, assignmentEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &assignment.EditAssignmentResponse{
+		Base: utils.SuccessResponse("Edit Assignment Success"),
+		Id:   assignmentEntity.Id,
+	}, nil
+}
+
+func (as *assignmentService) DeleteAssignment(ctx context.Context, request *assignment.DeleteAssignmentRequest) (*assignment.DeleteAssignmentResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	assignmentEntity, err := as.assignmentRepository.GetAssignmentById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if assignmentEntity == nil {
+		return nil, apperror.NotFound("Assignment not found")
+	}
+
+	err = ensureCourseOwner(ctx, as.courseRepository, claims, assignmentEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, as.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	assignmentRepo := as.assignmentRepository.WithTransaction(tx.Tx)
+
+	// *update delete_at & delete_by ke DB, submission & file-nya tetap disimpan
+	err = assignmentRepo.DeleteAssignment(ctx, request.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &assignment.DeleteAssignmentResponse{
+		Base: utils.SuccessResponse("Delete with SoftDelete Assignment Success"),
+	}, nil
+}
+
+func (as *assignmentService) SubmitAssignment(ctx context.Context, request *assignment.SubmitAssignmentRequest) (*assignment.SubmitAssignmentResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentEntity, err := as.assignmentRepository.GetAssignmentById(ctx, request.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if assignmentEntity == nil {
+		return nil, apperror.NotFound("Assignment not found")
+	}
+
+	//* hanya learner yang enroll yang bisa mengumpulkan
+	err = ensureEnrolled(ctx, as.enrollmentRepository, claims, assignmentEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = as.releaseService.EnsureLessonReleased(ctx, claims, assignmentEntity.LessonId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* file harus sudah di-upload lewat REST (/assignment/upload) ke storage/<course_id>/submission oleh user yang sama
+	for i, fileName := range request.FileNames {
+		field := fmt.Sprintf("file_names[%d]", i)
+
+		filePath, pathErr := as.storageResolver.CourseFile(assignmentEntity.CourseId, storage.FolderSubmission, fileName)
+		if pathErr != nil {
+			return nil, apperror.InvalidArgument("Invalid submission file name").WithFieldViolation(field, pathErr.Error())
+		}
+		if !isOwnSubmissionFile(claims.Subject, fileName) {
+			return nil, apperror.PermissionDenied("Submission file belongs to another user").WithFieldViolation(field, "upload the file yourself")
+		}
+		_, err = statFile(ctx, filePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				err = apperror.FailedPrecondition("File not found").WithFieldViolation(field, "upload the file first")
+			}
+			return nil, err
+		}
+	}
+
+	//* lewat due date: ditolak atau diberi flag late
+	now := time.Now()
+	late := assignmentEntity.DueAt != nil && now.After(*assignmentEntity.DueAt)
+	if late && !assignmentEntity.AllowLateSubmissions {
+		return nil, apperror.FailedPrecondition("Assignment due date has passed")
+	}
+
+	existing, err := as.submissionRepository.GetSubmission(ctx, assignmentEntity.Id, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.GradedAt != nil {
+		return nil, apperror.FailedPrecondition("Submission already graded")
+	}
+
+	//* satu file hanya boleh dipakai satu submission (file dihapus saat submission lain menggantinya)
+	if len(request.FileNames) > 0 {
+		referencing, refErr := as.submissionRepository.GetSubmissionsByFileNames(ctx, assignmentEntity.CourseId, request.FileNames)
+		if refErr != nil {
+			return nil, refErr
+		}
+		for _, other := range referencing {
+			if existing != nil && other.Id == existing.Id {
+				continue
+			}
+			for i, fileName := range request.FileNames {
+				if slices.Contains(other.FileNames, fileName) {
+					return nil, apperror.FailedPrecondition("Submission file already used by another submission").WithFieldViolation(fmt.Sprintf("file_names[%d]", i), "upload a new file")
+				}
+			}
+		}
+	}
+
+	tx, err := database.BeginTransaction(ctx, as.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	submissionRepo := as.submissionRepository.WithTransaction(tx.Tx)
+
+	//? nama & email di-snapshot agar list / notifikasi tidak perlu join ke users
+	submissionEntity := entity.AssignmentSubmission{
+		Id:           uuid.NewString(),
+		AssignmentId: assignmentEntity.Id,
+		CourseId:     assignmentEntity.CourseId,
+		UserId:       claims.Subject,
+		UserName:     claims.FullName,
+		UserEmail:    claims.Email,
+		FileNames:    request.FileNames,
+		Comment:      request.Comment,
+		SubmittedAt:  now,
+		Late:         late,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if existing == nil {
+		err = submissionRepo.CreateNewSubmission(ctx, &submissionEntity)
+		if err != nil {
+			if database.IsUniqueViolation(err, repository.AssignmentSubmissionUniqueIndex) {
+				err = apperror.AlreadyExists("Assignment already submitted") //? request submit ganda yang berbarengan
+			}
+			return nil, err
+		}
+	} else {
+		//* submit ulang selama belum dinilai, file lama yang tidak dipakai lagi dihapus setelah commit
+		submissionEntity.Id = existing.Id
+		var resubmitted bool
+		resubmitted, err = submissionRepo.ResubmitSubmission(ctx, &submissionEntity)
+		if err != nil {
+			return nil, err
+		}
+		if !resubmitted {
+			err = apperror.FailedPrecondition("Submission already graded")
+			return nil, err
+		}
+
+		for _, fileName := range existing.FileNames {
+			//? hanya file milik user ini (file lama tanpa pemilik dibiarkan, dibersihkan storage sweeper jika orphan)
+			if slices.Contains(request.FileNames, fileName) || !isOwnSubmissionFile(claims.Subject, fileName) {
+				continue
+			}
+			if filePath, pathErr := as.storageResolver.CourseFile(existing.CourseId, storage.FolderSubmission, fileName); pathErr == nil {
+				tx.AfterCommit("remove replaced submission file", removeFileAction(filePath))
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &assignment.SubmitAssignmentResponse{
+		Base: utils.SuccessResponse("Assignment successfully submitted"),
+		Id:   submissionEntity.Id,
+		Late: late,
+	}, nil
+}
+
+func (as *assignmentService) ListAssignmentSubmissions(ctx context.Context, request *assignment.ListAssignmentSubmissionsRequest) (*assignment.ListAssignmentSubmissionsResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentEntity, err := as.assignmentRepository.GetAssignmentById(ctx, request.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if assignmentEntity == nil {
+		return nil, apperror.NotFound("Assignment not found")
+	}
+
+	canManage, err := courseAccess(ctx, as.courseRepository, as.enrollmentRepository, claims, assignmentEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//? learner hanya melihat submission miliknya sendiri
+	var userId *string
+	if !canManage {
+		userId = &claims.Subject
+	}
+
+	submissions, err := as.submissionRepository.GetSubmissionsByAssignmentId(ctx, assignmentEntity.Id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*assignment.AssignmentSubmissionSummary, 0, len(submissions))
+	for _, submission := range submissions {
+		items = append(items, assignmentSubmissionSummary(submission, assignmentEntity))
+	}
+
+	return &assignment.ListAssignmentSubmissionsResponse{
+		Base:  utils.SuccessResponse("Get Assignment Submissions Success"),
+		Items: items,
+	}, nil
+}
+
+func (as *assignmentService) DetailAssignmentSubmission(ctx context.Context, request *assignment.DetailAssignmentSubmissionRequest) (*assignment.DetailAssignmentSubmissionResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := as.submissionRepository.GetSubmissionById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if submission == nil {
+		return nil, apperror.NotFound("Submission not found")
+	}
+
+	assignmentEntity, err := as.assignmentRepository.GetAssignmentById(ctx, submission.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if assignmentEntity == nil {
+		return nil, apperror.NotFound("Assignment not found")
+	}
+
+	canManage, err := courseAccess(ctx, as.courseRepository, as.enrollmentRepository, claims, submission.CourseId)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage && submission.UserId != claims.Subject {
+		return nil, apperror.NotFound("Submission not found") //? submission learner lain tidak dibocorkan
+	}
+
+	//* file private diberikan sbg signed URL milik user yang meminta
+	files := make([]*assignment.SubmissionFile, 0, len(submission.FileNames))
+	for _, fileName := range submission.FileNames {
+		url, err := as.signSubmissionFileUrl(claims.Subject, submission.CourseId, fileName)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &assignment.SubmissionFile{
+			FileName: fileName,
+			Url:      url,
+		})
+	}
+
+	return &assignment.DetailAssignmentSubmissionResponse{
+		Base:       utils.SuccessResponse("Assignment Submission Detail Success"),
+		Submission: assignmentSubmissionSummary(submission, assignmentEntity),
+		Files:      files,
+		Comment:    submission.Comment,
+		Feedback:   submission.Feedback,
+	}, nil
+}
+
+func (as *assignmentService) GradeAssignmentSubmission(ctx context.Context, request *assignment.GradeAssignmentSubmissionRequest) (*assignment.GradeAssignmentSubmissionResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	submission, err := as.submissionRepository.GetSubmissionById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if submission == nil {
+		return nil, apperror.NotFound("Submission not found")
+	}
+
+	err = ensureCourseOwner(ctx, as.courseRepository, claims, submission.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentEntity, err := as.assignmentRepository.GetAssignmentById(ctx, submission.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if assignmentEntity == nil {
+		return nil, apperror.NotFound("Assignment not found")
+	}
+
+	if request.Score > assignmentEntity.MaxScore {
+		return nil, apperror.InvalidArgument("Score exceeds max score").WithFieldViolation("score", fmt.Sprintf("must be at most %d", assignmentEntity.MaxScore))
+	}
+
+	tx, err := database.BeginTransaction(ctx, as.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	submissionRepo := as.submissionRepository.WithTransaction(tx.Tx)
+
+	//? nilai ulang diperbolehkan, learner diberi notifikasi setiap kali dinilai
+	now := time.Now()
+	submission.Score = &request.Score
+	submission.Feedback = request.Feedback
+	submission.GradedAt = &now
+	submission.GradedBy = &claims.FullName
+	submission.UpdatedAt = now
+
+	err = submissionRepo.GradeSubmission(ctx, submission)
+	if err != nil {
+		return nil, err
+	}
+
+	//* notifikasi dikirim setelah commit (di-retry di background jika gagal)
+	subject := fmt.Sprintf("Tugas \"%s\" sudah dinilai", assignmentEntity.Title)
+	htmlBody := utils.GetAssignmentGradedEmailTemplate(assignmentEntity.Title, request.Score, assignmentEntity.MaxScore, request.Feedback)
+	userEmail := submission.UserEmail
+	tx.AfterCommit("notify graded submission", func(ctx context.Context) error {
+		return as.messageSender.Send(ctx, userEmail, subject, htmlBody)
+	})
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &assignment.GradeAssignmentSubmissionResponse{
+		Base:       utils.SuccessResponse("Assignment submission successfully graded"),
+		Submission: assignmentSubmissionSummary(submission, assignmentEntity),
+	}, nil
+}
+
+func (as *assignmentService) signSubmissionFileUrl(userId string, courseId string, fileName string) (string, error) {
+	storagePath := path.Join(courseId, storage.FolderSubmission, fileName)
+	expiresAt := time.Now().Add(as.storageConfig.SignedURLTTL)
+
+	return utils.BuildSignedStorageURL(as.storageConfig.ServiceURL, storagePath, userId, expiresAt, as.storageConfig.SigningSecret)
+}
+
+func applyAssignmentSettings(assignmentEntity *entity.Assignment, settings *assignment.AssignmentSettings) {
+	assignmentEntity.Title = settings.Title
+	assignmentEntity.Instructions = settings.Instructions
+	assignmentEntity.MaxScore = settings.MaxScore
+	assignmentEntity.AllowLateSubmissions = settings.AllowLateSubmissions
+	assignmentEntity.DueAt = nil
+	if settings.DueAt != nil {
+		dueAt := settings.DueAt.AsTime()
+		assignmentEntity.DueAt = &dueAt
+	}
+}
+
+func assignmentSubmissionSummary(submission *entity.AssignmentSubmission, assignmentEntity *entity.Assignment) *assignment.AssignmentSubmissionSummary {
+	return &assignment.AssignmentSubmissionSummary{
+		Id:           submission.Id,
+		AssignmentId: submission.AssignmentId,
+		UserId:       submission.UserId,
+		UserName:     submission.UserName,
+		SubmittedAt:  submission.SubmittedAt.Format(time.RFC3339),
+		Late:         submission.Late,
+		Score:        submission.Score,
+		MaxScore:     assignmentEntity.MaxScore,
+		GradedAt:     utils.PtrTimeToPtr(submission.GradedAt),
+		GradedBy:     submission.GradedBy,
+	}
+}
+
+// isOwnSubmissionFile: nama file submission_<user_id>_<unixnano>.<ext> hasil upload oleh userId
+func isOwnSubmissionFile(userId string, fileName string) bool {
+	return strings.HasPrefix(fileName, storage.UserFilePrefix(storage.SubmissionFilePrefix, userId))
+}
+
+func NewAssignmentService(db *sqlx.DB, assignmentRepository repository.IAssignmentRepository, submissionRepository repository.IAssignmentSubmissionRepository, chapterLessonRepository repository.IChapterLessonRepository, courseRepository repository.ICourseRepository, enrollmentRepository repository.IEnrollmentRepository, releaseService IReleaseService, messageSender IMessageSender, storageResolver *storage.Resolver, storageConfig config.StorageConfig) IAssignmentService {
+	return &assignmentService{
+		db:                      db,
+		assignmentRepository:    assignmentRepository,
+		submissionRepository:    submissionRepository,
+		chapterLessonRepository: chapterLessonRepository,
+		courseRepository:        courseRepository,
+		enrollmentRepository:    enrollmentRepository,
+		releaseService:          releaseService,
+		messageSender:           messageSender,
+		storageResolver:         storageResolver,
+		storageConfig:           storageConfig,
+	}
+}
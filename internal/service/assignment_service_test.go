@@ -0,0 +1,482 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/assignment"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	testAssignmentId = "2d4f6a8c-0e1b-4c3d-9e5f-7a9b1c3d5e7f"
+	testSubmissionId = "3e5a7b9d-1f2c-4d4e-8f6a-8b0c2d4e6f8a"
+)
+
+type assignmentFixture struct {
+	root        string
+	assignments *fake.AssignmentRepository
+	submissions *fake.AssignmentSubmissionRepository
+	lessons     *fake.ChapterLessonRepository
+	courses     *fake.CourseRepository
+	enrollments *fake.EnrollmentRepository
+	sender      *fake.MessageSender
+	rules       *fake.ReleaseRuleRepository
+}
+
+func newAssignmentFixture(t *testing.T) *assignmentFixture {
+	t.Helper()
+
+	f := &assignmentFixture{
+		root:        t.TempDir(),
+		assignments: fake.NewAssignmentRepository(),
+		submissions: fake.NewAssignmentSubmissionRepository(),
+		lessons:     fake.NewChapterLessonRepository(),
+		courses:     fake.NewCourseRepository(),
+		enrollments: fake.NewEnrollmentRepository(),
+		sender:      fake.NewMessageSender(),
+	}
+	f.rules = fake.NewReleaseRuleRepository(fake.NewCourseChapterRepository(), f.lessons)
+
+	owner := testUserId
+	f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: &owner})
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:       testLessonId,
+		CourseId: utils.StringToPtr(testCourseId),
+		Title:    "Assignment 1",
+		Kind:     utils.StringToPtr(entity.LessonKindAssignment),
+		Content:  utils.StringToPtr(`{"assignment":{}}`),
+	})
+
+	return f
+}
+
+func (f *assignmentFixture) service(t *testing.T, expect txExpectation) IAssignmentService {
+	t.Helper()
+
+	resolver, err := storage.NewResolver(f.root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	releaseService := NewReleaseService(f.courses, f.lessons, f.enrollments, f.rules, fake.NewLessonCompletionRepository(f.lessons), fake.NewQuizRepository(), fake.NewQuizAttemptRepository())
+
+	return NewAssignmentService(newMockDB(t, expect), f.assignments, f.submissions, f.lessons, f.courses, f.enrollments, releaseService, f.sender, resolver, testStorageConfig)
+}
+
+func (f *assignmentFixture) enroll() {
+	f.enrollments.AddEnrollment(entity.Enrollment{
+		Id:         "enrollment-1",
+		UserId:     testUserId,
+		CourseId:   testCourseId,
+		EnrolledAt: time.Now(),
+	})
+}
+
+// addAssignment menyimpan assignment dgn max score 100 tanpa due date, modify bisa mengubah field sebelum disimpan
+func (f *assignmentFixture) addAssignment(modify func(assignmentEntity *entity.Assignment)) {
+	assignmentEntity := entity.Assignment{
+		Id:        testAssignmentId,
+		LessonId:  testLessonId,
+		CourseId:  testCourseId,
+		Title:     "Essay",
+		MaxScore:  100,
+		CreatedAt: time.Now(),
+		CreatedBy: "Test User",
+	}
+	if modify != nil {
+		modify(&assignmentEntity)
+	}
+
+	f.assignments.AddAssignment(assignmentEntity)
+}
+
+// file submission hasil upload testUserId (submission_<user_id>_<unixnano>.<ext>)
+var (
+	testSubmissionFile      = "submission_" + testUserId + "_1.pdf"
+	testOtherSubmissionFile = "submission_" + testUserId + "_2.pdf"
+)
+
+// addSubmission menyimpan submission milik testUserId dgn file testSubmissionFile
+func (f *assignmentFixture) addSubmission(modify func(submission *entity.AssignmentSubmission)) {
+	submission := entity.AssignmentSubmission{
+		Id:           testSubmissionId,
+		AssignmentId: testAssignmentId,
+		CourseId:     testCourseId,
+		UserId:       testUserId,
+		UserName:     "Test User",
+		UserEmail:    "user@example.com",
+		FileNames:    []string{testSubmissionFile},
+		SubmittedAt:  time.Now().Add(-time.Hour),
+	}
+	if modify != nil {
+		modify(&submission)
+	}
+
+	f.submissions.AddSubmission(submission)
+}
+
+func (f *assignmentFixture) submissionFile(courseId string, fileName string) string {
+	return filepath.Join(f.root, courseId, storage.FolderSubmission, fileName)
+}
+
+func (f *assignmentFixture) writeSubmissionFile(t *testing.T, fileName string) {
+	t.Helper()
+
+	filePath := f.submissionFile(testCourseId, fileName)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filePath, []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAssignmentServiceCreateAssignment(t *testing.T) {
+	request := &assignment.CreateAssignmentRequest{
+		LessonId: testLessonId,
+		Settings: &assignment.AssignmentSettings{Title: "Essay", Instructions: "Write 500 words", MaxScore: 100},
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(f *assignmentFixture)
+		ctx      context.Context
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "lesson is not an assignment lesson",
+			setup: func(f *assignmentFixture) {
+				f.lessons.AddChapterLesson(entity.ChapterLesson{Id: testLessonId, CourseId: utils.StringToPtr(testCourseId), Kind: utils.StringToPtr(entity.LessonKindQuiz)})
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "course of another instructor",
+			setup: func(f *assignmentFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr("someone-else")})
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "lesson already has an assignment",
+			setup:    func(f *assignmentFixture) { f.addAssignment(nil) },
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "success",
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAssignmentFixture(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, tt.tx).CreateAssignment(tt.ctx, request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, ok := f.assignments.Assignment(res.Id)
+			if !ok || stored.CourseId != testCourseId || stored.MaxScore != 100 || stored.DueAt != nil {
+				t.Errorf("unexpected assignment: %+v", stored)
+			}
+		})
+	}
+}
+
+func TestAssignmentServiceSubmitAssignment(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		setup     func(t *testing.T, f *assignmentFixture)
+		ctx       context.Context
+		fileNames []string
+		tx        txExpectation
+		wantCode  codes.Code
+		wantLate  bool
+		wantKept  string //? file submission lama yang harus tetap ada
+	}{
+		{
+			name:     "not enrolled",
+			setup:    func(t *testing.T, f *assignmentFixture) { f.enrollments = fake.NewEnrollmentRepository() },
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:      "file not uploaded",
+			ctx:       contextUser,
+			fileNames: []string{testOtherSubmissionFile, "submission_" + testUserId + "_3.pdf"},
+			tx:        txNone,
+			wantCode:  codes.FailedPrecondition,
+		},
+		{
+			name:      "file name with path",
+			ctx:       contextUser,
+			fileNames: []string{"../lesson/lesson_1.mp4"},
+			tx:        txNone,
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "file uploaded by other user",
+			setup: func(t *testing.T, f *assignmentFixture) {
+				f.writeSubmissionFile(t, "submission_"+testOtherUserId+"_1.pdf")
+			},
+			ctx:       contextUser,
+			fileNames: []string{"submission_" + testOtherUserId + "_1.pdf"},
+			tx:        txNone,
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			name: "file used by other submission",
+			setup: func(t *testing.T, f *assignmentFixture) {
+				f.addSubmission(func(s *entity.AssignmentSubmission) {
+					s.Id = "submission-other"
+					s.AssignmentId = "assignment-other"
+					s.FileNames = []string{testOtherSubmissionFile}
+				})
+			},
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "due date passed",
+			setup: func(t *testing.T, f *assignmentFixture) {
+				f.addAssignment(func(a *entity.Assignment) { a.DueAt = &past })
+			},
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "late submission allowed and flagged",
+			setup: func(t *testing.T, f *assignmentFixture) {
+				f.addAssignment(func(a *entity.Assignment) {
+					a.DueAt = &past
+					a.AllowLateSubmissions = true
+				})
+			},
+			ctx:      contextUser,
+			tx:       txCommit,
+			wantCode: codes.OK,
+			wantLate: true,
+		},
+		{
+			name: "already graded",
+			setup: func(t *testing.T, f *assignmentFixture) {
+				f.addSubmission(func(s *entity.AssignmentSubmission) { s.GradedAt = &past })
+			},
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "resubmit replaces old files",
+			setup: func(t *testing.T, f *assignmentFixture) {
+				f.writeSubmissionFile(t, testSubmissionFile)
+				f.addSubmission(nil)
+			},
+			ctx:      contextUser,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+		{
+			name: "resubmit keeps files without owner",
+			setup: func(t *testing.T, f *assignmentFixture) {
+				f.writeSubmissionFile(t, "submission_1.pdf")
+				f.addSubmission(func(s *entity.AssignmentSubmission) { s.FileNames = []string{"submission_1.pdf"} })
+			},
+			ctx:      contextUser,
+			tx:       txCommit,
+			wantCode: codes.OK,
+			wantKept: "submission_1.pdf",
+		},
+		{
+			name:     "success",
+			ctx:      contextUser,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAssignmentFixture(t)
+			f.enroll()
+			f.addAssignment(nil)
+			f.writeSubmissionFile(t, testOtherSubmissionFile)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			fileNames := tt.fileNames
+			if fileNames == nil {
+				fileNames = []string{testOtherSubmissionFile}
+			}
+
+			res, err := f.service(t, tt.tx).SubmitAssignment(tt.ctx, &assignment.SubmitAssignmentRequest{
+				AssignmentId: testAssignmentId,
+				FileNames:    fileNames,
+			})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.Late != tt.wantLate {
+				t.Errorf("late = %v, want %v", res.Late, tt.wantLate)
+			}
+			stored, ok := f.submissions.Submission(res.Id)
+			if !ok || stored.Late != tt.wantLate || stored.UserEmail != "user@example.com" || len(stored.FileNames) != 1 || stored.FileNames[0] != testOtherSubmissionFile {
+				t.Errorf("unexpected submission: %+v", stored)
+			}
+			if _, err := os.Stat(f.submissionFile(testCourseId, testSubmissionFile)); !os.IsNotExist(err) {
+				t.Errorf("replaced submission file still exists (err: %v)", err)
+			}
+			if tt.wantKept != "" && !fileExists(t, f.submissionFile(testCourseId, tt.wantKept)) {
+				t.Errorf("%s removed, want kept", tt.wantKept)
+			}
+		})
+	}
+}
+
+func TestAssignmentServiceGradeAssignmentSubmission(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *assignmentFixture)
+		ctx      context.Context
+		score    int64
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			score:    80,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "course of another instructor",
+			setup: func(f *assignmentFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr("someone-else")})
+			},
+			ctx:      contextInstructor,
+			score:    80,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "score above max score",
+			ctx:      contextInstructor,
+			score:    101,
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "success",
+			ctx:      contextInstructor,
+			score:    80,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAssignmentFixture(t)
+			f.addAssignment(nil)
+			f.addSubmission(nil)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, tt.tx).GradeAssignmentSubmission(tt.ctx, &assignment.GradeAssignmentSubmissionRequest{
+				Id:       testSubmissionId,
+				Score:    tt.score,
+				Feedback: utils.StringToPtr("Good <work>"),
+			})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				if messages := f.sender.Messages(); len(messages) != 0 {
+					t.Errorf("unexpected notification: %+v", messages)
+				}
+				return
+			}
+
+			if res.Submission.Score == nil || *res.Submission.Score != 80 || res.Submission.GradedAt == nil {
+				t.Errorf("unexpected summary: %+v", res.Submission)
+			}
+			stored, _ := f.submissions.Submission(testSubmissionId)
+			if stored.Score == nil || *stored.Score != 80 || stored.GradedBy == nil || *stored.GradedBy != "Test User" {
+				t.Errorf("unexpected submission: %+v", stored)
+			}
+
+			message, ok := f.sender.WaitMessage(time.Second)
+			if !ok {
+				t.Fatal("graded notification not sent")
+			}
+			if message.To != "user@example.com" || !strings.Contains(message.Body, "80 / 100") || !strings.Contains(message.Body, "Good &lt;work&gt;") {
+				t.Errorf("unexpected notification: %+v", message)
+			}
+		})
+	}
+}
+
+func TestAssignmentServiceDetailAssignmentSubmission(t *testing.T) {
+	f := newAssignmentFixture(t)
+	f.enroll()
+	f.addAssignment(nil)
+	f.addSubmission(nil)
+
+	learner, err := f.service(t, txNone).DetailAssignmentSubmission(contextUser, &assignment.DetailAssignmentSubmissionRequest{Id: testSubmissionId})
+	assertCode(t, err, codes.OK)
+	if len(learner.Files) != 1 || !strings.HasPrefix(learner.Files[0].Url, testStorageConfig.ServiceURL+"/"+testCourseId+"/submission/"+testSubmissionFile+"?") {
+		t.Errorf("unexpected files: %+v", learner.Files)
+	}
+
+	_, err = f.service(t, txNone).DetailAssignmentSubmission(contextInstructor, &assignment.DetailAssignmentSubmissionRequest{Id: testSubmissionId})
+	assertCode(t, err, codes.OK)
+
+	//? submission learner lain tidak terlihat
+	f.addSubmission(func(s *entity.AssignmentSubmission) { s.UserId = "someone-else" })
+	_, err = f.service(t, txNone).DetailAssignmentSubmission(contextUser, &assignment.DetailAssignmentSubmissionRequest{Id: testSubmissionId})
+	assertCode(t, err, codes.NotFound)
+
+	list, err := f.service(t, txNone).ListAssignmentSubmissions(contextUser, &assignment.ListAssignmentSubmissionsRequest{AssignmentId: testAssignmentId})
+	assertCode(t, err, codes.OK)
+	if len(list.Items) != 0 {
+		t.Errorf("learner sees %d submissions of others", len(list.Items))
+	}
+}
@@ -4,20 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/config"
 	"github.com/abu-umair/be-lms-go/internal/entity"
 	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/logger"
 	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/tracing"
 	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/abu-umair/be-lms-go/pb/auth"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	gocache "github.com/patrickmn/go-cache"
 	"golang.org/x/crypto/bcrypt"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -35,14 +36,13 @@ type authService struct {
 	authRepository repository.IAuthRepository
 	cacheService   *gocache.Cache // Bisa digunakan nanti saat pindah ke Redis
 	messageSender  IMessageSender // Untuk fleksibilitas Email/WA
+	jwtConfig      config.JWTConfig
 }
 
 func (as *authService) Register(ctx context.Context, request *auth.RegisterRequest) (*auth.RegisterResponse, error) {
 	//? apakah password sama dengan confirm password
 	if request.Password != request.PasswordConfirmation {
-		return &auth.RegisterResponse{
-			Base: utils.BadRequestResponse("Password is not matched"),
-		}, nil
+		return nil, apperror.InvalidArgument("Password is not matched").WithFieldViolation("password_confirmation", "Password is not matched")
 	}
 
 	//? ngecek email ke DB
@@ -54,13 +54,11 @@ func (as *authService) Register(ctx context.Context, request *auth.RegisterReque
 
 	//* jika emal sudah terdaftar/ada, di error in
 	if user != nil {
-		return &auth.RegisterResponse{
-			Base: utils.BadRequestResponse("User already exist"),
-		}, nil
+		return nil, apperror.AlreadyExists("User already exist")
 	}
 
 	//? Hash password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(request.Password), 10)
+	hashedPassword, err := hashPassword(ctx, request.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -94,16 +92,14 @@ func (as *authService) Login(ctx context.Context, request *auth.LoginRequest) (*
 	}
 
 	if user == nil {
-		return &auth.LoginResponse{
-			Base: utils.BadRequestResponse("User is not registered"),
-		}, nil
+		return nil, apperror.NotFound("User is not registered")
 	}
 
 	//* check apakah password sama dengan password di database
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password)) //? mengecek password yang hash dengan password yang diinput/request
+	err = comparePassword(ctx, user.Password, request.Password) //? mengecek password yang hash dengan password yang diinput/request
 	if err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) { //? jika password tidak sama
-			return nil, status.Errorf(codes.Unauthenticated, "Unauthenticated") //?kembalikan error unauthenticated dari middleware (gRPC)
+			return nil, utils.UnauthenticatedResponse() //?kembalikan error unauthenticated dari middleware (gRPC)
 		}
 		return nil, err
 	}
@@ -117,7 +113,7 @@ func (as *authService) Login(ctx context.Context, request *auth.LoginRequest) (*
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtentity.JwtClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   user.Id,
-			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour * 244)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(as.jwtConfig.TTL)),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
 		Email:      user.Email,
@@ -125,8 +121,7 @@ func (as *authService) Login(ctx context.Context, request *auth.LoginRequest) (*
 		Role:       user.RoleCode,
 		VerifiedAt: verifiedStr,
 	})
-	secretKey := os.Getenv("JWT_SECRET")
-	accessToken, err := token.SignedString([]byte(secretKey))
+	accessToken, err := token.SignedString([]byte(as.jwtConfig.Secret))
 	if err != nil {
 		return nil, err
 	}
@@ -166,9 +161,7 @@ func (as *authService) Logout(ctx context.Context, request *auth.LogoutRequest)
 func (as *authService) ChangePassword(ctx context.Context, request *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error) {
 	//*Cek apakah new pass confirmation matched
 	if request.NewPassword != request.NewPasswordConfirmation {
-		return &auth.ChangePasswordResponse{
-			Base: utils.BadRequestResponse("New password is not matched"),
-		}, nil
+		return nil, apperror.InvalidArgument("New password is not matched").WithFieldViolation("new_password_confirmation", "New password is not matched")
 	}
 
 	//* Cek apakah old password sama
@@ -177,7 +170,7 @@ func (as *authService) ChangePassword(ctx context.Context, request *auth.ChangeP
 		return nil, err
 	}
 
-	claims, err := jwtentity.GetClaimsFromToken(jwtToken)
+	claims, err := jwtentity.GetClaimsFromToken(jwtToken, as.jwtConfig.Secret)
 	if err != nil {
 		return nil, err
 	}
@@ -187,23 +180,19 @@ func (as *authService) ChangePassword(ctx context.Context, request *auth.ChangeP
 		return nil, err
 	}
 	if user == nil {
-		return &auth.ChangePasswordResponse{
-			Base: utils.BadRequestResponse("User does not exist"),
-		}, nil
+		return nil, apperror.NotFound("User does not exist")
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.OldPassword))
+	err = comparePassword(ctx, user.Password, request.OldPassword)
 	if err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			return &auth.ChangePasswordResponse{
-				Base: utils.BadRequestResponse("Old password is not matched"),
-			}, nil
+			return nil, apperror.InvalidArgument("Old password is not matched").WithFieldViolation("old_password", "Old password is not matched")
 		}
 		return nil, err
 	}
 
 	//* Update new password ke DB
-	hashedNewPassword, err := bcrypt.GenerateFromPassword([]byte(request.NewPassword), 10)
+	hashedNewPassword, err := hashPassword(ctx, request.NewPassword)
 	if err != nil {
 		return nil, err
 	}
@@ -234,9 +223,7 @@ func (as *authService) GetProfile(ctx context.Context, request *auth.GetProfileR
 	}
 
 	if user == nil {
-		return &auth.GetProfileResponse{
-			Base: utils.BadRequestResponse("User doesn't exist"),
-		}, nil
+		return nil, apperror.NotFound("User doesn't exist")
 	}
 
 	//* Buat/Kirim Response
@@ -272,9 +259,7 @@ func (as *authService) RequestOTP(ctx context.Context, request *auth.RequestOTPR
 		if time.Since(lastOTP.CreatedAt).Seconds() < 60 {
 			remaining := 60 - int(time.Since(lastOTP.CreatedAt).Seconds())
 
-			return &auth.RequestOTPResponse{
-				Base: utils.BadRequestResponse(fmt.Sprintf("Silakan tunggu %d detik lagi untuk meminta kode baru", remaining)),
-			}, nil
+			return nil, apperror.ResourceExhausted(fmt.Sprintf("Silakan tunggu %d detik lagi untuk meminta kode baru", remaining))
 		}
 	}
 
@@ -293,11 +278,12 @@ func (as *authService) RequestOTP(ctx context.Context, request *auth.RequestOTPR
 	//* Kirim via Email (lewat interface)
 	subject := "Kode Verifikasi OTP Anda"
 	htmlBody := utils.GetOTPEmailTemplate(code)
+	requestLogger := logger.FromContext(ctx) //? diambil sebelum goroutine agar tetap membawa request_id
+	sendCtx := context.WithoutCancel(ctx)    //? tetap satu trace dgn request, tapi tidak ikut batal saat response sudah dikirim
 	go func() {
-		errSend := as.messageSender.Send(claims.Email, subject, htmlBody)
+		errSend := as.messageSender.Send(sendCtx, claims.Email, subject, htmlBody)
 		if errSend != nil {
-			// Log error kirim email di sini (misal pakai logrus/zap)
-			fmt.Printf("Error sending email to %s: %v\n", claims.Email, errSend)
+			requestLogger.Error("failed to send otp email", "email", claims.Email, "error", errSend)
 		}
 	}()
 
@@ -316,23 +302,17 @@ func (as *authService) Verify(ctx context.Context, request *auth.VerifyRequest)
 	// * Get OTP From repo
 	otpData, err := as.authRepository.GetOTPByEmail(ctx, claims.Email)
 	if err != nil {
-		return &auth.VerifyResponse{
-			Base: utils.BadRequestResponse("OTP tidak ditemukan atau kadaluarsa"),
-		}, nil
+		return nil, apperror.NotFound("OTP tidak ditemukan atau kadaluarsa")
 	}
 
 	//* Cek Expiry
 	if time.Now().After(otpData.ExpiredAt) {
-		return &auth.VerifyResponse{
-			Base: utils.BadRequestResponse("OTP sudah kadaluarsa"),
-		}, nil
+		return nil, apperror.FailedPrecondition("OTP sudah kadaluarsa")
 	}
 
 	//* Cek Kecocokan Kode
 	if otpData.OTPCode != request.CodeOtp {
-		return &auth.VerifyResponse{
-			Base: utils.BadRequestResponse("kode OTP salah"),
-		}, nil
+		return nil, apperror.InvalidArgument("kode OTP salah").WithFieldViolation("code_otp", "kode OTP salah")
 	}
 
 	//* Update User jadi Verified
@@ -342,17 +322,13 @@ func (as *authService) Verify(ctx context.Context, request *auth.VerifyRequest)
 	}
 
 	if user == nil {
-		return &auth.VerifyResponse{
-			Base: utils.BadRequestResponse("User doesn't exist"),
-		}, nil
+		return nil, apperror.NotFound("User doesn't exist")
 	}
 
 	//* VerifyEmail
 	//? jika sudah verifikasi, kembalikan error
 	if user.VerifiedAt != nil {
-		return &auth.VerifyResponse{
-			Base: utils.BadRequestResponse("Email already verified"),
-		}, nil
+		return nil, apperror.FailedPrecondition("Email already verified")
 	}
 
 	//? jika belum verifikasi, update verified_at
@@ -370,10 +346,26 @@ func (as *authService) Verify(ctx context.Context, request *auth.VerifyRequest)
 	}, nil
 }
 
-func NewAuthService(authRepository repository.IAuthRepository, cacheService *gocache.Cache, sender IMessageSender) IAuthService {
+// hashPassword & comparePassword dibungkus span karena bcrypt sengaja lambat (terlihat jelas di trace)
+func hashPassword(ctx context.Context, password string) ([]byte, error) {
+	_, span := tracing.StartSpan(ctx, "bcrypt.GenerateFromPassword")
+	defer span.End()
+
+	return bcrypt.GenerateFromPassword([]byte(password), 10)
+}
+
+func comparePassword(ctx context.Context, hashedPassword string, password string) error {
+	_, span := tracing.StartSpan(ctx, "bcrypt.CompareHashAndPassword")
+	defer span.End()
+
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+}
+
+func NewAuthService(authRepository repository.IAuthRepository, cacheService *gocache.Cache, sender IMessageSender, jwtConfig config.JWTConfig) IAuthService {
 	return &authService{
 		authRepository: authRepository,
 		cacheService:   cacheService,
 		messageSender:  sender,
+		jwtConfig:      jwtConfig,
 	}
 }
@@ -0,0 +1,620 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/pb/auth"
+	gocache "github.com/patrickmn/go-cache"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+)
+
+const testPassword = "secret123"
+
+var errDatabase = errors.New("database is down")
+
+// otpDigitPattern: template email menampilkan tiap angka OTP di <div class="otp-digit">
+var otpDigitPattern = regexp.MustCompile(`<div class="otp-digit">(\d)</div>`)
+
+func otpCodeFromBody(body string) string {
+	var code strings.Builder
+	for _, match := range otpDigitPattern.FindAllStringSubmatch(body, -1) {
+		code.WriteString(match[1])
+	}
+
+	return code.String()
+}
+
+type authFixture struct {
+	repo    *fake.AuthRepository
+	sender  *fake.MessageSender
+	cache   *gocache.Cache
+	service IAuthService
+}
+
+func newAuthFixture(t *testing.T) *authFixture {
+	t.Helper()
+
+	f := &authFixture{
+		repo:   fake.NewAuthRepository(),
+		sender: fake.NewMessageSender(),
+		cache:  gocache.New(time.Hour, time.Hour),
+	}
+	f.service = NewAuthService(f.repo, f.cache, f.sender, testJwtConfig)
+
+	return f
+}
+
+// addUser menyimpan user "user@example.com" (sama dgn claims di newTestClaims) dgn password testPassword
+func (f *authFixture) addUser(t *testing.T, verifiedAt *time.Time) entity.Users {
+	t.Helper()
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := entity.Users{
+		Id:         testUserId,
+		FullName:   "Test User",
+		Email:      "user@example.com",
+		Password:   string(hashed),
+		RoleCode:   entity.UserRoleUser,
+		VerifiedAt: verifiedAt,
+		CreatedAt:  time.Now().Add(-24 * time.Hour),
+	}
+	f.repo.AddUser(user)
+
+	return user
+}
+
+func TestAuthServiceRegister(t *testing.T) {
+	validRequest := func() *auth.RegisterRequest {
+		return &auth.RegisterRequest{
+			FullName:             "New User",
+			Email:                "new@example.com",
+			Password:             testPassword,
+			PasswordConfirmation: testPassword,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *authFixture)
+		request  func() *auth.RegisterRequest
+		wantCode codes.Code
+	}{
+		{
+			name: "password confirmation mismatch",
+			request: func() *auth.RegisterRequest {
+				r := validRequest()
+				r.PasswordConfirmation = "different"
+				return r
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "email already registered",
+			setup: func(t *testing.T, f *authFixture) {
+				f.repo.AddUser(entity.Users{Id: "existing", Email: "new@example.com"})
+			},
+			request:  validRequest,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "repository read error",
+			setup:    func(t *testing.T, f *authFixture) { f.repo.ReadErr = errDatabase },
+			request:  validRequest,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "repository write error",
+			setup:    func(t *testing.T, f *authFixture) { f.repo.WriteErr = errDatabase },
+			request:  validRequest,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			request:  validRequest,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service.Register(context.Background(), tt.request())
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.Base.StatusCode != 200 {
+				t.Errorf("status_code = %d, want 200", res.Base.StatusCode)
+			}
+			user, ok := f.repo.User("new@example.com")
+			if !ok {
+				t.Fatal("user not stored")
+			}
+			if user.RoleCode != entity.UserRoleUser {
+				t.Errorf("role = %q, want %q", user.RoleCode, entity.UserRoleUser)
+			}
+			if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(testPassword)); err != nil {
+				t.Errorf("password is not hashed with bcrypt: %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthServiceLogin(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *authFixture)
+		request  *auth.LoginRequest
+		wantCode codes.Code
+	}{
+		{
+			name:     "user not registered",
+			request:  &auth.LoginRequest{Email: "user@example.com", Password: testPassword},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "wrong password",
+			setup:    func(t *testing.T, f *authFixture) { f.addUser(t, nil) },
+			request:  &auth.LoginRequest{Email: "user@example.com", Password: "wrong"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "repository error",
+			setup:    func(t *testing.T, f *authFixture) { f.repo.ReadErr = errDatabase },
+			request:  &auth.LoginRequest{Email: "user@example.com", Password: testPassword},
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			setup:    func(t *testing.T, f *authFixture) { f.addUser(t, nil) },
+			request:  &auth.LoginRequest{Email: "user@example.com", Password: testPassword},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service.Login(context.Background(), tt.request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			claims, err := jwtentity.GetClaimsFromToken(res.AccessToken, testJwtSecret)
+			if err != nil {
+				t.Fatalf("access token is not valid: %v", err)
+			}
+			if claims.Subject != testUserId || claims.Email != "user@example.com" || claims.Role != entity.UserRoleUser {
+				t.Errorf("unexpected claims: %+v", claims)
+			}
+		})
+	}
+}
+
+func TestAuthServiceLogout(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      func(t *testing.T) context.Context
+		wantCode codes.Code
+	}{
+		{
+			name:     "without token",
+			ctx:      func(t *testing.T) context.Context { return contextUser },
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "success",
+			ctx: func(t *testing.T) context.Context {
+				return contextWithToken(t, newTestClaims(entity.UserRoleUser), testJwtSecret)
+			},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			ctx := tt.ctx(t)
+
+			_, err := f.service.Logout(ctx, &auth.LogoutRequest{})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			//? token masuk ke cache sehingga ditolak auth middleware
+			token, err := jwtentity.ParseTokenFromContext(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := f.cache.Get(token); !ok {
+				t.Error("token is not blacklisted after logout")
+			}
+		})
+	}
+}
+
+func TestAuthServiceChangePassword(t *testing.T) {
+	validRequest := func() *auth.ChangePasswordRequest {
+		return &auth.ChangePasswordRequest{
+			OldPassword:             testPassword,
+			NewPassword:             "newsecret123",
+			NewPasswordConfirmation: "newsecret123",
+		}
+	}
+	withToken := func(t *testing.T) context.Context {
+		return contextWithToken(t, newTestClaims(entity.UserRoleUser), testJwtSecret)
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *authFixture)
+		ctx      func(t *testing.T) context.Context
+		request  func() *auth.ChangePasswordRequest
+		wantCode codes.Code
+	}{
+		{
+			name: "new password confirmation mismatch",
+			ctx:  withToken,
+			request: func() *auth.ChangePasswordRequest {
+				r := validRequest()
+				r.NewPasswordConfirmation = "different"
+				return r
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "without token",
+			ctx:      func(t *testing.T) context.Context { return contextUser },
+			request:  validRequest,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "token signed with another secret",
+			ctx: func(t *testing.T) context.Context {
+				return contextWithToken(t, newTestClaims(entity.UserRoleUser), "another-secret")
+			},
+			request:  validRequest,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user does not exist",
+			ctx:      withToken,
+			request:  validRequest,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "old password is wrong",
+			setup: func(t *testing.T, f *authFixture) { f.addUser(t, nil) },
+			ctx:   withToken,
+			request: func() *auth.ChangePasswordRequest {
+				r := validRequest()
+				r.OldPassword = "wrong"
+				return r
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "repository write error",
+			setup: func(t *testing.T, f *authFixture) {
+				f.addUser(t, nil)
+				f.repo.WriteErr = errDatabase
+			},
+			ctx:      withToken,
+			request:  validRequest,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			setup:    func(t *testing.T, f *authFixture) { f.addUser(t, nil) },
+			ctx:      withToken,
+			request:  validRequest,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			_, err := f.service.ChangePassword(tt.ctx(t), tt.request())
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			user, _ := f.repo.User("user@example.com")
+			if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte("newsecret123")); err != nil {
+				t.Errorf("password is not updated: %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthServiceGetProfile(t *testing.T) {
+	verifiedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, f *authFixture)
+		ctx          context.Context
+		wantCode     codes.Code
+		wantVerified bool
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user does not exist",
+			ctx:      contextUser,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "repository error",
+			setup:    func(t *testing.T, f *authFixture) { f.repo.ReadErr = errDatabase },
+			ctx:      contextUser,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "not verified",
+			setup:    func(t *testing.T, f *authFixture) { f.addUser(t, nil) },
+			ctx:      contextUser,
+			wantCode: codes.OK,
+		},
+		{
+			name:         "verified",
+			setup:        func(t *testing.T, f *authFixture) { f.addUser(t, &verifiedAt) },
+			ctx:          contextUser,
+			wantCode:     codes.OK,
+			wantVerified: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service.GetProfile(tt.ctx, &auth.GetProfileRequest{})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.UserId != testUserId || res.Email != "user@example.com" {
+				t.Errorf("unexpected profile: %+v", res)
+			}
+			if (res.VerifiedAt != nil) != tt.wantVerified {
+				t.Errorf("verified_at = %v, want verified %v", res.VerifiedAt, tt.wantVerified)
+			}
+			if res.MemberSince == nil {
+				t.Error("member_since is empty")
+			}
+		})
+	}
+}
+
+func TestAuthServiceRequestOTP(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *authFixture)
+		ctx      context.Context
+		wantCode codes.Code
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "rate limited within 60 seconds",
+			setup: func(t *testing.T, f *authFixture) {
+				f.repo.UpsertOTP(context.Background(), &entity.UserOTP{
+					Email:     "user@example.com",
+					OTPCode:   "111111",
+					ExpiredAt: time.Now().Add(5 * time.Minute),
+					CreatedAt: time.Now().Add(-10 * time.Second),
+				})
+			},
+			ctx:      contextUser,
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name: "previous otp older than 60 seconds",
+			setup: func(t *testing.T, f *authFixture) {
+				f.repo.UpsertOTP(context.Background(), &entity.UserOTP{
+					Email:     "user@example.com",
+					OTPCode:   "111111",
+					ExpiredAt: time.Now().Add(3 * time.Minute),
+					CreatedAt: time.Now().Add(-2 * time.Minute),
+				})
+			},
+			ctx:      contextUser,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "first request",
+			ctx:      contextUser,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "sender error does not fail the request",
+			setup:    func(t *testing.T, f *authFixture) { f.sender.Err = errors.New("smtp is down") },
+			ctx:      contextUser,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			_, err := f.service.RequestOTP(tt.ctx, &auth.RequestOTPRequest{})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				if messages := f.sender.Messages(); len(messages) != 0 {
+					t.Errorf("sent %d messages, want none", len(messages))
+				}
+				return
+			}
+
+			otp, ok := f.repo.OTP("user@example.com")
+			if !ok {
+				t.Fatal("otp not stored")
+			}
+			if len(otp.OTPCode) != 6 || otp.OTPCode == "111111" {
+				t.Errorf("otp code = %q, want a new 6 digit code", otp.OTPCode)
+			}
+			if time.Until(otp.ExpiredAt) < 4*time.Minute {
+				t.Errorf("otp expires at %v, want ~5 minutes from now", otp.ExpiredAt)
+			}
+
+			message, ok := f.sender.WaitMessage(time.Second)
+			if !ok {
+				t.Fatal("otp email was not sent")
+			}
+			if message.To != "user@example.com" {
+				t.Errorf("message sent to %q, want user@example.com", message.To)
+			}
+			if code := otpCodeFromBody(message.Body); code != otp.OTPCode {
+				t.Errorf("code in email = %q, want %q", code, otp.OTPCode)
+			}
+		})
+	}
+}
+
+func TestAuthServiceVerify(t *testing.T) {
+	verifiedAt := time.Now().Add(-time.Hour)
+	addOTP := func(f *authFixture, code string, expiredAt time.Time) {
+		f.repo.UpsertOTP(context.Background(), &entity.UserOTP{
+			Email:     "user@example.com",
+			OTPCode:   code,
+			ExpiredAt: expiredAt,
+			CreatedAt: time.Now().Add(-time.Minute),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *authFixture)
+		ctx      context.Context
+		code     string
+		wantCode codes.Code
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			code:     "123456",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "otp not requested",
+			ctx:      contextUser,
+			code:     "123456",
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "otp expired",
+			setup: func(t *testing.T, f *authFixture) {
+				f.addUser(t, nil)
+				addOTP(f, "123456", time.Now().Add(-time.Second))
+			},
+			ctx:      contextUser,
+			code:     "123456",
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "wrong code",
+			setup: func(t *testing.T, f *authFixture) {
+				f.addUser(t, nil)
+				addOTP(f, "123456", time.Now().Add(time.Minute))
+			},
+			ctx:      contextUser,
+			code:     "654321",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "user does not exist",
+			setup:    func(t *testing.T, f *authFixture) { addOTP(f, "123456", time.Now().Add(time.Minute)) },
+			ctx:      contextUser,
+			code:     "123456",
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "already verified",
+			setup: func(t *testing.T, f *authFixture) {
+				f.addUser(t, &verifiedAt)
+				addOTP(f, "123456", time.Now().Add(time.Minute))
+			},
+			ctx:      contextUser,
+			code:     "123456",
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "success",
+			setup: func(t *testing.T, f *authFixture) {
+				f.addUser(t, nil)
+				addOTP(f, "123456", time.Now().Add(time.Minute))
+			},
+			ctx:      contextUser,
+			code:     "123456",
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			_, err := f.service.Verify(tt.ctx, &auth.VerifyRequest{CodeOtp: tt.code})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			user, _ := f.repo.User("user@example.com")
+			if user.VerifiedAt == nil {
+				t.Error("user is not marked as verified")
+			}
+			if _, ok := f.repo.OTP("user@example.com"); ok {
+				t.Error("otp is not deleted after verify")
+			}
+		})
+	}
+}
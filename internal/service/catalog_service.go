@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/catalog"
+)
+
+// publicStorageUserId: uid di signed URL file lesson preview (pengunjung tanpa akun)
+const publicStorageUserId = "public"
+
+// ICatalogService: katalog publik tanpa token, tidak ada claims sehingga semua aturan akses dicek dari data
+type ICatalogService interface {
+	GetPublicCourse(ctx context.Context, request *catalog.GetPublicCourseRequest) (*catalog.GetPublicCourseResponse, error)
+	GetPreviewLesson(ctx context.Context, request *catalog.GetPreviewLessonRequest) (*catalog.GetPreviewLessonResponse, error)
+}
+
+type catalogService struct {
+	courseRepository        repository.ICourseRepository
+	courseChapterRepository repository.ICourseChapterRepository
+	chapterLessonRepository repository.IChapterLessonRepository
+	storageConfig           config.StorageConfig
+}
+
+func (cs *catalogService) GetPublicCourse(ctx context.Context, request *catalog.GetPublicCourseRequest) (*catalog.GetPublicCourseResponse, error) {
+	courseEntity, err := cs.publishedCourse(ctx, request.Id, "Course not found")
+	if err != nil {
+		return nil, err
+	}
+
+	status := entity.ChapterStatusActive
+	courseChapters, err := cs.courseChapterRepository.GetCourseChaptersByCourseId(ctx, courseEntity.Id, &status, []string{"id", "title", "order_chapter"})
+	if err != nil {
+		return nil, err
+	}
+
+	lessons, err := cs.chapterLessonRepository.GetCurriculumLessonsByCourseId(ctx, courseEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	//* hanya lesson preview yang aktif, dikelompokkan per chapter (urutan order_lesson dari repository)
+	previewLessons := make(map[string][]*catalog.PublicLesson, len(courseChapters))
+	for _, lesson := range lessons {
+		if !isPublicPreviewLesson(lesson) || lesson.ChapterId == nil {
+			continue
+		}
+
+		previewLessons[*lesson.ChapterId] = append(previewLessons[*lesson.ChapterId], &catalog.PublicLesson{
+			Id:          lesson.Id,
+			Title:       lesson.Title,
+			OrderLesson: lesson.OrderLesson,
+			Kind:        utils.PtrStringToPtr(lesson.Kind),
+			Duration:    utils.PtrStringToPtr(lesson.Duration),
+		})
+	}
+
+	chapters := make([]*catalog.PublicChapter, 0, len(courseChapters))
+	for _, courseChapter := range courseChapters {
+		chapters = append(chapters, &catalog.PublicChapter{
+			Id:             courseChapter.Id,
+			Title:          courseChapter.Title,
+			OrderChapter:   courseChapter.OrderChapter,
+			PreviewLessons: previewLessons[courseChapter.Id],
+		})
+	}
+
+	// *success
+	res := &catalog.GetPublicCourseResponse{
+		Base:             utils.SuccessResponse("Public Course Detail Success"),
+		Id:               courseEntity.Id,
+		Name:             courseEntity.Name,
+		Slug:             utils.PtrStringToPtr(courseEntity.Slug),
+		CategoryId:       utils.PtrStringToPtr(courseEntity.CategoryId),
+		CourseType:       utils.PtrStringToPtr(courseEntity.CourseType),
+		SeoDescription:   utils.PtrStringToPtr(courseEntity.SeoDescription),
+		Duration:         utils.PtrStringToPtr(courseEntity.Duration),
+		Thumbnail:        utils.PtrStringToPtr(courseEntity.Thumbnail),
+		DemoVideoStorage: utils.PtrStringToPtr(courseEntity.DemoVideoStorage),
+		DemoVideoSource:  utils.PtrStringToPtr(courseEntity.DemoVideoSource),
+		Description:      utils.PtrStringToPtr(courseEntity.Description),
+		Price:            utils.PtrDecimalToPtr(courseEntity.Price),
+		Discount:         utils.PtrDecimalToPtr(courseEntity.Discount),
+		Certificate:      utils.PtrStringToPtr(courseEntity.Certificate),
+		CourseLevelId:    utils.PtrStringToPtr(courseEntity.CourseLevelId),
+		CourseLanguageId: utils.PtrStringToPtr(courseEntity.CourseLanguageId),
+		Chapters:         chapters,
+	}
+
+	//? cover course memang public (tanpa signed URL)
+	if courseEntity.ImageFileName != "" {
+		imageUrl := fmt.Sprintf("%s/%s/course/%s", cs.storageConfig.ServiceURL, courseEntity.Id, courseEntity.ImageFileName)
+		res.ImageUrl = &imageUrl
+	}
+
+	return res, nil
+}
+
+func (cs *catalogService) GetPreviewLesson(ctx context.Context, request *catalog.GetPreviewLessonRequest) (*catalog.GetPreviewLessonResponse, error) {
+	//? lesson yang bukan preview / belum publish dianggap tidak ada (tidak membocorkan keberadaannya)
+	lesson, err := cs.chapterLessonRepository.GetChapterLessonById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if lesson == nil || !isPublicPreviewLesson(lesson) || lesson.CourseId == nil || lesson.ChapterId == nil {
+		return nil, apperror.NotFound("Preview lesson not found")
+	}
+
+	courseChapter, err := cs.courseChapterRepository.GetCourseChapterById(ctx, *lesson.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+	if courseChapter == nil || courseChapter.Status != entity.ChapterStatusActive {
+		return nil, apperror.NotFound("Preview lesson not found")
+	}
+
+	_, err = cs.publishedCourse(ctx, *lesson.CourseId, "Preview lesson not found")
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := parseLessonContent(lesson.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	res := &catalog.GetPreviewLessonResponse{
+		Base:          utils.SuccessResponse("Preview Lesson Detail Success"),
+		Id:            lesson.Id,
+		CourseId:      *lesson.CourseId,
+		ChapterId:     utils.PtrStringToPtr(lesson.ChapterId),
+		Title:         lesson.Title,
+		OrderLesson:   lesson.OrderLesson,
+		Slug:          utils.PtrStringToPtr(lesson.Slug),
+		Description:   utils.PtrStringToPtr(lesson.Description),
+		FilePath:      utils.PtrStringToPtr(lesson.FilePath),
+		StorageLesson: utils.PtrStringToPtr(lesson.StorageLesson),
+		LessonType:    utils.PtrStringToPtr(lesson.LessonType),
+		Duration:      utils.PtrStringToPtr(lesson.Duration),
+		FileType:      utils.PtrStringToPtr(lesson.FileType),
+		Kind:          utils.PtrStringToPtr(lesson.Kind),
+		Content:       content,
+	}
+
+	err = signCaptionUrls(cs.storageConfig, publicStorageUserId, lesson.CourseId, res.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	//? khusus file upload: kirim signed URL yang kadaluarsa, bukan path asli
+	if isUploadedLessonFile(lesson) {
+		if !isValidLessonFileRef(lesson.StorageLesson, lesson.CourseId, lesson.FilePath) {
+			res.FilePath = nil
+		} else {
+			signedUrl, err := signedLessonFileUrl(cs.storageConfig, publicStorageUserId, lesson)
+			if err != nil {
+				return nil, err
+			}
+			res.FilePath = &signedUrl
+		}
+	}
+
+	return res, nil
+}
+
+// publishedCourse: course yang belum publish / belum di-approve diperlakukan sama dgn tidak ada
+func (cs *catalogService) publishedCourse(ctx context.Context, courseId string, notFoundMessage string) (*entity.Course, error) {
+	courseEntity, err := cs.courseRepository.GetCourseById(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+	if courseEntity == nil || !isPublishedCourse(courseEntity) {
+		return nil, apperror.NotFound(notFoundMessage)
+	}
+
+	return courseEntity, nil
+}
+
+func isPublishedCourse(courseEntity *entity.Course) bool {
+	return courseEntity.Status != nil && *courseEntity.Status == entity.CourseStatusActive &&
+		courseEntity.IsApproved != nil && *courseEntity.IsApproved == entity.CourseApproved
+}
+
+func isPublicPreviewLesson(lesson *entity.ChapterLesson) bool {
+	return lesson.IsPreview != nil && *lesson.IsPreview == entity.LessonIsPreview &&
+		lesson.Status != nil && *lesson.Status == entity.LessonStatusActive
+}
+
+func NewCatalogService(courseRepository repository.ICourseRepository, courseChapterRepository repository.ICourseChapterRepository, chapterLessonRepository repository.IChapterLessonRepository, storageConfig config.StorageConfig) ICatalogService {
+	return &catalogService{
+		courseRepository:        courseRepository,
+		courseChapterRepository: courseChapterRepository,
+		chapterLessonRepository: chapterLessonRepository,
+		storageConfig:           storageConfig,
+	}
+}
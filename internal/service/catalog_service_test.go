@@ -0,0 +1,202 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/catalog"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	testPreviewLessonId = "0d6f1e2a-3b4c-4d5e-8f60-718293a4b5c6"
+	testHiddenLessonId  = "1e7a2f3b-4c5d-4e6f-9071-8293a4b5c6d7"
+	testDraftChapterId  = "2f8b3a4c-5d6e-4f70-8182-93a4b5c6d7e8"
+)
+
+type catalogFixture struct {
+	courses  *fake.CourseRepository
+	chapters *fake.CourseChapterRepository
+	lessons  *fake.ChapterLessonRepository
+}
+
+// newCatalogFixture: course published & approved, 1 chapter aktif (lesson preview + lesson biasa) dan 1 chapter draft
+func newCatalogFixture() *catalogFixture {
+	f := &catalogFixture{
+		courses:  fake.NewCourseRepository(),
+		chapters: fake.NewCourseChapterRepository(),
+		lessons:  fake.NewChapterLessonRepository(),
+	}
+
+	f.courses.AddCourse(entity.Course{
+		Id:            testCourseId,
+		Name:          "Golang Dasar",
+		ImageFileName: "cover.png",
+		Status:        utils.StringToPtr(entity.CourseStatusActive),
+		IsApproved:    utils.StringToPtr(entity.CourseApproved),
+	})
+	f.chapters.AddCourseChapter(entity.CourseChapter{Id: testChapterId, CourseId: testCourseId, Title: "Intro", OrderChapter: 1, Status: entity.ChapterStatusActive})
+	f.chapters.AddCourseChapter(entity.CourseChapter{Id: testDraftChapterId, CourseId: testCourseId, Title: "Draft", OrderChapter: 2, Status: "inactive"})
+
+	f.addLesson(testPreviewLessonId, testChapterId, 1, true)
+	f.addLesson(testHiddenLessonId, testChapterId, 2, false)
+	f.addLesson(testLessonId, testDraftChapterId, 1, true)
+
+	return f
+}
+
+func (f *catalogFixture) addLesson(id string, chapterId string, order int64, preview bool) {
+	var isPreview int64
+	if preview {
+		isPreview = entity.LessonIsPreview
+	}
+
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:            id,
+		CourseId:      utils.StringToPtr(testCourseId),
+		ChapterId:     utils.StringToPtr(chapterId),
+		Title:         "Lesson " + id[:4],
+		OrderLesson:   order,
+		FilePath:      utils.StringToPtr("lesson_1.mp4"),
+		StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+		IsPreview:     &isPreview,
+		Status:        utils.StringToPtr(entity.LessonStatusActive),
+		CreatedAt:     time.Now(),
+	})
+}
+
+func (f *catalogFixture) service() ICatalogService {
+	return NewCatalogService(f.courses, f.chapters, f.lessons, testStorageConfig)
+}
+
+func (f *catalogFixture) updateCourse(modify func(course *entity.Course)) {
+	course, _ := f.courses.Course(testCourseId)
+	modify(&course)
+	f.courses.AddCourse(course)
+}
+
+func TestCatalogServiceGetPublicCourse(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *catalogFixture)
+		wantCode codes.Code
+	}{
+		{
+			name:     "published course",
+			wantCode: codes.OK,
+		},
+		{
+			name: "course not published",
+			setup: func(f *catalogFixture) {
+				f.updateCourse(func(c *entity.Course) { c.Status = utils.StringToPtr("inactive") })
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "course not approved",
+			setup: func(f *catalogFixture) {
+				f.updateCourse(func(c *entity.Course) { c.IsApproved = utils.StringToPtr("pending") })
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "course does not exist",
+			setup:    func(f *catalogFixture) { f.courses = fake.NewCourseRepository() },
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCatalogFixture()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			//? tanpa claims: katalog dipanggil pengunjung yang belum login
+			res, err := f.service().GetPublicCourse(contextAnonymous, &catalog.GetPublicCourseRequest{Id: testCourseId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.GetImageUrl() != testStorageConfig.ServiceURL+"/"+testCourseId+"/course/cover.png" {
+				t.Errorf("image_url = %q", res.GetImageUrl())
+			}
+			//? chapter draft tidak tampil, lesson non-preview tidak ikut
+			if len(res.Chapters) != 1 || res.Chapters[0].Id != testChapterId {
+				t.Fatalf("chapters = %v, want only %s", res.Chapters, testChapterId)
+			}
+			lessons := res.Chapters[0].PreviewLessons
+			if len(lessons) != 1 || lessons[0].Id != testPreviewLessonId {
+				t.Errorf("preview lessons = %v, want only %s", lessons, testPreviewLessonId)
+			}
+		})
+	}
+}
+
+func TestCatalogServiceGetPreviewLesson(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		setup    func(f *catalogFixture)
+		wantCode codes.Code
+	}{
+		{
+			name:     "preview lesson",
+			id:       testPreviewLessonId,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "lesson is not preview",
+			id:       testHiddenLessonId,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "chapter not active",
+			id:       testLessonId,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "lesson not active",
+			id:   testPreviewLessonId,
+			setup: func(f *catalogFixture) {
+				lesson, _ := f.lessons.ChapterLesson(testPreviewLessonId)
+				lesson.Status = utils.StringToPtr("inactive")
+				f.lessons.AddChapterLesson(lesson)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "course not approved",
+			id:       testPreviewLessonId,
+			setup:    func(f *catalogFixture) { f.updateCourse(func(c *entity.Course) { c.IsApproved = nil }) },
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "lesson does not exist",
+			id:       "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCatalogFixture()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service().GetPreviewLesson(contextAnonymous, &catalog.GetPreviewLessonRequest{Id: tt.id})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			assertSignedLessonFileURL(t, res.GetFilePath(), "lesson_1.mp4", publicStorageUserId)
+		})
+	}
+}
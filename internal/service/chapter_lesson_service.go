@@ -2,14 +2,23 @@ package service
 
 import (
 	"context"
-	"runtime/debug"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"sort"
 	"time"
 
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/config"
 	"github.com/abu-umair/be-lms-go/internal/entity"
 	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
 	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/tracing"
 	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/abu-umair/be-lms-go/pb/chapter_lesson"
+	"github.com/abu-umair/be-lms-go/pkg/database"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -19,11 +28,29 @@ type IChapterLessonService interface {
 	DetailChapterLesson(ctx context.Context, request *chapter_lesson.DetailChapterLessonRequest) (*chapter_lesson.DetailChapterLessonResponse, error)
 	EditChapterLesson(ctx context.Context, request *chapter_lesson.EditChapterLessonRequest) (*chapter_lesson.EditChapterLessonResponse, error)
 	DeleteChapterLesson(ctx context.Context, request *chapter_lesson.DeleteChapterLessonRequest) (*chapter_lesson.DeleteChapterLessonResponse, error)
+	RestoreChapterLesson(ctx context.Context, request *chapter_lesson.RestoreChapterLessonRequest) (*chapter_lesson.RestoreChapterLessonResponse, error)
+	ReorderLessons(ctx context.Context, request *chapter_lesson.ReorderLessonsRequest) (*chapter_lesson.ReorderLessonsResponse, error)
+	MoveLesson(ctx context.Context, request *chapter_lesson.MoveLessonRequest) (*chapter_lesson.MoveLessonResponse, error)
+	CopyLesson(ctx context.Context, request *chapter_lesson.CopyLessonRequest) (*chapter_lesson.CopyLessonResponse, error)
+	ListChapterLessons(ctx context.Context, request *chapter_lesson.ListChapterLessonsRequest) (*chapter_lesson.ListChapterLessonsResponse, error)
+	SetLessonReleaseRule(ctx context.Context, request *chapter_lesson.SetLessonReleaseRuleRequest) (*chapter_lesson.SetLessonReleaseRuleResponse, error)
+	CompleteLesson(ctx context.Context, request *chapter_lesson.CompleteLessonRequest) (*chapter_lesson.CompleteLessonResponse, error)
+	GetCourseCurriculum(ctx context.Context, request *chapter_lesson.GetCourseCurriculumRequest) (*chapter_lesson.GetCourseCurriculumResponse, error)
+	SearchLessonTranscripts(ctx context.Context, request *chapter_lesson.SearchLessonTranscriptsRequest) (*chapter_lesson.SearchLessonTranscriptsResponse, error)
 }
 
 type chapterLessonService struct {
 	db                      *sqlx.DB
 	chapterLessonRepository repository.IChapterLessonRepository
+	courseChapterRepository repository.ICourseChapterRepository
+	courseRepository        repository.ICourseRepository
+	enrollmentRepository    repository.IEnrollmentRepository
+	releaseRuleRepository   repository.IReleaseRuleRepository
+	completionRepository    repository.ILessonCompletionRepository
+	transcriptRepository    repository.ILessonTranscriptRepository
+	releaseService          IReleaseService
+	storageResolver         *storage.Resolver
+	storageConfig           config.StorageConfig
 }
 
 func (ls *chapterLessonService) CreateChapterLesson(ctx context.Context, request *chapter_lesson.CreateChapterLessonRequest) (*chapter_lesson.CreateChapterLessonResponse, error) {
@@ -35,10 +62,52 @@ func (ls *chapterLessonService) CreateChapterLesson(ctx context.Context, request
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
-	tx, err := ls.db.BeginTxx(ctx, nil)
+	//* lesson wajib milik course instructor yang login (file_path & is_preview dibaca katalog publik)
+	if request.CourseId == nil {
+		return nil, apperror.InvalidArgument("Course is required").WithFieldViolation("course_id", "must be set")
+	}
+	err = ensureCourseOwner(ctx, ls.courseRepository, claims, *request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* chapter (jika diisi) harus masih aktif & berada di course yang sama
+	if request.ChapterId != nil {
+		courseChapter, err := ls.courseChapterRepository.GetCourseChapterById(ctx, *request.ChapterId)
+		if err != nil {
+			return nil, err
+		}
+		if courseChapter == nil {
+			return nil, apperror.NotFound("Course chapter not found")
+		}
+		if courseChapter.CourseId != *request.CourseId {
+			return nil, apperror.InvalidArgument("Chapter belongs to another course").WithFieldViolation("chapter_id", "must be a chapter of course_id")
+		}
+	}
+
+	//* file upload wajib berupa nama file di storage/<course_id>/lesson (tanpa path)
+	if !isValidLessonFileRef(request.StorageLesson, request.CourseId, request.FilePath) {
+		return nil, apperror.InvalidArgument("Invalid lesson file path").WithFieldViolation("file_path", "must be an uploaded file name in the course lesson folder")
+	}
+
+	//* lesson berjenis: field lama diturunkan dari content
+	if request.Content != nil {
+		err = validateLessonContentRequest(request, request.CourseId, request.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	//* transcript dari file caption, dibaca sebelum transaksi dimulai
+	transcript, err := ls.lessonTranscript(request.CourseId, request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, ls.db)
 	if err != nil {
 		return nil, err
 	}
@@ -49,8 +118,7 @@ func (ls *chapterLessonService) CreateChapterLesson(ctx context.Context, request
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -60,12 +128,13 @@ func (ls *chapterLessonService) CreateChapterLesson(ctx context.Context, request
 		}
 	}()
 
-	chapterLessonRepo := ls.chapterLessonRepository.WithTransaction(tx)
+	chapterLessonRepo := ls.chapterLessonRepository.WithTransaction(tx.Tx)
+	transcriptRepo := ls.transcriptRepository.WithTransaction(tx.Tx)
 
 	// *insert ke DB
 	chapterLessonEntity := entity.ChapterLesson{
 		Id:            uuid.NewString(),
-		InstructorId:  request.InstructorId,
+		InstructorId:  &claims.Subject, //? pembuat lesson selalu instructor yang login, instructor_id di request diabaikan
 		CourseId:      request.CourseId,
 		Title:         request.Title,
 		OrderLesson:   request.OrderLesson,
@@ -86,7 +155,22 @@ func (ls *chapterLessonService) CreateChapterLesson(ctx context.Context, request
 		CreatedBy: claims.FullName,
 	}
 
+	if request.Content != nil {
+		err = applyLessonContent(&chapterLessonEntity, request.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = chapterLessonRepo.CreateNewChapterLesson(ctx, &chapterLessonEntity)
+	if err != nil {
+		if database.IsUniqueViolation(err, repository.ChapterLessonOrderUniqueIndex) {
+			return nil, apperror.AlreadyExists("Order lesson already used").WithFieldViolation("order_lesson", "another lesson of this chapter already has this order")
+		}
+		return nil, err
+	}
+
+	err = transcriptRepo.ReplaceLessonTranscript(ctx, chapterLessonEntity.Id, transcriptCues(chapterLessonEntity.Id, transcript))
 	if err != nil {
 		return nil, err
 	}
@@ -110,9 +194,28 @@ func (cs *chapterLessonService) DetailChapterLesson(ctx context.Context, request
 		return nil, err
 	}
 
-	//* apakah role user adl Instructor
-	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+	// *Apakah Id lesson ada di DB
+	lessonAccess, err := cs.chapterLessonRepository.GetChapterLessonById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if lessonAccess == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
+	}
+
+	//* selain instructor pemilik course / Admin, hanya user yang enroll (atau lesson preview) yang boleh akses
+	canAccess, err := cs.canAccessLesson(ctx, claims, lessonAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess {
+		return nil, apperror.PermissionDenied("You are not enrolled in this course")
+	}
+
+	//* drip / prerequisite: lesson yang masih terkunci hanya menampilkan info umum, tanpa isi
+	release, err := cs.releaseService.LessonRelease(ctx, claims, lessonAccess)
+	if err != nil {
+		return nil, err
 	}
 
 	// * Get chapter_lessons by lesson_id
@@ -129,9 +232,7 @@ func (cs *chapterLessonService) DetailChapterLesson(ctx context.Context, request
 
 	//* Apabila null lesson_id, return not found
 	if chapterLessonEntity == nil {
-		return &chapter_lesson.DetailChapterLessonResponse{
-			Base: utils.NotFoundResponse("Course chapter lesson not found"),
-		}, nil
+		return nil, apperror.NotFound("Course chapter lesson not found")
 	}
 
 	// *success
@@ -163,6 +264,40 @@ func (cs *chapterLessonService) DetailChapterLesson(ctx context.Context, request
 
 	res.CreatedBy = utils.StringToPtr(chapterLessonEntity.CreatedBy)
 
+	//? payload sesuai jenis lesson (lesson lama tanpa jenis: kind & content kosong)
+	res.Kind = utils.PtrStringToPtr(chapterLessonEntity.Kind)
+	res.Content, err = parseLessonContent(chapterLessonEntity.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Release = releaseStatus(release)
+	if release.Locked {
+		res.FilePath = nil
+		res.StorageLesson = nil
+		res.Content = nil
+	}
+
+	//? caption: signed URL per track (content sudah nil jika lesson terkunci)
+	err = signCaptionUrls(cs.storageConfig, claims.Subject, lessonAccess.CourseId, res.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	//? khusus file upload: kirim signed URL yang kadaluarsa, bukan path asli
+	if res.FilePath != nil && isUploadedLessonFile(lessonAccess) {
+		//? data lama dgn path tidak valid tidak pernah ditandatangani
+		if !isValidLessonFileRef(lessonAccess.StorageLesson, lessonAccess.CourseId, lessonAccess.FilePath) {
+			res.FilePath = nil
+		} else {
+			signedUrl, err := cs.signLessonFileUrl(claims.Subject, lessonAccess)
+			if err != nil {
+				return nil, err
+			}
+			res.FilePath = &signedUrl
+		}
+	}
+
 	//?Mapping Field Pointer String (*string di Struct)
 	res.UpdatedBy = utils.PtrStringToPtr(chapterLessonEntity.UpdatedBy)
 	res.DeletedBy = utils.PtrStringToPtr(chapterLessonEntity.DeletedBy)
@@ -184,7 +319,7 @@ func (cs *chapterLessonService) EditChapterLesson(ctx context.Context, request *
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// *Apakah Id course ada di DB
@@ -192,13 +327,44 @@ func (cs *chapterLessonService) EditChapterLesson(ctx context.Context, request *
 	if err != nil {
 		return nil, err
 	}
-	if courseEntity == nil {
-		return &chapter_lesson.EditChapterLessonResponse{
-			Base: utils.NotFoundResponse("Course chapter not found"),
-		}, nil
+	if courseEntity == nil || courseEntity.CourseId == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
+	}
+
+	//* hanya pemilik course yang bisa mengubah lesson (termasuk is_preview & file_path)
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, *courseEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* pindah chapter / course hanya lewat MoveLesson (agar chapter tujuan dicek & urutan kedua sisi dinomori ulang)
+	if !equalStringPtr(courseEntity.ChapterId, request.ChapterId) {
+		return nil, apperror.InvalidArgument("Chapter cannot be changed by edit").WithFieldViolation("chapter_id", "must equal the current chapter, use MoveLesson to move the lesson")
+	}
+	if !equalStringPtr(courseEntity.CourseId, request.CourseId) {
+		return nil, apperror.InvalidArgument("Course cannot be changed by edit").WithFieldViolation("course_id", "must equal the current course, use MoveLesson to move the lesson")
+	}
+
+	//* file upload wajib berupa nama file di storage/<course_id>/lesson (tanpa path)
+	if !isValidLessonFileRef(request.StorageLesson, request.CourseId, request.FilePath) {
+		return nil, apperror.InvalidArgument("Invalid lesson file path").WithFieldViolation("file_path", "must be an uploaded file name in the course lesson folder")
+	}
+
+	//* lesson berjenis: field lama diturunkan dari content
+	if request.Content != nil {
+		err = validateLessonContentRequest(request, request.CourseId, request.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	//* transcript dari file caption, dibaca sebelum transaksi dimulai
+	transcript, err := cs.lessonTranscript(request.CourseId, request.Content)
+	if err != nil {
+		return nil, err
 	}
 
-	tx, err := cs.db.BeginTxx(ctx, nil)
+	tx, err := database.BeginTransaction(ctx, cs.db)
 	if err != nil {
 		return nil, err
 	}
@@ -209,8 +375,7 @@ func (cs *chapterLessonService) EditChapterLesson(ctx context.Context, request *
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -220,12 +385,12 @@ func (cs *chapterLessonService) EditChapterLesson(ctx context.Context, request *
 		}
 	}()
 
-	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx)
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
 
 	// *update ke DB
 	newCourse := entity.ChapterLesson{
 		Id:            request.Id,
-		InstructorId:  request.InstructorId,
+		InstructorId:  courseEntity.InstructorId, //? pembuat lesson tidak berubah lewat edit
 		CourseId:      request.CourseId,
 		Title:         request.Title,
 		OrderLesson:   request.OrderLesson,
@@ -246,7 +411,24 @@ func (cs *chapterLessonService) EditChapterLesson(ctx context.Context, request *
 		UpdatedBy: &claims.FullName,
 	}
 
+	//? Edit mengganti seluruh lesson: tanpa content, kind & content ikut dikosongkan
+	if request.Content != nil {
+		err = applyLessonContent(&newCourse, request.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = chapterLessonRepo.UpdateChapterLesson(ctx, &newCourse)
+	if err != nil {
+		if database.IsUniqueViolation(err, repository.ChapterLessonOrderUniqueIndex) {
+			return nil, apperror.AlreadyExists("Order lesson already used").WithFieldViolation("order_lesson", "another lesson of this chapter already has this order")
+		}
+		return nil, err
+	}
+
+	//? tanpa content (lesson lama) transcript ikut dikosongkan
+	err = cs.transcriptRepository.WithTransaction(tx.Tx).ReplaceLessonTranscript(ctx, request.Id, transcriptCues(request.Id, transcript))
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +454,7 @@ func (cs *chapterLessonService) DeleteChapterLesson(ctx context.Context, request
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// *Apakah Id course ada di DB
@@ -280,13 +462,17 @@ func (cs *chapterLessonService) DeleteChapterLesson(ctx context.Context, request
 	if err != nil {
 		return nil, err
 	}
-	if chapterLessonEntity == nil {
-		return &chapter_lesson.DeleteChapterLessonResponse{
-			Base: utils.NotFoundResponse("Course Chapter Lesson not found"),
-		}, nil
+	if chapterLessonEntity == nil || chapterLessonEntity.CourseId == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
 	}
 
-	tx, err := cs.db.BeginTxx(ctx, nil)
+	//* hanya pemilik course yang bisa menghapus (sama dgn restore)
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, *chapterLessonEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
 	if err != nil {
 		return nil, err
 	}
@@ -297,8 +483,7 @@ func (cs *chapterLessonService) DeleteChapterLesson(ctx context.Context, request
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -308,7 +493,7 @@ func (cs *chapterLessonService) DeleteChapterLesson(ctx context.Context, request
 		}
 	}()
 
-	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx)
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
 
 	// *update delete_at & delete_by ke DB
 	err = chapterLessonRepo.DeleteChapterLesson(ctx, request.Id, time.Now(), claims.FullName)
@@ -327,9 +512,1156 @@ func (cs *chapterLessonService) DeleteChapterLesson(ctx context.Context, request
 	}, nil
 }
 
-func NewChapterLessonService(db *sqlx.DB, chapterLessonRepository repository.IChapterLessonRepository) IChapterLessonService {
+func (cs *chapterLessonService) RestoreChapterLesson(ctx context.Context, request *chapter_lesson.RestoreChapterLessonRequest) (*chapter_lesson.RestoreChapterLessonResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* instructor pemilik course / admin (pengelola trash)
+	if claims.Role != entity.UserRoleInstructor && claims.Role != entity.UserRoleAdmin {
+		return nil, apperror.PermissionDenied("Only instructor or admin can access this resource")
+	}
+
+	// *Apakah lesson ada di trash & dihapus langsung (bukan ikut terhapus bersama chapter / course)
+	chapterLessonEntity, err := cs.chapterLessonRepository.GetDeletedChapterLessonById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if chapterLessonEntity == nil {
+		return nil, apperror.NotFound("Deleted course chapter lesson not found")
+	}
+	if !isDeleteRoot(chapterLessonEntity.Id, chapterLessonEntity.DeletedWithId) {
+		return nil, apperror.FailedPrecondition("Course chapter lesson was deleted together with its chapter or course, restore that instead")
+	}
+
+	err = ensureRestoreAccess(ctx, cs.courseRepository, claims, chapterLessonEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	// *chapter-nya harus aktif, lesson tidak boleh kembali ke chapter yang ada di trash
+	if chapterLessonEntity.ChapterId != nil {
+		chapterEntity, chapterErr := cs.courseChapterRepository.GetCourseChapterById(ctx, *chapterLessonEntity.ChapterId)
+		if chapterErr != nil {
+			return nil, chapterErr
+		}
+		if chapterEntity == nil {
+			return nil, apperror.FailedPrecondition("Course chapter of this lesson is deleted, restore the chapter first")
+		}
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
+
+	_, err = chapterLessonRepo.RestoreChapterLessons(ctx, chapterLessonEntity.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &chapter_lesson.RestoreChapterLessonResponse{
+		Base: utils.SuccessResponse("Restore Course Chapter Lesson Success"),
+	}, nil
+}
+
+func (cs *chapterLessonService) ReorderLessons(ctx context.Context, request *chapter_lesson.ReorderLessonsRequest) (*chapter_lesson.ReorderLessonsResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	// *chapter harus ada & course-nya milik instructor yang login
+	courseChapter, err := cs.courseChapterRepository.GetCourseChapterById(ctx, request.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+	if courseChapter == nil {
+		return nil, apperror.NotFound("Course chapter not found")
+	}
+
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, courseChapter.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
+
+	// *Ambil & kunci semua lesson chapter ini, agar tidak berubah sampai commit
+	currentIds, err := chapterLessonRepo.GetChapterLessonIdsForUpdate(ctx, request.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+	if len(currentIds) == 0 {
+		err = apperror.NotFound("Chapter lessons not found")
+		return nil, err
+	}
+
+	// *ordered_ids harus sama persis dgn lesson yang ada
+	err = validateOrderedIds(currentIds, request.OrderedIds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = chapterLessonRepo.ReorderChapterLessons(ctx, request.ChapterId, request.OrderedIds, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &chapter_lesson.ReorderLessonsResponse{
+		Base: utils.SuccessResponse("Reorder Chapter Lessons Success"),
+	}, nil
+}
+
+func (cs *chapterLessonService) MoveLesson(ctx context.Context, request *chapter_lesson.MoveLessonRequest) (*chapter_lesson.MoveLessonResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var copiedFilePaths []string
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+			for _, copiedFilePath := range copiedFilePaths {
+				os.Remove(copiedFilePath)
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+		if err != nil {
+			for _, copiedFilePath := range copiedFilePaths {
+				os.Remove(copiedFilePath) //?file hasil salinan tidak jadi dipakai
+			}
+		}
+	}()
+
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
+
+	transfer, err := cs.prepareLessonTransfer(ctx, chapterLessonRepo, claims, request.Id, request.TargetChapterId, request.TargetCourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	lesson := transfer.lesson
+	targetCourseId := transfer.targetChapter.CourseId
+	now := time.Now()
+
+	if transfer.sourceChapterId != request.TargetChapterId || !equalStringPtr(lesson.CourseId, &targetCourseId) {
+		var lastOrder int64
+		lastOrder, err = chapterLessonRepo.GetLastOrderLesson(ctx, request.TargetChapterId)
+		if err != nil {
+			return nil, err
+		}
+
+		//? taruh dulu paling akhir di chapter tujuan (tidak bentrok dgn unique index), posisi final diatur saat renumber
+		movedLesson := entity.ChapterLesson{
+			Id:          lesson.Id,
+			CourseId:    &targetCourseId,
+			ChapterId:   &request.TargetChapterId,
+			OrderLesson: lastOrder + 1,
+			FilePath:    lesson.FilePath,
+			Content:     lesson.Content,
+
+			UpdatedAt: now,
+			UpdatedBy: &claims.FullName,
+		}
+
+		//* pindah course: file upload (termasuk caption) ikut pindah ke storage/<course_id tujuan>/lesson, file lama dihapus setelah commit
+		if !equalStringPtr(lesson.CourseId, &targetCourseId) {
+			var copied *lessonFilesCopy
+			copied, err = cs.copyLessonFiles(ctx, lesson, targetCourseId)
+			if err != nil {
+				return nil, err
+			}
+			movedLesson.FilePath = copied.filePath
+			movedLesson.Content = copied.content
+			for _, file := range copied.files {
+				copiedFilePaths = append(copiedFilePaths, file.targetPath)
+				tx.AfterCommit("remove moved lesson file", removeFileAction(file.sourcePath))
+			}
+		}
+
+		err = chapterLessonRepo.MoveChapterLesson(ctx, &movedLesson)
+		if err != nil {
+			return nil, err
+		}
+
+		//* chapter asal dinomori ulang tanpa lesson ini
+		if transfer.sourceChapterId != "" && transfer.sourceChapterId != request.TargetChapterId {
+			remainingIds := removeLessonId(transfer.sourceIds, lesson.Id)
+			if len(remainingIds) > 0 {
+				err = chapterLessonRepo.ReorderChapterLessons(ctx, transfer.sourceChapterId, remainingIds, now, claims.FullName)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	//* chapter tujuan dinomori ulang dgn lesson ini di posisi yang diminta
+	targetIds := insertLessonId(transfer.targetIds, lesson.Id, request.Position)
+	err = chapterLessonRepo.ReorderChapterLessons(ctx, request.TargetChapterId, targetIds, now, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &chapter_lesson.MoveLessonResponse{
+		Base:        utils.SuccessResponse("Move Chapter Lesson Success"),
+		Id:          lesson.Id,
+		CourseId:    targetCourseId,
+		ChapterId:   request.TargetChapterId,
+		OrderLesson: lessonPosition(targetIds, lesson.Id),
+	}, nil
+}
+
+func (cs *chapterLessonService) CopyLesson(ctx context.Context, request *chapter_lesson.CopyLessonRequest) (*chapter_lesson.CopyLessonResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var copiedFilePaths []string
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+			for _, copiedFilePath := range copiedFilePaths {
+				os.Remove(copiedFilePath)
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+		if err != nil {
+			for _, copiedFilePath := range copiedFilePaths {
+				os.Remove(copiedFilePath) //?file hasil salinan tidak jadi dipakai
+			}
+		}
+	}()
+
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
+
+	transfer, err := cs.prepareLessonTransfer(ctx, chapterLessonRepo, claims, request.Id, request.TargetChapterId, request.TargetCourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	targetCourseId := transfer.targetChapter.CourseId
+	now := time.Now()
+
+	lastOrder, err := chapterLessonRepo.GetLastOrderLesson(ctx, request.TargetChapterId)
+	if err != nil {
+		return nil, err
+	}
+
+	// *insert salinan ke DB (paling akhir dulu, posisi final diatur saat renumber)
+	newLesson := *transfer.lesson
+	newLesson.Id = uuid.NewString()
+	newLesson.InstructorId = &claims.Subject
+	newLesson.CourseId = &targetCourseId
+	newLesson.ChapterId = &request.TargetChapterId
+	newLesson.OrderLesson = lastOrder + 1
+	newLesson.CreatedAt = now
+	newLesson.CreatedBy = claims.FullName
+	newLesson.UpdatedAt = time.Time{}
+	newLesson.UpdatedBy = nil
+	newLesson.DeletedAt = nil
+	newLesson.DeletedBy = nil
+
+	//* file upload (termasuk caption) disalin dgn nama baru, jadi lesson asal & salinan tidak berbagi file
+	copied, err := cs.copyLessonFiles(ctx, transfer.lesson, targetCourseId)
+	if err != nil {
+		return nil, err
+	}
+	newLesson.FilePath = copied.filePath
+	newLesson.Content = copied.content
+	for _, file := range copied.files {
+		copiedFilePaths = append(copiedFilePaths, file.targetPath)
+	}
+
+	err = chapterLessonRepo.CreateNewChapterLesson(ctx, &newLesson)
+	if err != nil {
+		return nil, err
+	}
+
+	//* transcript salinan dibaca dari file caption hasil salinan
+	copiedContent, err := parseLessonContent(newLesson.Content)
+	if err != nil {
+		return nil, err
+	}
+	transcript, err := cs.lessonTranscript(&targetCourseId, copiedContent)
+	if err != nil {
+		return nil, err
+	}
+	err = cs.transcriptRepository.WithTransaction(tx.Tx).ReplaceLessonTranscript(ctx, newLesson.Id, transcriptCues(newLesson.Id, transcript))
+	if err != nil {
+		return nil, err
+	}
+
+	//* chapter tujuan dinomori ulang dgn salinan di posisi yang diminta
+	targetIds := insertLessonId(transfer.targetIds, newLesson.Id, request.Position)
+	err = chapterLessonRepo.ReorderChapterLessons(ctx, request.TargetChapterId, targetIds, now, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &chapter_lesson.CopyLessonResponse{
+		Base:        utils.SuccessResponse("Copy Chapter Lesson Success"),
+		Id:          newLesson.Id,
+		CourseId:    targetCourseId,
+		ChapterId:   request.TargetChapterId,
+		OrderLesson: lessonPosition(targetIds, newLesson.Id),
+	}, nil
+}
+
+func (cs *chapterLessonService) ListChapterLessons(ctx context.Context, request *chapter_lesson.ListChapterLessonsRequest) (*chapter_lesson.ListChapterLessonsResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	//* chapter harus ada & course-nya milik instructor yang login
+	courseChapter, err := cs.courseChapterRepository.GetCourseChapterById(ctx, request.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+	if courseChapter == nil {
+		return nil, apperror.NotFound("Course chapter not found")
+	}
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, courseChapter.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{"id"} // ID wajib ada untuk mapping
+	if request.FieldMask != nil {
+		paths = append(paths, request.FieldMask.Paths...)
+		//? storage_lesson & course_id dibutuhkan utk menandatangani file_path upload
+		if slices.Contains(paths, "file_path") {
+			paths = append(paths, "storage_lesson", "course_id")
+		}
+	}
+
+	chapterLessons, err := cs.chapterLessonRepository.GetChapterLessonsByChapterId(ctx, request.ChapterId, request.Status, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*chapter_lesson.ChapterLessonItem, 0, len(chapterLessons))
+	for _, chapterLesson := range chapterLessons {
+		item := chapterLessonItem(chapterLesson)
+
+		//? sama seperti detail: file upload dikirim sbg signed URL, bukan path asli
+		if isUploadedLessonFile(chapterLesson) {
+			item.FilePath = nil
+			if isValidLessonFileRef(chapterLesson.StorageLesson, chapterLesson.CourseId, chapterLesson.FilePath) {
+				signedUrl, err := cs.signLessonFileUrl(claims.Subject, chapterLesson)
+				if err != nil {
+					return nil, err
+				}
+				item.FilePath = &signedUrl
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	// *success
+	return &chapter_lesson.ListChapterLessonsResponse{
+		Base:  utils.SuccessResponse("List Chapter Lessons Success"),
+		Items: items,
+	}, nil
+}
+
+func (cs *chapterLessonService) SetLessonReleaseRule(ctx context.Context, request *chapter_lesson.SetLessonReleaseRuleRequest) (*chapter_lesson.SetLessonReleaseRuleResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	//? rule kosong = aturan rilis lesson dihapus
+	rule, err := releaseRuleFromProto(request.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	// *Apakah Id lesson ada di DB
+	chapterLessonEntity, err := cs.chapterLessonRepository.GetChapterLessonById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if chapterLessonEntity == nil || chapterLessonEntity.CourseId == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
+	}
+
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, *chapterLessonEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule != nil {
+		rule.Id = uuid.NewString()
+		rule.LessonId = &chapterLessonEntity.Id
+		rule.CreatedAt = time.Now()
+		rule.CreatedBy = claims.FullName
+
+		err = cs.releaseService.ValidateReleaseRule(ctx, *chapterLessonEntity.CourseId, rule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	releaseRuleRepo := cs.releaseRuleRepository.WithTransaction(tx.Tx)
+
+	//* aturan lama diganti (satu lesson maksimal satu aturan)
+	err = releaseRuleRepo.DeleteLessonReleaseRule(ctx, chapterLessonEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule != nil {
+		err = releaseRuleRepo.CreateNewReleaseRule(ctx, rule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &chapter_lesson.SetLessonReleaseRuleResponse{
+		Base: utils.SuccessResponse("Set Lesson Release Rule Success"),
+	}, nil
+}
+
+func (cs *chapterLessonService) CompleteLesson(ctx context.Context, request *chapter_lesson.CompleteLessonRequest) (*chapter_lesson.CompleteLessonResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// *Apakah Id lesson ada di DB
+	chapterLessonEntity, err := cs.chapterLessonRepository.GetChapterLessonById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if chapterLessonEntity == nil || chapterLessonEntity.CourseId == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
+	}
+
+	err = ensureEnrolled(ctx, cs.enrollmentRepository, claims, *chapterLessonEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//? lesson quiz selesai otomatis saat quiz-nya lulus
+	if chapterLessonEntity.Kind != nil && *chapterLessonEntity.Kind == entity.LessonKindQuiz {
+		return nil, apperror.FailedPrecondition("Quiz lessons are completed by passing the quiz")
+	}
+
+	//* lesson yang masih terkunci tidak bisa diselesaikan
+	release, err := cs.releaseService.LessonRelease(ctx, claims, chapterLessonEntity)
+	if err != nil {
+		return nil, err
+	}
+	err = releaseLockedError(release)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	completionRepo := cs.completionRepository.WithTransaction(tx.Tx)
+
+	//? idempotent: menyelesaikan ulang mengembalikan waktu selesai pertama
+	completion, err := completionRepo.CompleteLesson(ctx, &entity.LessonCompletion{
+		Id:          uuid.NewString(),
+		LessonId:    chapterLessonEntity.Id,
+		UserId:      claims.Subject,
+		CompletedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &chapter_lesson.CompleteLessonResponse{
+		Base:        utils.SuccessResponse("Complete Lesson Success"),
+		CompletedAt: completion.CompletedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (cs *chapterLessonService) GetCourseCurriculum(ctx context.Context, request *chapter_lesson.GetCourseCurriculumRequest) (*chapter_lesson.GetCourseCurriculumResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* instructor pemilik / admin melihat aturan rilis, learner yang enroll melihat status terkunci & progress
+	canManage, err := courseAccess(ctx, cs.courseRepository, cs.enrollmentRepository, claims, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	courseRelease, err := cs.releaseService.CourseRelease(ctx, claims, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	courseChapters, err := cs.courseChapterRepository.GetCourseChaptersByCourseId(ctx, request.CourseId, nil, []string{"id", "title", "order_chapter"})
+	if err != nil {
+		return nil, err
+	}
+
+	lessons, err := cs.chapterLessonRepository.GetCurriculumLessonsByCourseId(ctx, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* lesson dikelompokkan per chapter (urutan order_lesson dari repository)
+	chapterLessons := make(map[string][]*chapter_lesson.CurriculumLesson, len(courseChapters))
+	for _, lesson := range lessons {
+		if lesson.ChapterId == nil {
+			continue
+		}
+
+		item := &chapter_lesson.CurriculumLesson{
+			Id:          lesson.Id,
+			Title:       lesson.Title,
+			OrderLesson: lesson.OrderLesson,
+			Kind:        utils.PtrStringToPtr(lesson.Kind),
+			Duration:    utils.PtrStringToPtr(lesson.Duration),
+			IsPreview:   lesson.IsPreview != nil && *lesson.IsPreview == entity.LessonIsPreview,
+			Completed:   courseRelease.Completed[lesson.Id],
+			Release:     releaseStatus(courseRelease.Lessons[lesson.Id]),
+		}
+		if canManage {
+			item.ReleaseRule = releaseRuleToProto(courseRelease.LessonRules[lesson.Id])
+		}
+
+		chapterLessons[*lesson.ChapterId] = append(chapterLessons[*lesson.ChapterId], item)
+	}
+
+	chapters := make([]*chapter_lesson.CurriculumChapter, 0, len(courseChapters))
+	for _, courseChapter := range courseChapters {
+		chapter := &chapter_lesson.CurriculumChapter{
+			Id:           courseChapter.Id,
+			Title:        courseChapter.Title,
+			OrderChapter: courseChapter.OrderChapter,
+			Release:      releaseStatus(courseRelease.Chapters[courseChapter.Id]),
+			Lessons:      chapterLessons[courseChapter.Id],
+		}
+		if canManage {
+			chapter.ReleaseRule = releaseRuleToProto(courseRelease.ChapterRules[courseChapter.Id])
+		}
+
+		chapters = append(chapters, chapter)
+	}
+
+	// *success
+	return &chapter_lesson.GetCourseCurriculumResponse{
+		Base:     utils.SuccessResponse("Get Course Curriculum Success"),
+		Chapters: chapters,
+	}, nil
+}
+
+func (cs *chapterLessonService) SearchLessonTranscripts(ctx context.Context, request *chapter_lesson.SearchLessonTranscriptsRequest) (*chapter_lesson.SearchLessonTranscriptsResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* instructor pemilik / admin mencari di semua lesson, learner yang enroll hanya di lesson aktif yang sudah rilis
+	canManage, err := courseAccess(ctx, cs.courseRepository, cs.enrollmentRepository, claims, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultTranscriptSearchLimit
+	if request.Limit != nil {
+		limit = *request.Limit
+	}
+
+	//? learner: hanya lesson aktif yang sudah rilis, difilter di query agar LIMIT tidak terpakai lesson terkunci
+	var visibleLessonIds []string
+	if !canManage {
+		courseRelease, err := cs.releaseService.CourseRelease(ctx, claims, request.CourseId)
+		if err != nil {
+			return nil, err
+		}
+
+		lessons, err := cs.chapterLessonRepository.GetCurriculumLessonsByCourseId(ctx, request.CourseId)
+		if err != nil {
+			return nil, err
+		}
+
+		visibleLessonIds = []string{}
+		for _, lesson := range lessons {
+			if lesson.Status != nil && *lesson.Status == entity.LessonStatusActive && !courseRelease.Lessons[lesson.Id].Locked {
+				visibleLessonIds = append(visibleLessonIds, lesson.Id)
+			}
+		}
+	}
+
+	var transcriptHits []*entity.LessonTranscriptHit
+	if visibleLessonIds == nil || len(visibleLessonIds) > 0 {
+		transcriptHits, err = cs.transcriptRepository.SearchLessonTranscripts(ctx, request.CourseId, request.Query, request.Language, visibleLessonIds, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hits := make([]*chapter_lesson.TranscriptHit, 0, len(transcriptHits))
+	for _, hit := range transcriptHits {
+		hits = append(hits, &chapter_lesson.TranscriptHit{
+			LessonId:    hit.LessonId,
+			LessonTitle: hit.LessonTitle,
+			ChapterId:   utils.PtrStringToPtr(hit.ChapterId),
+			Language:    hit.Language,
+			StartMs:     hit.StartMs,
+			EndMs:       hit.EndMs,
+			Text:        hit.Text,
+		})
+	}
+
+	// *success
+	return &chapter_lesson.SearchLessonTranscriptsResponse{
+		Base: utils.SuccessResponse("Search Lesson Transcripts Success"),
+		Hits: hits,
+	}, nil
+}
+
+// chapterLessonItem memetakan lesson ke item list, kolom yang tidak di-select (field mask) tetap kosong
+func chapterLessonItem(chapterLessonEntity *entity.ChapterLesson) *chapter_lesson.ChapterLessonItem {
+	return &chapter_lesson.ChapterLessonItem{
+		Id:            chapterLessonEntity.Id,
+		ChapterId:     utils.PtrStringToPtr(chapterLessonEntity.ChapterId),
+		Title:         chapterLessonEntity.Title,
+		OrderLesson:   chapterLessonEntity.OrderLesson,
+		Slug:          utils.PtrStringToPtr(chapterLessonEntity.Slug),
+		Description:   utils.PtrStringToPtr(chapterLessonEntity.Description),
+		FilePath:      utils.PtrStringToPtr(chapterLessonEntity.FilePath),
+		StorageLesson: utils.PtrStringToPtr(chapterLessonEntity.StorageLesson),
+		LessonType:    utils.PtrStringToPtr(chapterLessonEntity.LessonType),
+		Volume:        utils.PtrStringToPtr(chapterLessonEntity.Volume),
+		Duration:      utils.PtrStringToPtr(chapterLessonEntity.Duration),
+		FileType:      utils.PtrStringToPtr(chapterLessonEntity.FileType),
+		Downloadable:  utils.PtrStringToPtr(chapterLessonEntity.Downloadable),
+		IsPreview:     utils.PtrInt64ToPtr(chapterLessonEntity.IsPreview),
+		Status:        utils.PtrStringToPtr(chapterLessonEntity.Status),
+		InstructorId:  utils.PtrStringToPtr(chapterLessonEntity.InstructorId),
+		CourseId:      utils.PtrStringToPtr(chapterLessonEntity.CourseId),
+
+		CreatedAt: utils.TimeToPtr(chapterLessonEntity.CreatedAt),
+		CreatedBy: utils.StringToPtr(chapterLessonEntity.CreatedBy),
+		UpdatedAt: utils.TimeToPtr(chapterLessonEntity.UpdatedAt),
+		UpdatedBy: utils.PtrStringToPtr(chapterLessonEntity.UpdatedBy),
+		DeletedAt: utils.PtrTimeToPtr(chapterLessonEntity.DeletedAt),
+		DeletedBy: utils.PtrStringToPtr(chapterLessonEntity.DeletedBy),
+	}
+}
+
+// lessonTransfer: data MoveLesson / CopyLesson yang sudah dicek, lesson di chapter asal & tujuan sudah terkunci
+type lessonTransfer struct {
+	lesson          *entity.ChapterLesson
+	targetChapter   *entity.CourseChapter
+	sourceChapterId string   //? kosong jika lesson belum punya chapter
+	sourceIds       []string //? lesson aktif di chapter asal, urut order_lesson
+	targetIds       []string //? lesson aktif di chapter tujuan, urut order_lesson
+}
+
+// prepareLessonTransfer mengecek lesson & chapter tujuan, memastikan course asal & tujuan milik instructor yang login,
+// lalu mengunci lesson di chapter asal & tujuan (dipanggil di dalam transaksi)
+func (cs *chapterLessonService) prepareLessonTransfer(ctx context.Context, chapterLessonRepo repository.IChapterLessonRepository, claims *jwtentity.JwtClaims, lessonId string, targetChapterId string, targetCourseId *string) (*lessonTransfer, error) {
+	lesson, err := chapterLessonRepo.GetChapterLessonByIdFieldMask(ctx, lessonId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if lesson == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
+	}
+
+	targetChapter, err := cs.courseChapterRepository.GetCourseChapterById(ctx, targetChapterId)
+	if err != nil {
+		return nil, err
+	}
+	if targetChapter == nil {
+		return nil, apperror.NotFound("Target chapter not found")
+	}
+	if targetCourseId != nil && *targetCourseId != targetChapter.CourseId {
+		return nil, apperror.InvalidArgument("Target chapter does not belong to target course").WithFieldViolation("target_course_id", "must be the course of target_chapter_id")
+	}
+
+	//* instructor hanya boleh memindah / menyalin lesson di antara course miliknya sendiri
+	courseIds := []string{targetChapter.CourseId}
+	if lesson.CourseId != nil && *lesson.CourseId != targetChapter.CourseId {
+		courseIds = append(courseIds, *lesson.CourseId)
+	}
+	for _, courseId := range courseIds {
+		courseEntity, err := cs.courseRepository.GetCourseById(ctx, courseId)
+		if err != nil {
+			return nil, err
+		}
+		if courseEntity == nil {
+			return nil, apperror.NotFound("Course not found")
+		}
+		if courseEntity.InstructorId == nil || *courseEntity.InstructorId != claims.Subject {
+			return nil, apperror.PermissionDenied("Lessons can only be moved or copied within your own courses")
+		}
+	}
+
+	transfer := &lessonTransfer{
+		lesson:        lesson,
+		targetChapter: targetChapter,
+	}
+	if lesson.ChapterId != nil {
+		transfer.sourceChapterId = *lesson.ChapterId
+	}
+
+	//* kunci lesson kedua chapter, selalu urut id chapter agar dua request berlawanan arah tidak deadlock
+	chapterIds := []string{targetChapterId}
+	if transfer.sourceChapterId != "" && transfer.sourceChapterId != targetChapterId {
+		chapterIds = append(chapterIds, transfer.sourceChapterId)
+	}
+	sort.Strings(chapterIds)
+
+	lockedIds := make(map[string][]string, len(chapterIds))
+	for _, chapterId := range chapterIds {
+		ids, err := chapterLessonRepo.GetChapterLessonIdsForUpdate(ctx, chapterId)
+		if err != nil {
+			return nil, err
+		}
+		lockedIds[chapterId] = ids
+	}
+	transfer.sourceIds = lockedIds[transfer.sourceChapterId]
+	transfer.targetIds = lockedIds[targetChapterId]
+
+	return transfer, nil
+}
+
+// lessonFileCopy: hasil salinan file upload lesson ke folder lesson course tujuan
+type lessonFileCopy struct {
+	fileName   string
+	sourcePath string
+	targetPath string
+}
+
+// lessonFilesCopy: semua file upload lesson (file utama & caption di content) yang sudah disalin ke course tujuan
+type lessonFilesCopy struct {
+	filePath *string //? file_path baru (tetap sama jika bukan file upload)
+	content  *string //? content dgn nama file baru
+	files    []*lessonFileCopy
+}
+
+// copyLessonFiles menyalin file upload lesson & file di content ke storage/<targetCourseId>/lesson dgn nama baru.
+// Jika gagal di tengah jalan, salinan yang sudah dibuat dihapus lagi.
+func (cs *chapterLessonService) copyLessonFiles(ctx context.Context, lesson *entity.ChapterLesson, targetCourseId string) (result *lessonFilesCopy, err error) {
+	result = &lessonFilesCopy{
+		filePath: lesson.FilePath,
+		content:  lesson.Content,
+	}
+
+	defer func() {
+		if err != nil {
+			for _, copied := range result.files {
+				os.Remove(copied.targetPath)
+			}
+			result = nil
+		}
+	}()
+
+	content, err := parseLessonContent(lesson.Content)
+	if err != nil {
+		return result, err
+	}
+
+	renames := map[string]string{}
+	if lesson.StorageLesson != nil && *lesson.StorageLesson == entity.LessonStorageUpload && lesson.FilePath != nil && *lesson.FilePath != "" {
+		if lesson.CourseId == nil {
+			err = apperror.FailedPrecondition("Lesson file not found")
+			return result, err
+		}
+
+		var copied *lessonFileCopy
+		copied, err = cs.copyLessonFile(ctx, *lesson.CourseId, *lesson.FilePath, targetCourseId, "lesson")
+		if err != nil {
+			return result, err
+		}
+		result.files = append(result.files, copied)
+		result.filePath = &copied.fileName
+		renames[*lesson.FilePath] = copied.fileName
+	}
+
+	if content == nil {
+		return result, nil
+	}
+
+	//* caption (dan file lain di content) yang belum tersalin sbg file utama
+	for _, fileName := range lessonContentFiles(content) {
+		if _, ok := renames[fileName]; ok {
+			continue
+		}
+		if lesson.CourseId == nil {
+			err = apperror.FailedPrecondition("Lesson file not found")
+			return result, err
+		}
+
+		var copied *lessonFileCopy
+		copied, err = cs.copyLessonFile(ctx, *lesson.CourseId, fileName, targetCourseId, "caption")
+		if err != nil {
+			return result, err
+		}
+		result.files = append(result.files, copied)
+		renames[fileName] = copied.fileName
+	}
+
+	renameLessonContentFiles(content, renames)
+	result.content, err = marshalLessonContent(content)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// copyLessonFile menyalin satu file di storage/<sourceCourseId>/lesson ke storage/<targetCourseId>/lesson dgn nama baru
+func (cs *chapterLessonService) copyLessonFile(ctx context.Context, sourceCourseId string, fileName string, targetCourseId string, prefix string) (*lessonFileCopy, error) {
+	sourcePath, err := cs.storageResolver.CourseFile(sourceCourseId, storage.FolderLesson, fileName)
+	if err != nil {
+		return nil, apperror.FailedPrecondition("Lesson file not found")
+	}
+
+	//? format nama sama dgn hasil upload: <prefix>_<unix nano><ext>
+	newFileName := fmt.Sprintf("%s_%d%s", prefix, time.Now().UnixNano(), path.Ext(fileName))
+	targetPath, err := cs.storageResolver.CourseFile(targetCourseId, storage.FolderLesson, newFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, span := tracing.StartSpan(ctx, "storage.Copy")
+	err = storage.CopyFile(sourcePath, targetPath)
+	span.End()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, apperror.FailedPrecondition("Lesson file not found")
+		}
+		return nil, err
+	}
+
+	return &lessonFileCopy{
+		fileName:   newFileName,
+		sourcePath: sourcePath,
+		targetPath: targetPath,
+	}, nil
+}
+
+// insertLessonId menaruh id di posisi (mulai 1) pada urutan ids; position kosong / melebihi jumlah = paling akhir
+func insertLessonId(ids []string, id string, position *int64) []string {
+	ordered := removeLessonId(ids, id)
+
+	index := len(ordered)
+	if position != nil && *position >= 1 && *position-1 < int64(len(ordered)) {
+		index = int(*position - 1)
+	}
+
+	ordered = append(ordered, "")
+	copy(ordered[index+1:], ordered[index:])
+	ordered[index] = id
+
+	return ordered
+}
+
+func removeLessonId(ids []string, id string) []string {
+	remaining := make([]string, 0, len(ids))
+	for _, current := range ids {
+		if current != id {
+			remaining = append(remaining, current)
+		}
+	}
+
+	return remaining
+}
+
+// lessonPosition: order_lesson hasil renumber (posisi di ids, mulai 1)
+func lessonPosition(ids []string, id string) int64 {
+	for i, current := range ids {
+		if current == id {
+			return int64(i + 1)
+		}
+	}
+
+	return 0
+}
+
+func equalStringPtr(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// canAccessLesson menentukan apakah user boleh melihat isi lesson (termasuk file-nya)
+func (cs *chapterLessonService) canAccessLesson(ctx context.Context, claims *jwtentity.JwtClaims, lesson *entity.ChapterLesson) (bool, error) {
+	//? lesson preview boleh diakses siapa saja yang login, syaratnya sama dgn katalog publik
+	isPreview, err := cs.isPreviewLesson(ctx, lesson)
+	if err != nil {
+		return false, err
+	}
+	if isPreview {
+		return true, nil
+	}
+
+	if lesson.CourseId == nil {
+		return claims.Role == entity.UserRoleAdmin, nil
+	}
+
+	//* instructor pemilik course / admin / learner yang enroll
+	_, err = courseAccess(ctx, cs.courseRepository, cs.enrollmentRepository, claims, *lesson.CourseId)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isPreviewLesson: lesson preview aktif, di chapter aktif & course yang active + approved
+func (cs *chapterLessonService) isPreviewLesson(ctx context.Context, lesson *entity.ChapterLesson) (bool, error) {
+	if !isPublicPreviewLesson(lesson) || lesson.CourseId == nil || lesson.ChapterId == nil {
+		return false, nil
+	}
+
+	courseChapter, err := cs.courseChapterRepository.GetCourseChapterById(ctx, *lesson.ChapterId)
+	if err != nil {
+		return false, err
+	}
+	if courseChapter == nil || courseChapter.Status != entity.ChapterStatusActive {
+		return false, nil
+	}
+
+	courseEntity, err := cs.courseRepository.GetCourseById(ctx, *lesson.CourseId)
+	if err != nil {
+		return false, err
+	}
+
+	return courseEntity != nil && isPublishedCourse(courseEntity), nil
+}
+
+// isUploadedLessonFile true jika file lesson disimpan di storage lokal (bukan link eksternal)
+func isUploadedLessonFile(lesson *entity.ChapterLesson) bool {
+	return lesson.StorageLesson != nil && *lesson.StorageLesson == entity.LessonStorageUpload &&
+		lesson.CourseId != nil && lesson.FilePath != nil && *lesson.FilePath != ""
+}
+
+// isValidLessonFileRef memastikan course_id & file_path lesson upload aman dipakai sbg path storage
+func isValidLessonFileRef(storageLesson *string, courseId *string, filePath *string) bool {
+	if storageLesson == nil || *storageLesson != entity.LessonStorageUpload || filePath == nil || *filePath == "" {
+		return true //? link eksternal (youtube, dll) tidak disimpan di storage
+	}
+	if courseId == nil || storage.ValidateId(*courseId) != nil {
+		return false
+	}
+
+	return storage.ValidateFileName(*filePath) == nil
+}
+
+func (cs *chapterLessonService) signLessonFileUrl(userId string, lesson *entity.ChapterLesson) (string, error) {
+	return signedLessonFileUrl(cs.storageConfig, userId, lesson)
+}
+
+// signedLessonFileUrl: signed URL file lesson upload, dipakai juga katalog publik (lesson preview)
+func signedLessonFileUrl(storageConfig config.StorageConfig, userId string, lesson *entity.ChapterLesson) (string, error) {
+	storagePath := path.Join(*lesson.CourseId, storage.FolderLesson, *lesson.FilePath)
+	expiresAt := time.Now().Add(storageConfig.SignedURLTTL)
+
+	return utils.BuildSignedStorageURL(storageConfig.ServiceURL, storagePath, userId, expiresAt, storageConfig.SigningSecret)
+}
+
+func NewChapterLessonService(db *sqlx.DB, chapterLessonRepository repository.IChapterLessonRepository, courseChapterRepository repository.ICourseChapterRepository, courseRepository repository.ICourseRepository, enrollmentRepository repository.IEnrollmentRepository, releaseRuleRepository repository.IReleaseRuleRepository, completionRepository repository.ILessonCompletionRepository, transcriptRepository repository.ILessonTranscriptRepository, releaseService IReleaseService, storageResolver *storage.Resolver, storageConfig config.StorageConfig) IChapterLessonService {
 	return &chapterLessonService{
 		db:                      db,
 		chapterLessonRepository: chapterLessonRepository,
+		courseChapterRepository: courseChapterRepository,
+		courseRepository:        courseRepository,
+		enrollmentRepository:    enrollmentRepository,
+		releaseRuleRepository:   releaseRuleRepository,
+		completionRepository:    completionRepository,
+		transcriptRepository:    transcriptRepository,
+		releaseService:          releaseService,
+		storageResolver:         storageResolver,
+		storageConfig:           storageConfig,
 	}
 }
@@ -0,0 +1,1878 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/chapter_lesson"
+	"github.com/abu-umair/be-lms-go/pb/common"
+	"google.golang.org/grpc/codes"
+)
+
+const testLessonId = "7f3c2b8e-1a4d-4c6b-8e2f-9d0a1b2c3d4e"
+
+type lessonFixture struct {
+	root        string
+	lessons     *fake.ChapterLessonRepository
+	chapters    *fake.CourseChapterRepository
+	courses     *fake.CourseRepository
+	enrollments *fake.EnrollmentRepository
+	rules       *fake.ReleaseRuleRepository
+	completions *fake.LessonCompletionRepository
+	transcripts *fake.LessonTranscriptRepository
+	quizzes     *fake.QuizRepository
+	attempts    *fake.QuizAttemptRepository
+}
+
+func newLessonFixture(t *testing.T) *lessonFixture {
+	t.Helper()
+
+	f := &lessonFixture{
+		root:        t.TempDir(),
+		lessons:     fake.NewChapterLessonRepository(),
+		chapters:    fake.NewCourseChapterRepository(),
+		courses:     fake.NewCourseRepository(),
+		enrollments: fake.NewEnrollmentRepository(),
+		quizzes:     fake.NewQuizRepository(),
+		attempts:    fake.NewQuizAttemptRepository(),
+	}
+	f.rules = fake.NewReleaseRuleRepository(f.chapters, f.lessons)
+	f.completions = fake.NewLessonCompletionRepository(f.lessons)
+	f.transcripts = fake.NewLessonTranscriptRepository(f.lessons)
+
+	return f
+}
+
+func (f *lessonFixture) releaseService() IReleaseService {
+	return NewReleaseService(f.courses, f.lessons, f.enrollments, f.rules, f.completions, f.quizzes, f.attempts)
+}
+
+func (f *lessonFixture) service(t *testing.T, expect txExpectation) IChapterLessonService {
+	t.Helper()
+
+	resolver, err := storage.NewResolver(f.root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewChapterLessonService(newMockDB(t, expect), f.lessons, f.chapters, f.courses, f.enrollments, f.rules, f.completions, f.transcripts, f.releaseService(), resolver, testStorageConfig)
+}
+
+// addLesson menyimpan lesson upload "lesson_1.mp4", modify bisa mengubah field sebelum disimpan
+func (f *lessonFixture) addLesson(modify func(lesson *entity.ChapterLesson)) {
+	lesson := entity.ChapterLesson{
+		Id:            testLessonId,
+		CourseId:      utils.StringToPtr(testCourseId),
+		Title:         "Variables",
+		OrderLesson:   1,
+		FilePath:      utils.StringToPtr("lesson_1.mp4"),
+		StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+		CreatedAt:     time.Now(),
+		CreatedBy:     "Test User",
+	}
+	if modify != nil {
+		modify(&lesson)
+	}
+
+	f.lessons.AddChapterLesson(lesson)
+}
+
+// testCaptionVTT: caption 2 cue, transcript-nya dipakai test pencarian
+const testCaptionVTT = "WEBVTT\n\n00:00:01.000 --> 00:00:03.000\nDeklarasi <i>variabel</i> di Go\n\n00:00:04.000 --> 00:00:06.500\nKonstanta tidak bisa diubah\n"
+
+// writeCaption menyimpan file caption WebVTT di storage/<course_id>/lesson
+func (f *lessonFixture) writeCaption(t *testing.T, courseId string, fileName string, content string) {
+	t.Helper()
+
+	folder := path.Join(f.root, courseId, storage.FolderLesson)
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(folder, fileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// addCourse menyimpan course testCourseId milik testUserId (jika belum ada)
+func (f *lessonFixture) addCourse() {
+	if _, ok := f.courses.Course(testCourseId); !ok {
+		f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUserId)})
+	}
+}
+
+// addPreviewLesson: lesson preview aktif di chapter aktif, course-nya active & approved
+func (f *lessonFixture) addPreviewLesson(modify func(lesson *entity.ChapterLesson)) {
+	f.courses.AddCourse(entity.Course{
+		Id:           testCourseId,
+		InstructorId: utils.StringToPtr(testUserId),
+		Status:       utils.StringToPtr(entity.CourseStatusActive),
+		IsApproved:   utils.StringToPtr(entity.CourseApproved),
+	})
+	addTestCourseChapter(f.chapters)
+	f.addLesson(func(lesson *entity.ChapterLesson) {
+		isPreview := int64(entity.LessonIsPreview)
+		lesson.IsPreview = &isPreview
+		lesson.Status = utils.StringToPtr(entity.LessonStatusActive)
+		lesson.ChapterId = utils.StringToPtr(testChapterId)
+		if modify != nil {
+			modify(lesson)
+		}
+	})
+}
+
+// enroll: course ikut disimpan (aturan rilis dibaca sesuai timezone course)
+func (f *lessonFixture) enroll() {
+	f.addCourse()
+	f.enrollments.AddEnrollment(entity.Enrollment{
+		Id:         "enrollment-1",
+		UserId:     testUserId,
+		CourseId:   testCourseId,
+		EnrolledAt: time.Now(),
+	})
+}
+
+func TestChapterLessonServiceCreateChapterLesson(t *testing.T) {
+	validRequest := func() *chapter_lesson.CreateChapterLessonRequest {
+		return &chapter_lesson.CreateChapterLessonRequest{
+			InstructorId:  utils.StringToPtr(testOtherUserId), //? diabaikan, pemilik diambil dari token
+			CourseId:      utils.StringToPtr(testCourseId),
+			Title:         "Variables",
+			OrderLesson:   1,
+			FilePath:      utils.StringToPtr("lesson_1.mp4"),
+			StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		request  func() *chapter_lesson.CreateChapterLessonRequest
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			request:  validRequest,
+			tx:       txNone,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			request:  validRequest,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "upload file path with traversal",
+			ctx:  contextInstructor,
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := validRequest()
+				r.FilePath = utils.StringToPtr("../../other/lesson/lesson_1.mp4")
+				return r
+			},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "upload without course id",
+			ctx:  contextInstructor,
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := validRequest()
+				r.CourseId = nil
+				return r
+			},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(f *lessonFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testOtherUserId)})
+			},
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "chapter of another course",
+			setup: func(f *lessonFixture) {
+				f.chapters.AddCourseChapter(entity.CourseChapter{Id: testOtherChapterId, CourseId: testOtherCourseId, OrderChapter: 1})
+			},
+			ctx: contextInstructor,
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := validRequest()
+				r.ChapterId = utils.StringToPtr(testOtherChapterId)
+				return r
+			},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "deleted chapter",
+			setup: func(f *lessonFixture) {
+				addTestCourseChapter(f.chapters)
+				f.chapters.DeleteCourseChapter(context.Background(), testChapterId, time.Now(), "Test User")
+			},
+			ctx: contextInstructor,
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := validRequest()
+				r.ChapterId = utils.StringToPtr(testChapterId)
+				return r
+			},
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "external link is not validated as storage path",
+			ctx:  contextInstructor,
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := validRequest()
+				r.StorageLesson = utils.StringToPtr("youtube")
+				r.FilePath = utils.StringToPtr("https://youtu.be/abc?t=1")
+				return r
+			},
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "repository write error",
+			setup:    func(f *lessonFixture) { f.lessons.WriteErr = errDatabase },
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name: "order already used in chapter",
+			setup: func(f *lessonFixture) {
+				addTestCourseChapter(f.chapters)
+				f.addLesson(func(lesson *entity.ChapterLesson) { lesson.ChapterId = utils.StringToPtr(testChapterId) })
+			},
+			ctx: contextInstructor,
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := validRequest()
+				r.ChapterId = utils.StringToPtr(testChapterId)
+				return r
+			},
+			tx:       txRollback,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "success",
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addCourse()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			_, err := f.service(t, tt.tx).CreateChapterLesson(tt.ctx, tt.request())
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			lessons := f.lessons.ChapterLessons()
+			if len(lessons) != 1 {
+				t.Fatalf("stored %d lessons, want 1", len(lessons))
+			}
+			if lessons[0].Title != "Variables" || lessons[0].CreatedBy != "Test User" {
+				t.Errorf("unexpected lesson: %+v", lessons[0])
+			}
+			//? instructor_id selalu dari token, bukan dari request
+			if lessons[0].InstructorId == nil || *lessons[0].InstructorId != testUserId {
+				t.Errorf("instructor_id = %v, want %s", lessons[0].InstructorId, testUserId)
+			}
+		})
+	}
+}
+
+func videoContent(captions ...*chapter_lesson.VideoCaption) *chapter_lesson.LessonContent {
+	return &chapter_lesson.LessonContent{Kind: &chapter_lesson.LessonContent_Video{Video: &chapter_lesson.VideoLesson{
+		Source:          &chapter_lesson.VideoLesson_FileName{FileName: "lesson_1.mp4"},
+		DurationSeconds: 90,
+		Captions:        captions,
+	}}}
+}
+
+func TestChapterLessonServiceCreateTypedLesson(t *testing.T) {
+	request := func(content *chapter_lesson.LessonContent) *chapter_lesson.CreateChapterLessonRequest {
+		return &chapter_lesson.CreateChapterLessonRequest{
+			CourseId:    utils.StringToPtr(testCourseId),
+			Title:       "Variables",
+			OrderLesson: 1,
+			Content:     content,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *lessonFixture)
+		request  *chapter_lesson.CreateChapterLessonRequest
+		tx       txExpectation
+		wantCode codes.Code
+		want     entity.ChapterLesson //? hanya kind & kolom lama yang diturunkan yang dicek
+	}{
+		{
+			name: "video upload",
+			setup: func(t *testing.T, f *lessonFixture) {
+				f.writeCaption(t, testCourseId, "caption_1.vtt", testCaptionVTT)
+			},
+			request:  request(videoContent(&chapter_lesson.VideoCaption{Language: "en", FileName: "caption_1.vtt", Url: "https://example.com/old"})),
+			tx:       txCommit,
+			wantCode: codes.OK,
+			want: entity.ChapterLesson{
+				Kind:          utils.StringToPtr(entity.LessonKindVideo),
+				FilePath:      utils.StringToPtr("lesson_1.mp4"),
+				StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+				Duration:      utils.StringToPtr("90"),
+			},
+		},
+		{
+			name: "video url",
+			request: request(&chapter_lesson.LessonContent{Kind: &chapter_lesson.LessonContent_Video{Video: &chapter_lesson.VideoLesson{
+				Source:          &chapter_lesson.VideoLesson_Url{Url: "https://youtu.be/abc"},
+				DurationSeconds: 60,
+			}}}),
+			tx:       txCommit,
+			wantCode: codes.OK,
+			want: entity.ChapterLesson{
+				Kind:          utils.StringToPtr(entity.LessonKindVideo),
+				FilePath:      utils.StringToPtr("https://youtu.be/abc"),
+				StorageLesson: utils.StringToPtr(entity.LessonStorageExternal),
+				Duration:      utils.StringToPtr("60"),
+			},
+		},
+		{
+			name: "article has no file",
+			request: request(&chapter_lesson.LessonContent{Kind: &chapter_lesson.LessonContent_Article{Article: &chapter_lesson.ArticleLesson{
+				Body: "<p>Hello</p>",
+			}}}),
+			tx:       txCommit,
+			wantCode: codes.OK,
+			want:     entity.ChapterLesson{Kind: utils.StringToPtr(entity.LessonKindArticle)},
+		},
+		{
+			name: "file download",
+			request: request(&chapter_lesson.LessonContent{Kind: &chapter_lesson.LessonContent_File{File: &chapter_lesson.FileLesson{
+				FileName: "lesson_2.pdf",
+			}}}),
+			tx:       txCommit,
+			wantCode: codes.OK,
+			want: entity.ChapterLesson{
+				Kind:          utils.StringToPtr(entity.LessonKindFile),
+				FilePath:      utils.StringToPtr("lesson_2.pdf"),
+				StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+				FileType:      utils.StringToPtr("pdf"),
+			},
+		},
+		{
+			name: "legacy fields together with content",
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := request(videoContent())
+				r.FilePath = utils.StringToPtr("lesson_2.mp4")
+				r.Duration = utils.StringToPtr("10")
+				return r
+			}(),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "duplicate caption language",
+			request: request(videoContent(
+				&chapter_lesson.VideoCaption{Language: "en", FileName: "caption_1.vtt"},
+				&chapter_lesson.VideoCaption{Language: "en", FileName: "caption_2.vtt"},
+			)),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "caption file not uploaded",
+			request:  request(videoContent(&chapter_lesson.VideoCaption{Language: "en", FileName: "caption_1.vtt"})),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "caption file with invalid timing",
+			setup: func(t *testing.T, f *lessonFixture) {
+				f.writeCaption(t, testCourseId, "caption_1.vtt", "WEBVTT\n\n00:00:05.000 --> 00:00:02.000\nHalo\n")
+			},
+			request:  request(videoContent(&chapter_lesson.VideoCaption{Language: "en", FileName: "caption_1.vtt"})),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "content file without course id",
+			request: func() *chapter_lesson.CreateChapterLessonRequest {
+				r := request(videoContent())
+				r.CourseId = nil
+				return r
+			}(),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addCourse()
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			_, err := f.service(t, tt.tx).CreateChapterLesson(contextInstructor, tt.request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			lessons := f.lessons.ChapterLessons()
+			if len(lessons) != 1 {
+				t.Fatalf("stored %d lessons, want 1", len(lessons))
+			}
+			got := lessons[0]
+			value := func(p *string) string {
+				if p == nil {
+					return "<nil>"
+				}
+				return *p
+			}
+			for field, pair := range map[string][2]*string{
+				"kind":           {got.Kind, tt.want.Kind},
+				"lesson_type":    {got.LessonType, tt.want.Kind},
+				"file_path":      {got.FilePath, tt.want.FilePath},
+				"storage_lesson": {got.StorageLesson, tt.want.StorageLesson},
+				"duration":       {got.Duration, tt.want.Duration},
+				"file_type":      {got.FileType, tt.want.FileType},
+			} {
+				if !equalStringPtr(pair[0], pair[1]) {
+					t.Errorf("%s = %s, want %s", field, value(pair[0]), value(pair[1]))
+				}
+			}
+
+			//? content tersimpan bisa dibaca kembali sbg jenis yang sama
+			content, err := parseLessonContent(got.Content)
+			if err != nil || content == nil || lessonContentKind(content) != *tt.want.Kind {
+				t.Errorf("content = %v (%v), want kind %s", content, err, *tt.want.Kind)
+			}
+
+			//? caption: url tidak disimpan, teks cue diindex utk pencarian
+			if captions := content.GetVideo().GetCaptions(); len(captions) > 0 {
+				if captions[0].Url != "" {
+					t.Errorf("stored caption url = %q, want empty", captions[0].Url)
+				}
+				cues := f.transcripts.LessonTranscript(got.Id)
+				if len(cues) != 2 || cues[0].Text != "Deklarasi variabel di Go" || cues[0].Language != "en" || cues[1].StartMs != 4000 {
+					t.Errorf("transcript = %+v, want 2 english cues", cues)
+				}
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceDetailChapterLesson(t *testing.T) {
+	const (
+		wantSigned = "signed"
+		wantNone   = "none"
+	)
+
+	tests := []struct {
+		name         string
+		setup        func(f *lessonFixture)
+		ctx          context.Context
+		wantCode     codes.Code
+		wantFilePath string //? wantSigned, wantNone, atau nilai persis
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "user not enrolled",
+			setup:    func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:      contextUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "enrollment repository error",
+			setup: func(f *lessonFixture) {
+				f.addLesson(nil)
+				f.enrollments.ReadErr = errDatabase
+			},
+			ctx:      contextUser,
+			wantCode: codes.Unknown,
+		},
+		{
+			name: "lesson repository error",
+			setup: func(f *lessonFixture) {
+				f.lessons.ReadErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			wantCode: codes.Unknown,
+		},
+		{
+			name: "enrolled user gets signed url",
+			setup: func(f *lessonFixture) {
+				f.addLesson(nil)
+				f.enroll()
+			},
+			ctx:          contextUser,
+			wantCode:     codes.OK,
+			wantFilePath: wantSigned,
+		},
+		{
+			name:         "preview lesson without enrollment",
+			setup:        func(f *lessonFixture) { f.addPreviewLesson(nil) },
+			ctx:          contextUser,
+			wantCode:     codes.OK,
+			wantFilePath: wantSigned,
+		},
+		{
+			name: "inactive preview lesson",
+			setup: func(f *lessonFixture) {
+				f.addPreviewLesson(func(lesson *entity.ChapterLesson) { lesson.Status = utils.StringToPtr("inactive") })
+			},
+			ctx:      contextUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "preview lesson of unapproved course",
+			setup: func(f *lessonFixture) {
+				f.addPreviewLesson(nil)
+				stored, _ := f.courses.Course(testCourseId)
+				stored.IsApproved = utils.StringToPtr("pending")
+				f.courses.AddCourse(stored)
+			},
+			ctx:      contextUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "instructor gets signed url",
+			setup: func(f *lessonFixture) {
+				f.addCourse()
+				f.addLesson(nil)
+			},
+			ctx:          contextInstructor,
+			wantCode:     codes.OK,
+			wantFilePath: wantSigned,
+		},
+		{
+			name: "instructor of other course",
+			setup: func(f *lessonFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr("someone-else")})
+				f.addLesson(nil)
+			},
+			ctx:      contextInstructor,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:         "admin gets signed url",
+			setup:        func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:          contextAdmin,
+			wantCode:     codes.OK,
+			wantFilePath: wantSigned,
+		},
+		{
+			name: "external link is returned as is",
+			setup: func(f *lessonFixture) {
+				f.addCourse()
+				f.addLesson(func(lesson *entity.ChapterLesson) {
+					lesson.StorageLesson = utils.StringToPtr("youtube")
+					lesson.FilePath = utils.StringToPtr("https://youtu.be/abc")
+				})
+			},
+			ctx:          contextInstructor,
+			wantCode:     codes.OK,
+			wantFilePath: "https://youtu.be/abc",
+		},
+		{
+			name: "legacy unsafe path is never signed",
+			setup: func(f *lessonFixture) {
+				f.addCourse()
+				f.addLesson(func(lesson *entity.ChapterLesson) {
+					lesson.FilePath = utils.StringToPtr("../secret.mp4")
+				})
+			},
+			ctx:          contextInstructor,
+			wantCode:     codes.OK,
+			wantFilePath: wantNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, txNone).DetailChapterLesson(tt.ctx, &chapter_lesson.DetailChapterLessonRequest{Id: testLessonId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			switch tt.wantFilePath {
+			case wantNone:
+				if res.FilePath != nil {
+					t.Errorf("file_path = %q, want empty", res.GetFilePath())
+				}
+			case wantSigned:
+				assertSignedLessonURL(t, res.GetFilePath())
+			default:
+				if res.GetFilePath() != tt.wantFilePath {
+					t.Errorf("file_path = %q, want %q", res.GetFilePath(), tt.wantFilePath)
+				}
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceDetailTypedLesson(t *testing.T) {
+	f := newLessonFixture(t)
+	f.addCourse()
+	raw, err := marshalLessonContent(videoContent(&chapter_lesson.VideoCaption{Language: "id", Label: "Indonesia", FileName: "caption_1.vtt"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.addLesson(func(lesson *entity.ChapterLesson) {
+		lesson.Kind = utils.StringToPtr(entity.LessonKindVideo)
+		lesson.Content = raw
+	})
+
+	res, err := f.service(t, txNone).DetailChapterLesson(contextInstructor, &chapter_lesson.DetailChapterLessonRequest{Id: testLessonId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.GetKind() != entity.LessonKindVideo {
+		t.Errorf("kind = %q, want video", res.GetKind())
+	}
+	video := res.GetContent().GetVideo()
+	if video.GetFileName() != "lesson_1.mp4" || video.GetDurationSeconds() != 90 {
+		t.Errorf("video = %v, want lesson_1.mp4 of 90 seconds", video)
+	}
+	if captions := video.GetCaptions(); len(captions) != 1 || captions[0].Language != "id" || captions[0].Label != "Indonesia" {
+		t.Errorf("captions = %v, want one indonesian track", captions)
+	}
+	//? file upload tetap dikirim sbg signed URL lewat file_path
+	assertSignedLessonURL(t, res.GetFilePath())
+	assertSignedLessonFileURL(t, video.GetCaptions()[0].GetUrl(), "caption_1.vtt", testUserId)
+}
+
+// assertSignedLessonURL memastikan URL bisa diverifikasi oleh handler storage REST
+func assertSignedLessonURL(t *testing.T, signedURL string) {
+	t.Helper()
+
+	assertSignedLessonFileURL(t, signedURL, "lesson_1.mp4", testUserId)
+}
+
+func assertSignedLessonFileURL(t *testing.T, signedURL string, fileName string, wantUid string) {
+	t.Helper()
+
+	storagePath := path.Join(testCourseId, storage.FolderLesson, fileName)
+	if !strings.HasPrefix(signedURL, testStorageConfig.ServiceURL+"/"+storagePath+"?") {
+		t.Fatalf("file_path = %q, want signed url for %s", signedURL, storagePath)
+	}
+
+	parsed, err := url.Parse(signedURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := parsed.Query()
+	if query.Get("uid") != wantUid {
+		t.Errorf("uid = %q, want %q", query.Get("uid"), wantUid)
+	}
+	if err := utils.VerifyStorageSignature(storagePath, query.Get("uid"), query.Get("expires"), query.Get("sig"), testStorageConfig.SigningSecret); err != nil {
+		t.Errorf("signature is not valid: %v", err)
+	}
+}
+
+func TestChapterLessonServiceEditChapterLesson(t *testing.T) {
+	validRequest := func() *chapter_lesson.EditChapterLessonRequest {
+		return &chapter_lesson.EditChapterLessonRequest{
+			Id:            testLessonId,
+			CourseId:      utils.StringToPtr(testCourseId),
+			Title:         "Constants",
+			OrderLesson:   2,
+			FilePath:      utils.StringToPtr("lesson_2.mp4"),
+			StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		request  func() *chapter_lesson.EditChapterLessonRequest
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			setup:    func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:      contextUser,
+			request:  validRequest,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(f *lessonFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testOtherUserId)})
+				f.addLesson(nil)
+			},
+			ctx: contextInstructor,
+			request: func() *chapter_lesson.EditChapterLessonRequest {
+				r := validRequest()
+				isPreview := int64(entity.LessonIsPreview)
+				r.IsPreview = &isPreview
+				return r
+			},
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "upload file path with separator",
+			setup: func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:   contextInstructor,
+			request: func() *chapter_lesson.EditChapterLessonRequest {
+				r := validRequest()
+				r.FilePath = utils.StringToPtr("lesson/lesson_2.mp4")
+				return r
+			},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "chapter change must use move",
+			setup: func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:   contextInstructor,
+			request: func() *chapter_lesson.EditChapterLessonRequest {
+				r := validRequest()
+				r.ChapterId = utils.StringToPtr(testChapterId)
+				return r
+			},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "repository write error",
+			setup: func(f *lessonFixture) {
+				f.addLesson(nil)
+				f.lessons.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			setup:    func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addCourse()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			_, err := f.service(t, tt.tx).EditChapterLesson(tt.ctx, tt.request())
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := f.lessons.ChapterLesson(testLessonId)
+			if stored.Title != "Constants" || stored.FilePath == nil || *stored.FilePath != "lesson_2.mp4" {
+				t.Errorf("lesson not updated: %+v", stored)
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceDeleteChapterLesson(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			setup:    func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(f *lessonFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testOtherUserId)})
+				f.addLesson(nil)
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "repository write error",
+			setup: func(f *lessonFixture) {
+				f.addCourse()
+				f.addLesson(nil)
+				f.lessons.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name: "success",
+			setup: func(f *lessonFixture) {
+				f.addCourse()
+				f.addLesson(nil)
+			},
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			_, err := f.service(t, tt.tx).DeleteChapterLesson(tt.ctx, &chapter_lesson.DeleteChapterLessonRequest{Id: testLessonId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := f.lessons.ChapterLesson(testLessonId)
+			if stored.DeletedAt == nil {
+				t.Error("lesson not soft deleted")
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceRestoreChapterLesson(t *testing.T) {
+	//? lesson dihapus, lalu order 1-nya dipakai lesson lain
+	deleteLesson := func(f *lessonFixture) {
+		f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUserId)})
+		addTestCourseChapter(f.chapters)
+		f.addLesson(func(lesson *entity.ChapterLesson) { lesson.ChapterId = utils.StringToPtr(testChapterId) })
+		f.lessons.DeleteChapterLesson(context.Background(), testLessonId, time.Now(), "Test User")
+		f.lessons.AddChapterLesson(entity.ChapterLesson{Id: testOtherLessonId, ChapterId: utils.StringToPtr(testChapterId), CourseId: utils.StringToPtr(testCourseId), OrderLesson: 1})
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			setup:    deleteLesson,
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "lesson not in trash",
+			setup:    func(f *lessonFixture) { f.addLesson(nil) },
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "chapter in trash",
+			setup: func(f *lessonFixture) {
+				deleteLesson(f)
+				f.chapters.DeleteCourseChapter(context.Background(), testChapterId, time.Now(), "Test User")
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "repository write error",
+			setup: func(f *lessonFixture) {
+				deleteLesson(f)
+				f.lessons.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			setup:    deleteLesson,
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			_, err := f.service(t, tt.tx).RestoreChapterLesson(tt.ctx, &chapter_lesson.RestoreChapterLessonRequest{Id: testLessonId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			//? order lama sudah dipakai, lesson dipindah ke akhir chapter
+			stored, _ := f.lessons.ChapterLesson(testLessonId)
+			if stored.DeletedAt != nil || stored.OrderLesson != 2 {
+				t.Errorf("lesson deleted_at = %v, order = %d, want restored at order 2", stored.DeletedAt, stored.OrderLesson)
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceReorderLessons(t *testing.T) {
+	lessonIds := []string{
+		"2a4c6e8f-1b3d-4f5a-8c7e-9d0b1a2c3e4f",
+		"4b6d8f0a-2c4e-4a6b-9d8f-0e1c2b3d4f5a",
+		"6c8e0a2b-3d5f-4b7c-8e9a-1f2d3c4e5a6b",
+	}
+	addLessons := func(f *lessonFixture) {
+		for i, id := range lessonIds {
+			f.lessons.AddChapterLesson(entity.ChapterLesson{Id: id, ChapterId: utils.StringToPtr(testChapterId), OrderLesson: int64(i + 1)})
+		}
+	}
+	reordered := []string{lessonIds[1], lessonIds[2], lessonIds[0]}
+
+	tests := []struct {
+		name       string
+		setup      func(f *lessonFixture)
+		ctx        context.Context
+		orderedIds []string
+		tx         txExpectation
+		wantCode   codes.Code
+	}{
+		{
+			name:       "user role",
+			setup:      addLessons,
+			ctx:        contextUser,
+			orderedIds: reordered,
+			tx:         txNone,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(f *lessonFixture) {
+				addLessons(f)
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testOtherUserId)})
+			},
+			ctx:        contextInstructor,
+			orderedIds: reordered,
+			tx:         txNone,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "chapter without lessons",
+			ctx:        contextInstructor,
+			orderedIds: reordered,
+			tx:         txRollback,
+			wantCode:   codes.NotFound,
+		},
+		{
+			name:       "id set mismatch",
+			setup:      addLessons,
+			ctx:        contextInstructor,
+			orderedIds: []string{lessonIds[1], lessonIds[2], testLessonId},
+			tx:         txRollback,
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name: "repository write error",
+			setup: func(f *lessonFixture) {
+				addLessons(f)
+				f.lessons.WriteErr = errDatabase
+			},
+			ctx:        contextInstructor,
+			orderedIds: reordered,
+			tx:         txRollback,
+			wantCode:   codes.Unknown,
+		},
+		{
+			name:       "success",
+			setup:      addLessons,
+			ctx:        contextInstructor,
+			orderedIds: reordered,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addCourse()
+			addTestCourseChapter(f.chapters)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			_, err := f.service(t, tt.tx).ReorderLessons(tt.ctx, &chapter_lesson.ReorderLessonsRequest{ChapterId: testChapterId, OrderedIds: tt.orderedIds})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			for i, id := range tt.orderedIds {
+				stored, _ := f.lessons.ChapterLesson(id)
+				if stored.OrderLesson != int64(i+1) {
+					t.Errorf("lesson %s order = %d, want %d", id, stored.OrderLesson, i+1)
+				}
+			}
+		})
+	}
+}
+
+const (
+	testOtherCourseId  = "4a7b9c1d-2e3f-4a5b-8c6d-7e8f9a0b1c2d"
+	testOtherChapterId = "8b9c0d1e-2f3a-4b5c-9d6e-0f1a2b3c4d5e"
+	testOtherLessonId  = "9c0d1e2f-3a4b-4c5d-8e6f-1a2b3c4d5e6f"
+)
+
+// transferLessonIds: lesson di chapter testChapterId (urut), yang pertama punya file upload lesson_1.mp4
+var transferLessonIds = []string{
+	testLessonId,
+	"1d2e3f4a-5b6c-4d7e-8f9a-0b1c2d3e4f5a",
+	"2e3f4a5b-6c7d-4e8f-9a0b-1c2d3e4f5a6b",
+}
+
+// addTransferData menyiapkan 2 course milik testUserId: course A (3 lesson di testChapterId) & course B (1 lesson di testOtherChapterId)
+func (f *lessonFixture) addTransferData(t *testing.T) {
+	t.Helper()
+
+	owner := testUserId
+	f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: &owner})
+	f.courses.AddCourse(entity.Course{Id: testOtherCourseId, InstructorId: &owner})
+	f.chapters.AddCourseChapter(entity.CourseChapter{Id: testChapterId, CourseId: testCourseId, OrderChapter: 1})
+	f.chapters.AddCourseChapter(entity.CourseChapter{Id: testOtherChapterId, CourseId: testOtherCourseId, OrderChapter: 1})
+
+	for i, id := range transferLessonIds {
+		f.addLesson(func(lesson *entity.ChapterLesson) {
+			lesson.Id = id
+			lesson.ChapterId = utils.StringToPtr(testChapterId)
+			lesson.OrderLesson = int64(i + 1)
+			if i > 0 {
+				lesson.FilePath = nil
+				lesson.StorageLesson = nil
+			}
+		})
+	}
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:          testOtherLessonId,
+		CourseId:    utils.StringToPtr(testOtherCourseId),
+		ChapterId:   utils.StringToPtr(testOtherChapterId),
+		OrderLesson: 1,
+	})
+
+	dir := path.Join(f.root, testCourseId, storage.FolderLesson)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(dir, "lesson_1.mp4"), []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// chapterOrder mengembalikan id lesson aktif di chapter, urut order_lesson
+func (f *lessonFixture) chapterOrder(t *testing.T, chapterId string) []string {
+	t.Helper()
+
+	ids, err := f.lessons.GetChapterLessonIdsForUpdate(context.Background(), chapterId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ids
+}
+
+// lessonFiles mengembalikan nama file di storage/<courseId>/lesson
+func (f *lessonFixture) lessonFiles(t *testing.T, courseId string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(path.Join(f.root, courseId, storage.FolderLesson))
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	return names
+}
+
+func assertOrder(t *testing.T, got []string, want []string) {
+	t.Helper()
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+}
+
+func TestChapterLessonServiceMoveLesson(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		request  *chapter_lesson.MoveLessonRequest
+		tx       txExpectation
+		wantCode codes.Code
+		check    func(t *testing.T, f *lessonFixture, res *chapter_lesson.MoveLessonResponse)
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId},
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "lesson not found",
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testOtherChapterId, TargetChapterId: testOtherChapterId},
+			tx:       txRollback,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "target chapter not found",
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testLessonId, TargetChapterId: testOtherLessonId},
+			tx:       txRollback,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "target chapter of another course",
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId, TargetCourseId: utils.StringToPtr(testCourseId)},
+			tx:       txRollback,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "target course owned by another instructor",
+			setup: func(f *lessonFixture) {
+				f.courses.AddCourse(entity.Course{Id: testOtherCourseId, InstructorId: utils.StringToPtr("someone-else")})
+			},
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId},
+			tx:       txRollback,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "within chapter",
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testLessonId, TargetChapterId: testChapterId, Position: utils.Int64ToPtr(2)},
+			tx:       txCommit,
+			wantCode: codes.OK,
+			check: func(t *testing.T, f *lessonFixture, res *chapter_lesson.MoveLessonResponse) {
+				assertOrder(t, f.chapterOrder(t, testChapterId), []string{transferLessonIds[1], testLessonId, transferLessonIds[2]})
+				if res.OrderLesson != 2 {
+					t.Errorf("order_lesson = %d, want 2", res.OrderLesson)
+				}
+				if files := f.lessonFiles(t, testCourseId); len(files) != 1 || files[0] != "lesson_1.mp4" {
+					t.Errorf("files = %v, want lesson file untouched", files)
+				}
+			},
+		},
+		{
+			name:     "to another course",
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId, Position: utils.Int64ToPtr(1)},
+			tx:       txCommit,
+			wantCode: codes.OK,
+			check: func(t *testing.T, f *lessonFixture, res *chapter_lesson.MoveLessonResponse) {
+				assertOrder(t, f.chapterOrder(t, testOtherChapterId), []string{testLessonId, testOtherLessonId})
+				assertOrder(t, f.chapterOrder(t, testChapterId), transferLessonIds[1:])
+
+				moved, _ := f.lessons.ChapterLesson(testLessonId)
+				if res.CourseId != testOtherCourseId || moved.CourseId == nil || *moved.CourseId != testOtherCourseId {
+					t.Errorf("course = %q / %v, want %s", res.CourseId, moved.CourseId, testOtherCourseId)
+				}
+
+				//? file ikut pindah ke folder course tujuan, file lama dihapus setelah commit
+				if files := f.lessonFiles(t, testCourseId); len(files) != 0 {
+					t.Errorf("source files = %v, want none", files)
+				}
+				if files := f.lessonFiles(t, testOtherCourseId); len(files) != 1 || files[0] != *moved.FilePath {
+					t.Errorf("target files = %v, want [%s]", files, *moved.FilePath)
+				}
+			},
+		},
+		{
+			name:     "repository write error keeps files",
+			setup:    func(f *lessonFixture) { f.lessons.WriteErr = errDatabase },
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.MoveLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId},
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+			check: func(t *testing.T, f *lessonFixture, res *chapter_lesson.MoveLessonResponse) {
+				if files := f.lessonFiles(t, testCourseId); len(files) != 1 {
+					t.Errorf("source files = %v, want lesson file kept", files)
+				}
+				if files := f.lessonFiles(t, testOtherCourseId); len(files) != 0 {
+					t.Errorf("target files = %v, want copy removed", files)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addTransferData(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, tt.tx).MoveLesson(tt.ctx, tt.request)
+			assertCode(t, err, tt.wantCode)
+			if tt.check != nil {
+				tt.check(t, f, res)
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceCopyLesson(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		request  *chapter_lesson.CopyLessonRequest
+		tx       txExpectation
+		wantCode codes.Code
+		check    func(t *testing.T, f *lessonFixture, res *chapter_lesson.CopyLessonResponse)
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			request:  &chapter_lesson.CopyLessonRequest{Id: testLessonId, TargetChapterId: testChapterId},
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "lesson file missing",
+			setup: func(f *lessonFixture) {
+				if err := os.Remove(path.Join(f.root, testCourseId, storage.FolderLesson, "lesson_1.mp4")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.CopyLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId},
+			tx:       txRollback,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "into same chapter",
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.CopyLessonRequest{Id: testLessonId, TargetChapterId: testChapterId},
+			tx:       txCommit,
+			wantCode: codes.OK,
+			check: func(t *testing.T, f *lessonFixture, res *chapter_lesson.CopyLessonResponse) {
+				assertOrder(t, f.chapterOrder(t, testChapterId), append(append([]string{}, transferLessonIds...), res.Id))
+				if res.OrderLesson != 4 {
+					t.Errorf("order_lesson = %d, want 4", res.OrderLesson)
+				}
+
+				//? salinan punya file sendiri, file asal tetap ada
+				copied, _ := f.lessons.ChapterLesson(res.Id)
+				if copied.FilePath == nil || *copied.FilePath == "lesson_1.mp4" {
+					t.Fatalf("copied file path = %v, want a new file", copied.FilePath)
+				}
+				if files := f.lessonFiles(t, testCourseId); len(files) != 2 {
+					t.Errorf("files = %v, want original and copy", files)
+				}
+				if copied.Title != "Variables" || copied.CreatedBy != "Test User" {
+					t.Errorf("unexpected copy: %+v", copied)
+				}
+			},
+		},
+		{
+			name: "typed video copies captions with new names",
+			setup: func(f *lessonFixture) {
+				lesson, _ := f.lessons.ChapterLesson(testLessonId)
+				lesson.Kind = utils.StringToPtr(entity.LessonKindVideo)
+				lesson.Content, _ = marshalLessonContent(videoContent(&chapter_lesson.VideoCaption{Language: "en", FileName: "caption_1.vtt"}))
+				f.lessons.AddChapterLesson(lesson)
+				f.writeCaption(t, testCourseId, "caption_1.vtt", testCaptionVTT)
+			},
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.CopyLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId},
+			tx:       txCommit,
+			wantCode: codes.OK,
+			check: func(t *testing.T, f *lessonFixture, res *chapter_lesson.CopyLessonResponse) {
+				copied, _ := f.lessons.ChapterLesson(res.Id)
+				content, err := parseLessonContent(copied.Content)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				//? content menunjuk ke salinan di course tujuan, bukan file course asal
+				video := content.GetVideo()
+				if video.GetFileName() != *copied.FilePath {
+					t.Errorf("video file = %q, want file_path %q", video.GetFileName(), *copied.FilePath)
+				}
+				captionFile := video.GetCaptions()[0].FileName
+				if captionFile == "caption_1.vtt" {
+					t.Errorf("caption file is not renamed")
+				}
+				files := f.lessonFiles(t, testOtherCourseId)
+				assertOrder(t, files, []string{captionFile, *copied.FilePath})
+				if files := f.lessonFiles(t, testCourseId); len(files) != 2 {
+					t.Errorf("source files = %v, want video and caption kept", files)
+				}
+				if cues := f.transcripts.LessonTranscript(res.Id); len(cues) != 2 {
+					t.Errorf("copied transcript = %+v, want 2 cues", cues)
+				}
+			},
+		},
+		{
+			name:     "to another course at first position",
+			ctx:      contextInstructor,
+			request:  &chapter_lesson.CopyLessonRequest{Id: testLessonId, TargetChapterId: testOtherChapterId, Position: utils.Int64ToPtr(1)},
+			tx:       txCommit,
+			wantCode: codes.OK,
+			check: func(t *testing.T, f *lessonFixture, res *chapter_lesson.CopyLessonResponse) {
+				assertOrder(t, f.chapterOrder(t, testOtherChapterId), []string{res.Id, testOtherLessonId})
+				assertOrder(t, f.chapterOrder(t, testChapterId), transferLessonIds)
+
+				copied, _ := f.lessons.ChapterLesson(res.Id)
+				if files := f.lessonFiles(t, testOtherCourseId); len(files) != 1 || files[0] != *copied.FilePath {
+					t.Errorf("target files = %v, want [%s]", files, *copied.FilePath)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addTransferData(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, tt.tx).CopyLesson(tt.ctx, tt.request)
+			assertCode(t, err, tt.wantCode)
+			if tt.check != nil {
+				tt.check(t, f, res)
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceListChapterLessons(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		status   *string
+		wantCode codes.Code
+		wantIds  []string
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(f *lessonFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testOtherUserId)})
+			},
+			ctx:      contextInstructor,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "all lessons in order",
+			ctx:      contextInstructor,
+			wantCode: codes.OK,
+			wantIds:  []string{testLessonId, transferLessonIds[1], transferLessonIds[2]},
+		},
+		{
+			name:     "status filter",
+			ctx:      contextInstructor,
+			status:   utils.StringToPtr("draft"),
+			wantCode: codes.OK,
+			wantIds:  []string{transferLessonIds[2]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addTransferData(t)
+			for i, id := range transferLessonIds {
+				lesson, _ := f.lessons.ChapterLesson(id)
+				lesson.Status = utils.StringToPtr("active")
+				if i == 2 {
+					lesson.Status = utils.StringToPtr("draft")
+				}
+				f.lessons.AddChapterLesson(lesson)
+			}
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, txNone).ListChapterLessons(tt.ctx, &chapter_lesson.ListChapterLessonsRequest{ChapterId: testChapterId, Status: tt.status})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			var ids []string
+			for _, item := range res.Items {
+				ids = append(ids, item.Id)
+			}
+			assertOrder(t, ids, tt.wantIds)
+			if res.Items[0].Title != "Variables" || res.Items[0].GetChapterId() != testChapterId {
+				t.Errorf("unexpected item: %+v", res.Items[0])
+			}
+			if res.Items[0].GetId() == testLessonId {
+				//? file upload tidak pernah dikirim sbg path asli
+				assertSignedLessonURL(t, res.Items[0].GetFilePath())
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceDetailLockedLesson(t *testing.T) {
+	future := time.Date(2099, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	f := newLessonFixture(t)
+	f.addReleaseData()
+	f.enroll()
+	f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+
+	res, err := f.service(t, txNone).DetailChapterLesson(contextUser, &chapter_lesson.DetailChapterLessonRequest{Id: testLessonId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !res.GetRelease().GetLocked() || res.GetRelease().GetUnlockAt() != "2099-01-01T08:00:00+07:00" {
+		t.Errorf("release = %v, want locked until 2099-01-01T08:00:00+07:00", res.GetRelease())
+	}
+	if res.FilePath != nil || res.StorageLesson != nil || res.Content != nil {
+		t.Errorf("locked lesson served file_path = %q, storage = %q, content = %v", res.GetFilePath(), res.GetStorageLesson(), res.GetContent())
+	}
+
+	//? instructor tetap melihat isi lesson
+	res, err = f.service(t, txNone).DetailChapterLesson(contextInstructor, &chapter_lesson.DetailChapterLessonRequest{Id: testLessonId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetRelease().GetLocked() {
+		t.Errorf("instructor release = %v, want unlocked", res.GetRelease())
+	}
+	assertSignedLessonURL(t, res.GetFilePath())
+}
+
+func TestChapterLessonServiceSetLessonReleaseRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		ctx      context.Context
+		rule     *common.ReleaseRule
+		tx       txExpectation
+		wantCode codes.Code
+		wantRule *entity.ReleaseRule
+	}{
+		{
+			name:     "user cannot set rule",
+			ctx:      contextUser,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: 3}},
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "lesson not found",
+			setup: func(f *lessonFixture) {
+				f.lessons.DeleteChapterLesson(context.Background(), testLessonId, time.Now(), "Test User")
+			},
+			ctx:      contextInstructor,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: 3}},
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(f *lessonFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr("someone-else")})
+			},
+			ctx:      contextInstructor,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: 3}},
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "cycle is rejected",
+			ctx:      contextInstructor,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_PrerequisiteLessonId{PrerequisiteLessonId: testLessonId}},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "set prerequisite",
+			ctx:      contextInstructor,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_PrerequisiteLessonId{PrerequisiteLessonId: testOtherLessonId}},
+			tx:       txCommit,
+			wantCode: codes.OK,
+			wantRule: &entity.ReleaseRule{PrerequisiteLessonId: utils.StringToPtr(testOtherLessonId)},
+		},
+		{
+			name: "replace existing rule",
+			setup: func(f *lessonFixture) {
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+			},
+			ctx:      contextInstructor,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: 3}},
+			tx:       txCommit,
+			wantCode: codes.OK,
+			wantRule: &entity.ReleaseRule{DaysAfterEnrollment: utils.Int64ToPtr(3)},
+		},
+		{
+			name: "empty rule removes it",
+			setup: func(f *lessonFixture) {
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+			},
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+		{
+			name: "write error rolls back",
+			setup: func(f *lessonFixture) {
+				f.rules.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: 3}},
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addReleaseData()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			_, err := f.service(t, tt.tx).SetLessonReleaseRule(tt.ctx, &chapter_lesson.SetLessonReleaseRuleRequest{Id: testLessonId, Rule: tt.rule})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			rules := f.rules.ReleaseRules()
+			if tt.wantRule == nil {
+				if len(rules) != 0 {
+					t.Errorf("rules = %v, want none", rules)
+				}
+				return
+			}
+			if len(rules) != 1 {
+				t.Fatalf("rules = %v, want 1 rule", rules)
+			}
+			got := rules[0]
+			if got.LessonId == nil || *got.LessonId != testLessonId || got.CreatedBy != "Test User" {
+				t.Errorf("rule = %+v, want lesson %s created by Test User", got, testLessonId)
+			}
+			if !equalStringPtr(got.PrerequisiteLessonId, tt.wantRule.PrerequisiteLessonId) || (got.DaysAfterEnrollment == nil) != (tt.wantRule.DaysAfterEnrollment == nil) {
+				t.Errorf("rule = %+v, want %+v", got, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceCompleteLesson(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "not enrolled",
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "quiz lesson",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				lesson, _ := f.lessons.ChapterLesson(testLessonId)
+				lesson.Kind = utils.StringToPtr(entity.LessonKindQuiz)
+				f.lessons.AddChapterLesson(lesson)
+			},
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "locked lesson",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+			},
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "completed",
+			setup:    func(f *lessonFixture) { f.enroll() },
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+		{
+			name: "write error rolls back",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.completions.WriteErr = errDatabase
+			},
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addReleaseData()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, tt.tx).CompleteLesson(contextUser, &chapter_lesson.CompleteLessonRequest{Id: testLessonId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			completion, ok := f.completions.LessonCompletion(testLessonId, testUserId)
+			if !ok || res.CompletedAt != completion.CompletedAt.Format(time.RFC3339) {
+				t.Errorf("completed_at = %q, stored = %v", res.CompletedAt, completion)
+			}
+		})
+	}
+}
+
+func TestChapterLessonServiceGetCourseCurriculum(t *testing.T) {
+	f := newLessonFixture(t)
+	f.addReleaseData()
+	f.enroll()
+	f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+
+	res, err := f.service(t, txNone).GetCourseCurriculum(contextUser, &chapter_lesson.GetCourseCurriculumRequest{CourseId: testCourseId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Chapters) != 1 || len(res.Chapters[0].Lessons) != 2 {
+		t.Fatalf("chapters = %v, want 1 chapter with 2 lessons", res.Chapters)
+	}
+	intro, target := res.Chapters[0].Lessons[0], res.Chapters[0].Lessons[1]
+	if intro.Id != testOtherLessonId || intro.Release.GetLocked() || intro.Completed {
+		t.Errorf("intro = %v, want unlocked & not completed", intro)
+	}
+	if target.Id != testLessonId || !target.Release.GetLocked() || target.Release.GetPrerequisiteLessonId() != testOtherLessonId {
+		t.Errorf("target = %v, want locked by %s", target, testOtherLessonId)
+	}
+	if target.ReleaseRule != nil {
+		t.Errorf("learner sees release rule %v", target.ReleaseRule)
+	}
+
+	//* setelah prerequisite selesai lesson terbuka
+	_, err = f.service(t, txCommit).CompleteLesson(contextUser, &chapter_lesson.CompleteLessonRequest{Id: testOtherLessonId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = f.service(t, txNone).GetCourseCurriculum(contextUser, &chapter_lesson.GetCourseCurriculumRequest{CourseId: testCourseId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lessons := res.Chapters[0].Lessons; !lessons[0].Completed || lessons[1].Release.GetLocked() {
+		t.Errorf("lessons = %v, want intro completed & target unlocked", lessons)
+	}
+
+	//* instructor pemilik melihat aturan rilis
+	res, err = f.service(t, txNone).GetCourseCurriculum(contextInstructor, &chapter_lesson.GetCourseCurriculumRequest{CourseId: testCourseId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule := res.Chapters[0].Lessons[1].ReleaseRule; rule.GetPrerequisiteLessonId() != testOtherLessonId {
+		t.Errorf("release_rule = %v, want prerequisite %s", rule, testOtherLessonId)
+	}
+}
+
+func TestChapterLessonServiceSearchLessonTranscripts(t *testing.T) {
+	future := time.Date(2099, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setup       func(f *lessonFixture)
+		ctx         context.Context
+		request     *chapter_lesson.SearchLessonTranscriptsRequest
+		wantCode    codes.Code
+		wantLessons []string //? lesson_id setiap hit (fake mengurutkan per lesson_id lalu start)
+	}{
+		{
+			name:        "owner searches all lessons",
+			ctx:         contextInstructor,
+			request:     &chapter_lesson.SearchLessonTranscriptsRequest{CourseId: testCourseId, Query: "variabel"},
+			wantCode:    codes.OK,
+			wantLessons: []string{testLessonId, testOtherLessonId, testOtherLessonId},
+		},
+		{
+			name:        "filter by language",
+			ctx:         contextInstructor,
+			request:     &chapter_lesson.SearchLessonTranscriptsRequest{CourseId: testCourseId, Query: "variabel", Language: utils.StringToPtr("id")},
+			wantCode:    codes.OK,
+			wantLessons: []string{testOtherLessonId},
+		},
+		{
+			name: "learner does not see locked lesson",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+			},
+			ctx:         contextUser,
+			request:     &chapter_lesson.SearchLessonTranscriptsRequest{CourseId: testCourseId, Query: "variabel"},
+			wantCode:    codes.OK,
+			wantLessons: []string{testOtherLessonId, testOtherLessonId},
+		},
+		{
+			name: "limit applies after locked lessons are filtered",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+			},
+			ctx:         contextUser,
+			request:     &chapter_lesson.SearchLessonTranscriptsRequest{CourseId: testCourseId, Query: "variabel", Limit: utils.Int64ToPtr(1)},
+			wantCode:    codes.OK,
+			wantLessons: []string{testOtherLessonId},
+		},
+		{
+			name: "learner does not see inactive lesson",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				lesson, _ := f.lessons.ChapterLesson(testOtherLessonId)
+				lesson.Status = utils.StringToPtr("inactive")
+				f.lessons.AddChapterLesson(lesson)
+			},
+			ctx:         contextUser,
+			request:     &chapter_lesson.SearchLessonTranscriptsRequest{CourseId: testCourseId, Query: "variabel"},
+			wantCode:    codes.OK,
+			wantLessons: []string{testLessonId},
+		},
+		{
+			name:     "learner not enrolled",
+			ctx:      contextUser,
+			request:  &chapter_lesson.SearchLessonTranscriptsRequest{CourseId: testCourseId, Query: "variabel"},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addReleaseData()
+			for _, lessonId := range []string{testOtherLessonId, testLessonId} {
+				lesson, _ := f.lessons.ChapterLesson(lessonId)
+				lesson.Status = utils.StringToPtr(entity.LessonStatusActive)
+				f.lessons.AddChapterLesson(lesson)
+			}
+			f.transcripts.ReplaceLessonTranscript(context.Background(), testOtherLessonId, []*entity.LessonTranscriptCue{
+				{Id: "cue-1", LessonId: testOtherLessonId, Language: "en", StartMs: 1000, EndMs: 2000, Text: "Variabel di Go"},
+				{Id: "cue-2", LessonId: testOtherLessonId, Language: "id", StartMs: 1500, EndMs: 2500, Text: "Membuat variabel baru"},
+			})
+			f.transcripts.ReplaceLessonTranscript(context.Background(), testLessonId, []*entity.LessonTranscriptCue{
+				{Id: "cue-3", LessonId: testLessonId, Language: "en", StartMs: 0, EndMs: 1000, Text: "Variabel dan konstanta"},
+				{Id: "cue-4", LessonId: testLessonId, Language: "en", StartMs: 1000, EndMs: 2000, Text: "Tanpa kata kunci"},
+			})
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, txNone).SearchLessonTranscripts(tt.ctx, tt.request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			var got []string
+			for _, hit := range res.Hits {
+				got = append(got, hit.LessonId)
+			}
+			assertOrder(t, got, tt.wantLessons)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+)
+
+// ensureCourseOwner memastikan course ada & milik instructor yang login
+func ensureCourseOwner(ctx context.Context, courseRepository repository.ICourseRepository, claims *jwtentity.JwtClaims, courseId string) error {
+	courseEntity, err := courseRepository.GetCourseById(ctx, courseId)
+	if err != nil {
+		return err
+	}
+	if courseEntity == nil {
+		return apperror.NotFound("Course not found")
+	}
+	if courseEntity.InstructorId == nil || *courseEntity.InstructorId != claims.Subject {
+		return apperror.PermissionDenied("This resource can only be managed within your own courses")
+	}
+
+	return nil
+}
+
+func ensureEnrolled(ctx context.Context, enrollmentRepository repository.IEnrollmentRepository, claims *jwtentity.JwtClaims, courseId string) error {
+	enrollment, err := enrollmentRepository.GetEnrollment(ctx, courseId, claims.Subject)
+	if err != nil {
+		return err
+	}
+	if enrollment == nil {
+		return apperror.PermissionDenied("You are not enrolled in this course")
+	}
+
+	return nil
+}
+
+// courseAccess: true utk instructor pemilik course / admin (boleh review), false utk learner yang enroll
+func courseAccess(ctx context.Context, courseRepository repository.ICourseRepository, enrollmentRepository repository.IEnrollmentRepository, claims *jwtentity.JwtClaims, courseId string) (bool, error) {
+	switch claims.Role {
+	case entity.UserRoleAdmin:
+		return true, nil
+	case entity.UserRoleInstructor:
+		err := ensureCourseOwner(ctx, courseRepository, claims, courseId)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	err := ensureEnrolled(ctx, enrollmentRepository, claims, courseId)
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// ensureRestoreAccess: course tujuan restore harus aktif (tidak di trash) dan milik instructor yang login, admin boleh semua course
+func ensureRestoreAccess(ctx context.Context, courseRepository repository.ICourseRepository, claims *jwtentity.JwtClaims, courseId *string) error {
+	if courseId == nil {
+		if claims.Role == entity.UserRoleAdmin {
+			return nil
+		}
+		return apperror.PermissionDenied("This resource can only be managed within your own courses")
+	}
+
+	courseEntity, err := courseRepository.GetCourseById(ctx, *courseId)
+	if err != nil {
+		return err
+	}
+	if courseEntity == nil {
+		return apperror.FailedPrecondition("Course is deleted, restore the course first")
+	}
+	if claims.Role == entity.UserRoleInstructor && (courseEntity.InstructorId == nil || *courseEntity.InstructorId != claims.Subject) {
+		return apperror.PermissionDenied("This resource can only be managed within your own courses")
+	}
+
+	return nil
+}
+
+// isDeleteRoot: row dihapus langsung (bukan ikut terhapus cascade), hanya root yang bisa di-restore / tampil di trash
+func isDeleteRoot(id string, deletedWithId *string) bool {
+	return deletedWithId != nil && *deletedWithId == id
+}
@@ -2,14 +2,17 @@ package service
 
 import (
 	"context"
-	"runtime/debug"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/abu-umair/be-lms-go/internal/apperror"
 	"github.com/abu-umair/be-lms-go/internal/entity"
 	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
 	"github.com/abu-umair/be-lms-go/internal/repository"
 	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/abu-umair/be-lms-go/pb/course_chapter"
+	"github.com/abu-umair/be-lms-go/pkg/database"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -19,11 +22,19 @@ type ICourseChapterService interface {
 	DetailCourseChapter(ctx context.Context, request *course_chapter.DetailCourseChapterRequest) (*course_chapter.DetailCourseChapterResponse, error)
 	EditCourseChapter(ctx context.Context, request *course_chapter.EditCourseChapterRequest) (*course_chapter.EditCourseChapterResponse, error)
 	DeleteCourseChapter(ctx context.Context, request *course_chapter.DeleteCourseChapterRequest) (*course_chapter.DeleteCourseChapterResponse, error)
+	RestoreCourseChapter(ctx context.Context, request *course_chapter.RestoreCourseChapterRequest) (*course_chapter.RestoreCourseChapterResponse, error)
+	ReorderChapters(ctx context.Context, request *course_chapter.ReorderChaptersRequest) (*course_chapter.ReorderChaptersResponse, error)
+	ListCourseChapters(ctx context.Context, request *course_chapter.ListCourseChaptersRequest) (*course_chapter.ListCourseChaptersResponse, error)
+	SetChapterReleaseRule(ctx context.Context, request *course_chapter.SetChapterReleaseRuleRequest) (*course_chapter.SetChapterReleaseRuleResponse, error)
 }
 
 type courseChapterService struct {
 	db                      *sqlx.DB
 	courseChapterRepository repository.ICourseChapterRepository
+	chapterLessonRepository repository.IChapterLessonRepository
+	courseRepository        repository.ICourseRepository
+	releaseRuleRepository   repository.IReleaseRuleRepository
+	releaseService          IReleaseService
 }
 
 func (cs *courseChapterService) CreateCourseChapter(ctx context.Context, request *course_chapter.CreateCourseChapterRequest) (*course_chapter.CreateCourseChapterResponse, error) {
@@ -35,10 +46,16 @@ func (cs *courseChapterService) CreateCourseChapter(ctx context.Context, request
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
-	tx, err := cs.db.BeginTxx(ctx, nil)
+	//* chapter hanya bisa ditambahkan ke course (aktif, bukan di trash) milik instructor yang login
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
 	if err != nil {
 		return nil, err
 	}
@@ -49,8 +66,7 @@ func (cs *courseChapterService) CreateCourseChapter(ctx context.Context, request
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -60,12 +76,12 @@ func (cs *courseChapterService) CreateCourseChapter(ctx context.Context, request
 		}
 	}()
 
-	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx)
+	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx.Tx)
 
 	// *insert ke DB
 	courseChapterEntity := entity.CourseChapter{
 		Id:           uuid.NewString(),
-		InstructorId: request.InstructorId,
+		InstructorId: claims.Subject, //? pembuat chapter selalu instructor yang login, instructor_id di request diabaikan
 		CourseId:     request.CourseId,
 		Title:        request.Title,
 		OrderChapter: request.OrderChapter,
@@ -77,6 +93,9 @@ func (cs *courseChapterService) CreateCourseChapter(ctx context.Context, request
 
 	err = courseChapterRepo.CreateNewCourseChapter(ctx, &courseChapterEntity)
 	if err != nil {
+		if database.IsUniqueViolation(err, repository.CourseChapterOrderUniqueIndex) {
+			return nil, apperror.AlreadyExists("Order chapter already used").WithFieldViolation("order_chapter", "another chapter of this course already has this order")
+		}
 		return nil, err
 	}
 
@@ -101,7 +120,7 @@ func (cs *courseChapterService) DetailCourseChapter(ctx context.Context, request
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// * Get course_chapters by chapter_id
@@ -118,9 +137,7 @@ func (cs *courseChapterService) DetailCourseChapter(ctx context.Context, request
 
 	//* Apabila null chapter_id, return not found
 	if courseChapterEntity == nil {
-		return &course_chapter.DetailCourseChapterResponse{
-			Base: utils.NotFoundResponse("Course chapter not found"),
-		}, nil
+		return nil, apperror.NotFound("Course chapter not found")
 	}
 
 	// *success
@@ -161,7 +178,7 @@ func (cs *courseChapterService) EditCourseChapter(ctx context.Context, request *
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// *Apakah Id course ada di DB
@@ -170,12 +187,22 @@ func (cs *courseChapterService) EditCourseChapter(ctx context.Context, request *
 		return nil, err
 	}
 	if courseEntity == nil {
-		return &course_chapter.EditCourseChapterResponse{
-			Base: utils.NotFoundResponse("Course chapter not found"),
-		}, nil
+		return nil, apperror.NotFound("Course chapter not found")
 	}
 
-	tx, err := cs.db.BeginTxx(ctx, nil)
+	//* course asal (dan course tujuan jika course_id diganti) harus milik instructor yang login
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, courseEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+	if request.CourseId != courseEntity.CourseId {
+		err = ensureCourseOwner(ctx, cs.courseRepository, claims, request.CourseId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
 	if err != nil {
 		return nil, err
 	}
@@ -186,8 +213,7 @@ func (cs *courseChapterService) EditCourseChapter(ctx context.Context, request *
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -197,12 +223,12 @@ func (cs *courseChapterService) EditCourseChapter(ctx context.Context, request *
 		}
 	}()
 
-	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx)
+	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx.Tx)
 
 	// *update ke DB
 	newCourse := entity.CourseChapter{
 		Id:           request.Id,
-		InstructorId: request.InstructorId,
+		InstructorId: courseEntity.InstructorId, //? pembuat chapter tidak berubah lewat edit
 		CourseId:     request.CourseId,
 		Title:        request.Title,
 		OrderChapter: request.OrderChapter,
@@ -214,6 +240,9 @@ func (cs *courseChapterService) EditCourseChapter(ctx context.Context, request *
 
 	err = courseChapterRepo.UpdateCourseChapter(ctx, &newCourse)
 	if err != nil {
+		if database.IsUniqueViolation(err, repository.CourseChapterOrderUniqueIndex) {
+			return nil, apperror.AlreadyExists("Order chapter already used").WithFieldViolation("order_chapter", "another chapter of this course already has this order")
+		}
 		return nil, err
 	}
 
@@ -238,7 +267,7 @@ func (cs *courseChapterService) DeleteCourseChapter(ctx context.Context, request
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// *Apakah Id course ada di DB
@@ -247,12 +276,16 @@ func (cs *courseChapterService) DeleteCourseChapter(ctx context.Context, request
 		return nil, err
 	}
 	if courseChapterEntity == nil {
-		return &course_chapter.DeleteCourseChapterResponse{
-			Base: utils.NotFoundResponse("Course Chapter not found"),
-		}, nil
+		return nil, apperror.NotFound("Course chapter not found")
 	}
 
-	tx, err := cs.db.BeginTxx(ctx, nil)
+	//* hanya pemilik course yang bisa menghapus (sama dgn restore)
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, courseChapterEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +296,7 @@ func (cs *courseChapterService) DeleteCourseChapter(ctx context.Context, request
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -274,11 +306,17 @@ func (cs *courseChapterService) DeleteCourseChapter(ctx context.Context, request
 		}
 	}()
 
-	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx)
+	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx.Tx)
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
 
-	// *update delete_at & delete_by ke DB
+	// *update delete_at & delete_by ke DB, lesson yang masih aktif ikut dihapus (deleted_with_id = id chapter)
+	deletedAt := time.Now()
+	err = courseChapterRepo.DeleteCourseChapter(ctx, courseChapterEntity.Id, deletedAt, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
 
-	err = courseChapterRepo.DeleteCourseChapter(ctx, request.Id, time.Now(), claims.FullName)
+	deletedLessons, err := chapterLessonRepo.DeleteChapterLessonsByChapterId(ctx, courseChapterEntity.Id, deletedAt, claims.FullName)
 	if err != nil {
 		return nil, err
 	}
@@ -290,13 +328,392 @@ func (cs *courseChapterService) DeleteCourseChapter(ctx context.Context, request
 
 	// *success
 	return &course_chapter.DeleteCourseChapterResponse{
-		Base: utils.SuccessResponse("Delete with SoftDelete Course Success"),
+		Base:           utils.SuccessResponse("Delete with SoftDelete Course Success"),
+		DeletedLessons: deletedLessons,
+	}, nil
+}
+
+func (cs *courseChapterService) RestoreCourseChapter(ctx context.Context, request *course_chapter.RestoreCourseChapterRequest) (*course_chapter.RestoreCourseChapterResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* instructor pemilik course / admin (pengelola trash)
+	if claims.Role != entity.UserRoleInstructor && claims.Role != entity.UserRoleAdmin {
+		return nil, apperror.PermissionDenied("Only instructor or admin can access this resource")
+	}
+
+	// *Apakah chapter ada di trash & dihapus langsung (bukan ikut terhapus bersama course)
+	courseChapterEntity, err := cs.courseChapterRepository.GetDeletedCourseChapterById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if courseChapterEntity == nil {
+		return nil, apperror.NotFound("Deleted course chapter not found")
+	}
+	if !isDeleteRoot(courseChapterEntity.Id, courseChapterEntity.DeletedWithId) {
+		return nil, apperror.FailedPrecondition("Course chapter was deleted together with its course, restore the course instead")
+	}
+
+	err = ensureRestoreAccess(ctx, cs.courseRepository, claims, &courseChapterEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx.Tx)
+	chapterLessonRepo := cs.chapterLessonRepository.WithTransaction(tx.Tx)
+
+	// *kembalikan chapter beserta lesson yang ikut terhapus bersamanya saja
+	restoredAt := time.Now()
+	_, err = courseChapterRepo.RestoreCourseChapters(ctx, courseChapterEntity.Id, restoredAt, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	restoredLessons, err := chapterLessonRepo.RestoreChapterLessons(ctx, courseChapterEntity.Id, restoredAt, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &course_chapter.RestoreCourseChapterResponse{
+		Base:            utils.SuccessResponse("Restore Course Chapter Success"),
+		RestoredLessons: restoredLessons,
+	}, nil
+}
+
+func (cs *courseChapterService) ReorderChapters(ctx context.Context, request *course_chapter.ReorderChaptersRequest) (*course_chapter.ReorderChaptersResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	//* hanya pemilik course yang bisa mengubah urutan chapter
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	courseChapterRepo := cs.courseChapterRepository.WithTransaction(tx.Tx)
+
+	// *Ambil & kunci semua chapter course ini, agar tidak berubah sampai commit
+	currentIds, err := courseChapterRepo.GetCourseChapterIdsForUpdate(ctx, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+	if len(currentIds) == 0 {
+		err = apperror.NotFound("Course chapters not found")
+		return nil, err
+	}
+
+	// *ordered_ids harus sama persis dgn chapter yang ada
+	err = validateOrderedIds(currentIds, request.OrderedIds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = courseChapterRepo.ReorderCourseChapters(ctx, request.CourseId, request.OrderedIds, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &course_chapter.ReorderChaptersResponse{
+		Base: utils.SuccessResponse("Reorder Course Chapters Success"),
 	}, nil
 }
 
-func NewCourseChapterService(db *sqlx.DB, courseChapterRepository repository.ICourseChapterRepository) ICourseChapterService {
+func (cs *courseChapterService) ListCourseChapters(ctx context.Context, request *course_chapter.ListCourseChaptersRequest) (*course_chapter.ListCourseChaptersResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	paths := []string{"id"} // ID wajib ada untuk mapping & ringkasan lesson
+	if request.FieldMask != nil {
+		paths = append(paths, request.FieldMask.Paths...)
+	}
+
+	courseChapters, err := cs.courseChapterRepository.GetCourseChaptersByCourseId(ctx, request.CourseId, request.Status, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*course_chapter.CourseChapterItem, 0, len(courseChapters))
+	if len(courseChapters) == 0 {
+		return &course_chapter.ListCourseChaptersResponse{
+			Base:  utils.SuccessResponse("List Course Chapters Success"),
+			Items: items,
+		}, nil
+	}
+
+	// *ringkasan lesson per chapter (jumlah & total durasi), diambil sekali utk semua chapter
+	chapterIds := make([]string, 0, len(courseChapters))
+	for _, courseChapter := range courseChapters {
+		chapterIds = append(chapterIds, courseChapter.Id)
+	}
+
+	lessons, err := cs.chapterLessonRepository.GetLessonDurationsByChapterIds(ctx, chapterIds)
+	if err != nil {
+		return nil, err
+	}
+
+	lessonCounts := make(map[string]int64, len(chapterIds))
+	totalDurations := make(map[string]time.Duration, len(chapterIds))
+	for _, lesson := range lessons {
+		if lesson.ChapterId == nil {
+			continue
+		}
+		lessonCounts[*lesson.ChapterId]++
+		if lesson.Duration != nil {
+			if duration, ok := parseLessonDuration(*lesson.Duration); ok {
+				totalDurations[*lesson.ChapterId] += duration
+			}
+		}
+	}
+
+	for _, courseChapter := range courseChapters {
+		item := courseChapterItem(courseChapter)
+		item.LessonCount = lessonCounts[courseChapter.Id]
+		item.TotalDurationSeconds = int64(totalDurations[courseChapter.Id] / time.Second)
+		items = append(items, item)
+	}
+
+	// *success
+	return &course_chapter.ListCourseChaptersResponse{
+		Base:  utils.SuccessResponse("List Course Chapters Success"),
+		Items: items,
+	}, nil
+}
+
+func (cs *courseChapterService) SetChapterReleaseRule(ctx context.Context, request *course_chapter.SetChapterReleaseRuleRequest) (*course_chapter.SetChapterReleaseRuleResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	//? rule kosong = aturan rilis chapter dihapus
+	rule, err := releaseRuleFromProto(request.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	// *Apakah Id chapter ada di DB
+	courseChapterEntity, err := cs.courseChapterRepository.GetCourseChapterById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if courseChapterEntity == nil {
+		return nil, apperror.NotFound("Course chapter not found")
+	}
+
+	err = ensureCourseOwner(ctx, cs.courseRepository, claims, courseChapterEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule != nil {
+		rule.Id = uuid.NewString()
+		rule.ChapterId = &courseChapterEntity.Id
+		rule.CreatedAt = time.Now()
+		rule.CreatedBy = claims.FullName
+
+		err = cs.releaseService.ValidateReleaseRule(ctx, courseChapterEntity.CourseId, rule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := database.BeginTransaction(ctx, cs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	releaseRuleRepo := cs.releaseRuleRepository.WithTransaction(tx.Tx)
+
+	//* aturan lama diganti (satu chapter maksimal satu aturan)
+	err = releaseRuleRepo.DeleteChapterReleaseRule(ctx, courseChapterEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule != nil {
+		err = releaseRuleRepo.CreateNewReleaseRule(ctx, rule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &course_chapter.SetChapterReleaseRuleResponse{
+		Base: utils.SuccessResponse("Set Chapter Release Rule Success"),
+	}, nil
+}
+
+// courseChapterItem memetakan chapter ke item list, kolom yang tidak di-select (field mask) tetap kosong
+func courseChapterItem(courseChapterEntity *entity.CourseChapter) *course_chapter.CourseChapterItem {
+	return &course_chapter.CourseChapterItem{
+		Id:           courseChapterEntity.Id,
+		InstructorId: utils.StringToPtr(courseChapterEntity.InstructorId),
+		CourseId:     utils.StringToPtr(courseChapterEntity.CourseId),
+		Title:        utils.StringToPtr(courseChapterEntity.Title),
+		OrderChapter: utils.Int64ToPtr(courseChapterEntity.OrderChapter),
+		Status:       utils.StringToPtr(courseChapterEntity.Status),
+
+		CreatedAt: utils.TimeToPtr(courseChapterEntity.CreatedAt),
+		CreatedBy: utils.StringToPtr(courseChapterEntity.CreatedBy),
+		UpdatedAt: utils.TimeToPtr(courseChapterEntity.UpdatedAt),
+		UpdatedBy: utils.PtrStringToPtr(courseChapterEntity.UpdatedBy),
+		DeletedAt: utils.PtrTimeToPtr(courseChapterEntity.DeletedAt),
+		DeletedBy: utils.PtrStringToPtr(courseChapterEntity.DeletedBy),
+	}
+}
+
+// parseLessonDuration membaca kolom duration lesson (bebas format) menjadi durasi:
+// angka saja = detik, "MM:SS", "HH:MM:SS", atau format Go seperti "1h30m"
+func parseLessonDuration(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if strings.Contains(value, ":") {
+		parts := strings.Split(value, ":")
+		if len(parts) > 3 {
+			return 0, false
+		}
+
+		var total int64
+		for i, part := range parts {
+			number, err := strconv.ParseInt(part, 10, 64)
+			if err != nil || number < 0 {
+				return 0, false
+			}
+			//? selain bagian pertama, menit & detik harus < 60
+			if i > 0 && (number >= 60 || len(part) != 2) {
+				return 0, false
+			}
+			total = total*60 + number
+		}
+
+		return time.Duration(total) * time.Second, true
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return 0, false
+	}
+
+	return duration, true
+}
+
+func NewCourseChapterService(db *sqlx.DB, courseChapterRepository repository.ICourseChapterRepository, chapterLessonRepository repository.IChapterLessonRepository, courseRepository repository.ICourseRepository, releaseRuleRepository repository.IReleaseRuleRepository, releaseService IReleaseService) ICourseChapterService {
 	return &courseChapterService{
 		db:                      db,
 		courseChapterRepository: courseChapterRepository,
+		chapterLessonRepository: chapterLessonRepository,
+		courseRepository:        courseRepository,
+		releaseRuleRepository:   releaseRuleRepository,
+		releaseService:          releaseService,
 	}
 }
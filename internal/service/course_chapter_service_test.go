@@ -0,0 +1,774 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/common"
+	"github.com/abu-umair/be-lms-go/pb/course_chapter"
+	"google.golang.org/grpc/codes"
+)
+
+const testChapterId = "0d6f1a52-3d55-4b0e-9a0f-2f1e1cfa2f10"
+
+// newCourseChapterService: testCourseId milik testUserId, testOtherCourseId milik instructor lain, aturan rilis kosong
+func newCourseChapterService(t *testing.T, expect txExpectation, chapters *fake.CourseChapterRepository, lessons *fake.ChapterLessonRepository) ICourseChapterService {
+	t.Helper()
+
+	courses := fake.NewCourseRepository()
+	courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUserId)})
+	courses.AddCourse(entity.Course{Id: testOtherCourseId, InstructorId: utils.StringToPtr(testOtherUserId)})
+	rules := fake.NewReleaseRuleRepository(chapters, lessons)
+	releaseService := NewReleaseService(courses, lessons, fake.NewEnrollmentRepository(), rules, fake.NewLessonCompletionRepository(lessons), fake.NewQuizRepository(), fake.NewQuizAttemptRepository())
+
+	return NewCourseChapterService(newMockDB(t, expect), chapters, lessons, courses, rules, releaseService)
+}
+
+func addTestCourseChapter(repo *fake.CourseChapterRepository) {
+	repo.AddCourseChapter(entity.CourseChapter{
+		Id:           testChapterId,
+		InstructorId: testUserId,
+		CourseId:     testCourseId,
+		Title:        "Introduction",
+		OrderChapter: 1,
+		Status:       "active",
+		CreatedAt:    time.Now(),
+		CreatedBy:    "Test User",
+	})
+}
+
+func TestCourseChapterServiceCreateCourseChapter(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(repo *fake.CourseChapterRepository)
+		ctx      context.Context
+		courseId string //? default testCourseId
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			tx:       txNone,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "course not found",
+			ctx:      contextInstructor,
+			courseId: testDeletedCourseId,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "other instructor's course",
+			ctx:      contextInstructor,
+			courseId: testOtherCourseId,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "repository write error",
+			setup:    func(repo *fake.CourseChapterRepository) { repo.WriteErr = errDatabase },
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "order already used in course",
+			setup:    addTestCourseChapter,
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "success",
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := fake.NewCourseChapterRepository()
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			service := newCourseChapterService(t, tt.tx, repo, fake.NewChapterLessonRepository())
+			request := &course_chapter.CreateCourseChapterRequest{
+				InstructorId: testOtherUserId, //? diabaikan, pemilik diambil dari token
+				CourseId:     testCourseId,
+				Title:        "Introduction",
+				OrderChapter: 1,
+				Status:       "active",
+			}
+			if tt.courseId != "" {
+				request.CourseId = tt.courseId
+			}
+
+			_, err := service.CreateCourseChapter(tt.ctx, request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			chapters := repo.CourseChapters()
+			if len(chapters) != 1 {
+				t.Fatalf("stored %d chapters, want 1", len(chapters))
+			}
+			if chapters[0].Id == "" || chapters[0].Title != "Introduction" || chapters[0].CreatedBy != "Test User" {
+				t.Errorf("unexpected chapter: %+v", chapters[0])
+			}
+			if chapters[0].InstructorId != testUserId {
+				t.Errorf("instructor_id = %q, want %s", chapters[0].InstructorId, testUserId)
+			}
+		})
+	}
+}
+
+func TestCourseChapterServiceDetailCourseChapter(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(repo *fake.CourseChapterRepository)
+		ctx      context.Context
+		wantCode codes.Code
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user role",
+			setup:    addTestCourseChapter,
+			ctx:      contextUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "repository error",
+			setup:    func(repo *fake.CourseChapterRepository) { repo.ReadErr = errDatabase },
+			ctx:      contextInstructor,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			setup:    addTestCourseChapter,
+			ctx:      contextInstructor,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := fake.NewCourseChapterRepository()
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			service := newCourseChapterService(t, txNone, repo, fake.NewChapterLessonRepository())
+
+			res, err := service.DetailCourseChapter(tt.ctx, &course_chapter.DetailCourseChapterRequest{Id: testChapterId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.Id != testChapterId || res.GetTitle() != "Introduction" || res.GetOrderChapter() != 1 {
+				t.Errorf("unexpected response: %+v", res)
+			}
+		})
+	}
+}
+
+func TestCourseChapterServiceEditCourseChapter(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(repo *fake.CourseChapterRepository)
+		ctx      context.Context
+		courseId string //? default testCourseId
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			setup:    addTestCourseChapter,
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(repo *fake.CourseChapterRepository) {
+				repo.AddCourseChapter(entity.CourseChapter{Id: testChapterId, InstructorId: testOtherUserId, CourseId: testOtherCourseId, OrderChapter: 1})
+			},
+			ctx:      contextInstructor,
+			courseId: testOtherCourseId,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "move to other instructor's course",
+			setup:    addTestCourseChapter,
+			ctx:      contextInstructor,
+			courseId: testOtherCourseId,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "repository write error",
+			setup: func(repo *fake.CourseChapterRepository) {
+				addTestCourseChapter(repo)
+				repo.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			setup:    addTestCourseChapter,
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := fake.NewCourseChapterRepository()
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			service := newCourseChapterService(t, tt.tx, repo, fake.NewChapterLessonRepository())
+			request := &course_chapter.EditCourseChapterRequest{
+				Id:           testChapterId,
+				InstructorId: testOtherUserId, //? diabaikan, pembuat chapter tidak berubah
+				CourseId:     testCourseId,
+				Title:        "Getting Started",
+				OrderChapter: 2,
+				Status:       "active",
+			}
+			if tt.courseId != "" {
+				request.CourseId = tt.courseId
+			}
+
+			_, err := service.EditCourseChapter(tt.ctx, request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := repo.CourseChapter(testChapterId)
+			if stored.Title != "Getting Started" || stored.OrderChapter != 2 {
+				t.Errorf("chapter not updated: %+v", stored)
+			}
+			if stored.InstructorId != testUserId {
+				t.Errorf("instructor_id = %q, want %s kept", stored.InstructorId, testUserId)
+			}
+		})
+	}
+}
+
+func TestCourseChapterServiceDeleteCourseChapter(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(repo *fake.CourseChapterRepository)
+		ctx      context.Context
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			setup:    addTestCourseChapter,
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(repo *fake.CourseChapterRepository) {
+				repo.AddCourseChapter(entity.CourseChapter{Id: testChapterId, CourseId: testOtherCourseId, OrderChapter: 1})
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "repository write error",
+			setup: func(repo *fake.CourseChapterRepository) {
+				addTestCourseChapter(repo)
+				repo.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "success",
+			setup:    addTestCourseChapter,
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := fake.NewCourseChapterRepository()
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			service := newCourseChapterService(t, tt.tx, repo, fake.NewChapterLessonRepository())
+
+			_, err := service.DeleteCourseChapter(tt.ctx, &course_chapter.DeleteCourseChapterRequest{Id: testChapterId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := repo.CourseChapter(testChapterId)
+			if stored.DeletedAt == nil {
+				t.Error("chapter not soft deleted")
+			}
+		})
+	}
+}
+
+func TestCourseChapterServiceRestoreCourseChapter(t *testing.T) {
+	type restoreFixture struct {
+		courses  *fake.CourseRepository
+		chapters *fake.CourseChapterRepository
+		lessons  *fake.ChapterLessonRepository
+	}
+	//? chapter dihapus bersama 1 lesson, lalu order 1-nya dipakai chapter baru
+	deleteChapter := func(f *restoreFixture) {
+		f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUserId)})
+		addTestCourseChapter(f.chapters)
+		f.lessons.AddChapterLesson(entity.ChapterLesson{Id: testLessonId, ChapterId: utils.StringToPtr(testChapterId), CourseId: utils.StringToPtr(testCourseId), OrderLesson: 1})
+		deletedAt := time.Now()
+		f.chapters.DeleteCourseChapter(context.Background(), testChapterId, deletedAt, "Test User")
+		f.lessons.DeleteChapterLessonsByChapterId(context.Background(), testChapterId, deletedAt, "Test User")
+		f.chapters.AddCourseChapter(entity.CourseChapter{Id: "5d2f8e3b-1a5c-4b9f-8d7c-2e3f4a5b6c7d", CourseId: testCourseId, OrderChapter: 1})
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(f *restoreFixture)
+		ctx      context.Context
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			setup:    deleteChapter,
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "chapter not in trash",
+			setup: func(f *restoreFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUserId)})
+				addTestCourseChapter(f.chapters)
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "deleted together with course",
+			setup: func(f *restoreFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUserId)})
+				addTestCourseChapter(f.chapters)
+				f.courses.DeleteCourse(context.Background(), testCourseId, time.Now(), "Test User")
+				f.chapters.DeleteCourseChaptersByCourseId(context.Background(), testCourseId, time.Now(), "Test User")
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "course in trash",
+			setup: func(f *restoreFixture) {
+				deleteChapter(f)
+				f.courses.DeleteCourse(context.Background(), testCourseId, time.Now(), "Test User")
+			},
+			ctx:      contextAdmin,
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "success",
+			setup:    deleteChapter,
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &restoreFixture{
+				courses:  fake.NewCourseRepository(),
+				chapters: fake.NewCourseChapterRepository(),
+				lessons:  fake.NewChapterLessonRepository(),
+			}
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+			rules := fake.NewReleaseRuleRepository(f.chapters, f.lessons)
+			releaseService := NewReleaseService(f.courses, f.lessons, fake.NewEnrollmentRepository(), rules, fake.NewLessonCompletionRepository(f.lessons), fake.NewQuizRepository(), fake.NewQuizAttemptRepository())
+			service := NewCourseChapterService(newMockDB(t, tt.tx), f.chapters, f.lessons, f.courses, rules, releaseService)
+
+			res, err := service.RestoreCourseChapter(tt.ctx, &course_chapter.RestoreCourseChapterRequest{Id: testChapterId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := f.chapters.CourseChapter(testChapterId)
+			if stored.DeletedAt != nil || stored.OrderChapter != 2 {
+				t.Errorf("chapter deleted_at = %v, order = %d, want restored at order 2", stored.DeletedAt, stored.OrderChapter)
+			}
+			if lesson, _ := f.lessons.ChapterLesson(testLessonId); lesson.DeletedAt != nil || res.RestoredLessons != 1 {
+				t.Errorf("lesson not restored (restored_lessons = %d)", res.RestoredLessons)
+			}
+		})
+	}
+}
+
+func TestCourseChapterServiceReorderChapters(t *testing.T) {
+	chapterIds := []string{
+		"3b1e7c2a-0f4d-4a8e-9c6b-1d2e3f4a5b6c",
+		"5d2f8e3b-1a5c-4b9f-8d7c-2e3f4a5b6c7d",
+		"7e3a9f4c-2b6d-4c0a-9e8d-3f4a5b6c7d8e",
+	}
+	addChapters := func(repo *fake.CourseChapterRepository) {
+		for i, id := range chapterIds {
+			repo.AddCourseChapter(entity.CourseChapter{Id: id, CourseId: testCourseId, OrderChapter: int64(i + 1)})
+		}
+	}
+	reversed := []string{chapterIds[2], chapterIds[1], chapterIds[0]}
+
+	tests := []struct {
+		name       string
+		setup      func(repo *fake.CourseChapterRepository)
+		ctx        context.Context
+		courseId   string //? default testCourseId
+		orderedIds []string
+		tx         txExpectation
+		wantCode   codes.Code
+	}{
+		{
+			name:       "user role",
+			setup:      addChapters,
+			ctx:        contextUser,
+			orderedIds: reversed,
+			tx:         txNone,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "other instructor's course",
+			ctx:        contextInstructor,
+			courseId:   testOtherCourseId,
+			orderedIds: reversed,
+			tx:         txNone,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "course without chapters",
+			ctx:        contextInstructor,
+			orderedIds: reversed,
+			tx:         txRollback,
+			wantCode:   codes.NotFound,
+		},
+		{
+			name:       "missing id",
+			setup:      addChapters,
+			ctx:        contextInstructor,
+			orderedIds: reversed[:2],
+			tx:         txRollback,
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name:       "unknown id",
+			setup:      addChapters,
+			ctx:        contextInstructor,
+			orderedIds: []string{chapterIds[2], chapterIds[1], testChapterId},
+			tx:         txRollback,
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name: "repository write error",
+			setup: func(repo *fake.CourseChapterRepository) {
+				addChapters(repo)
+				repo.WriteErr = errDatabase
+			},
+			ctx:        contextInstructor,
+			orderedIds: reversed,
+			tx:         txRollback,
+			wantCode:   codes.Unknown,
+		},
+		{
+			name:       "success",
+			setup:      addChapters,
+			ctx:        contextInstructor,
+			orderedIds: reversed,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := fake.NewCourseChapterRepository()
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			service := newCourseChapterService(t, tt.tx, repo, fake.NewChapterLessonRepository())
+			courseId := tt.courseId
+			if courseId == "" {
+				courseId = testCourseId
+			}
+
+			_, err := service.ReorderChapters(tt.ctx, &course_chapter.ReorderChaptersRequest{CourseId: courseId, OrderedIds: tt.orderedIds})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			for i, id := range tt.orderedIds {
+				stored, _ := repo.CourseChapter(id)
+				if stored.OrderChapter != int64(i+1) || stored.UpdatedBy == nil || *stored.UpdatedBy != "Test User" {
+					t.Errorf("chapter %s = order %d updated by %v, want order %d", id, stored.OrderChapter, stored.UpdatedBy, i+1)
+				}
+			}
+		})
+	}
+}
+
+func TestCourseChapterServiceListCourseChapters(t *testing.T) {
+	const secondChapterId = "6e4a2c1b-8d7f-4a3e-b5c9-0f1e2d3c4b5a"
+
+	setup := func(chapters *fake.CourseChapterRepository, lessons *fake.ChapterLessonRepository) {
+		addTestCourseChapter(chapters)
+		chapters.AddCourseChapter(entity.CourseChapter{Id: secondChapterId, CourseId: testCourseId, Title: "Advanced", OrderChapter: 2, Status: "draft"})
+		chapters.AddCourseChapter(entity.CourseChapter{Id: "other-course-chapter", CourseId: "other-course", OrderChapter: 1, Status: "active"})
+
+		durations := []string{"90", "01:30", "1h", "unknown"}
+		for i, duration := range durations {
+			lessons.AddChapterLesson(entity.ChapterLesson{
+				Id:          fmt.Sprintf("lesson-%d", i),
+				ChapterId:   utils.StringToPtr(testChapterId),
+				OrderLesson: int64(i + 1),
+				Duration:    utils.StringToPtr(duration),
+			})
+		}
+		deletedAt := time.Now()
+		lessons.AddChapterLesson(entity.ChapterLesson{Id: "deleted-lesson", ChapterId: utils.StringToPtr(testChapterId), Duration: utils.StringToPtr("600"), DeletedAt: &deletedAt})
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		status    *string
+		readErr   error
+		wantCode  codes.Code
+		wantItems []*course_chapter.CourseChapterItem
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "repository error",
+			ctx:      contextInstructor,
+			readErr:  errDatabase,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "all chapters in order with lesson summary",
+			ctx:      contextInstructor,
+			wantCode: codes.OK,
+			wantItems: []*course_chapter.CourseChapterItem{
+				{Id: testChapterId, LessonCount: 4, TotalDurationSeconds: 90 + 90 + 3600},
+				{Id: secondChapterId},
+			},
+		},
+		{
+			name:     "status filter",
+			ctx:      contextInstructor,
+			status:   utils.StringToPtr("draft"),
+			wantCode: codes.OK,
+			wantItems: []*course_chapter.CourseChapterItem{
+				{Id: secondChapterId},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chapters := fake.NewCourseChapterRepository()
+			lessons := fake.NewChapterLessonRepository()
+			setup(chapters, lessons)
+			chapters.ReadErr = tt.readErr
+			service := newCourseChapterService(t, txNone, chapters, lessons)
+
+			res, err := service.ListCourseChapters(tt.ctx, &course_chapter.ListCourseChaptersRequest{CourseId: testCourseId, Status: tt.status})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if len(res.Items) != len(tt.wantItems) {
+				t.Fatalf("items = %v, want %d items", res.Items, len(tt.wantItems))
+			}
+			for i, want := range tt.wantItems {
+				got := res.Items[i]
+				if got.Id != want.Id || got.LessonCount != want.LessonCount || got.TotalDurationSeconds != want.TotalDurationSeconds {
+					t.Errorf("item %d = {%s %d %d}, want {%s %d %d}", i, got.Id, got.LessonCount, got.TotalDurationSeconds, want.Id, want.LessonCount, want.TotalDurationSeconds)
+				}
+			}
+		})
+	}
+}
+
+func TestParseLessonDuration(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{value: "45", want: 45 * time.Second, wantOk: true},
+		{value: " 05:30 ", want: 5*time.Minute + 30*time.Second, wantOk: true},
+		{value: "1:02:03", want: time.Hour + 2*time.Minute + 3*time.Second, wantOk: true},
+		{value: "1h30m", want: 90 * time.Minute, wantOk: true},
+		{value: "5:75", wantOk: false},
+		{value: "1:2:3:4", wantOk: false},
+		{value: "-10", wantOk: false},
+		{value: "10 menit", wantOk: false},
+		{value: "", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseLessonDuration(tt.value)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("parseLessonDuration(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestCourseChapterServiceSetChapterReleaseRule(t *testing.T) {
+	owner := testUserId
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		courseOwn *string
+		rule      *common.ReleaseRule
+		tx        txExpectation
+		wantCode  codes.Code
+		wantRules int
+	}{
+		{
+			name:     "user cannot set rule",
+			ctx:      contextUser,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: 7}},
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:      "other instructor's course",
+			ctx:       contextInstructor,
+			courseOwn: utils.StringToPtr("someone-else"),
+			rule:      &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: 7}},
+			tx:        txNone,
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			name:     "invalid release date",
+			ctx:      contextInstructor,
+			rule:     &common.ReleaseRule{Rule: &common.ReleaseRule_ReleaseAt{ReleaseAt: "2030-13-01T08:00"}},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:      "set release date",
+			ctx:       contextInstructor,
+			rule:      &common.ReleaseRule{Rule: &common.ReleaseRule_ReleaseAt{ReleaseAt: "2030-01-01T08:00"}},
+			tx:        txCommit,
+			wantCode:  codes.OK,
+			wantRules: 1,
+		},
+		{
+			name:     "empty rule removes it",
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chapters := fake.NewCourseChapterRepository()
+			lessons := fake.NewChapterLessonRepository()
+			addTestCourseChapter(chapters)
+
+			courses := fake.NewCourseRepository()
+			courseOwner := &owner
+			if tt.courseOwn != nil {
+				courseOwner = tt.courseOwn
+			}
+			courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: courseOwner})
+
+			rules := fake.NewReleaseRuleRepository(chapters, lessons)
+			days := int64(3)
+			rules.AddReleaseRule(entity.ReleaseRule{Id: "rule-1", ChapterId: utils.StringToPtr(testChapterId), DaysAfterEnrollment: &days})
+			releaseService := NewReleaseService(courses, lessons, fake.NewEnrollmentRepository(), rules, fake.NewLessonCompletionRepository(lessons), fake.NewQuizRepository(), fake.NewQuizAttemptRepository())
+			service := NewCourseChapterService(newMockDB(t, tt.tx), chapters, lessons, courses, rules, releaseService)
+
+			_, err := service.SetChapterReleaseRule(tt.ctx, &course_chapter.SetChapterReleaseRuleRequest{Id: testChapterId, Rule: tt.rule})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored := rules.ReleaseRules()
+			if len(stored) != tt.wantRules {
+				t.Fatalf("rules = %v, want %d", stored, tt.wantRules)
+			}
+			if tt.wantRules == 1 && (stored[0].ReleaseAt == nil || stored[0].DaysAfterEnrollment != nil) {
+				t.Errorf("rule = %+v, want release date only", stored[0])
+			}
+		})
+	}
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
-	"runtime/debug"
 	"time"
 
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/config"
 	"github.com/abu-umair/be-lms-go/internal/entity"
 	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
 	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/tracing"
 	"github.com/abu-umair/be-lms-go/internal/utils"
 	"github.com/abu-umair/be-lms-go/pb/course"
+	"github.com/abu-umair/be-lms-go/pkg/database"
 	"github.com/jmoiron/sqlx"
 	"github.com/shopspring/decimal"
 )
@@ -22,11 +25,16 @@ type ICourseService interface {
 	DetailCourse(ctx context.Context, request *course.DetailCourseRequest) (*course.DetailCourseResponse, error)
 	EditCourse(ctx context.Context, request *course.EditCourseRequest) (*course.EditCourseResponse, error)
 	DeleteCourse(ctx context.Context, request *course.DeleteCourseRequest) (*course.DeleteCourseResponse, error)
+	RestoreCourse(ctx context.Context, request *course.RestoreCourseRequest) (*course.RestoreCourseResponse, error)
 }
 
 type courseService struct {
-	db               *sqlx.DB
-	courseRepository repository.ICourseRepository
+	db                      *sqlx.DB
+	courseRepository        repository.ICourseRepository
+	courseChapterRepository repository.ICourseChapterRepository
+	chapterLessonRepository repository.IChapterLessonRepository
+	storageResolver         *storage.Resolver
+	storageConfig           config.StorageConfig
 }
 
 func (ss *courseService) CreateCourse(ctx context.Context, request *course.CreateCourseRequest) (*course.CreateCourseResponse, error) {
@@ -38,10 +46,10 @@ func (ss *courseService) CreateCourse(ctx context.Context, request *course.Creat
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
-	tx, err := ss.db.BeginTxx(ctx, nil)
+	tx, err := database.BeginTransaction(ctx, ss.db)
 	if err != nil {
 		return nil, err
 	}
@@ -52,8 +60,7 @@ func (ss *courseService) CreateCourse(ctx context.Context, request *course.Creat
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -63,28 +70,26 @@ func (ss *courseService) CreateCourse(ctx context.Context, request *course.Creat
 		}
 	}()
 
-	courseRepo := ss.courseRepository.WithTransaction(tx)
+	courseRepo := ss.courseRepository.WithTransaction(tx.Tx)
 
 	// *insert ke DB
 	var priceDecimal *decimal.Decimal
 	if request.Price != nil {
 		// Konversi string ke decimal
-		d, err := decimal.NewFromString(*request.Price)
-		if err != nil {
-			return &course.CreateCourseResponse{
-				Base: utils.BadRequestResponse("Invalid price format"),
-			}, nil
+		d, parseErr := decimal.NewFromString(*request.Price)
+		if parseErr != nil {
+			err = apperror.InvalidArgument("Invalid price format").WithFieldViolation("price", parseErr.Error()) //? err di-set agar transaksi di-rollback
+			return nil, err
 		}
 		priceDecimal = &d
 	}
 
 	var discountDecimal *decimal.Decimal
-	if request.Price != nil {
-		d, err := decimal.NewFromString(*request.Discount)
-		if err != nil {
-			return &course.CreateCourseResponse{
-				Base: utils.BadRequestResponse("Invalid discount format"),
-			}, nil
+	if request.Discount != nil {
+		d, parseErr := decimal.NewFromString(*request.Discount)
+		if parseErr != nil {
+			err = apperror.InvalidArgument("Invalid discount format").WithFieldViolation("discount", parseErr.Error())
+			return nil, err
 		}
 		discountDecimal = &d
 	}
@@ -95,7 +100,7 @@ func (ss *courseService) CreateCourse(ctx context.Context, request *course.Creat
 		Address:            request.Address,
 		ImageFileName:      request.ImageFileName,
 		Slug:               request.Slug,
-		InstructorId:       request.InstructorId,
+		InstructorId:       &claims.Subject, //? pemilik course selalu instructor yang login, instructor_id di request diabaikan
 		CategoryId:         request.CategoryId,
 		CourseType:         request.CourseType,
 		SeoDescription:     request.SeoDescription,
@@ -125,13 +130,15 @@ func (ss *courseService) CreateCourse(ctx context.Context, request *course.Creat
 	}
 
 	// *apakah image ada
-	imagePath := filepath.Join("storage", courseEntity.Id, "course", request.ImageFileName)
-	_, err = os.Stat(imagePath)
+	imagePath, err := ss.storageResolver.CourseFile(courseEntity.Id, storage.FolderCourse, request.ImageFileName)
+	if err != nil {
+		err = apperror.InvalidArgument("Invalid image file name").WithFieldViolation("image_file_name", err.Error())
+		return nil, err
+	}
+	_, err = statFile(ctx, imagePath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return &course.CreateCourseResponse{
-				Base: utils.BadRequestResponse("File not found"),
-			}, nil
+			err = apperror.FailedPrecondition("File not found") //? image harus di-upload dulu lewat REST
 		}
 		return nil, err
 	}
@@ -157,7 +164,7 @@ func (ss *courseService) DetailCourse(ctx context.Context, request *course.Detai
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// * Get course by course_id
@@ -174,9 +181,7 @@ func (ss *courseService) DetailCourse(ctx context.Context, request *course.Detai
 
 	//* Apabila null course_id, return not found
 	if courseEntity == nil {
-		return &course.DetailCourseResponse{
-			Base: utils.NotFoundResponse("Course not found"),
-		}, nil
+		return nil, apperror.NotFound("Course not found")
 	}
 
 	// *success
@@ -225,7 +230,7 @@ func (ss *courseService) DetailCourse(ctx context.Context, request *course.Detai
 
 	//? khusus image: Cek dulu apakah ImageFileName ada di database
 	if courseEntity.ImageFileName != "" {
-		fullUrl := fmt.Sprintf("%s/%s/course/%s", os.Getenv("STORAGE_SERVICE_URL"), courseEntity.Id, courseEntity.ImageFileName)
+		fullUrl := fmt.Sprintf("%s/%s/course/%s", ss.storageConfig.ServiceURL, courseEntity.Id, courseEntity.ImageFileName)
 		res.ImageFileName = &fullUrl
 	}
 
@@ -241,7 +246,7 @@ func (ss *courseService) EditCourse(ctx context.Context, request *course.EditCou
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// *Apakah Id course ada di DB
@@ -250,12 +255,32 @@ func (ss *courseService) EditCourse(ctx context.Context, request *course.EditCou
 		return nil, err
 	}
 	if courseEntity == nil {
-		return &course.EditCourseResponse{
-			Base: utils.NotFoundResponse("Course not found"),
-		}, nil
+		return nil, apperror.NotFound("Course not found")
+	}
+
+	//* hanya pemilik course yang bisa mengubah course (instructor_id tidak ikut diubah)
+	err = ensureCourseOwner(ctx, ss.courseRepository, claims, courseEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// *jika ada image baru, pastikan file-nya sudah di-upload (sebelum transaksi dimulai)
+	imageChanged := courseEntity.ImageFileName != request.ImageFileName
+	if imageChanged {
+		newImagePath, pathErr := ss.storageResolver.CourseFile(request.Id, storage.FolderCourse, request.ImageFileName)
+		if pathErr != nil {
+			return nil, apperror.InvalidArgument("Invalid image file name").WithFieldViolation("image_file_name", pathErr.Error())
+		}
+		_, err = statFile(ctx, newImagePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, apperror.FailedPrecondition("Image not found")
+			}
+			return nil, err
+		}
 	}
 
-	tx, err := ss.db.BeginTxx(ctx, nil)
+	tx, err := database.BeginTransaction(ctx, ss.db)
 	if err != nil {
 		return nil, err
 	}
@@ -266,8 +291,7 @@ func (ss *courseService) EditCourse(ctx context.Context, request *course.EditCou
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -277,28 +301,26 @@ func (ss *courseService) EditCourse(ctx context.Context, request *course.EditCou
 		}
 	}()
 
-	courseRepo := ss.courseRepository.WithTransaction(tx)
+	courseRepo := ss.courseRepository.WithTransaction(tx.Tx)
 
 	// *update ke DB
 	var priceDecimal *decimal.Decimal
 	if request.Price != nil {
 		// Konversi string ke decimal
-		d, err := decimal.NewFromString(*request.Price)
-		if err != nil {
-			return &course.EditCourseResponse{
-				Base: utils.BadRequestResponse("Invalid price format"),
-			}, nil
+		d, parseErr := decimal.NewFromString(*request.Price)
+		if parseErr != nil {
+			err = apperror.InvalidArgument("Invalid price format").WithFieldViolation("price", parseErr.Error()) //? err di-set agar transaksi di-rollback
+			return nil, err
 		}
 		priceDecimal = &d
 	}
 
 	var discountDecimal *decimal.Decimal
-	if request.Price != nil {
-		d, err := decimal.NewFromString(*request.Discount)
-		if err != nil {
-			return &course.EditCourseResponse{
-				Base: utils.BadRequestResponse("Invalid discount format"),
-			}, nil
+	if request.Discount != nil {
+		d, parseErr := decimal.NewFromString(*request.Discount)
+		if parseErr != nil {
+			err = apperror.InvalidArgument("Invalid discount format").WithFieldViolation("discount", parseErr.Error())
+			return nil, err
 		}
 		discountDecimal = &d
 	}
@@ -309,7 +331,6 @@ func (ss *courseService) EditCourse(ctx context.Context, request *course.EditCou
 		Address:            request.Address,
 		ImageFileName:      request.ImageFileName,
 		Slug:               request.Slug,
-		InstructorId:       request.InstructorId,
 		CategoryId:         request.CategoryId,
 		CourseType:         request.CourseType,
 		SeoDescription:     request.SeoDescription,
@@ -339,23 +360,11 @@ func (ss *courseService) EditCourse(ctx context.Context, request *course.EditCou
 		return nil, err
 	}
 
-	// *jika ada image baru, hapus image lama
-	if courseEntity.ImageFileName != request.ImageFileName {
-		newImagePath := filepath.Join("storage", request.Id, "course", request.ImageFileName)
-		_, err := os.Stat(newImagePath)
-		if err != nil {
-			if os.IsNotExist(err) {
-				return &course.EditCourseResponse{
-					Base: utils.BadRequestResponse("Image not found"),
-				}, nil
-			}
-			return nil, err
-		}
-
-		oldImagePath := filepath.Join("storage", courseEntity.Id, "course", courseEntity.ImageFileName)
-		err = os.Remove(oldImagePath)
-		if err != nil {
-			return nil, err
+	// *jika ada image baru, hapus image lama (hanya setelah commit berhasil)
+	if imageChanged && courseEntity.ImageFileName != "" {
+		//? nama file lama yang tidak valid tidak dihapus, biar storage sweeper yang menilai
+		if oldImagePath, pathErr := ss.storageResolver.CourseFile(courseEntity.Id, storage.FolderCourse, courseEntity.ImageFileName); pathErr == nil {
+			tx.AfterCommit("remove old course image", removeFileAction(oldImagePath))
 		}
 	}
 
@@ -380,7 +389,7 @@ func (ss *courseService) DeleteCourse(ctx context.Context, request *course.Delet
 
 	//* apakah role user adl Instructor
 	if claims.Role != entity.UserRoleInstructor {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
 	}
 
 	// *Apakah Id course ada di DB
@@ -389,12 +398,16 @@ func (ss *courseService) DeleteCourse(ctx context.Context, request *course.Delet
 		return nil, err
 	}
 	if courseEntity == nil {
-		return &course.DeleteCourseResponse{
-			Base: utils.NotFoundResponse("Course not found"),
-		}, nil
+		return nil, apperror.NotFound("Course not found")
+	}
+
+	//* hanya pemilik course yang bisa menghapus (sama dgn restore)
+	err = ensureCourseOwner(ctx, ss.courseRepository, claims, courseEntity.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	tx, err := ss.db.BeginTxx(ctx, nil)
+	tx, err := database.BeginTransaction(ctx, ss.db)
 	if err != nil {
 		return nil, err
 	}
@@ -405,8 +418,7 @@ func (ss *courseService) DeleteCourse(ctx context.Context, request *course.Delet
 				tx.Rollback() //?rollback jika ada error saan runtime
 			}
 
-			debug.PrintStack() //?agar ada stock tracenya yang digunakan utk debug
-			panic(e)           //?agar bisa nyampai ke Middleware
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
 		}
 	}()
 
@@ -416,22 +428,26 @@ func (ss *courseService) DeleteCourse(ctx context.Context, request *course.Delet
 		}
 	}()
 
-	courseRepo := ss.courseRepository.WithTransaction(tx)
+	courseRepo := ss.courseRepository.WithTransaction(tx.Tx)
+	courseChapterRepo := ss.courseChapterRepository.WithTransaction(tx.Tx)
+	chapterLessonRepo := ss.chapterLessonRepository.WithTransaction(tx.Tx)
 
-	// *update delete_at & delete_by ke DB
+	// *update delete_at & delete_by ke DB, chapter & lesson yang masih aktif ikut dihapus (deleted_with_id = id course)
+	//? image course tidak dihapus agar bisa di-restore, file baru dihapus saat trash di-purge
+	deletedAt := time.Now()
+	err = courseRepo.DeleteCourse(ctx, courseEntity.Id, deletedAt, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
 
-	err = courseRepo.DeleteCourse(ctx, request.Id, time.Now(), claims.FullName)
+	deletedChapters, err := courseChapterRepo.DeleteCourseChaptersByCourseId(ctx, courseEntity.Id, deletedAt, claims.FullName)
 	if err != nil {
 		return nil, err
 	}
 
-	// *jika ada image, hapus image
-	if courseEntity.ImageFileName != "" {
-		imagePath := filepath.Join("storage", courseEntity.Id, "course", courseEntity.ImageFileName)
-		err = os.Remove(imagePath)
-		if err != nil {
-			return nil, err
-		}
+	deletedLessons, err := chapterLessonRepo.DeleteChapterLessonsByCourseId(ctx, courseEntity.Id, deletedAt, claims.FullName)
+	if err != nil {
+		return nil, err
 	}
 
 	err = tx.Commit()
@@ -441,13 +457,118 @@ func (ss *courseService) DeleteCourse(ctx context.Context, request *course.Delet
 
 	// *success
 	return &course.DeleteCourseResponse{
-		Base: utils.SuccessResponse("Delete with SoftDelete Course Success"),
+		Base:            utils.SuccessResponse("Delete with SoftDelete Course Success"),
+		DeletedChapters: deletedChapters,
+		DeletedLessons:  deletedLessons,
+	}, nil
+}
+
+func (ss *courseService) RestoreCourse(ctx context.Context, request *course.RestoreCourseRequest) (*course.RestoreCourseResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* instructor pemilik course / admin (pengelola trash)
+	if claims.Role != entity.UserRoleInstructor && claims.Role != entity.UserRoleAdmin {
+		return nil, apperror.PermissionDenied("Only instructor or admin can access this resource")
+	}
+
+	// *Apakah course ada di trash
+	courseEntity, err := ss.courseRepository.GetDeletedCourseById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if courseEntity == nil {
+		return nil, apperror.NotFound("Deleted course not found")
+	}
+	if claims.Role == entity.UserRoleInstructor && (courseEntity.InstructorId == nil || *courseEntity.InstructorId != claims.Subject) {
+		return nil, apperror.PermissionDenied("This resource can only be managed within your own courses")
+	}
+
+	tx, err := database.BeginTransaction(ctx, ss.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	courseRepo := ss.courseRepository.WithTransaction(tx.Tx)
+	courseChapterRepo := ss.courseChapterRepository.WithTransaction(tx.Tx)
+	chapterLessonRepo := ss.chapterLessonRepository.WithTransaction(tx.Tx)
+
+	// *kembalikan course beserta chapter & lesson yang ikut terhapus bersamanya saja
+	restoredAt := time.Now()
+	err = courseRepo.RestoreCourse(ctx, courseEntity.Id, restoredAt, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	restoredChapters, err := courseChapterRepo.RestoreCourseChapters(ctx, courseEntity.Id, restoredAt, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	restoredLessons, err := chapterLessonRepo.RestoreChapterLessons(ctx, courseEntity.Id, restoredAt, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &course.RestoreCourseResponse{
+		Base:             utils.SuccessResponse("Restore Course Success"),
+		RestoredChapters: restoredChapters,
+		RestoredLessons:  restoredLessons,
 	}, nil
 }
 
-func NewCourseService(db *sqlx.DB, courseRepository repository.ICourseRepository) ICourseService {
+// removeFileAction membuat aksi post-commit utk menghapus file, file yang sudah tidak ada dianggap sukses
+func removeFileAction(filePath string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		err := os.Remove(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// statFile: cek file di storage (disk / network mount) diberi span sendiri
+func statFile(ctx context.Context, filePath string) (os.FileInfo, error) {
+	_, span := tracing.StartSpan(ctx, "storage.Stat")
+	defer span.End()
+
+	return os.Stat(filePath)
+}
+
+func NewCourseService(db *sqlx.DB, courseRepository repository.ICourseRepository, courseChapterRepository repository.ICourseChapterRepository, chapterLessonRepository repository.IChapterLessonRepository, storageResolver *storage.Resolver, storageConfig config.StorageConfig) ICourseService {
 	return &courseService{
-		db:               db,
-		courseRepository: courseRepository,
+		db:                      db,
+		courseRepository:        courseRepository,
+		courseChapterRepository: courseChapterRepository,
+		chapterLessonRepository: chapterLessonRepository,
+		storageResolver:         storageResolver,
+		storageConfig:           storageConfig,
 	}
 }
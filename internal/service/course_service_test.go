@@ -0,0 +1,667 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/course"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type courseFixture struct {
+	repo        *fake.CourseRepository
+	chapters    *fake.CourseChapterRepository
+	lessons     *fake.ChapterLessonRepository
+	storageRoot string
+}
+
+func newCourseFixture(t *testing.T) *courseFixture {
+	t.Helper()
+
+	return &courseFixture{
+		repo:        fake.NewCourseRepository(),
+		chapters:    fake.NewCourseChapterRepository(),
+		lessons:     fake.NewChapterLessonRepository(),
+		storageRoot: t.TempDir(),
+	}
+}
+
+func (f *courseFixture) service(t *testing.T, expect txExpectation) ICourseService {
+	t.Helper()
+
+	resolver, err := storage.NewResolver(f.storageRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewCourseService(newMockDB(t, expect), f.repo, f.chapters, f.lessons, resolver, testStorageConfig)
+}
+
+// uploadImage membuat file seolah-olah sudah di-upload lewat REST
+func (f *courseFixture) uploadImage(t *testing.T, fileName string) string {
+	t.Helper()
+
+	dir := filepath.Join(f.storageRoot, testCourseId, storage.FolderCourse)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(dir, fileName)
+	if err := os.WriteFile(filePath, []byte("image"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return filePath
+}
+
+func (f *courseFixture) addCourse(imageFileName string) {
+	f.repo.AddCourse(entity.Course{
+		Id:            testCourseId,
+		Name:          "Go Basics",
+		ImageFileName: imageFileName,
+		InstructorId:  utils.StringToPtr(testUserId),
+		CreatedAt:     time.Now(),
+		CreatedBy:     "Test User",
+	})
+}
+
+// addCurriculum: 2 chapter (chapter kedua punya 1 lesson) di course testCourseId
+func (f *courseFixture) addCurriculum() {
+	for i, id := range []string{testChapterId, testOtherChapterId} {
+		f.chapters.AddCourseChapter(entity.CourseChapter{
+			Id:           id,
+			InstructorId: testUserId,
+			CourseId:     testCourseId,
+			Title:        fmt.Sprintf("Chapter %d", i+1),
+			OrderChapter: int64(i + 1),
+			Status:       "active",
+			CreatedAt:    time.Now(),
+			CreatedBy:    "Test User",
+		})
+	}
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:          testLessonId,
+		ChapterId:   utils.StringToPtr(testOtherChapterId),
+		CourseId:    utils.StringToPtr(testCourseId),
+		Title:       "Lesson 1",
+		OrderLesson: 1,
+		CreatedAt:   time.Now(),
+		CreatedBy:   "Test User",
+	})
+}
+
+func fileExists(t *testing.T, filePath string) bool {
+	t.Helper()
+
+	_, err := os.Stat(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+
+	return err == nil
+}
+
+func TestCourseServiceCreateCourse(t *testing.T) {
+	validRequest := func() *course.CreateCourseRequest {
+		return &course.CreateCourseRequest{
+			Id:            testCourseId,
+			Name:          "Go Basics",
+			ImageFileName: "course_1.jpg",
+			InstructorId:  utils.StringToPtr(testOtherUserId), //? diabaikan, pemilik diambil dari token
+			Price:         utils.StringToPtr("150000"),
+			Discount:      utils.StringToPtr("10"),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, f *courseFixture)
+		ctx      context.Context
+		request  func() *course.CreateCourseRequest
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			request:  validRequest,
+			tx:       txNone,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			request:  validRequest,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "invalid price",
+			ctx:  contextInstructor,
+			request: func() *course.CreateCourseRequest {
+				r := validRequest()
+				r.Price = utils.StringToPtr("abc")
+				return r
+			},
+			tx:       txRollback,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid discount",
+			ctx:  contextInstructor,
+			request: func() *course.CreateCourseRequest {
+				r := validRequest()
+				r.Discount = utils.StringToPtr("ten")
+				return r
+			},
+			tx:       txRollback,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "repository write error",
+			setup:    func(t *testing.T, f *courseFixture) { f.repo.WriteErr = errDatabase },
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name: "unsafe image file name",
+			ctx:  contextInstructor,
+			request: func() *course.CreateCourseRequest {
+				r := validRequest()
+				r.ImageFileName = "../course_1.jpg"
+				return r
+			},
+			tx:       txRollback,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "image not uploaded",
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txRollback,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "success",
+			setup:    func(t *testing.T, f *courseFixture) { f.uploadImage(t, "course_1.jpg") },
+			ctx:      contextInstructor,
+			request:  validRequest,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCourseFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			_, err := f.service(t, tt.tx).CreateCourse(tt.ctx, tt.request())
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, ok := f.repo.Course(testCourseId)
+			if !ok {
+				t.Fatal("course not stored")
+			}
+			if stored.CreatedBy != "Test User" {
+				t.Errorf("created_by = %q, want Test User", stored.CreatedBy)
+			}
+			if stored.InstructorId == nil || *stored.InstructorId != testUserId {
+				t.Errorf("instructor_id = %v, want %s", stored.InstructorId, testUserId)
+			}
+			if stored.Price == nil || stored.Price.String() != "150000" {
+				t.Errorf("price = %v, want 150000", stored.Price)
+			}
+		})
+	}
+}
+
+func TestCourseServiceDetailCourse(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(t *testing.T, f *courseFixture)
+		ctx       context.Context
+		wantCode  codes.Code
+		wantImage string
+	}{
+		{
+			name:     "without claims",
+			ctx:      contextAnonymous,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user role",
+			setup:    func(t *testing.T, f *courseFixture) { f.addCourse("course_1.jpg") },
+			ctx:      contextUser,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "soft deleted",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+				f.repo.DeleteCourse(context.Background(), testCourseId, time.Now(), "Test User")
+			},
+			ctx:      contextInstructor,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "repository error",
+			setup:    func(t *testing.T, f *courseFixture) { f.repo.ReadErr = errDatabase },
+			ctx:      contextInstructor,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:      "success",
+			setup:     func(t *testing.T, f *courseFixture) { f.addCourse("course_1.jpg") },
+			ctx:       contextInstructor,
+			wantCode:  codes.OK,
+			wantImage: testStorageConfig.ServiceURL + "/" + testCourseId + "/course/course_1.jpg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCourseFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service(t, txNone).DetailCourse(tt.ctx, &course.DetailCourseRequest{
+				Id:        testCourseId,
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "image_file_name"}},
+			})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.GetName() != "Go Basics" {
+				t.Errorf("name = %q, want Go Basics", res.GetName())
+			}
+			if res.GetImageFileName() != tt.wantImage {
+				t.Errorf("image_file_name = %q, want %q", res.GetImageFileName(), tt.wantImage)
+			}
+		})
+	}
+}
+
+func TestCourseServiceEditCourse(t *testing.T) {
+	request := func(imageFileName string) *course.EditCourseRequest {
+		return &course.EditCourseRequest{
+			Id:            testCourseId,
+			Name:          "Go Advanced",
+			ImageFileName: imageFileName,
+			InstructorId:  utils.StringToPtr(testOtherUserId), //? tidak boleh memindahkan pemilik course
+			Price:         utils.StringToPtr("200000"),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		setup          func(t *testing.T, f *courseFixture)
+		ctx            context.Context
+		request        *course.EditCourseRequest
+		tx             txExpectation
+		wantCode       codes.Code
+		wantOldRemoved bool
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			request:  request("course_1.jpg"),
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			request:  request("course_1.jpg"),
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+				stored, _ := f.repo.Course(testCourseId)
+				stored.InstructorId = utils.StringToPtr(testOtherUserId)
+				f.repo.AddCourse(stored)
+			},
+			ctx:      contextInstructor,
+			request:  request("course_1.jpg"),
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "new image not uploaded",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+			},
+			ctx:      contextInstructor,
+			request:  request("course_2.jpg"),
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "unsafe new image file name",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+			},
+			ctx:      contextInstructor,
+			request:  request("../../etc/passwd"),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid price",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+			},
+			ctx: contextInstructor,
+			request: func() *course.EditCourseRequest {
+				r := request("course_1.jpg")
+				r.Price = utils.StringToPtr("abc")
+				return r
+			}(),
+			tx:       txRollback,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "repository write error",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+				f.uploadImage(t, "course_2.jpg")
+				f.repo.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			request:  request("course_2.jpg"),
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name: "same image",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+			},
+			ctx:      contextInstructor,
+			request:  request("course_1.jpg"),
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+		{
+			name: "new image",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+				f.uploadImage(t, "course_2.jpg")
+			},
+			ctx:            contextInstructor,
+			request:        request("course_2.jpg"),
+			tx:             txCommit,
+			wantCode:       codes.OK,
+			wantOldRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCourseFixture(t)
+			oldImage := f.uploadImage(t, "course_1.jpg")
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			_, err := f.service(t, tt.tx).EditCourse(tt.ctx, tt.request)
+			assertCode(t, err, tt.wantCode)
+
+			//? image lama hanya boleh dihapus jika commit berhasil
+			if removed := !fileExists(t, oldImage); removed != tt.wantOldRemoved {
+				t.Errorf("old image removed = %v, want %v", removed, tt.wantOldRemoved)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := f.repo.Course(testCourseId)
+			if stored.Name != "Go Advanced" || stored.ImageFileName != tt.request.ImageFileName {
+				t.Errorf("course not updated: %+v", stored)
+			}
+			if stored.UpdatedBy == nil || *stored.UpdatedBy != "Test User" {
+				t.Errorf("updated_by = %v, want Test User", stored.UpdatedBy)
+			}
+			if stored.InstructorId == nil || *stored.InstructorId != testUserId {
+				t.Errorf("instructor_id = %v, want owner %s kept", stored.InstructorId, testUserId)
+			}
+		})
+	}
+}
+
+func TestCourseServiceDeleteCourse(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, f *courseFixture)
+		ctx          context.Context
+		tx           txExpectation
+		wantCode     codes.Code
+		wantImageDel bool
+	}{
+		{
+			name:     "user role",
+			setup:    func(t *testing.T, f *courseFixture) { f.addCourse("course_1.jpg") },
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "not found",
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor's course",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+				stored, _ := f.repo.Course(testCourseId)
+				stored.InstructorId = utils.StringToPtr(testOtherUserId)
+				f.repo.AddCourse(stored)
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "repository write error",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+				f.repo.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name: "success",
+			setup: func(t *testing.T, f *courseFixture) {
+				f.addCourse("course_1.jpg")
+				f.addCurriculum()
+			},
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCourseFixture(t)
+			image := f.uploadImage(t, "course_1.jpg")
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service(t, tt.tx).DeleteCourse(tt.ctx, &course.DeleteCourseRequest{Id: testCourseId})
+			assertCode(t, err, tt.wantCode)
+
+			//? image baru dihapus saat trash di-purge
+			if !fileExists(t, image) {
+				t.Error("image deleted, want kept until purge")
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := f.repo.Course(testCourseId)
+			if stored.DeletedAt == nil || stored.DeletedBy == nil || *stored.DeletedBy != "Test User" {
+				t.Errorf("course not soft deleted: %+v", stored)
+			}
+			if res.DeletedChapters != 2 || res.DeletedLessons != 1 {
+				t.Errorf("deleted chapters/lessons = %d/%d, want 2/1", res.DeletedChapters, res.DeletedLessons)
+			}
+			lesson, _ := f.lessons.ChapterLesson(testLessonId)
+			if lesson.DeletedAt == nil || !lesson.DeletedAt.Equal(*stored.DeletedAt) || lesson.DeletedWithId == nil || *lesson.DeletedWithId != testCourseId {
+				t.Errorf("lesson not cascaded: %+v", lesson)
+			}
+		})
+	}
+}
+
+// testNewChapterId: chapter aktif di testCourseId yang tidak ikut terhapus bersama course
+const testNewChapterId = "5b0c9e3a-7d2f-4a61-b8e4-3f1a2c9d6e70"
+
+func TestCourseServiceRestoreCourse(t *testing.T) {
+	//? chapter pertama dihapus sendiri sebelum course dihapus, jadi tetap di trash setelah course di-restore
+	deleteCourse := func(t *testing.T, f *courseFixture) {
+		f.addCourse("course_1.jpg")
+		f.addCurriculum()
+		f.chapters.DeleteCourseChapter(context.Background(), testChapterId, time.Now().Add(-time.Hour), "Test User")
+		if _, err := f.service(t, txCommit).DeleteCourse(contextInstructor, &course.DeleteCourseRequest{Id: testCourseId}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		setup      func(t *testing.T, f *courseFixture)
+		ctx        context.Context
+		tx         txExpectation
+		wantCode   codes.Code
+		wantOrders map[string]int64 //? order_chapter setelah restore
+	}{
+		{
+			name:     "user role",
+			setup:    deleteCourse,
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "course not in trash",
+			setup:    func(t *testing.T, f *courseFixture) { f.addCourse("course_1.jpg") },
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "other instructor",
+			setup: func(t *testing.T, f *courseFixture) {
+				deleteCourse(t, f)
+				stored, _ := f.repo.Course(testCourseId)
+				stored.InstructorId = utils.StringToPtr(testOtherUserId)
+				f.repo.AddCourse(stored)
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "repository write error",
+			setup: func(t *testing.T, f *courseFixture) {
+				deleteCourse(t, f)
+				f.repo.WriteErr = errDatabase
+			},
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "instructor",
+			setup:    deleteCourse,
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "admin",
+			setup:    deleteCourse,
+			ctx:      contextAdmin,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+		{
+			//? data lama: chapter aktif memakai posisi chapter yang ikut terhapus bersama course
+			name: "cascaded chapter order already used",
+			setup: func(t *testing.T, f *courseFixture) {
+				deleteCourse(t, f)
+				f.chapters.AddCourseChapter(entity.CourseChapter{Id: testNewChapterId, CourseId: testCourseId, OrderChapter: 2})
+			},
+			ctx:        contextInstructor,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+			wantOrders: map[string]int64{testNewChapterId: 2, testOtherChapterId: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCourseFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service(t, tt.tx).RestoreCourse(tt.ctx, &course.RestoreCourseRequest{Id: testCourseId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, _ := f.repo.Course(testCourseId)
+			if stored.DeletedAt != nil || stored.DeletedWithId != nil {
+				t.Errorf("course still deleted: %+v", stored)
+			}
+			if res.RestoredChapters != 1 || res.RestoredLessons != 1 {
+				t.Errorf("restored chapters/lessons = %d/%d, want 1/1", res.RestoredChapters, res.RestoredLessons)
+			}
+			if chapter, _ := f.chapters.CourseChapter(testChapterId); chapter.DeletedAt == nil {
+				t.Error("chapter deleted before the course was restored")
+			}
+			if lesson, _ := f.lessons.ChapterLesson(testLessonId); lesson.DeletedAt != nil {
+				t.Errorf("lesson not restored: %+v", lesson)
+			}
+			for id, want := range tt.wantOrders {
+				if chapter, _ := f.chapters.CourseChapter(id); chapter.OrderChapter != want {
+					t.Errorf("chapter %s order = %d, want %d", id, chapter.OrderChapter, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// marshalJSONB menyimpan message proto sbg json (nama field snake_case sama dgn proto) utk kolom jsonb
+func marshalJSONB(message proto.Message) (string, error) {
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// unmarshalJSONB membaca kolom jsonb ke message proto, field yang sudah tidak dikenal diabaikan
+func unmarshalJSONB(raw string, message proto.Message) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(raw), message)
+}
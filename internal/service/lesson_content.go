@@ -0,0 +1,260 @@
+package service
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/chapter_lesson"
+)
+
+// legacyLessonFields: field bebas lesson lama, diturunkan dari content jika content diisi
+type legacyLessonFields interface {
+	GetFilePath() string
+	GetStorageLesson() string
+	GetLessonType() string
+	GetVolume() string
+	GetDuration() string
+	GetFileType() string
+}
+
+// validateLegacyLessonFields menolak field lama yang dikirim bersamaan dgn content (agar tidak ada dua sumber kebenaran)
+func validateLegacyLessonFields(request legacyLessonFields) error {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"file_path", request.GetFilePath()},
+		{"storage_lesson", request.GetStorageLesson()},
+		{"lesson_type", request.GetLessonType()},
+		{"volume", request.GetVolume()},
+		{"duration", request.GetDuration()},
+		{"file_type", request.GetFileType()},
+	}
+
+	var violations []apperror.FieldViolation
+	for _, field := range fields {
+		if field.value != "" {
+			violations = append(violations, apperror.FieldViolation{
+				Field:       field.name,
+				Description: "must be empty when content is set, it is derived from content",
+			})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return apperror.InvalidArgument("Lesson fields conflict with content").WithFieldViolations(violations)
+}
+
+// validateLessonContent: aturan per jenis yang tidak bisa ditulis di proto
+func validateLessonContent(content *chapter_lesson.LessonContent) error {
+	video := content.GetVideo()
+	if video == nil {
+		return nil
+	}
+
+	var violations []apperror.FieldViolation
+	languages := make(map[string]bool, len(video.Captions))
+	for i, caption := range video.Captions {
+		if languages[caption.Language] {
+			violations = append(violations, apperror.FieldViolation{
+				Field:       fmt.Sprintf("content.video.captions[%d].language", i),
+				Description: "only one caption track per language",
+			})
+		}
+		languages[caption.Language] = true
+
+		if caption.FileName == video.GetFileName() {
+			violations = append(violations, apperror.FieldViolation{
+				Field:       fmt.Sprintf("content.video.captions[%d].file_name", i),
+				Description: "must not be the video file",
+			})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return apperror.InvalidArgument("Invalid video captions").WithFieldViolations(violations)
+}
+
+// lessonContentKind: nilai kolom kind sesuai oneof yang diisi
+func lessonContentKind(content *chapter_lesson.LessonContent) string {
+	switch content.Kind.(type) {
+	case *chapter_lesson.LessonContent_Video:
+		return entity.LessonKindVideo
+	case *chapter_lesson.LessonContent_Article:
+		return entity.LessonKindArticle
+	case *chapter_lesson.LessonContent_File:
+		return entity.LessonKindFile
+	case *chapter_lesson.LessonContent_Link:
+		return entity.LessonKindLink
+	case *chapter_lesson.LessonContent_LiveSession:
+		return entity.LessonKindLiveSession
+	case *chapter_lesson.LessonContent_Quiz:
+		return entity.LessonKindQuiz
+	case *chapter_lesson.LessonContent_Assignment:
+		return entity.LessonKindAssignment
+	}
+
+	return ""
+}
+
+// applyLessonContent menyimpan kind & content ke lesson sekaligus menurunkan kolom lama
+// (lesson_type, file_path, storage_lesson, duration, file_type, volume) agar list / sweeper / signed URL tetap bekerja
+func applyLessonContent(lesson *entity.ChapterLesson, content *chapter_lesson.LessonContent) error {
+	//? url caption hanya output (signed URL kadaluarsa), tidak ikut disimpan
+	for _, track := range content.GetVideo().GetCaptions() {
+		track.Url = ""
+	}
+
+	raw, err := marshalLessonContent(content)
+	if err != nil {
+		return err
+	}
+
+	kind := lessonContentKind(content)
+	lesson.Kind = &kind
+	lesson.Content = raw
+	lesson.LessonType = &kind
+	lesson.FilePath = nil
+	lesson.StorageLesson = nil
+	lesson.Duration = nil
+	lesson.FileType = nil
+	lesson.Volume = nil
+
+	switch kind {
+	case entity.LessonKindVideo:
+		video := content.GetVideo()
+		if fileName := video.GetFileName(); fileName != "" {
+			lesson.FilePath = utils.StringToPtr(fileName)
+			lesson.StorageLesson = utils.StringToPtr(entity.LessonStorageUpload)
+		} else {
+			lesson.FilePath = utils.StringToPtr(video.GetUrl())
+			lesson.StorageLesson = utils.StringToPtr(entity.LessonStorageExternal)
+		}
+		lesson.Duration = utils.StringToPtr(strconv.FormatInt(video.DurationSeconds, 10))
+	case entity.LessonKindArticle:
+		if seconds := content.GetArticle().ReadingTimeSeconds; seconds != nil {
+			lesson.Duration = utils.StringToPtr(strconv.FormatInt(*seconds, 10))
+		}
+	case entity.LessonKindFile:
+		fileName := content.GetFile().FileName
+		lesson.FilePath = &fileName
+		lesson.StorageLesson = utils.StringToPtr(entity.LessonStorageUpload)
+		if ext := path.Ext(fileName); ext != "" {
+			lesson.FileType = utils.StringToPtr(ext[1:])
+		}
+	case entity.LessonKindLink:
+		lesson.FilePath = utils.StringToPtr(content.GetLink().Url)
+		lesson.StorageLesson = utils.StringToPtr(entity.LessonStorageExternal)
+	case entity.LessonKindLiveSession:
+		lesson.Duration = utils.StringToPtr(strconv.FormatInt(content.GetLiveSession().DurationSeconds, 10))
+	}
+
+	return nil
+}
+
+func marshalLessonContent(content *chapter_lesson.LessonContent) (*string, error) {
+	raw, err := marshalJSONB(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &raw, nil
+}
+
+// parseLessonContent: content nil (lesson lama tanpa jenis) mengembalikan nil
+func parseLessonContent(raw *string) (*chapter_lesson.LessonContent, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	var content chapter_lesson.LessonContent
+	err := unmarshalJSONB(*raw, &content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &content, nil
+}
+
+// lessonContentFiles: file upload di storage/<course_id>/lesson yang dipakai content (file utama & caption)
+func lessonContentFiles(content *chapter_lesson.LessonContent) []string {
+	var files []string
+
+	switch kind := content.GetKind().(type) {
+	case *chapter_lesson.LessonContent_Video:
+		if fileName := kind.Video.GetFileName(); fileName != "" {
+			files = append(files, fileName)
+		}
+		for _, caption := range kind.Video.Captions {
+			files = append(files, caption.FileName)
+		}
+	case *chapter_lesson.LessonContent_File:
+		files = append(files, kind.File.FileName)
+	}
+
+	return files
+}
+
+// renameLessonContentFiles mengganti nama file di content sesuai renames (nama lama -> nama baru)
+func renameLessonContentFiles(content *chapter_lesson.LessonContent, renames map[string]string) {
+	rename := func(fileName string) string {
+		if renamed, ok := renames[fileName]; ok {
+			return renamed
+		}
+		return fileName
+	}
+
+	switch kind := content.GetKind().(type) {
+	case *chapter_lesson.LessonContent_Video:
+		if source, ok := kind.Video.Source.(*chapter_lesson.VideoLesson_FileName); ok {
+			source.FileName = rename(source.FileName)
+		}
+		for _, caption := range kind.Video.Captions {
+			caption.FileName = rename(caption.FileName)
+		}
+	case *chapter_lesson.LessonContent_File:
+		kind.File.FileName = rename(kind.File.FileName)
+	}
+}
+
+// isValidLessonContentFiles memastikan semua file di content aman dipakai sbg path storage course
+func isValidLessonContentFiles(courseId *string, content *chapter_lesson.LessonContent) bool {
+	files := lessonContentFiles(content)
+	if len(files) == 0 {
+		return true
+	}
+	if courseId == nil || storage.ValidateId(*courseId) != nil {
+		return false
+	}
+	for _, fileName := range files {
+		if storage.ValidateFileName(fileName) != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateLessonContentRequest: cek content Create / Edit beserta field lama yang tidak boleh ikut dikirim
+func validateLessonContentRequest(request legacyLessonFields, courseId *string, content *chapter_lesson.LessonContent) error {
+	if err := validateLegacyLessonFields(request); err != nil {
+		return err
+	}
+	if err := validateLessonContent(content); err != nil {
+		return err
+	}
+	if !isValidLessonContentFiles(courseId, content) {
+		return apperror.InvalidArgument("Invalid lesson content file").WithFieldViolation("content", "file names must be uploaded files in the course lesson folder")
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/caption"
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/chapter_lesson"
+	"github.com/google/uuid"
+)
+
+// defaultTranscriptSearchLimit: jumlah cue maksimal jika limit tidak dikirim
+const defaultTranscriptSearchLimit int64 = 20
+
+// captionTranscript: isi satu track caption video (sudah lolos validasi timing)
+type captionTranscript struct {
+	language string
+	cues     []caption.Cue
+}
+
+func (cs *chapterLessonService) lessonTranscript(courseId *string, content *chapter_lesson.LessonContent) ([]captionTranscript, error) {
+	return readLessonTranscript(cs.storageResolver, courseId, content)
+}
+
+// readLessonTranscript membaca semua file caption video di storage/<course_id>/lesson.
+// File yang belum di-upload / bukan WebVTT valid ditolak, agar track yang dikirim ke player selalu bisa dibaca.
+func readLessonTranscript(storageResolver *storage.Resolver, courseId *string, content *chapter_lesson.LessonContent) ([]captionTranscript, error) {
+	video := content.GetVideo()
+	if video == nil || len(video.Captions) == 0 {
+		return nil, nil
+	}
+
+	transcript := make([]captionTranscript, 0, len(video.Captions))
+	for i, track := range video.Captions {
+		field := fmt.Sprintf("content.video.captions[%d].file_name", i)
+
+		//? nama file & course_id sudah dicek isValidLessonContentFiles
+		filePath, err := storageResolver.CourseFile(*courseId, storage.FolderLesson, track.FileName)
+		if err != nil {
+			return nil, apperror.InvalidArgument("Invalid caption file").WithFieldViolation(field, "must be an uploaded caption file in the course lesson folder")
+		}
+
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, apperror.InvalidArgument("Caption file not found").WithFieldViolation(field, "upload the caption first via /lesson/caption/upload")
+			}
+			return nil, err
+		}
+
+		cues, err := caption.ParseVTT(data)
+		if err != nil {
+			var captionErr *caption.Error
+			if errors.As(err, &captionErr) {
+				return nil, apperror.InvalidArgument("Invalid caption file").WithFieldViolation(field, captionErr.Error())
+			}
+			return nil, err
+		}
+
+		transcript = append(transcript, captionTranscript{language: track.Language, cues: cues})
+	}
+
+	return transcript, nil
+}
+
+// transcriptCues: baris lesson_transcript_cues, cue tanpa teks (hanya tag) tidak diindex
+func transcriptCues(lessonId string, transcript []captionTranscript) []*entity.LessonTranscriptCue {
+	var cues []*entity.LessonTranscriptCue
+	for _, track := range transcript {
+		for i, cue := range track.cues {
+			text := caption.PlainText(cue)
+			if text == "" {
+				continue
+			}
+
+			cues = append(cues, &entity.LessonTranscriptCue{
+				Id:       uuid.NewString(),
+				LessonId: lessonId,
+				Language: track.language,
+				CueIndex: int64(i),
+				StartMs:  cue.Start.Milliseconds(),
+				EndMs:    cue.End.Milliseconds(),
+				Text:     text,
+			})
+		}
+	}
+
+	return cues
+}
+
+// signCaptionUrls mengisi url setiap track caption dgn signed URL (uid: user yang login / "public" utk katalog)
+func signCaptionUrls(storageConfig config.StorageConfig, userId string, courseId *string, content *chapter_lesson.LessonContent) error {
+	video := content.GetVideo()
+	if video == nil || courseId == nil || storage.ValidateId(*courseId) != nil {
+		return nil
+	}
+
+	expiresAt := time.Now().Add(storageConfig.SignedURLTTL)
+	for _, track := range video.Captions {
+		track.Url = ""
+		//? data lama dgn nama file tidak valid tidak pernah ditandatangani
+		if storage.ValidateFileName(track.FileName) != nil {
+			continue
+		}
+
+		storagePath := path.Join(*courseId, storage.FolderLesson, track.FileName)
+		signedUrl, err := utils.BuildSignedStorageURL(storageConfig.ServiceURL, storagePath, userId, expiresAt, storageConfig.SigningSecret)
+		if err != nil {
+			return err
+		}
+		track.Url = signedUrl
+	}
+
+	return nil
+}
@@ -1,44 +1,44 @@
 package service
 
 import (
+	"context"
 	"crypto/tls"
+	"time"
 
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/metrics"
+	"github.com/abu-umair/be-lms-go/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"gopkg.in/gomail.v2"
 )
 
 type IMessageSender interface {
-	Send(to string, subject string, body string) error
+	Send(ctx context.Context, to string, subject string, body string) error
 }
 
 type emailSender struct {
-	host     string
-	port     int
-	user     string
-	password string
+	smtpConfig config.SMTPConfig
 }
 
 // NewEmailSender sekarang menerima konfigurasi SMTP
-func NewEmailSender(host string, port int, user string, password string) IMessageSender {
+func NewEmailSender(smtpConfig config.SMTPConfig) IMessageSender {
 	return &emailSender{
-		host:     host,
-		port:     port,
-		user:     user,
-		password: password,
+		smtpConfig: smtpConfig,
 	}
 }
 
-func (s *emailSender) Send(to string, subject string, body string) error {
+func (s *emailSender) Send(ctx context.Context, to string, subject string, body string) error { //? gomail belum mendukung context
 	m := gomail.NewMessage()
-	// m.SetHeader("From", s.user)
-	m.SetHeader("From", "admin@myapp.dev")
+	m.SetHeader("From", s.smtpConfig.From)
 	m.SetHeader("To", to)
 	m.SetHeader("Subject", subject)
 	m.SetBody("text/html", body)
 
-	d := gomail.NewDialer(s.host, s.port, s.user, s.password)
+	d := gomail.NewDialer(s.smtpConfig.Host, s.smtpConfig.Port, s.smtpConfig.Username, s.smtpConfig.Password)
 
 	// Opsi tambahan jika menggunakan self-signed certificate atau SMTP lokal (// Mailtrap biasanya bekerja dengan InsecureSkipVerify: true di tahap dev)
-	d.TLSConfig = &tls.Config{InsecureSkipVerify: true}
+	d.TLSConfig = &tls.Config{ServerName: s.smtpConfig.Host, InsecureSkipVerify: s.smtpConfig.InsecureSkipVerify}
 
 	// Send the email
 	if err := d.DialAndSend(m); err != nil {
@@ -46,3 +46,50 @@ func (s *emailSender) Send(to string, subject string, body string) error {
 	}
 	return nil
 }
+
+// instrumentedSender membungkus IMessageSender lain utk menghitung sukses/gagal & durasi kirim
+type instrumentedSender struct {
+	next IMessageSender
+}
+
+func NewInstrumentedSender(next IMessageSender) IMessageSender {
+	return &instrumentedSender{
+		next: next,
+	}
+}
+
+func (s *instrumentedSender) Send(ctx context.Context, to string, subject string, body string) error {
+	start := time.Now()
+	err := s.next.Send(ctx, to, subject, body)
+
+	metrics.EmailSendDuration.Observe(time.Since(start).Seconds())
+	metrics.EmailSendTotal.WithLabelValues(metrics.Result(err != nil)).Inc()
+
+	return err
+}
+
+// tracedSender membuat span utk setiap pengiriman (alamat tujuan & isi tidak dicatat)
+type tracedSender struct {
+	next IMessageSender
+}
+
+func NewTracedSender(next IMessageSender) IMessageSender {
+	return &tracedSender{
+		next: next,
+	}
+}
+
+func (s *tracedSender) Send(ctx context.Context, to string, subject string, body string) error {
+	ctx, span := tracing.StartSpan(ctx, "email.Send")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("email.subject", subject))
+
+	err := s.next.Send(ctx, to, subject, body)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
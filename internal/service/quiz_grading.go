@@ -0,0 +1,277 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/pb/quiz"
+)
+
+// quizSubmitGrace: toleransi latency jaringan setelah batas waktu attempt
+const quizSubmitGrace = 30 * time.Second
+
+// questionContentType: nilai kolom question_type sesuai oneof yang diisi
+func questionContentType(content *quiz.QuestionContent) string {
+	switch content.Type.(type) {
+	case *quiz.QuestionContent_SingleChoice:
+		return entity.QuestionTypeSingleChoice
+	case *quiz.QuestionContent_MultipleChoice:
+		return entity.QuestionTypeMultipleChoice
+	case *quiz.QuestionContent_TrueFalse:
+		return entity.QuestionTypeTrueFalse
+	case *quiz.QuestionContent_ShortAnswer:
+		return entity.QuestionTypeShortAnswer
+	case *quiz.QuestionContent_Ordering:
+		return entity.QuestionTypeOrdering
+	}
+
+	return ""
+}
+
+// questionOptions: pilihan jawaban (atau item yang diurutkan) dalam urutan yang ditulis instructor
+func questionOptions(content *quiz.QuestionContent) []*quiz.QuestionOption {
+	switch question := content.Type.(type) {
+	case *quiz.QuestionContent_SingleChoice:
+		return question.SingleChoice.Options
+	case *quiz.QuestionContent_MultipleChoice:
+		return question.MultipleChoice.Options
+	case *quiz.QuestionContent_Ordering:
+		return question.Ordering.Items
+	}
+
+	return nil
+}
+
+// validateQuestionContent: aturan kunci jawaban yang tidak bisa ditulis di proto
+func validateQuestionContent(content *quiz.QuestionContent) error {
+	var violations []apperror.FieldViolation
+
+	optionIds := map[string]bool{}
+	for i, option := range questionOptions(content) {
+		if optionIds[option.Id] {
+			violations = append(violations, apperror.FieldViolation{
+				Field:       fmt.Sprintf("content.options[%d].id", i),
+				Description: "option ids must be unique",
+			})
+		}
+		optionIds[option.Id] = true
+	}
+
+	switch question := content.Type.(type) {
+	case *quiz.QuestionContent_SingleChoice:
+		if !optionIds[question.SingleChoice.CorrectOptionId] {
+			violations = append(violations, apperror.FieldViolation{
+				Field:       "content.single_choice.correct_option_id",
+				Description: "must be one of the option ids",
+			})
+		}
+	case *quiz.QuestionContent_MultipleChoice:
+		for i, id := range question.MultipleChoice.CorrectOptionIds {
+			if !optionIds[id] {
+				violations = append(violations, apperror.FieldViolation{
+					Field:       fmt.Sprintf("content.multiple_choice.correct_option_ids[%d]", i),
+					Description: "must be one of the option ids",
+				})
+			}
+		}
+	case *quiz.QuestionContent_ShortAnswer:
+		for i, answer := range question.ShortAnswer.AcceptedAnswers {
+			if normalizeShortAnswer(answer, true) == "" {
+				violations = append(violations, apperror.FieldViolation{
+					Field:       fmt.Sprintf("content.short_answer.accepted_answers[%d]", i),
+					Description: "must not be blank",
+				})
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return apperror.InvalidArgument("Invalid question content").WithFieldViolations(violations)
+}
+
+// buildAttemptSheet mengambil soal dari bank (acak jika questions_per_attempt diisi), mengacak urutan soal / pilihan
+// sesuai pengaturan quiz, lalu menyimpan snapshot-nya agar perubahan bank soal tidak mengubah attempt yang berjalan
+func buildAttemptSheet(quizEntity *entity.Quiz, questions []*entity.QuizQuestion, shuffle func(n int, swap func(i, j int))) (*quiz.QuizAttemptSheet, int64, error) {
+	picked := slices.Clone(questions)
+	if quizEntity.QuestionsPerAttempt != nil && *quizEntity.QuestionsPerAttempt < int64(len(picked)) {
+		shuffle(len(picked), func(i, j int) { picked[i], picked[j] = picked[j], picked[i] })
+		picked = picked[:*quizEntity.QuestionsPerAttempt]
+		sort.SliceStable(picked, func(i, j int) bool { return picked[i].OrderQuestion < picked[j].OrderQuestion })
+	}
+	if quizEntity.ShuffleQuestions {
+		shuffle(len(picked), func(i, j int) { picked[i], picked[j] = picked[j], picked[i] })
+	}
+
+	sheet := &quiz.QuizAttemptSheet{}
+	var maxScore int64
+	for _, question := range picked {
+		var content quiz.QuestionContent
+		if err := unmarshalJSONB(question.Content, &content); err != nil {
+			return nil, 0, err
+		}
+
+		options := slices.Clone(questionOptions(&content))
+		_, isOrdering := content.Type.(*quiz.QuestionContent_Ordering)
+		if isOrdering || quizEntity.ShuffleOptions {
+			shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+		}
+		//? soal ordering tidak boleh tampil dalam urutan yang sudah benar
+		if isOrdering && slices.Equal(optionIds(options), optionIds(content.GetOrdering().Items)) {
+			options = append(options[1:], options[0])
+		}
+
+		sheet.Questions = append(sheet.Questions, &quiz.AttemptQuestionResult{
+			QuestionId:   question.Id,
+			QuestionType: question.QuestionType,
+			Prompt:       question.Prompt,
+			Points:       question.Points,
+			Options:      options,
+			Content:      &content,
+		})
+		maxScore += question.Points
+	}
+
+	return sheet, maxScore, nil
+}
+
+func optionIds(options []*quiz.QuestionOption) []string {
+	ids := make([]string, 0, len(options))
+	for _, option := range options {
+		ids = append(ids, option.Id)
+	}
+
+	return ids
+}
+
+// attemptQuestions: soal attempt utk learner, tanpa kunci jawaban
+func attemptQuestions(sheet *quiz.QuizAttemptSheet) []*quiz.AttemptQuestion {
+	questions := make([]*quiz.AttemptQuestion, 0, len(sheet.Questions))
+	for _, question := range sheet.Questions {
+		questions = append(questions, &quiz.AttemptQuestion{
+			Id:           question.QuestionId,
+			QuestionType: question.QuestionType,
+			Prompt:       question.Prompt,
+			Points:       question.Points,
+			Options:      question.Options,
+		})
+	}
+
+	return questions
+}
+
+// indexQuestionAnswers memetakan jawaban per soal, jawaban utk soal di luar attempt / dobel ditolak
+func indexQuestionAnswers(sheet *quiz.QuizAttemptSheet, answers []*quiz.QuestionAnswer) (map[string]*quiz.QuestionAnswer, error) {
+	inSheet := make(map[string]bool, len(sheet.Questions))
+	for _, question := range sheet.Questions {
+		inSheet[question.QuestionId] = true
+	}
+
+	var violations []apperror.FieldViolation
+	indexed := make(map[string]*quiz.QuestionAnswer, len(answers))
+	for i, answer := range answers {
+		switch {
+		case !inSheet[answer.QuestionId]:
+			violations = append(violations, apperror.FieldViolation{
+				Field:       fmt.Sprintf("answers[%d].question_id", i),
+				Description: "is not a question of this attempt",
+			})
+		case indexed[answer.QuestionId] != nil:
+			violations = append(violations, apperror.FieldViolation{
+				Field:       fmt.Sprintf("answers[%d].question_id", i),
+				Description: "is answered more than once",
+			})
+		default:
+			indexed[answer.QuestionId] = answer
+		}
+	}
+	if len(violations) > 0 {
+		return nil, apperror.InvalidArgument("Invalid answers").WithFieldViolations(violations)
+	}
+
+	return indexed, nil
+}
+
+// gradeAttemptSheet menilai setiap soal (benar = poin penuh) dan mencatat jawabannya di sheet, mengembalikan total skor
+func gradeAttemptSheet(sheet *quiz.QuizAttemptSheet, answers map[string]*quiz.QuestionAnswer) int64 {
+	var score int64
+	for _, question := range sheet.Questions {
+		answer := answers[question.QuestionId]
+		question.Answer = answer
+		question.Correct = answer != nil && isAnswerCorrect(question.Content, answer)
+		question.PointsAwarded = 0
+		if question.Correct {
+			question.PointsAwarded = question.Points
+			score += question.Points
+		}
+	}
+
+	return score
+}
+
+func isAnswerCorrect(content *quiz.QuestionContent, answer *quiz.QuestionAnswer) bool {
+	switch question := content.Type.(type) {
+	case *quiz.QuestionContent_SingleChoice:
+		return len(answer.OptionIds) == 1 && answer.OptionIds[0] == question.SingleChoice.CorrectOptionId
+	case *quiz.QuestionContent_MultipleChoice:
+		//? semua jawaban benar harus dipilih & tidak ada jawaban salah (tanpa nilai parsial)
+		given := slices.Clone(answer.OptionIds)
+		want := slices.Clone(question.MultipleChoice.CorrectOptionIds)
+		slices.Sort(given)
+		slices.Sort(want)
+		given = slices.Compact(given)
+		return len(given) == len(answer.OptionIds) && slices.Equal(given, want)
+	case *quiz.QuestionContent_TrueFalse:
+		return answer.TrueFalse != nil && *answer.TrueFalse == question.TrueFalse.CorrectAnswer
+	case *quiz.QuestionContent_ShortAnswer:
+		if answer.Text == nil {
+			return false
+		}
+		given := normalizeShortAnswer(*answer.Text, question.ShortAnswer.CaseSensitive)
+		for _, accepted := range question.ShortAnswer.AcceptedAnswers {
+			if given != "" && given == normalizeShortAnswer(accepted, question.ShortAnswer.CaseSensitive) {
+				return true
+			}
+		}
+		return false
+	case *quiz.QuestionContent_Ordering:
+		return slices.Equal(answer.OptionIds, optionIds(question.Ordering.Items))
+	}
+
+	return false
+}
+
+// normalizeShortAnswer: spasi berlebih diabaikan, huruf besar / kecil diabaikan kecuali caseSensitive
+func normalizeShortAnswer(value string, caseSensitive bool) string {
+	normalized := strings.Join(strings.Fields(value), " ")
+	if !caseSensitive {
+		normalized = strings.ToLower(normalized)
+	}
+
+	return normalized
+}
+
+// isQuizPassed: skor (dalam persen) mencapai pass mark, dihitung tanpa pembulatan
+func isQuizPassed(score int64, maxScore int64, passMarkPercent int64) bool {
+	return maxScore > 0 && score*100 >= passMarkPercent*maxScore
+}
+
+func scorePercent(score int64, maxScore int64) int64 {
+	if maxScore == 0 {
+		return 0
+	}
+
+	return score * 100 / maxScore
+}
+
+// isAttemptExpired: batas waktu (ditambah toleransi) sudah lewat
+func isAttemptExpired(attempt *entity.QuizAttempt, now time.Time) bool {
+	return attempt.ExpiresAt != nil && now.After(attempt.ExpiresAt.Add(quizSubmitGrace))
+}
@@ -0,0 +1,1068 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/quiz"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type IQuizService interface {
+	CreateQuiz(ctx context.Context, request *quiz.CreateQuizRequest) (*quiz.CreateQuizResponse, error)
+	DetailQuiz(ctx context.Context, request *quiz.DetailQuizRequest) (*quiz.DetailQuizResponse, error)
+	EditQuiz(ctx context.Context, request *quiz.EditQuizRequest) (*quiz.EditQuizResponse, error)
+	DeleteQuiz(ctx context.Context, request *quiz.DeleteQuizRequest) (*quiz.DeleteQuizResponse, error)
+	CreateQuizQuestion(ctx context.Context, request *quiz.CreateQuizQuestionRequest) (*quiz.CreateQuizQuestionResponse, error)
+	EditQuizQuestion(ctx context.Context, request *quiz.EditQuizQuestionRequest) (*quiz.EditQuizQuestionResponse, error)
+	DeleteQuizQuestion(ctx context.Context, request *quiz.DeleteQuizQuestionRequest) (*quiz.DeleteQuizQuestionResponse, error)
+	StartQuizAttempt(ctx context.Context, request *quiz.StartQuizAttemptRequest) (*quiz.StartQuizAttemptResponse, error)
+	SubmitQuizAttempt(ctx context.Context, request *quiz.SubmitQuizAttemptRequest) (*quiz.SubmitQuizAttemptResponse, error)
+	ListQuizAttempts(ctx context.Context, request *quiz.ListQuizAttemptsRequest) (*quiz.ListQuizAttemptsResponse, error)
+	DetailQuizAttempt(ctx context.Context, request *quiz.DetailQuizAttemptRequest) (*quiz.DetailQuizAttemptResponse, error)
+	GetCourseQuizProgress(ctx context.Context, request *quiz.GetCourseQuizProgressRequest) (*quiz.GetCourseQuizProgressResponse, error)
+}
+
+type quizService struct {
+	db                      *sqlx.DB
+	quizRepository          repository.IQuizRepository
+	quizAttemptRepository   repository.IQuizAttemptRepository
+	chapterLessonRepository repository.IChapterLessonRepository
+	courseRepository        repository.ICourseRepository
+	enrollmentRepository    repository.IEnrollmentRepository
+	releaseService          IReleaseService
+	shuffle                 func(n int, swap func(i, j int)) //? rand.Shuffle, bisa diganti di test
+}
+
+func (qs *quizService) CreateQuiz(ctx context.Context, request *quiz.CreateQuizRequest) (*quiz.CreateQuizResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	//* quiz hanya bisa dipasang di lesson berjenis quiz
+	lesson, err := qs.chapterLessonRepository.GetChapterLessonById(ctx, request.LessonId)
+	if err != nil {
+		return nil, err
+	}
+	if lesson == nil {
+		return nil, apperror.NotFound("Course chapter lesson not found")
+	}
+	if lesson.Kind == nil || *lesson.Kind != entity.LessonKindQuiz || lesson.CourseId == nil {
+		return nil, apperror.InvalidArgument("Lesson is not a quiz lesson").WithFieldViolation("lesson_id", "must be a lesson of kind quiz")
+	}
+
+	err = ensureCourseOwner(ctx, qs.courseRepository, claims, *lesson.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	quizRepo := qs.quizRepository.WithTransaction(tx.Tx)
+
+	// *insert ke DB
+	quizEntity := entity.Quiz{
+		Id:       uuid.NewString(),
+		LessonId: lesson.Id,
+		CourseId: *lesson.CourseId,
+
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+	applyQuizSettings(&quizEntity, request.Settings)
+
+	err = quizRepo.CreateNewQuiz(ctx, &quizEntity)
+	if err != nil {
+		if database.IsUniqueViolation(err, repository.QuizLessonUniqueIndex) {
+			err = apperror.AlreadyExists("Lesson already has a quiz").WithFieldViolation("lesson_id", "delete the existing quiz first")
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &quiz.CreateQuizResponse{
+		Base: utils.SuccessResponse("Quiz successfully created"),
+		Id:   quizEntity.Id,
+	}, nil
+}
+
+func (qs *quizService) DetailQuiz(ctx context.Context, request *quiz.DetailQuizRequest) (*quiz.DetailQuizResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	canManage, err := courseAccess(ctx, qs.courseRepository, qs.enrollmentRepository, claims, quizEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := qs.quizRepository.GetQuizQuestionsByQuizId(ctx, quizEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &quiz.DetailQuizResponse{
+		Base:          utils.SuccessResponse("Quiz Detail Success"),
+		Id:            quizEntity.Id,
+		LessonId:      quizEntity.LessonId,
+		CourseId:      quizEntity.CourseId,
+		Settings:      quizSettings(quizEntity),
+		QuestionCount: int64(len(questions)),
+	}
+
+	//? bank soal beserta kunci jawaban hanya utk instructor pemilik course / admin
+	if canManage {
+		for _, question := range questions {
+			item, err := quizQuestion(question)
+			if err != nil {
+				return nil, err
+			}
+			res.Questions = append(res.Questions, item)
+		}
+	}
+
+	attempts, err := qs.quizAttemptRepository.GetQuizAttempts(ctx, quizEntity.Id, &claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	res.AttemptsUsed = int64(len(attempts))
+	for _, attempt := range attempts {
+		res.Passed = res.Passed || (attempt.SubmittedAt != nil && attempt.Passed)
+	}
+
+	return res, nil
+}
+
+func (qs *quizService) EditQuiz(ctx context.Context, request *quiz.EditQuizRequest) (*quiz.EditQuizResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	err = ensureCourseOwner(ctx, qs.courseRepository, claims, quizEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	quizRepo := qs.quizRepository.WithTransaction(tx.Tx)
+
+	//? attempt yang sedang berjalan tetap memakai snapshot soalnya, pass mark baru berlaku saat dikumpulkan
+	applyQuizSettings(quizEntity, request.Settings)
+	quizEntity.UpdatedAt = time.Now()
+	quizEntity.UpdatedBy = &claims.FullName
+
+	err = quizRepo.UpdateQuiz(ctx, quizEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &quiz.EditQuizResponse{
+		Base: utils.SuccessResponse("Edit Quiz Success"),
+		Id:   quizEntity.Id,
+	}, nil
+}
+
+func (qs *quizService) DeleteQuiz(ctx context.Context, request *quiz.DeleteQuizRequest) (*quiz.DeleteQuizResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	err = ensureCourseOwner(ctx, qs.courseRepository, claims, quizEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	quizRepo := qs.quizRepository.WithTransaction(tx.Tx)
+
+	// *update delete_at & delete_by ke DB, riwayat attempt tetap disimpan
+	err = quizRepo.DeleteQuiz(ctx, request.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &quiz.DeleteQuizResponse{
+		Base: utils.SuccessResponse("Delete with SoftDelete Quiz Success"),
+	}, nil
+}
+
+func (qs *quizService) CreateQuizQuestion(ctx context.Context, request *quiz.CreateQuizQuestionRequest) (*quiz.CreateQuizQuestionResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	err = ensureCourseOwner(ctx, qs.courseRepository, claims, quizEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* kunci jawaban harus cocok dgn pilihan yang ada
+	err = validateQuestionContent(request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := marshalJSONB(request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	quizRepo := qs.quizRepository.WithTransaction(tx.Tx)
+
+	lastOrder, err := quizRepo.GetLastOrderQuestion(ctx, quizEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// *insert ke DB, soal baru selalu paling akhir
+	questionEntity := entity.QuizQuestion{
+		Id:            uuid.NewString(),
+		QuizId:        quizEntity.Id,
+		QuestionType:  questionContentType(request.Content),
+		Prompt:        request.Prompt,
+		Points:        request.Points,
+		OrderQuestion: lastOrder + 1,
+		Content:       content,
+
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+
+	err = quizRepo.CreateNewQuizQuestion(ctx, &questionEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &quiz.CreateQuizQuestionResponse{
+		Base: utils.SuccessResponse("Quiz question successfully created"),
+		Id:   questionEntity.Id,
+	}, nil
+}
+
+func (qs *quizService) EditQuizQuestion(ctx context.Context, request *quiz.EditQuizQuestionRequest) (*quiz.EditQuizQuestionResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	questionEntity, err := qs.quizQuestionOfOwner(ctx, claims, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	//* kunci jawaban harus cocok dgn pilihan yang ada
+	err = validateQuestionContent(request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := marshalJSONB(request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	quizRepo := qs.quizRepository.WithTransaction(tx.Tx)
+
+	//? attempt lama tidak berubah karena memakai snapshot soal
+	questionEntity.QuestionType = questionContentType(request.Content)
+	questionEntity.Prompt = request.Prompt
+	questionEntity.Points = request.Points
+	questionEntity.Content = content
+	questionEntity.UpdatedAt = time.Now()
+	questionEntity.UpdatedBy = &claims.FullName
+
+	err = quizRepo.UpdateQuizQuestion(ctx, questionEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &quiz.EditQuizQuestionResponse{
+		Base: utils.SuccessResponse("Edit Quiz Question Success"),
+		Id:   questionEntity.Id,
+	}, nil
+}
+
+func (qs *quizService) DeleteQuizQuestion(ctx context.Context, request *quiz.DeleteQuizQuestionRequest) (*quiz.DeleteQuizQuestionResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Instructor
+	if claims.Role != entity.UserRoleInstructor {
+		return nil, apperror.PermissionDenied("Only instructor can access this resource")
+	}
+
+	_, err = qs.quizQuestionOfOwner(ctx, claims, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	quizRepo := qs.quizRepository.WithTransaction(tx.Tx)
+
+	// *update delete_at & delete_by ke DB
+	err = quizRepo.DeleteQuizQuestion(ctx, request.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &quiz.DeleteQuizQuestionResponse{
+		Base: utils.SuccessResponse("Delete with SoftDelete Quiz Question Success"),
+	}, nil
+}
+
+func (qs *quizService) StartQuizAttempt(ctx context.Context, request *quiz.StartQuizAttemptRequest) (*quiz.StartQuizAttemptResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	//* hanya learner yang enroll yang bisa mengerjakan quiz
+	err = ensureEnrolled(ctx, qs.enrollmentRepository, claims, quizEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* soal lesson yang masih terkunci (drip / prerequisite) tidak boleh dibuka
+	err = qs.releaseService.EnsureLessonReleased(ctx, claims, quizEntity.LessonId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	quizRepo := qs.quizRepository.WithTransaction(tx.Tx)
+	attemptRepo := qs.quizAttemptRepository.WithTransaction(tx.Tx)
+	now := time.Now()
+
+	//* attempt yang masih berjalan dilanjutkan, yang sudah lewat waktu dinilai dgn jawaban kosong
+	openAttempt, err := attemptRepo.GetOpenQuizAttempt(ctx, quizEntity.Id, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if openAttempt != nil {
+		if !isAttemptExpired(openAttempt, now) {
+			var sheet quiz.QuizAttemptSheet
+			err = unmarshalJSONB(openAttempt.Sheet, &sheet)
+			if err != nil {
+				return nil, err
+			}
+
+			err = tx.Commit()
+			if err != nil {
+				return nil, err
+			}
+
+			return startQuizAttemptResponse(openAttempt, &sheet), nil
+		}
+
+		_, err = qs.finishAttempt(ctx, attemptRepo, quizEntity, openAttempt, nil, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	//* batas jumlah attempt (attempt yang lewat waktu tetap dihitung)
+	attemptCount, err := attemptRepo.CountQuizAttempts(ctx, quizEntity.Id, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity.MaxAttempts != nil && attemptCount >= *quizEntity.MaxAttempts {
+		err = apperror.ResourceExhausted("Attempt limit reached")
+		return nil, err
+	}
+
+	questions, err := quizRepo.GetQuizQuestionsByQuizId(ctx, quizEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) == 0 {
+		err = apperror.FailedPrecondition("Quiz has no questions")
+		return nil, err
+	}
+
+	sheet, maxScore, err := buildAttemptSheet(quizEntity, questions, qs.shuffle)
+	if err != nil {
+		return nil, err
+	}
+	sheetJSON, err := marshalJSONB(sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	// *insert attempt baru
+	attempt := entity.QuizAttempt{
+		Id:            uuid.NewString(),
+		QuizId:        quizEntity.Id,
+		CourseId:      quizEntity.CourseId,
+		UserId:        claims.Subject,
+		AttemptNumber: attemptCount + 1,
+		Sheet:         sheetJSON,
+		StartedAt:     now,
+		MaxScore:      maxScore,
+	}
+	if quizEntity.TimeLimitSeconds != nil {
+		expiresAt := now.Add(time.Duration(*quizEntity.TimeLimitSeconds) * time.Second)
+		attempt.ExpiresAt = &expiresAt
+	}
+
+	err = attemptRepo.CreateNewQuizAttempt(ctx, &attempt)
+	if err != nil {
+		//? dua request start bersamaan: hanya satu yang berhasil
+		if database.IsUniqueViolation(err, repository.QuizAttemptOpenUniqueIndex) || database.IsUniqueViolation(err, repository.QuizAttemptNumberUniqueIndex) {
+			err = apperror.AlreadyExists("Another attempt is already in progress")
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return startQuizAttemptResponse(&attempt, sheet), nil
+}
+
+func (qs *quizService) SubmitQuizAttempt(ctx context.Context, request *quiz.SubmitQuizAttemptRequest) (*quiz.SubmitQuizAttemptResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := qs.quizAttemptRepository.GetQuizAttemptById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if attempt == nil || attempt.UserId != claims.Subject {
+		return nil, apperror.NotFound("Quiz attempt not found")
+	}
+	if attempt.SubmittedAt != nil {
+		return nil, apperror.FailedPrecondition("Attempt already submitted")
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, attempt.QuizId)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	tx, err := database.BeginTransaction(ctx, qs.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	attemptRepo := qs.quizAttemptRepository.WithTransaction(tx.Tx)
+
+	// *nilai otomatis & simpan riwayat jawaban
+	submitted, err := qs.finishAttempt(ctx, attemptRepo, quizEntity, attempt, request.Answers, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !submitted {
+		err = apperror.FailedPrecondition("Attempt already submitted")
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// *success
+	return &quiz.SubmitQuizAttemptResponse{
+		Base:    utils.SuccessResponse("Submit Quiz Attempt Success"),
+		Attempt: quizAttemptSummary(attempt, time.Now()),
+	}, nil
+}
+
+func (qs *quizService) ListQuizAttempts(ctx context.Context, request *quiz.ListQuizAttemptsRequest) (*quiz.ListQuizAttemptsResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	canManage, err := courseAccess(ctx, qs.courseRepository, qs.enrollmentRepository, claims, quizEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	//* learner hanya melihat attempt miliknya sendiri
+	userId := request.UserId
+	if !canManage {
+		if userId != nil && *userId != claims.Subject {
+			return nil, apperror.PermissionDenied("You can only see your own attempts")
+		}
+		userId = &claims.Subject
+	}
+
+	attempts, err := qs.quizAttemptRepository.GetQuizAttempts(ctx, quizEntity.Id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	items := make([]*quiz.QuizAttemptSummary, 0, len(attempts))
+	for _, attempt := range attempts {
+		items = append(items, quizAttemptSummary(attempt, now))
+	}
+
+	// *success
+	return &quiz.ListQuizAttemptsResponse{
+		Base:  utils.SuccessResponse("List Quiz Attempts Success"),
+		Items: items,
+	}, nil
+}
+
+func (qs *quizService) DetailQuizAttempt(ctx context.Context, request *quiz.DetailQuizAttemptRequest) (*quiz.DetailQuizAttemptResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := qs.quizAttemptRepository.GetQuizAttemptById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if attempt == nil {
+		return nil, apperror.NotFound("Quiz attempt not found")
+	}
+
+	//* instructor pemilik course / admin boleh review semua attempt, learner hanya miliknya
+	canManage := false
+	if claims.Role == entity.UserRoleInstructor || claims.Role == entity.UserRoleAdmin {
+		canManage, err = courseAccess(ctx, qs.courseRepository, qs.enrollmentRepository, claims, attempt.CourseId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !canManage && attempt.UserId != claims.Subject {
+		return nil, apperror.NotFound("Quiz attempt not found")
+	}
+
+	res := &quiz.DetailQuizAttemptResponse{
+		Base:    utils.SuccessResponse("Quiz Attempt Detail Success"),
+		Attempt: quizAttemptSummary(attempt, time.Now()),
+	}
+
+	//? hasil per soal baru ada setelah dikumpulkan, kunci jawaban tidak dikirim ke learner
+	if attempt.SubmittedAt != nil {
+		var sheet quiz.QuizAttemptSheet
+		err = unmarshalJSONB(attempt.Sheet, &sheet)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range sheet.Questions {
+			if !canManage {
+				result.Content = nil
+			}
+			res.Results = append(res.Results, result)
+		}
+	}
+
+	return res, nil
+}
+
+func (qs *quizService) GetCourseQuizProgress(ctx context.Context, request *quiz.GetCourseQuizProgressRequest) (*quiz.GetCourseQuizProgressResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canManage, err := courseAccess(ctx, qs.courseRepository, qs.enrollmentRepository, claims, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId := claims.Subject
+	if request.UserId != nil {
+		if !canManage && *request.UserId != claims.Subject {
+			return nil, apperror.PermissionDenied("You can only see your own progress")
+		}
+		userId = *request.UserId
+	}
+
+	quizzes, err := qs.quizRepository.GetQuizzesByCourseId(ctx, request.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := qs.quizAttemptRepository.GetQuizAttemptsByCourse(ctx, request.CourseId, userId)
+	if err != nil {
+		return nil, err
+	}
+	attemptsByQuiz := map[string][]*entity.QuizAttempt{}
+	for _, attempt := range attempts {
+		attemptsByQuiz[attempt.QuizId] = append(attemptsByQuiz[attempt.QuizId], attempt)
+	}
+
+	res := &quiz.GetCourseQuizProgressResponse{
+		Base:             utils.SuccessResponse("Course Quiz Progress Success"),
+		AllQuizzesPassed: true,
+	}
+	for _, quizEntity := range quizzes {
+		item := &quiz.QuizProgressItem{
+			QuizId:          quizEntity.Id,
+			LessonId:        quizEntity.LessonId,
+			Title:           quizEntity.Title,
+			PassMarkPercent: quizEntity.PassMarkPercent,
+			MaxAttempts:     quizEntity.MaxAttempts,
+			AttemptsUsed:    int64(len(attemptsByQuiz[quizEntity.Id])),
+		}
+
+		for _, attempt := range attemptsByQuiz[quizEntity.Id] {
+			if attempt.SubmittedAt == nil || attempt.Score == nil {
+				continue
+			}
+			percent := scorePercent(*attempt.Score, attempt.MaxScore)
+			if item.BestScorePercent == nil || percent > *item.BestScorePercent {
+				item.BestScorePercent = &percent
+			}
+			item.Passed = item.Passed || attempt.Passed
+		}
+
+		//* pass mark setiap quiz menjadi syarat course selesai
+		//? server tidak menandai course selesai, client menggabungkan flag ini dgn lesson completed
+		res.AllQuizzesPassed = res.AllQuizzesPassed && item.Passed
+		res.Items = append(res.Items, item)
+	}
+
+	return res, nil
+}
+
+// finishAttempt menilai & menyimpan attempt. Attempt yang lewat batas waktu dinilai tanpa jawaban.
+// false jika attempt sudah dikumpulkan oleh request lain.
+func (qs *quizService) finishAttempt(ctx context.Context, attemptRepo repository.IQuizAttemptRepository, quizEntity *entity.Quiz, attempt *entity.QuizAttempt, answers []*quiz.QuestionAnswer, now time.Time) (bool, error) {
+	var sheet quiz.QuizAttemptSheet
+	err := unmarshalJSONB(attempt.Sheet, &sheet)
+	if err != nil {
+		return false, err
+	}
+
+	expired := isAttemptExpired(attempt, now)
+	indexed := map[string]*quiz.QuestionAnswer{}
+	if !expired {
+		indexed, err = indexQuestionAnswers(&sheet, answers)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	score := gradeAttemptSheet(&sheet, indexed)
+	sheetJSON, err := marshalJSONB(&sheet)
+	if err != nil {
+		return false, err
+	}
+
+	attempt.Sheet = sheetJSON
+	attempt.SubmittedAt = &now
+	attempt.Score = &score
+	attempt.Passed = isQuizPassed(score, attempt.MaxScore, quizEntity.PassMarkPercent)
+	attempt.Expired = expired
+
+	return attemptRepo.SubmitQuizAttempt(ctx, attempt)
+}
+
+// quizQuestionOfOwner mengambil soal beserta memastikan quiz-nya milik course instructor yang login
+func (qs *quizService) quizQuestionOfOwner(ctx context.Context, claims *jwtentity.JwtClaims, questionId string) (*entity.QuizQuestion, error) {
+	questionEntity, err := qs.quizRepository.GetQuizQuestionById(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+	if questionEntity == nil {
+		return nil, apperror.NotFound("Quiz question not found")
+	}
+
+	quizEntity, err := qs.quizRepository.GetQuizById(ctx, questionEntity.QuizId)
+	if err != nil {
+		return nil, err
+	}
+	if quizEntity == nil {
+		return nil, apperror.NotFound("Quiz not found")
+	}
+
+	err = ensureCourseOwner(ctx, qs.courseRepository, claims, quizEntity.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return questionEntity, nil
+}
+
+func applyQuizSettings(quizEntity *entity.Quiz, settings *quiz.QuizSettings) {
+	quizEntity.Title = settings.Title
+	quizEntity.Description = settings.Description
+	quizEntity.TimeLimitSeconds = settings.TimeLimitSeconds
+	quizEntity.MaxAttempts = settings.MaxAttempts
+	quizEntity.PassMarkPercent = settings.PassMarkPercent
+	quizEntity.QuestionsPerAttempt = settings.QuestionsPerAttempt
+	quizEntity.ShuffleQuestions = settings.ShuffleQuestions
+	quizEntity.ShuffleOptions = settings.ShuffleOptions
+}
+
+func quizSettings(quizEntity *entity.Quiz) *quiz.QuizSettings {
+	return &quiz.QuizSettings{
+		Title:               quizEntity.Title,
+		Description:         quizEntity.Description,
+		TimeLimitSeconds:    quizEntity.TimeLimitSeconds,
+		MaxAttempts:         quizEntity.MaxAttempts,
+		PassMarkPercent:     quizEntity.PassMarkPercent,
+		QuestionsPerAttempt: quizEntity.QuestionsPerAttempt,
+		ShuffleQuestions:    quizEntity.ShuffleQuestions,
+		ShuffleOptions:      quizEntity.ShuffleOptions,
+	}
+}
+
+func quizQuestion(questionEntity *entity.QuizQuestion) (*quiz.QuizQuestion, error) {
+	var content quiz.QuestionContent
+	err := unmarshalJSONB(questionEntity.Content, &content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quiz.QuizQuestion{
+		Id:            questionEntity.Id,
+		Prompt:        questionEntity.Prompt,
+		Points:        questionEntity.Points,
+		OrderQuestion: questionEntity.OrderQuestion,
+		Content:       &content,
+	}, nil
+}
+
+func startQuizAttemptResponse(attempt *entity.QuizAttempt, sheet *quiz.QuizAttemptSheet) *quiz.StartQuizAttemptResponse {
+	return &quiz.StartQuizAttemptResponse{
+		Base:          utils.SuccessResponse("Start Quiz Attempt Success"),
+		Id:            attempt.Id,
+		AttemptNumber: attempt.AttemptNumber,
+		ExpiresAt:     utils.PtrTimeToPtr(attempt.ExpiresAt),
+		Questions:     attemptQuestions(sheet),
+	}
+}
+
+// quizAttemptSummary: attempt yang belum dikumpulkan tapi sudah lewat waktu ditandai expired (dinilai saat attempt berikutnya dimulai)
+func quizAttemptSummary(attempt *entity.QuizAttempt, now time.Time) *quiz.QuizAttemptSummary {
+	summary := &quiz.QuizAttemptSummary{
+		Id:            attempt.Id,
+		QuizId:        attempt.QuizId,
+		UserId:        attempt.UserId,
+		AttemptNumber: attempt.AttemptNumber,
+		StartedAt:     attempt.StartedAt.Format(time.RFC3339),
+		ExpiresAt:     utils.PtrTimeToPtr(attempt.ExpiresAt),
+		SubmittedAt:   utils.PtrTimeToPtr(attempt.SubmittedAt),
+		Score:         attempt.Score,
+		MaxScore:      attempt.MaxScore,
+		Passed:        attempt.Passed,
+		Expired:       attempt.Expired || (attempt.SubmittedAt == nil && isAttemptExpired(attempt, now)),
+	}
+	if attempt.Score != nil {
+		percent := scorePercent(*attempt.Score, attempt.MaxScore)
+		summary.ScorePercent = &percent
+	}
+
+	return summary
+}
+
+func NewQuizService(db *sqlx.DB, quizRepository repository.IQuizRepository, quizAttemptRepository repository.IQuizAttemptRepository, chapterLessonRepository repository.IChapterLessonRepository, courseRepository repository.ICourseRepository, enrollmentRepository repository.IEnrollmentRepository, releaseService IReleaseService) IQuizService {
+	return &quizService{
+		db:                      db,
+		quizRepository:          quizRepository,
+		quizAttemptRepository:   quizAttemptRepository,
+		chapterLessonRepository: chapterLessonRepository,
+		courseRepository:        courseRepository,
+		enrollmentRepository:    enrollmentRepository,
+		releaseService:          releaseService,
+		shuffle:                 rand.Shuffle,
+	}
+}
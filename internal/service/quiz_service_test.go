@@ -0,0 +1,765 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/quiz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+const testQuizId = "9c8b7a6d-5e4f-4a3b-9c2d-1e0f9a8b7c6d"
+
+type quizFixture struct {
+	quizzes     *fake.QuizRepository
+	attempts    *fake.QuizAttemptRepository
+	lessons     *fake.ChapterLessonRepository
+	courses     *fake.CourseRepository
+	enrollments *fake.EnrollmentRepository
+	rules       *fake.ReleaseRuleRepository
+}
+
+func newQuizFixture(t *testing.T) *quizFixture {
+	t.Helper()
+
+	f := &quizFixture{
+		quizzes:     fake.NewQuizRepository(),
+		attempts:    fake.NewQuizAttemptRepository(),
+		lessons:     fake.NewChapterLessonRepository(),
+		courses:     fake.NewCourseRepository(),
+		enrollments: fake.NewEnrollmentRepository(),
+	}
+	f.rules = fake.NewReleaseRuleRepository(fake.NewCourseChapterRepository(), f.lessons)
+
+	owner := testUserId
+	f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: &owner})
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:       testLessonId,
+		CourseId: utils.StringToPtr(testCourseId),
+		Title:    "Quiz 1",
+		Kind:     utils.StringToPtr(entity.LessonKindQuiz),
+		Content:  utils.StringToPtr(`{"quiz":{}}`),
+	})
+
+	return f
+}
+
+// service: shuffle tidak mengubah urutan agar hasil bisa diprediksi (soal ordering tetap diputar)
+func (f *quizFixture) service(t *testing.T, expect txExpectation) IQuizService {
+	t.Helper()
+
+	releaseService := NewReleaseService(f.courses, f.lessons, f.enrollments, f.rules, fake.NewLessonCompletionRepository(f.lessons), f.quizzes, f.attempts)
+	qs := NewQuizService(newMockDB(t, expect), f.quizzes, f.attempts, f.lessons, f.courses, f.enrollments, releaseService).(*quizService)
+	qs.shuffle = func(n int, swap func(i, j int)) {}
+
+	return qs
+}
+
+func (f *quizFixture) enroll() {
+	f.enrollments.AddEnrollment(entity.Enrollment{
+		Id:         "enrollment-1",
+		UserId:     testUserId,
+		CourseId:   testCourseId,
+		EnrolledAt: time.Now(),
+	})
+}
+
+// addQuiz menyimpan quiz dgn pass mark 50%, modify bisa mengubah field sebelum disimpan
+func (f *quizFixture) addQuiz(modify func(quizEntity *entity.Quiz)) {
+	quizEntity := entity.Quiz{
+		Id:              testQuizId,
+		LessonId:        testLessonId,
+		CourseId:        testCourseId,
+		Title:           "Quiz 1",
+		PassMarkPercent: 50,
+		CreatedAt:       time.Now(),
+		CreatedBy:       "Test User",
+	}
+	if modify != nil {
+		modify(&quizEntity)
+	}
+
+	f.quizzes.AddQuiz(quizEntity)
+}
+
+func (f *quizFixture) addQuestion(t *testing.T, id string, order int64, points int64, content *quiz.QuestionContent) {
+	t.Helper()
+
+	raw, err := marshalJSONB(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.quizzes.AddQuizQuestion(entity.QuizQuestion{
+		Id:            id,
+		QuizId:        testQuizId,
+		QuestionType:  questionContentType(content),
+		Prompt:        "Question " + id,
+		Points:        points,
+		OrderQuestion: order,
+		Content:       raw,
+	})
+}
+
+func options(ids ...string) []*quiz.QuestionOption {
+	var result []*quiz.QuestionOption
+	for _, id := range ids {
+		result = append(result, &quiz.QuestionOption{Id: id, Text: "Option " + id})
+	}
+
+	return result
+}
+
+func singleChoice(correct string, ids ...string) *quiz.QuestionContent {
+	return &quiz.QuestionContent{Type: &quiz.QuestionContent_SingleChoice{SingleChoice: &quiz.SingleChoiceQuestion{
+		Options:         options(ids...),
+		CorrectOptionId: correct,
+	}}}
+}
+
+// addQuestionBank: 5 soal (satu per jenis), total 6 poin
+func (f *quizFixture) addQuestionBank(t *testing.T) {
+	t.Helper()
+
+	f.addQuestion(t, "q1", 1, 1, singleChoice("a", "a", "b"))
+	f.addQuestion(t, "q2", 2, 2, &quiz.QuestionContent{Type: &quiz.QuestionContent_MultipleChoice{MultipleChoice: &quiz.MultipleChoiceQuestion{
+		Options:          options("a", "b", "c"),
+		CorrectOptionIds: []string{"a", "c"},
+	}}})
+	f.addQuestion(t, "q3", 3, 1, &quiz.QuestionContent{Type: &quiz.QuestionContent_TrueFalse{TrueFalse: &quiz.TrueFalseQuestion{CorrectAnswer: true}}})
+	f.addQuestion(t, "q4", 4, 1, &quiz.QuestionContent{Type: &quiz.QuestionContent_ShortAnswer{ShortAnswer: &quiz.ShortAnswerQuestion{AcceptedAnswers: []string{"Jakarta"}}}})
+	f.addQuestion(t, "q5", 5, 1, &quiz.QuestionContent{Type: &quiz.QuestionContent_Ordering{Ordering: &quiz.OrderingQuestion{Items: options("x", "y", "z")}}})
+}
+
+// addAttempt menyimpan attempt milik testUserId, modify bisa mengubah field sebelum disimpan
+func (f *quizFixture) addAttempt(t *testing.T, id string, modify func(attempt *entity.QuizAttempt)) {
+	t.Helper()
+
+	raw, err := marshalJSONB(&quiz.QuizAttemptSheet{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attempt := entity.QuizAttempt{
+		Id:            id,
+		QuizId:        testQuizId,
+		CourseId:      testCourseId,
+		UserId:        testUserId,
+		AttemptNumber: 1,
+		Sheet:         raw,
+		StartedAt:     time.Now(),
+	}
+	if modify != nil {
+		modify(&attempt)
+	}
+
+	f.attempts.AddQuizAttempt(attempt)
+}
+
+func TestQuizServiceCreateQuiz(t *testing.T) {
+	request := &quiz.CreateQuizRequest{
+		LessonId: testLessonId,
+		Settings: &quiz.QuizSettings{Title: "Quiz 1", PassMarkPercent: 70, MaxAttempts: utils.Int64ToPtr(3)},
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(f *quizFixture)
+		ctx      context.Context
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "user role",
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "lesson not found",
+			setup: func(f *quizFixture) {
+				f.lessons = fake.NewChapterLessonRepository()
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "lesson is not a quiz lesson",
+			setup: func(f *quizFixture) {
+				f.lessons.AddChapterLesson(entity.ChapterLesson{Id: testLessonId, CourseId: utils.StringToPtr(testCourseId), Kind: utils.StringToPtr(entity.LessonKindVideo)})
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "course of another instructor",
+			setup: func(f *quizFixture) {
+				f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr("someone-else")})
+			},
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "lesson already has a quiz",
+			setup:    func(f *quizFixture) { f.addQuiz(nil) },
+			ctx:      contextInstructor,
+			tx:       txRollback,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "success",
+			ctx:      contextInstructor,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newQuizFixture(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, tt.tx).CreateQuiz(tt.ctx, request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, ok := f.quizzes.Quiz(res.Id)
+			if !ok || stored.CourseId != testCourseId || stored.PassMarkPercent != 70 || stored.MaxAttempts == nil || *stored.MaxAttempts != 3 {
+				t.Errorf("unexpected quiz: %+v", stored)
+			}
+		})
+	}
+}
+
+func TestQuizServiceCreateQuizQuestion(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  *quiz.QuestionContent
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "correct option is not an option",
+			content:  singleChoice("c", "a", "b"),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "duplicate option ids",
+			content:  singleChoice("a", "a", "a"),
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "blank accepted answer",
+			content: &quiz.QuestionContent{Type: &quiz.QuestionContent_ShortAnswer{ShortAnswer: &quiz.ShortAnswerQuestion{
+				AcceptedAnswers: []string{"  "},
+			}}},
+			tx:       txNone,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "appended after last question",
+			content:  singleChoice("b", "a", "b"),
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newQuizFixture(t)
+			f.addQuiz(nil)
+			f.addQuestion(t, "q1", 4, 1, singleChoice("a", "a", "b"))
+
+			res, err := f.service(t, tt.tx).CreateQuizQuestion(contextInstructor, &quiz.CreateQuizQuestionRequest{
+				QuizId:  testQuizId,
+				Prompt:  "Pick one",
+				Points:  2,
+				Content: tt.content,
+			})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			stored, ok := f.quizzes.QuizQuestion(res.Id)
+			if !ok || stored.OrderQuestion != 5 || stored.QuestionType != entity.QuestionTypeSingleChoice {
+				t.Errorf("unexpected question: %+v", stored)
+			}
+		})
+	}
+}
+
+func TestQuizServiceStartQuizAttempt(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(t *testing.T, f *quizFixture)
+		ctx        context.Context
+		tx         txExpectation
+		wantCode   codes.Code
+		wantNumber int64
+		wantId     string //? kosong = attempt baru
+	}{
+		{
+			name:     "not enrolled",
+			setup:    func(t *testing.T, f *quizFixture) { f.enrollments = fake.NewEnrollmentRepository() },
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "lesson still locked",
+			setup: func(t *testing.T, f *quizFixture) {
+				releaseAt := time.Now().Add(24 * time.Hour)
+				f.rules.AddReleaseRule(entity.ReleaseRule{Id: "rule-1", LessonId: utils.StringToPtr(testLessonId), ReleaseAt: &releaseAt})
+			},
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "quiz has no questions",
+			setup:    func(t *testing.T, f *quizFixture) { f.quizzes = fake.NewQuizRepository(); f.addQuiz(nil) },
+			ctx:      contextUser,
+			tx:       txRollback,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "attempt limit reached",
+			setup: func(t *testing.T, f *quizFixture) {
+				f.addQuiz(func(quizEntity *entity.Quiz) { quizEntity.MaxAttempts = utils.Int64ToPtr(1) })
+				f.addAttempt(t, "attempt-1", func(attempt *entity.QuizAttempt) {
+					submittedAt := time.Now()
+					attempt.SubmittedAt = &submittedAt
+					attempt.Score = utils.Int64ToPtr(0)
+				})
+			},
+			ctx:      contextUser,
+			tx:       txRollback,
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name: "open attempt is resumed",
+			setup: func(t *testing.T, f *quizFixture) {
+				f.addAttempt(t, "attempt-1", func(attempt *entity.QuizAttempt) { attempt.AttemptNumber = 1 })
+			},
+			ctx:        contextUser,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+			wantNumber: 1,
+			wantId:     "attempt-1",
+		},
+		{
+			name: "expired attempt is closed and a new one started",
+			setup: func(t *testing.T, f *quizFixture) {
+				f.addAttempt(t, "attempt-1", func(attempt *entity.QuizAttempt) {
+					expiresAt := time.Now().Add(-time.Hour)
+					attempt.ExpiresAt = &expiresAt
+				})
+			},
+			ctx:        contextUser,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+			wantNumber: 2,
+		},
+		{
+			name:       "success",
+			ctx:        contextUser,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+			wantNumber: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newQuizFixture(t)
+			f.enroll()
+			f.addQuiz(func(quizEntity *entity.Quiz) { quizEntity.TimeLimitSeconds = utils.Int64ToPtr(600) })
+			f.addQuestionBank(t)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service(t, tt.tx).StartQuizAttempt(tt.ctx, &quiz.StartQuizAttemptRequest{QuizId: testQuizId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.AttemptNumber != tt.wantNumber {
+				t.Errorf("attempt number = %d, want %d", res.AttemptNumber, tt.wantNumber)
+			}
+			if tt.wantId != "" {
+				if res.Id != tt.wantId {
+					t.Errorf("id = %s, want resumed %s", res.Id, tt.wantId)
+				}
+				return
+			}
+
+			if len(res.Questions) != 5 || res.ExpiresAt == nil {
+				t.Errorf("questions = %d, expires at %v, want 5 questions with expiry", len(res.Questions), res.ExpiresAt)
+			}
+			if previous, ok := f.attempts.QuizAttempt("attempt-1"); ok && (previous.SubmittedAt == nil || !previous.Expired) {
+				t.Errorf("expired attempt not closed: %+v", previous)
+			}
+		})
+	}
+}
+
+func TestQuizServiceSubmitQuizAttempt(t *testing.T) {
+	allCorrect := []*quiz.QuestionAnswer{
+		{QuestionId: "q1", OptionIds: []string{"a"}},
+		{QuestionId: "q2", OptionIds: []string{"c", "a"}},
+		{QuestionId: "q3", TrueFalse: proto.Bool(true)},
+		{QuestionId: "q4", Text: proto.String("  jakarta ")},
+		{QuestionId: "q5", OptionIds: []string{"x", "y", "z"}},
+	}
+
+	tests := []struct {
+		name       string
+		answers    []*quiz.QuestionAnswer
+		modify     func(t *testing.T, f *quizFixture, attemptId string)
+		ctx        context.Context
+		tx         txExpectation
+		wantCode   codes.Code
+		wantScore  int64
+		wantPassed bool
+	}{
+		{
+			name:       "all answers correct",
+			answers:    allCorrect,
+			ctx:        contextUser,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+			wantScore:  6,
+			wantPassed: true,
+		},
+		{
+			name: "partially correct below pass mark",
+			answers: []*quiz.QuestionAnswer{
+				{QuestionId: "q1", OptionIds: []string{"b"}},
+				{QuestionId: "q2", OptionIds: []string{"a"}},
+				{QuestionId: "q3", TrueFalse: proto.Bool(true)},
+				{QuestionId: "q4", Text: proto.String("Bandung")},
+				{QuestionId: "q5", OptionIds: []string{"x", "y", "z"}},
+			},
+			ctx:        contextUser,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+			wantScore:  2,
+			wantPassed: false,
+		},
+		{
+			name: "answer for unknown question",
+			answers: []*quiz.QuestionAnswer{
+				{QuestionId: "q9", OptionIds: []string{"a"}},
+			},
+			ctx:      contextUser,
+			tx:       txRollback,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:    "expired attempt ignores answers",
+			answers: allCorrect,
+			modify: func(t *testing.T, f *quizFixture, attemptId string) {
+				attempt, _ := f.attempts.QuizAttempt(attemptId)
+				expiresAt := time.Now().Add(-time.Hour)
+				attempt.ExpiresAt = &expiresAt
+				f.attempts.AddQuizAttempt(attempt)
+			},
+			ctx:        contextUser,
+			tx:         txCommit,
+			wantCode:   codes.OK,
+			wantScore:  0,
+			wantPassed: false,
+		},
+		{
+			name:    "attempt of another learner",
+			answers: allCorrect,
+			modify: func(t *testing.T, f *quizFixture, attemptId string) {
+				attempt, _ := f.attempts.QuizAttempt(attemptId)
+				attempt.UserId = "someone-else"
+				f.attempts.AddQuizAttempt(attempt)
+			},
+			ctx:      contextUser,
+			tx:       txNone,
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newQuizFixture(t)
+			f.enroll()
+			f.addQuiz(func(quizEntity *entity.Quiz) { quizEntity.TimeLimitSeconds = utils.Int64ToPtr(600) })
+			f.addQuestionBank(t)
+
+			started, err := f.service(t, txCommit).StartQuizAttempt(contextUser, &quiz.StartQuizAttemptRequest{QuizId: testQuizId})
+			assertCode(t, err, codes.OK)
+			if tt.modify != nil {
+				tt.modify(t, f, started.Id)
+			}
+
+			res, err := f.service(t, tt.tx).SubmitQuizAttempt(tt.ctx, &quiz.SubmitQuizAttemptRequest{Id: started.Id, Answers: tt.answers})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.Attempt.Score == nil || *res.Attempt.Score != tt.wantScore || res.Attempt.MaxScore != 6 || res.Attempt.Passed != tt.wantPassed {
+				t.Errorf("score = %v/%d passed %v, want %d/6 passed %v", res.Attempt.Score, res.Attempt.MaxScore, res.Attempt.Passed, tt.wantScore, tt.wantPassed)
+			}
+
+			//? submit kedua ditolak
+			_, err = f.service(t, txNone).SubmitQuizAttempt(tt.ctx, &quiz.SubmitQuizAttemptRequest{Id: started.Id, Answers: tt.answers})
+			assertCode(t, err, codes.FailedPrecondition)
+		})
+	}
+}
+
+func TestQuizServiceDetailQuizAttempt(t *testing.T) {
+	f := newQuizFixture(t)
+	f.enroll()
+	f.addQuiz(nil)
+	f.addQuestionBank(t)
+
+	started, err := f.service(t, txCommit).StartQuizAttempt(contextUser, &quiz.StartQuizAttemptRequest{QuizId: testQuizId})
+	assertCode(t, err, codes.OK)
+
+	//? sebelum dikumpulkan belum ada hasil per soal
+	res, err := f.service(t, txNone).DetailQuizAttempt(contextUser, &quiz.DetailQuizAttemptRequest{Id: started.Id})
+	assertCode(t, err, codes.OK)
+	if len(res.Results) != 0 {
+		t.Fatalf("results before submit = %d, want 0", len(res.Results))
+	}
+
+	_, err = f.service(t, txCommit).SubmitQuizAttempt(contextUser, &quiz.SubmitQuizAttemptRequest{Id: started.Id, Answers: []*quiz.QuestionAnswer{
+		{QuestionId: "q1", OptionIds: []string{"b"}},
+	}})
+	assertCode(t, err, codes.OK)
+
+	learner, err := f.service(t, txNone).DetailQuizAttempt(contextUser, &quiz.DetailQuizAttemptRequest{Id: started.Id})
+	assertCode(t, err, codes.OK)
+	if len(learner.Results) != 5 {
+		t.Fatalf("results = %d, want 5", len(learner.Results))
+	}
+	for _, result := range learner.Results {
+		if result.Content != nil {
+			t.Errorf("answer key of %s sent to learner", result.QuestionId)
+		}
+	}
+	if first := learner.Results[0]; first.Answer == nil || !slices.Equal(first.Answer.OptionIds, []string{"b"}) || first.Correct {
+		t.Errorf("unexpected answer history: %+v", first)
+	}
+
+	instructor, err := f.service(t, txNone).DetailQuizAttempt(contextInstructor, &quiz.DetailQuizAttemptRequest{Id: started.Id})
+	assertCode(t, err, codes.OK)
+	if instructor.Results[0].Content == nil {
+		t.Errorf("answer key missing for course owner")
+	}
+
+	f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr("someone-else")})
+	_, err = f.service(t, txNone).DetailQuizAttempt(contextInstructor, &quiz.DetailQuizAttemptRequest{Id: started.Id})
+	assertCode(t, err, codes.PermissionDenied)
+}
+
+func TestQuizServiceGetCourseQuizProgress(t *testing.T) {
+	submitted := func(score int64, passed bool) func(attempt *entity.QuizAttempt) {
+		return func(attempt *entity.QuizAttempt) {
+			submittedAt := time.Now()
+			attempt.SubmittedAt = &submittedAt
+			attempt.Score = &score
+			attempt.MaxScore = 10
+			attempt.Passed = passed
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(t *testing.T, f *quizFixture)
+		ctx         context.Context
+		userId      *string
+		wantCode    codes.Code
+		wantAll     bool
+		wantBest    *int64
+		wantAttempt int64
+	}{
+		{
+			name:     "course without quizzes is complete",
+			setup:    func(t *testing.T, f *quizFixture) { f.quizzes = fake.NewQuizRepository() },
+			ctx:      contextUser,
+			wantCode: codes.OK,
+			wantAll:  true,
+		},
+		{
+			name: "failed attempt does not complete the course",
+			setup: func(t *testing.T, f *quizFixture) {
+				f.addAttempt(t, "attempt-1", submitted(3, false))
+			},
+			ctx:         contextUser,
+			wantCode:    codes.OK,
+			wantAll:     false,
+			wantBest:    utils.Int64ToPtr(30),
+			wantAttempt: 1,
+		},
+		{
+			name: "best attempt passes",
+			setup: func(t *testing.T, f *quizFixture) {
+				f.addAttempt(t, "attempt-1", submitted(3, false))
+				f.addAttempt(t, "attempt-2", func(attempt *entity.QuizAttempt) {
+					submitted(8, true)(attempt)
+					attempt.AttemptNumber = 2
+				})
+				f.addAttempt(t, "attempt-3", func(attempt *entity.QuizAttempt) { attempt.AttemptNumber = 3 })
+			},
+			ctx:         contextUser,
+			wantCode:    codes.OK,
+			wantAll:     true,
+			wantBest:    utils.Int64ToPtr(80),
+			wantAttempt: 3,
+		},
+		{
+			name:     "learner cannot see progress of another learner",
+			ctx:      contextUser,
+			userId:   utils.StringToPtr("someone-else"),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "course owner sees progress of a learner",
+			ctx:      contextInstructor,
+			userId:   utils.StringToPtr("someone-else"),
+			wantCode: codes.OK,
+			wantAll:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newQuizFixture(t)
+			f.enroll()
+			f.addQuiz(nil)
+			if tt.setup != nil {
+				tt.setup(t, f)
+			}
+
+			res, err := f.service(t, txNone).GetCourseQuizProgress(tt.ctx, &quiz.GetCourseQuizProgressRequest{CourseId: testCourseId, UserId: tt.userId})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			if res.AllQuizzesPassed != tt.wantAll {
+				t.Errorf("all quizzes passed = %v, want %v", res.AllQuizzesPassed, tt.wantAll)
+			}
+			if len(res.Items) == 0 {
+				return
+			}
+			item := res.Items[0]
+			if item.AttemptsUsed != tt.wantAttempt {
+				t.Errorf("attempts used = %d, want %d", item.AttemptsUsed, tt.wantAttempt)
+			}
+			if (item.BestScorePercent == nil) != (tt.wantBest == nil) || (tt.wantBest != nil && *item.BestScorePercent != *tt.wantBest) {
+				t.Errorf("best score = %v, want %v", item.BestScorePercent, tt.wantBest)
+			}
+		})
+	}
+}
+
+func TestIsAnswerCorrect(t *testing.T) {
+	multiple := &quiz.QuestionContent{Type: &quiz.QuestionContent_MultipleChoice{MultipleChoice: &quiz.MultipleChoiceQuestion{
+		Options:          options("a", "b", "c"),
+		CorrectOptionIds: []string{"a", "c"},
+	}}}
+	shortAnswer := func(caseSensitive bool) *quiz.QuestionContent {
+		return &quiz.QuestionContent{Type: &quiz.QuestionContent_ShortAnswer{ShortAnswer: &quiz.ShortAnswerQuestion{
+			AcceptedAnswers: []string{"New  York"},
+			CaseSensitive:   caseSensitive,
+		}}}
+	}
+	ordering := &quiz.QuestionContent{Type: &quiz.QuestionContent_Ordering{Ordering: &quiz.OrderingQuestion{Items: options("x", "y", "z")}}}
+
+	tests := []struct {
+		name    string
+		content *quiz.QuestionContent
+		answer  *quiz.QuestionAnswer
+		want    bool
+	}{
+		{"single choice correct", singleChoice("a", "a", "b"), &quiz.QuestionAnswer{OptionIds: []string{"a"}}, true},
+		{"single choice with two picks", singleChoice("a", "a", "b"), &quiz.QuestionAnswer{OptionIds: []string{"a", "b"}}, false},
+		{"multiple choice any order", multiple, &quiz.QuestionAnswer{OptionIds: []string{"c", "a"}}, true},
+		{"multiple choice missing one", multiple, &quiz.QuestionAnswer{OptionIds: []string{"a"}}, false},
+		{"multiple choice extra wrong one", multiple, &quiz.QuestionAnswer{OptionIds: []string{"a", "b", "c"}}, false},
+		{"multiple choice duplicate pick", multiple, &quiz.QuestionAnswer{OptionIds: []string{"a", "a", "c"}}, false},
+		{"true false without answer", &quiz.QuestionContent{Type: &quiz.QuestionContent_TrueFalse{TrueFalse: &quiz.TrueFalseQuestion{}}}, &quiz.QuestionAnswer{}, false},
+		{"true false false", &quiz.QuestionContent{Type: &quiz.QuestionContent_TrueFalse{TrueFalse: &quiz.TrueFalseQuestion{}}}, &quiz.QuestionAnswer{TrueFalse: proto.Bool(false)}, true},
+		{"short answer normalized", shortAnswer(false), &quiz.QuestionAnswer{Text: proto.String(" new york ")}, true},
+		{"short answer case sensitive", shortAnswer(true), &quiz.QuestionAnswer{Text: proto.String("new york")}, false},
+		{"short answer blank", shortAnswer(false), &quiz.QuestionAnswer{Text: proto.String(" ")}, false},
+		{"ordering correct", ordering, &quiz.QuestionAnswer{OptionIds: []string{"x", "y", "z"}}, true},
+		{"ordering wrong", ordering, &quiz.QuestionAnswer{OptionIds: []string{"y", "x", "z"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAnswerCorrect(tt.content, tt.answer); got != tt.want {
+				t.Errorf("isAnswerCorrect = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildAttemptSheet(t *testing.T) {
+	f := newQuizFixture(t)
+	f.addQuiz(nil)
+	f.addQuestionBank(t)
+	questions, err := f.quizzes.GetQuizQuestionsByQuizId(context.Background(), testQuizId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reverse := func(n int, swap func(i, j int)) {
+		for i := 0; i < n/2; i++ {
+			swap(i, n-1-i)
+		}
+	}
+
+	//? 3 soal diambil, tetap urut order_question karena shuffle_questions mati
+	sheet, maxScore, err := buildAttemptSheet(&entity.Quiz{QuestionsPerAttempt: utils.Int64ToPtr(3)}, questions, reverse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, question := range sheet.Questions {
+		ids = append(ids, question.QuestionId)
+	}
+	if !slices.Equal(ids, []string{"q3", "q4", "q5"}) || maxScore != 3 {
+		t.Errorf("picked %v (max %d), want [q3 q4 q5] (max 3)", ids, maxScore)
+	}
+
+	//? pilihan tidak diacak, item ordering tidak pernah tampil dalam urutan benar
+	sheet, _, err = buildAttemptSheet(&entity.Quiz{}, questions, func(n int, swap func(i, j int)) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := optionIds(sheet.Questions[1].Options); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("options = %v, want [a b c]", got)
+	}
+	if got := optionIds(sheet.Questions[4].Options); slices.Equal(got, []string{"x", "y", "z"}) {
+		t.Errorf("ordering items shown in the correct order")
+	}
+}
@@ -0,0 +1,527 @@
+package service
+
+import (
+	"context"
+	"time"
+	_ "time/tzdata" //? timezone course tetap bisa dibaca walau image tanpa /usr/share/zoneinfo
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/pb/common"
+)
+
+// releaseAtLayout: format release_at (waktu lokal tanpa zona) di request & response
+const releaseAtLayout = "2006-01-02T15:04:05"
+
+// ReleaseState: status rilis chapter / lesson utk satu user, UnlockAt nil jika waktunya belum bisa ditentukan
+type ReleaseState struct {
+	Locked               bool
+	UnlockAt             *time.Time
+	Reason               string
+	PrerequisiteLessonId *string
+	PrerequisiteQuizId   *string
+}
+
+// CourseRelease: hasil evaluasi aturan rilis satu course utk user yang login
+type CourseRelease struct {
+	ChapterRules map[string]*entity.ReleaseRule //? key: chapter_id
+	LessonRules  map[string]*entity.ReleaseRule //? key: lesson_id
+	Chapters     map[string]ReleaseState        //? hanya berisi yang terkunci
+	Lessons      map[string]ReleaseState        //? hanya berisi yang terkunci (aturan chapter sudah digabung)
+	Completed    map[string]bool                //? lesson yang sudah diselesaikan (lesson quiz: quiz-nya lulus)
+}
+
+// IReleaseService menghitung status rilis (drip & prerequisite) chapter / lesson, dipakai service lain sebelum menyajikan isi lesson
+type IReleaseService interface {
+	CourseRelease(ctx context.Context, claims *jwtentity.JwtClaims, courseId string) (*CourseRelease, error)
+	LessonRelease(ctx context.Context, claims *jwtentity.JwtClaims, lesson *entity.ChapterLesson) (ReleaseState, error)
+	EnsureLessonReleased(ctx context.Context, claims *jwtentity.JwtClaims, lessonId string) error
+	ValidateReleaseRule(ctx context.Context, courseId string, rule *entity.ReleaseRule) error
+}
+
+type releaseService struct {
+	courseRepository           repository.ICourseRepository
+	chapterLessonRepository    repository.IChapterLessonRepository
+	enrollmentRepository       repository.IEnrollmentRepository
+	releaseRuleRepository      repository.IReleaseRuleRepository
+	lessonCompletionRepository repository.ILessonCompletionRepository
+	quizRepository             repository.IQuizRepository
+	quizAttemptRepository      repository.IQuizAttemptRepository
+}
+
+// CourseRelease: instructor / admin tidak pernah terkunci, lesson preview selalu terbuka
+func (rs *releaseService) CourseRelease(ctx context.Context, claims *jwtentity.JwtClaims, courseId string) (*CourseRelease, error) {
+	courseEntity, err := rs.courseRepository.GetCourseById(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+	if courseEntity == nil {
+		return nil, apperror.NotFound("Course not found")
+	}
+
+	rules, err := rs.releaseRuleRepository.GetReleaseRulesByCourseId(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CourseRelease{
+		ChapterRules: map[string]*entity.ReleaseRule{},
+		LessonRules:  map[string]*entity.ReleaseRule{},
+		Chapters:     map[string]ReleaseState{},
+		Lessons:      map[string]ReleaseState{},
+		Completed:    map[string]bool{},
+	}
+	for _, rule := range rules {
+		if rule.ChapterId != nil {
+			res.ChapterRules[*rule.ChapterId] = rule
+		}
+		if rule.LessonId != nil {
+			res.LessonRules[*rule.LessonId] = rule
+		}
+	}
+
+	if claims.Role != entity.UserRoleUser {
+		return res, nil
+	}
+
+	lessons, err := rs.chapterLessonRepository.GetCurriculumLessonsByCourseId(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := rs.enrollmentRepository.GetEnrollment(ctx, courseId, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := rs.courseProgress(ctx, courseId, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	res.Completed = progress.completedLessons
+
+	liveLessons := make(map[string]bool, len(lessons))
+	for _, lesson := range lessons {
+		liveLessons[lesson.Id] = true
+	}
+
+	evaluator := releaseEvaluator{
+		now:         time.Now(),
+		location:    courseLocation(courseEntity),
+		enrollment:  enrollment,
+		liveLessons: liveLessons,
+		progress:    progress,
+	}
+
+	for chapterId, rule := range res.ChapterRules {
+		if state := evaluator.evaluate(rule); state.Locked {
+			res.Chapters[chapterId] = state
+		}
+	}
+
+	for _, lesson := range lessons {
+		//? lesson preview memang dibuka utk siapa saja
+		if lesson.IsPreview != nil && *lesson.IsPreview == entity.LessonIsPreview {
+			continue
+		}
+
+		var state ReleaseState
+		if lesson.ChapterId != nil {
+			state = res.Chapters[*lesson.ChapterId]
+		}
+		if rule, ok := res.LessonRules[lesson.Id]; ok {
+			state = mergeReleaseStates(state, evaluator.evaluate(rule))
+		}
+		if state.Locked {
+			res.Lessons[lesson.Id] = state
+		}
+	}
+
+	return res, nil
+}
+
+func (rs *releaseService) LessonRelease(ctx context.Context, claims *jwtentity.JwtClaims, lesson *entity.ChapterLesson) (ReleaseState, error) {
+	if claims.Role != entity.UserRoleUser || lesson.CourseId == nil {
+		return ReleaseState{}, nil
+	}
+	if lesson.IsPreview != nil && *lesson.IsPreview == entity.LessonIsPreview {
+		return ReleaseState{}, nil
+	}
+
+	courseRelease, err := rs.CourseRelease(ctx, claims, *lesson.CourseId)
+	if err != nil {
+		return ReleaseState{}, err
+	}
+
+	return courseRelease.Lessons[lesson.Id], nil
+}
+
+// EnsureLessonReleased menolak akses ke isi lesson yang masih terkunci (lesson yang tidak ditemukan dibiarkan, dicek pemanggil)
+func (rs *releaseService) EnsureLessonReleased(ctx context.Context, claims *jwtentity.JwtClaims, lessonId string) error {
+	lesson, err := rs.chapterLessonRepository.GetChapterLessonById(ctx, lessonId)
+	if err != nil {
+		return err
+	}
+	if lesson == nil {
+		return nil
+	}
+
+	state, err := rs.LessonRelease(ctx, claims, lesson)
+	if err != nil {
+		return err
+	}
+
+	return releaseLockedError(state)
+}
+
+// releaseLockedError: nil jika lesson sudah terbuka
+func releaseLockedError(state ReleaseState) error {
+	if !state.Locked {
+		return nil
+	}
+
+	if state.UnlockAt != nil {
+		return apperror.FailedPrecondition("Lesson is locked until " + state.UnlockAt.Format(time.RFC3339))
+	}
+	if state.Reason == entity.ReleaseReasonPrerequisite {
+		return apperror.FailedPrecondition("Lesson is locked until its prerequisite is completed")
+	}
+
+	return apperror.FailedPrecondition("Lesson is locked")
+}
+
+// ValidateReleaseRule: prerequisite harus berada di course yang sama & tidak boleh membentuk siklus (lesson tidak akan pernah terbuka)
+func (rs *releaseService) ValidateReleaseRule(ctx context.Context, courseId string, rule *entity.ReleaseRule) error {
+	lessons, err := rs.chapterLessonRepository.GetCurriculumLessonsByCourseId(ctx, courseId)
+	if err != nil {
+		return err
+	}
+
+	quizzes, err := rs.quizRepository.GetQuizzesByCourseId(ctx, courseId)
+	if err != nil {
+		return err
+	}
+	quizLessons := make(map[string]string, len(quizzes))
+	for _, quizEntity := range quizzes {
+		quizLessons[quizEntity.Id] = quizEntity.LessonId
+	}
+
+	lessonChapters := make(map[string]string, len(lessons))
+	for _, lesson := range lessons {
+		lessonChapters[lesson.Id] = ""
+		if lesson.ChapterId != nil {
+			lessonChapters[lesson.Id] = *lesson.ChapterId
+		}
+	}
+
+	if rule.PrerequisiteLessonId != nil {
+		if _, ok := lessonChapters[*rule.PrerequisiteLessonId]; !ok {
+			return apperror.InvalidArgument("Invalid prerequisite lesson").WithFieldViolation("rule.prerequisite_lesson_id", "must be a lesson in the same course")
+		}
+	}
+	if rule.PrerequisiteQuizId != nil {
+		if _, ok := quizLessons[*rule.PrerequisiteQuizId]; !ok {
+			return apperror.InvalidArgument("Invalid prerequisite quiz").WithFieldViolation("rule.prerequisite_quiz_id", "must be a quiz in the same course")
+		}
+	}
+
+	//* graf dependensi lesson -> prerequisite (aturan chapter berlaku utk semua lesson di dalamnya), aturan baru menggantikan yang lama
+	rules, err := rs.releaseRuleRepository.GetReleaseRulesByCourseId(ctx, courseId)
+	if err != nil {
+		return err
+	}
+	chapterRules := map[string]*entity.ReleaseRule{}
+	lessonRules := map[string]*entity.ReleaseRule{}
+	for _, existing := range append(rules, rule) {
+		if existing.ChapterId != nil {
+			chapterRules[*existing.ChapterId] = existing
+		}
+		if existing.LessonId != nil {
+			lessonRules[*existing.LessonId] = existing
+		}
+	}
+
+	prerequisiteOf := func(rule *entity.ReleaseRule) string {
+		switch {
+		case rule == nil:
+			return ""
+		case rule.PrerequisiteLessonId != nil:
+			return *rule.PrerequisiteLessonId
+		case rule.PrerequisiteQuizId != nil:
+			return quizLessons[*rule.PrerequisiteQuizId]
+		}
+		return ""
+	}
+
+	dependencies := make(map[string][]string, len(lessons))
+	for lessonId, chapterId := range lessonChapters {
+		for _, prerequisite := range []string{prerequisiteOf(chapterRules[chapterId]), prerequisiteOf(lessonRules[lessonId])} {
+			if _, ok := lessonChapters[prerequisite]; ok {
+				dependencies[lessonId] = append(dependencies[lessonId], prerequisite)
+			}
+		}
+	}
+
+	if hasDependencyCycle(dependencies) {
+		return apperror.InvalidArgument("Release rule creates a prerequisite cycle").WithFieldViolation("rule", "prerequisite must not (indirectly) depend on the locked lesson or chapter itself")
+	}
+
+	return nil
+}
+
+// courseProgress: lesson yang sudah selesai & quiz yang sudah lulus milik user di course
+type courseProgress struct {
+	completedLessons map[string]bool
+	passedQuizzes    map[string]bool
+	liveQuizzes      map[string]bool
+}
+
+func (rs *releaseService) courseProgress(ctx context.Context, courseId string, userId string) (*courseProgress, error) {
+	progress := &courseProgress{
+		completedLessons: map[string]bool{},
+		passedQuizzes:    map[string]bool{},
+		liveQuizzes:      map[string]bool{},
+	}
+
+	lessonIds, err := rs.lessonCompletionRepository.GetCompletedLessonIds(ctx, courseId, userId)
+	if err != nil {
+		return nil, err
+	}
+	for _, lessonId := range lessonIds {
+		progress.completedLessons[lessonId] = true
+	}
+
+	attempts, err := rs.quizAttemptRepository.GetQuizAttemptsByCourse(ctx, courseId, userId)
+	if err != nil {
+		return nil, err
+	}
+	for _, attempt := range attempts {
+		if attempt.SubmittedAt != nil && attempt.Passed {
+			progress.passedQuizzes[attempt.QuizId] = true
+		}
+	}
+
+	//? lesson quiz selesai jika quiz-nya lulus
+	quizzes, err := rs.quizRepository.GetQuizzesByCourseId(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+	for _, quizEntity := range quizzes {
+		progress.liveQuizzes[quizEntity.Id] = true
+		if progress.passedQuizzes[quizEntity.Id] {
+			progress.completedLessons[quizEntity.LessonId] = true
+		}
+	}
+
+	return progress, nil
+}
+
+type releaseEvaluator struct {
+	now         time.Time
+	location    *time.Location
+	enrollment  *entity.Enrollment
+	liveLessons map[string]bool
+	progress    *courseProgress
+}
+
+// evaluate: prerequisite yang sudah dihapus / dipindah ke course lain diabaikan (tidak mengunci selamanya)
+func (e releaseEvaluator) evaluate(rule *entity.ReleaseRule) ReleaseState {
+	switch {
+	case rule.ReleaseAt != nil:
+		unlockAt := releaseAtIn(*rule.ReleaseAt, e.location)
+		if e.now.Before(unlockAt) {
+			return ReleaseState{Locked: true, UnlockAt: &unlockAt, Reason: entity.ReleaseReasonReleaseAt}
+		}
+	case rule.DaysAfterEnrollment != nil:
+		if e.enrollment == nil {
+			return ReleaseState{Locked: true, Reason: entity.ReleaseReasonDaysAfterEnrollment}
+		}
+		unlockAt := e.enrollment.EnrolledAt.In(e.location).AddDate(0, 0, int(*rule.DaysAfterEnrollment))
+		if e.now.Before(unlockAt) {
+			return ReleaseState{Locked: true, UnlockAt: &unlockAt, Reason: entity.ReleaseReasonDaysAfterEnrollment}
+		}
+	case rule.PrerequisiteLessonId != nil:
+		lessonId := *rule.PrerequisiteLessonId
+		if e.liveLessons[lessonId] && !e.progress.completedLessons[lessonId] {
+			return ReleaseState{Locked: true, Reason: entity.ReleaseReasonPrerequisite, PrerequisiteLessonId: &lessonId}
+		}
+	case rule.PrerequisiteQuizId != nil:
+		quizId := *rule.PrerequisiteQuizId
+		if e.progress.liveQuizzes[quizId] && !e.progress.passedQuizzes[quizId] {
+			return ReleaseState{Locked: true, Reason: entity.ReleaseReasonPrerequisite, PrerequisiteQuizId: &quizId}
+		}
+	}
+
+	return ReleaseState{}
+}
+
+// mergeReleaseStates menggabungkan aturan chapter & lesson: terbuka jika keduanya terbuka,
+// waktu buka = yang paling akhir (kosong jika salah satu menunggu prerequisite / enroll)
+func mergeReleaseStates(a ReleaseState, b ReleaseState) ReleaseState {
+	if !a.Locked {
+		return b
+	}
+	if !b.Locked {
+		return a
+	}
+	if a.UnlockAt == nil {
+		return a
+	}
+	if b.UnlockAt == nil || !a.UnlockAt.Before(*b.UnlockAt) {
+		if b.UnlockAt == nil {
+			return b
+		}
+		return a
+	}
+
+	return b
+}
+
+// hasDependencyCycle: DFS tiga warna di graf lesson -> prerequisite
+func hasDependencyCycle(dependencies map[string][]string) bool {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	states := make(map[string]int, len(dependencies))
+	var visit func(lessonId string) bool
+	visit = func(lessonId string) bool {
+		switch states[lessonId] {
+		case visiting:
+			return true
+		case done:
+			return false
+		}
+
+		states[lessonId] = visiting
+		for _, prerequisite := range dependencies[lessonId] {
+			if visit(prerequisite) {
+				return true
+			}
+		}
+		states[lessonId] = done
+
+		return false
+	}
+
+	for lessonId := range dependencies {
+		if visit(lessonId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// courseLocation: timezone course (nama IANA), kosong / tidak dikenal = UTC
+func courseLocation(courseEntity *entity.Course) *time.Location {
+	if courseEntity.Timezone == nil || *courseEntity.Timezone == "" {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(*courseEntity.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// releaseAtIn membaca waktu lokal release_at (kolom TIMESTAMP tanpa zona) di timezone course
+func releaseAtIn(releaseAt time.Time, location *time.Location) time.Time {
+	year, month, day := releaseAt.Date()
+	hour, minute, second := releaseAt.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, 0, location)
+}
+
+// releaseRuleFromProto: rule nil = hapus aturan (dikembalikan nil)
+func releaseRuleFromProto(rule *common.ReleaseRule) (*entity.ReleaseRule, error) {
+	if rule == nil {
+		return nil, nil
+	}
+
+	releaseRule := &entity.ReleaseRule{}
+	switch value := rule.Rule.(type) {
+	case *common.ReleaseRule_ReleaseAt:
+		releaseAt, err := parseReleaseAt(value.ReleaseAt)
+		if err != nil {
+			return nil, apperror.InvalidArgument("Invalid release date").WithFieldViolation("rule.release_at", "must be a valid local date time (YYYY-MM-DDTHH:MM[:SS])")
+		}
+		releaseRule.ReleaseAt = &releaseAt
+	case *common.ReleaseRule_DaysAfterEnrollment:
+		days := value.DaysAfterEnrollment
+		releaseRule.DaysAfterEnrollment = &days
+	case *common.ReleaseRule_PrerequisiteLessonId:
+		lessonId := value.PrerequisiteLessonId
+		releaseRule.PrerequisiteLessonId = &lessonId
+	case *common.ReleaseRule_PrerequisiteQuizId:
+		quizId := value.PrerequisiteQuizId
+		releaseRule.PrerequisiteQuizId = &quizId
+	}
+
+	return releaseRule, nil
+}
+
+// parseReleaseAt: detik boleh tidak ditulis, hasilnya disimpan sbg waktu UTC dgn angka yang sama (tanpa konversi zona)
+func parseReleaseAt(value string) (time.Time, error) {
+	releaseAt, err := time.Parse(releaseAtLayout, value)
+	if err != nil {
+		releaseAt, err = time.Parse("2006-01-02T15:04", value)
+	}
+
+	return releaseAt, err
+}
+
+func releaseRuleToProto(rule *entity.ReleaseRule) *common.ReleaseRule {
+	if rule == nil {
+		return nil
+	}
+
+	switch {
+	case rule.ReleaseAt != nil:
+		return &common.ReleaseRule{Rule: &common.ReleaseRule_ReleaseAt{ReleaseAt: rule.ReleaseAt.Format(releaseAtLayout)}}
+	case rule.DaysAfterEnrollment != nil:
+		return &common.ReleaseRule{Rule: &common.ReleaseRule_DaysAfterEnrollment{DaysAfterEnrollment: *rule.DaysAfterEnrollment}}
+	case rule.PrerequisiteLessonId != nil:
+		return &common.ReleaseRule{Rule: &common.ReleaseRule_PrerequisiteLessonId{PrerequisiteLessonId: *rule.PrerequisiteLessonId}}
+	case rule.PrerequisiteQuizId != nil:
+		return &common.ReleaseRule{Rule: &common.ReleaseRule_PrerequisiteQuizId{PrerequisiteQuizId: *rule.PrerequisiteQuizId}}
+	}
+
+	return nil
+}
+
+func releaseStatus(state ReleaseState) *common.ReleaseStatus {
+	status := &common.ReleaseStatus{Locked: state.Locked}
+	if !state.Locked {
+		return status
+	}
+
+	if state.UnlockAt != nil {
+		unlockAt := state.UnlockAt.Format(time.RFC3339)
+		status.UnlockAt = &unlockAt
+	}
+	reason := state.Reason
+	status.Reason = &reason
+	status.PrerequisiteLessonId = state.PrerequisiteLessonId
+	status.PrerequisiteQuizId = state.PrerequisiteQuizId
+
+	return status
+}
+
+func NewReleaseService(courseRepository repository.ICourseRepository, chapterLessonRepository repository.IChapterLessonRepository, enrollmentRepository repository.IEnrollmentRepository, releaseRuleRepository repository.IReleaseRuleRepository, lessonCompletionRepository repository.ILessonCompletionRepository, quizRepository repository.IQuizRepository, quizAttemptRepository repository.IQuizAttemptRepository) IReleaseService {
+	return &releaseService{
+		courseRepository:           courseRepository,
+		chapterLessonRepository:    chapterLessonRepository,
+		enrollmentRepository:       enrollmentRepository,
+		releaseRuleRepository:      releaseRuleRepository,
+		lessonCompletionRepository: lessonCompletionRepository,
+		quizRepository:             quizRepository,
+		quizAttemptRepository:      quizAttemptRepository,
+	}
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/common"
+	"google.golang.org/grpc/codes"
+)
+
+// addReleaseData: course ber-timezone Asia/Jakarta, satu chapter berisi lesson prerequisite (quiz) & lesson target
+func (f *lessonFixture) addReleaseData() {
+	f.courses.AddCourse(entity.Course{Id: testCourseId, InstructorId: utils.StringToPtr(testUserId), Timezone: utils.StringToPtr("Asia/Jakarta")})
+	f.chapters.AddCourseChapter(entity.CourseChapter{Id: testChapterId, CourseId: testCourseId, Title: "Basics", OrderChapter: 1})
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:          testOtherLessonId,
+		CourseId:    utils.StringToPtr(testCourseId),
+		ChapterId:   utils.StringToPtr(testChapterId),
+		Title:       "Intro",
+		OrderLesson: 1,
+	})
+	f.addLesson(func(lesson *entity.ChapterLesson) {
+		lesson.ChapterId = utils.StringToPtr(testChapterId)
+		lesson.OrderLesson = 2
+	})
+	f.quizzes.AddQuiz(entity.Quiz{Id: testQuizId, LessonId: testOtherLessonId, CourseId: testCourseId, PassMarkPercent: 50})
+}
+
+func (f *lessonFixture) addLessonRule(id string, lessonId string, modify func(rule *entity.ReleaseRule)) {
+	rule := entity.ReleaseRule{Id: id, LessonId: utils.StringToPtr(lessonId)}
+	modify(&rule)
+	f.rules.AddReleaseRule(rule)
+}
+
+func (f *lessonFixture) addChapterRule(modify func(rule *entity.ReleaseRule)) {
+	rule := entity.ReleaseRule{Id: "rule-chapter", ChapterId: utils.StringToPtr(testChapterId)}
+	modify(&rule)
+	f.rules.AddReleaseRule(rule)
+}
+
+func TestReleaseServiceLessonRelease(t *testing.T) {
+	future := time.Date(2099, 1, 1, 8, 0, 0, 0, time.UTC) //? disimpan tanpa zona: 08:00 waktu course
+	past := time.Date(2000, 1, 1, 8, 0, 0, 0, time.UTC)
+	sevenDays := int64(7)
+
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFuture := time.Date(2099, 1, 1, 8, 0, 0, 0, jakarta)
+
+	tests := []struct {
+		name         string
+		setup        func(f *lessonFixture)
+		ctx          context.Context
+		wantLocked   bool
+		wantUnlockAt func(f *lessonFixture) *time.Time
+		wantReason   string
+	}{
+		{
+			name:  "no rule",
+			setup: func(f *lessonFixture) { f.enroll() },
+			ctx:   contextUser,
+		},
+		{
+			name: "release date in course timezone",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+			},
+			ctx:          contextUser,
+			wantLocked:   true,
+			wantUnlockAt: func(f *lessonFixture) *time.Time { return &wantFuture },
+			wantReason:   entity.ReleaseReasonReleaseAt,
+		},
+		{
+			name: "release date passed",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.ReleaseAt = &past })
+			},
+			ctx: contextUser,
+		},
+		{
+			name: "days after enrollment",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.DaysAfterEnrollment = &sevenDays })
+			},
+			ctx:        contextUser,
+			wantLocked: true,
+			wantUnlockAt: func(f *lessonFixture) *time.Time {
+				enrollment, _ := f.enrollments.GetEnrollment(context.Background(), testCourseId, testUserId)
+				unlockAt := enrollment.EnrolledAt.AddDate(0, 0, 7)
+				return &unlockAt
+			},
+			wantReason: entity.ReleaseReasonDaysAfterEnrollment,
+		},
+		{
+			name: "days after enrollment without enrollment has no unlock time",
+			setup: func(f *lessonFixture) {
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.DaysAfterEnrollment = &sevenDays })
+			},
+			ctx:        contextUser,
+			wantLocked: true,
+			wantReason: entity.ReleaseReasonDaysAfterEnrollment,
+		},
+		{
+			name: "prerequisite lesson not completed",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+			},
+			ctx:        contextUser,
+			wantLocked: true,
+			wantReason: entity.ReleaseReasonPrerequisite,
+		},
+		{
+			name: "prerequisite lesson completed",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+				f.completions.AddLessonCompletion(entity.LessonCompletion{Id: "completion-1", LessonId: testOtherLessonId, UserId: testUserId, CompletedAt: time.Now()})
+			},
+			ctx: contextUser,
+		},
+		{
+			name: "prerequisite lesson deleted is ignored",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+				f.lessons.DeleteChapterLesson(context.Background(), testOtherLessonId, time.Now(), "Test User")
+			},
+			ctx: contextUser,
+		},
+		{
+			name: "prerequisite quiz not passed",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteQuizId = utils.StringToPtr(testQuizId) })
+				submittedAt := time.Now()
+				f.attempts.AddQuizAttempt(entity.QuizAttempt{Id: "attempt-1", QuizId: testQuizId, CourseId: testCourseId, UserId: testUserId, SubmittedAt: &submittedAt})
+			},
+			ctx:        contextUser,
+			wantLocked: true,
+			wantReason: entity.ReleaseReasonPrerequisite,
+		},
+		{
+			name: "prerequisite quiz passed",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteQuizId = utils.StringToPtr(testQuizId) })
+				submittedAt := time.Now()
+				f.attempts.AddQuizAttempt(entity.QuizAttempt{Id: "attempt-1", QuizId: testQuizId, CourseId: testCourseId, UserId: testUserId, SubmittedAt: &submittedAt, Passed: true})
+			},
+			ctx: contextUser,
+		},
+		{
+			name: "chapter rule applies to its lessons",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addChapterRule(func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+			},
+			ctx:          contextUser,
+			wantLocked:   true,
+			wantUnlockAt: func(f *lessonFixture) *time.Time { return &wantFuture },
+			wantReason:   entity.ReleaseReasonReleaseAt,
+		},
+		{
+			name: "chapter date and lesson prerequisite both apply",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addChapterRule(func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+			},
+			ctx:        contextUser,
+			wantLocked: true,
+			wantReason: entity.ReleaseReasonPrerequisite,
+		},
+		{
+			name: "preview lesson is never locked",
+			setup: func(f *lessonFixture) {
+				f.enroll()
+				f.addChapterRule(func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+				lesson, _ := f.lessons.ChapterLesson(testLessonId)
+				isPreview := int64(entity.LessonIsPreview)
+				lesson.IsPreview = &isPreview
+				f.lessons.AddChapterLesson(lesson)
+			},
+			ctx: contextUser,
+		},
+		{
+			name: "instructor is never locked",
+			setup: func(f *lessonFixture) {
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.ReleaseAt = &future })
+			},
+			ctx: contextInstructor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addReleaseData()
+			tt.setup(f)
+
+			claims, err := jwtentity.GetClaimsFromContext(tt.ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lesson, _ := f.lessons.ChapterLesson(testLessonId)
+			state, err := f.releaseService().LessonRelease(context.Background(), claims, &lesson)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if state.Locked != tt.wantLocked {
+				t.Fatalf("locked = %v, want %v", state.Locked, tt.wantLocked)
+			}
+			if state.Reason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", state.Reason, tt.wantReason)
+			}
+
+			var wantUnlockAt *time.Time
+			if tt.wantUnlockAt != nil {
+				wantUnlockAt = tt.wantUnlockAt(f)
+			}
+			switch {
+			case wantUnlockAt == nil && state.UnlockAt != nil:
+				t.Errorf("unlock_at = %v, want empty", state.UnlockAt)
+			case wantUnlockAt != nil && (state.UnlockAt == nil || !state.UnlockAt.Equal(*wantUnlockAt)):
+				t.Errorf("unlock_at = %v, want %v", state.UnlockAt, wantUnlockAt)
+			}
+		})
+	}
+}
+
+func TestReleaseServiceValidateReleaseRule(t *testing.T) {
+	releaseAt := time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		setup    func(f *lessonFixture)
+		rule     entity.ReleaseRule
+		wantCode codes.Code
+	}{
+		{
+			name:     "valid prerequisite lesson",
+			rule:     entity.ReleaseRule{LessonId: utils.StringToPtr(testLessonId), PrerequisiteLessonId: utils.StringToPtr(testOtherLessonId)},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "valid prerequisite quiz",
+			rule:     entity.ReleaseRule{LessonId: utils.StringToPtr(testLessonId), PrerequisiteQuizId: utils.StringToPtr(testQuizId)},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "prerequisite lesson from another course",
+			rule:     entity.ReleaseRule{LessonId: utils.StringToPtr(testLessonId), PrerequisiteLessonId: utils.StringToPtr("5b6c7d8e-9f0a-4b1c-8d2e-3f4a5b6c7d8e")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "unknown prerequisite quiz",
+			rule:     entity.ReleaseRule{LessonId: utils.StringToPtr(testLessonId), PrerequisiteQuizId: utils.StringToPtr(testAssignmentId)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "lesson depends on itself",
+			rule:     entity.ReleaseRule{LessonId: utils.StringToPtr(testLessonId), PrerequisiteLessonId: utils.StringToPtr(testLessonId)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "chapter depends on its own lesson",
+			rule:     entity.ReleaseRule{ChapterId: utils.StringToPtr(testChapterId), PrerequisiteLessonId: utils.StringToPtr(testLessonId)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "indirect cycle through quiz",
+			setup: func(f *lessonFixture) {
+				f.addLessonRule("rule-1", testOtherLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testLessonId) })
+			},
+			rule:     entity.ReleaseRule{LessonId: utils.StringToPtr(testLessonId), PrerequisiteQuizId: utils.StringToPtr(testQuizId)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "replacing the rule that made the cycle",
+			setup: func(f *lessonFixture) {
+				f.addLessonRule("rule-1", testLessonId, func(rule *entity.ReleaseRule) { rule.PrerequisiteLessonId = utils.StringToPtr(testOtherLessonId) })
+			},
+			rule:     entity.ReleaseRule{LessonId: utils.StringToPtr(testOtherLessonId), ReleaseAt: &releaseAt},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLessonFixture(t)
+			f.addReleaseData()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			rule := tt.rule
+			err := f.releaseService().ValidateReleaseRule(context.Background(), testCourseId, &rule)
+			assertCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestReleaseRuleFromProto(t *testing.T) {
+	rule, err := releaseRuleFromProto(&common.ReleaseRule{Rule: &common.ReleaseRule_ReleaseAt{ReleaseAt: "2030-02-03T09:30"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := releaseRuleToProto(rule).GetReleaseAt(); got != "2030-02-03T09:30:00" {
+		t.Errorf("release_at = %q, want 2030-02-03T09:30:00", got)
+	}
+
+	_, err = releaseRuleFromProto(&common.ReleaseRule{Rule: &common.ReleaseRule_ReleaseAt{ReleaseAt: "2030-02-30T09:30"}})
+	assertCode(t, err, codes.InvalidArgument)
+
+	rule, err = releaseRuleFromProto(nil)
+	if err != nil || rule != nil {
+		t.Errorf("rule = %v, err = %v, want nil", rule, err)
+	}
+}
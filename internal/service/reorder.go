@@ -0,0 +1,63 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+)
+
+// validateOrderedIds memastikan orderedIds berisi tepat semua id sibling (tidak kurang, tidak lebih, tidak dobel)
+func validateOrderedIds(currentIds []string, orderedIds []string) error {
+	current := make(map[string]bool, len(currentIds))
+	for _, id := range currentIds {
+		current[id] = true
+	}
+
+	var unknown, duplicate []string
+	seen := make(map[string]bool, len(orderedIds))
+	for _, id := range orderedIds {
+		if seen[id] {
+			duplicate = append(duplicate, id)
+			continue
+		}
+		seen[id] = true
+
+		if !current[id] {
+			unknown = append(unknown, id)
+		}
+	}
+
+	var missing []string
+	for _, id := range currentIds {
+		if !seen[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(unknown) == 0 && len(missing) == 0 && len(duplicate) == 0 {
+		return nil
+	}
+
+	var violations []apperror.FieldViolation
+	if len(missing) > 0 {
+		violations = append(violations, apperror.FieldViolation{
+			Field:       "ordered_ids",
+			Description: fmt.Sprintf("missing ids: %s", strings.Join(missing, ", ")),
+		})
+	}
+	if len(unknown) > 0 {
+		violations = append(violations, apperror.FieldViolation{
+			Field:       "ordered_ids",
+			Description: fmt.Sprintf("unknown ids: %s", strings.Join(unknown, ", ")),
+		})
+	}
+	if len(duplicate) > 0 {
+		violations = append(violations, apperror.FieldViolation{
+			Field:       "ordered_ids",
+			Description: fmt.Sprintf("duplicate ids: %s", strings.Join(duplicate, ", ")),
+		})
+	}
+
+	return apperror.InvalidArgument("Ordered ids must contain every sibling exactly once").WithFieldViolations(violations)
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/abu-umair/be-lms-go/internal/config"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testUserId      = "5b0f7c1e-7d3f-4f36-9c55-3f7f0e3b9a11"
+	testOtherUserId = "6c1a8d2f-8e4a-4a47-8d66-4a8a1f4cab22"
+	testCourseId    = "1cc9d5f2-49af-4b7d-914a-c9d497007eed"
+	testJwtSecret   = "test-secret"
+)
+
+var (
+	testJwtConfig     = config.JWTConfig{Secret: testJwtSecret, TTL: time.Hour}
+	testStorageConfig = config.StorageConfig{
+		ServiceURL:    "http://localhost:3000/storage",
+		SigningSecret: "test-signing-secret",
+		SignedURLTTL:  time.Hour,
+	}
+)
+
+// txExpectation: apa yang harus terjadi pada transaksi DB di sebuah test case
+type txExpectation int
+
+const (
+	txNone     txExpectation = iota //? service tidak boleh membuka transaksi
+	txCommit                        //? Begin lalu Commit
+	txRollback                      //? Begin lalu Rollback
+)
+
+// newMockDB membuat *sqlx.DB di atas sqlmock, ekspektasi dicek otomatis di akhir test
+func newMockDB(t *testing.T, expect txExpectation) *sqlx.DB {
+	t.Helper()
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	switch expect {
+	case txCommit:
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+	case txRollback:
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+	}
+
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("transaction expectations: %v", err)
+		}
+		mockDB.Close()
+	})
+
+	return sqlx.NewDb(mockDB, "sqlmock")
+}
+
+func newTestClaims(role string) *jwtentity.JwtClaims {
+	now := time.Now()
+
+	return &jwtentity.JwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   testUserId,
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		Email:    "user@example.com",
+		FullName: "Test User",
+		Role:     role,
+	}
+}
+
+// contextWithRole: context setelah melewati auth middleware
+func contextWithRole(role string) context.Context {
+	return newTestClaims(role).SetToContext(context.Background())
+}
+
+// contextWithToken: context dgn metadata authorization (utk method yang membaca token langsung)
+func contextWithToken(t *testing.T, claims *jwtentity.JwtClaims, secret string) context.Context {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	return claims.SetToContext(ctx)
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("code = %s, want %s (err: %v)", got, want, err)
+	}
+}
+
+var (
+	contextInstructor = contextWithRole(entity.UserRoleInstructor)
+	contextAdmin      = contextWithRole(entity.UserRoleAdmin)
+	contextUser       = contextWithRole(entity.UserRoleUser)
+	contextAnonymous  = context.Background()
+)
@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+)
+
+// sweepableFolders adalah sub folder storage/<course_id>/<folder> yang isinya direferensikan DB.
+// Folder lain (misal sisa "store") tidak disentuh dan hanya dilaporkan.
+var sweepableFolders = map[string]bool{
+	storage.FolderCourse:     true,
+	storage.FolderLesson:     true,
+	storage.FolderSubmission: true,
+}
+
+type SweepReport struct {
+	DryRun         bool
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	ScannedFiles   int
+	KeptFiles      int
+	InGracePeriod  int
+	DeletedFiles   []string
+	DeletedFolders []string
+	SkippedPaths   []string
+	FreedBytes     int64
+	Errors         []string
+}
+
+type IStorageSweeperService interface {
+	Sweep(ctx context.Context, dryRun bool) (*SweepReport, error)
+	Run(ctx context.Context, interval time.Duration, dryRun bool)
+}
+
+type storageSweeperService struct {
+	storageRoot             string
+	gracePeriod             time.Duration
+	courseRepository        repository.ICourseRepository
+	chapterLessonRepository repository.IChapterLessonRepository
+	submissionRepository    repository.IAssignmentSubmissionRepository
+}
+
+type storageReferences struct {
+	knownCourses map[string]bool //? course aktif maupun yang masih di trash
+	files        map[string]bool //? key: <course_id>/<folder>/<file name>
+}
+
+func (r *storageReferences) add(courseId string, folder string, fileName string) {
+	if courseId == "" || fileName == "" {
+		return
+	}
+	r.files[path.Join(courseId, folder, fileName)] = true
+}
+
+func (r *storageReferences) has(courseId string, folder string, fileName string) bool {
+	return r.files[path.Join(courseId, folder, fileName)]
+}
+
+// Sweep menghapus file & folder upload yang tidak direferensikan course/lesson aktif maupun submission assignment
+// dan sudah lebih lama dari grace period. Jika dryRun, tidak ada yang dihapus (hanya laporan).
+func (ss *storageSweeperService) Sweep(ctx context.Context, dryRun bool) (*SweepReport, error) {
+	report := &SweepReport{
+		DryRun:    dryRun,
+		StartedAt: time.Now(),
+	}
+
+	//* 1. kumpulkan semua referensi file dari DB
+	refs, err := ss.loadReferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* 2. telusuri storage/<course_id>/<folder>/<file>
+	courseDirs, err := os.ReadDir(ss.storageRoot)
+	if err != nil {
+		if os.IsNotExist(err) {
+			report.FinishedAt = time.Now()
+			return report, nil
+		}
+		return nil, err
+	}
+
+	cutoff := report.StartedAt.Add(-ss.gracePeriod)
+
+	for _, courseDir := range courseDirs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		courseDirPath := filepath.Join(ss.storageRoot, courseDir.Name())
+		//? folder yang bukan UUID (atau symlink) bukan hasil upload, jangan disentuh
+		if !courseDir.IsDir() || storage.ValidateId(courseDir.Name()) != nil {
+			report.SkippedPaths = append(report.SkippedPaths, courseDirPath)
+			continue
+		}
+
+		folders, err := os.ReadDir(courseDirPath)
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+			continue
+		}
+
+		for _, folder := range folders {
+			folderPath := filepath.Join(courseDirPath, folder.Name())
+			if !folder.IsDir() || !sweepableFolders[folder.Name()] {
+				report.SkippedPaths = append(report.SkippedPaths, folderPath)
+				continue
+			}
+
+			ss.sweepFolder(report, refs, courseDir.Name(), folder.Name(), folderPath, cutoff)
+
+			//? folder milik course yang masih aktif / di trash dipertahankan (dipakai saat upload update & restore)
+			if !refs.knownCourses[courseDir.Name()] {
+				ss.removeEmptyDir(report, folderPath, cutoff)
+			}
+		}
+
+		if !refs.knownCourses[courseDir.Name()] {
+			ss.removeEmptyDir(report, courseDirPath, cutoff)
+		}
+	}
+
+	report.FinishedAt = time.Now()
+
+	return report, nil
+}
+
+func (ss *storageSweeperService) sweepFolder(report *SweepReport, refs *storageReferences, courseId string, folder string, folderPath string, cutoff time.Time) {
+	files, err := os.ReadDir(folderPath)
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return
+	}
+
+	for _, file := range files {
+		filePath := filepath.Join(folderPath, file.Name())
+		if file.IsDir() {
+			report.SkippedPaths = append(report.SkippedPaths, filePath)
+			continue
+		}
+		report.ScannedFiles++
+
+		if refs.has(courseId, folder, file.Name()) {
+			report.KeptFiles++
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+			continue
+		}
+
+		//? upload baru bisa saja belum di-Create/Edit oleh client, beri waktu
+		if info.ModTime().After(cutoff) {
+			report.InGracePeriod++
+			continue
+		}
+
+		if !report.DryRun {
+			if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+				report.Errors = append(report.Errors, err.Error())
+				continue
+			}
+		}
+
+		report.DeletedFiles = append(report.DeletedFiles, filePath)
+		report.FreedBytes += info.Size()
+	}
+}
+
+// removeEmptyDir menghapus folder yang sudah kosong (pada dry run: yang akan kosong).
+// Folder kosong yang masih baru tidak dihapus karena bisa jadi upload sedang berjalan.
+func (ss *storageSweeperService) removeEmptyDir(report *SweepReport, dirPath string, cutoff time.Time) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(dirPath, entry.Name())
+		if report.DryRun && (containsPath(report.DeletedFiles, entryPath) || containsPath(report.DeletedFolders, entryPath)) {
+			continue
+		}
+		return
+	}
+
+	//? jika tidak ada orphan yang dihapus dari folder ini, cek umur folder-nya sendiri
+	if !hasPathPrefix(report.DeletedFiles, dirPath) && !hasPathPrefix(report.DeletedFolders, dirPath) {
+		info, err := os.Stat(dirPath)
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+			return
+		}
+		if info.ModTime().After(cutoff) {
+			return
+		}
+	}
+
+	if !report.DryRun {
+		if err := os.Remove(dirPath); err != nil && !os.IsNotExist(err) {
+			report.Errors = append(report.Errors, err.Error())
+			return
+		}
+	}
+
+	report.DeletedFolders = append(report.DeletedFolders, dirPath)
+}
+
+func containsPath(paths []string, p string) bool {
+	for _, item := range paths {
+		if item == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPathPrefix(paths []string, dir string) bool {
+	prefix := dir + string(filepath.Separator)
+	for _, item := range paths {
+		if strings.HasPrefix(item, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ss *storageSweeperService) loadReferences(ctx context.Context) (*storageReferences, error) {
+	refs := &storageReferences{
+		knownCourses: map[string]bool{},
+		files:        map[string]bool{},
+	}
+
+	courses, err := ss.courseRepository.GetAllCourseImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range courses {
+		refs.knownCourses[c.Id] = true
+		refs.add(c.Id, storage.FolderCourse, c.ImageFileName)
+	}
+
+	lessons, err := ss.chapterLessonRepository.GetAllLessonFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lessons {
+		if l.CourseId == nil {
+			continue
+		}
+		if l.FilePath != nil {
+			refs.add(*l.CourseId, storage.FolderLesson, *l.FilePath)
+		}
+
+		//? caption video disimpan di content, bukan di file_path
+		content, err := parseLessonContent(l.Content)
+		if err != nil {
+			return nil, fmt.Errorf("parse content of lesson %s: %w", l.Id, err)
+		}
+		for _, fileName := range lessonContentFiles(content) {
+			refs.add(*l.CourseId, storage.FolderLesson, fileName)
+		}
+	}
+
+	submissions, err := ss.submissionRepository.GetAllSubmissionFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range submissions {
+		for _, fileName := range s.FileNames {
+			refs.add(s.CourseId, storage.FolderSubmission, fileName)
+		}
+	}
+
+	return refs, nil
+}
+
+// Run menjalankan Sweep secara berkala sampai ctx dibatalkan
+func (ss *storageSweeperService) Run(ctx context.Context, interval time.Duration, dryRun bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := ss.Sweep(ctx, dryRun)
+		if err != nil {
+			slog.Error("storage sweeper failed", "error", err)
+		} else {
+			LogSweepReport(report)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// LogSweepReport menulis ringkasan hasil sweep (dan detail file yang dihapus) ke log
+func LogSweepReport(report *SweepReport) {
+	mode := "delete"
+	if report.DryRun {
+		mode = "dry-run"
+	}
+
+	slog.Info("storage sweep finished",
+		"mode", mode,
+		"duration_ms", report.FinishedAt.Sub(report.StartedAt).Milliseconds(),
+		"scanned", report.ScannedFiles,
+		"kept", report.KeptFiles,
+		"in_grace_period", report.InGracePeriod,
+		"deleted_files", len(report.DeletedFiles),
+		"deleted_folders", len(report.DeletedFolders),
+		"freed", formatBytes(report.FreedBytes),
+		"freed_bytes", report.FreedBytes,
+		"skipped", len(report.SkippedPaths),
+		"errors", len(report.Errors),
+	)
+
+	for _, f := range report.DeletedFiles {
+		slog.Info("storage sweep orphan file", "mode", mode, "path", f)
+	}
+	for _, f := range report.DeletedFolders {
+		slog.Info("storage sweep orphan folder", "mode", mode, "path", f)
+	}
+	for _, e := range report.Errors {
+		slog.Error("storage sweep error", "mode", mode, "error", e)
+	}
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func NewStorageSweeperService(storageResolver *storage.Resolver, gracePeriod time.Duration, courseRepository repository.ICourseRepository, chapterLessonRepository repository.IChapterLessonRepository, submissionRepository repository.IAssignmentSubmissionRepository) IStorageSweeperService {
+	return &storageSweeperService{
+		storageRoot:             storageResolver.Root(),
+		gracePeriod:             gracePeriod,
+		courseRepository:        courseRepository,
+		chapterLessonRepository: chapterLessonRepository,
+		submissionRepository:    submissionRepository,
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+)
+
+const testDeletedCourseId = "9e8d7c6b-5a49-4382-a1b0-c9d8e7f6a5b4"
+
+type sweeperFixture struct {
+	root        string
+	courses     *fake.CourseRepository
+	lessons     *fake.ChapterLessonRepository
+	submissions *fake.AssignmentSubmissionRepository
+	oldFiles    map[string]string //? nama -> path, lewat dari grace period
+	recentFiles map[string]string
+}
+
+// newSweeperFixture menyiapkan storage berisi file yang direferensikan, orphan lama, orphan baru & folder asing
+func newSweeperFixture(t *testing.T) *sweeperFixture {
+	t.Helper()
+
+	f := &sweeperFixture{
+		root:        t.TempDir(),
+		courses:     fake.NewCourseRepository(),
+		lessons:     fake.NewChapterLessonRepository(),
+		submissions: fake.NewAssignmentSubmissionRepository(),
+		oldFiles:    map[string]string{},
+		recentFiles: map[string]string{},
+	}
+	old := time.Now().Add(-48 * time.Hour)
+
+	f.courses.AddCourse(entity.Course{Id: testCourseId, ImageFileName: "course_1.jpg"})
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:       testLessonId,
+		CourseId: utils.StringToPtr(testCourseId),
+		FilePath: utils.StringToPtr("lesson_1.mp4"),
+	})
+	//? caption hanya direferensikan lewat content
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:       testOtherLessonId,
+		CourseId: utils.StringToPtr(testCourseId),
+		FilePath: utils.StringToPtr("https://youtu.be/abc"),
+		Kind:     utils.StringToPtr(entity.LessonKindVideo),
+		Content:  utils.StringToPtr(`{"video":{"url":"https://youtu.be/abc","duration_seconds":"60","captions":[{"language":"en","file_name":"caption_1.vtt"}]}}`),
+	})
+
+	//? lesson di trash masih bisa di-restore, file-nya tetap direferensikan sampai di-purge
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:       "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d",
+		CourseId: utils.StringToPtr(testCourseId),
+		FilePath: utils.StringToPtr("lesson_3.mp4"),
+	})
+	f.lessons.DeleteChapterLesson(context.Background(), "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d", old, "Test User")
+
+	f.submissions.AddSubmission(entity.AssignmentSubmission{
+		Id:        "submission-1",
+		CourseId:  testCourseId,
+		FileNames: []string{"submission_1.pdf"},
+	})
+
+	f.writeFile(t, "image", testCourseId, storage.FolderCourse, "course_1.jpg", old)
+	f.writeFile(t, "orphan_image", testCourseId, storage.FolderCourse, "course_0.jpg", old)
+	f.writeFile(t, "recent_image", testCourseId, storage.FolderCourse, "course_2.jpg", time.Now())
+	f.writeFile(t, "lesson", testCourseId, storage.FolderLesson, "lesson_1.mp4", old)
+	f.writeFile(t, "caption", testCourseId, storage.FolderLesson, "caption_1.vtt", old)
+	f.writeFile(t, "trashed_lesson", testCourseId, storage.FolderLesson, "lesson_3.mp4", old)
+	f.writeFile(t, "submission", testCourseId, storage.FolderSubmission, "submission_1.pdf", old)
+	f.writeFile(t, "orphan_submission", testCourseId, storage.FolderSubmission, "submission_0.pdf", old)
+	f.writeFile(t, "deleted_course_image", testDeletedCourseId, storage.FolderCourse, "course_9.jpg", old)
+	f.writeFile(t, "unknown_folder", "not-a-course", storage.FolderCourse, "keep.jpg", old)
+
+	//? folder course yang sudah dihapus juga dibuat "lama"
+	for _, dir := range []string{
+		filepath.Join(f.root, testDeletedCourseId, storage.FolderCourse),
+		filepath.Join(f.root, testDeletedCourseId),
+	} {
+		if err := os.Chtimes(dir, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return f
+}
+
+func (f *sweeperFixture) writeFile(t *testing.T, name string, courseId string, folder string, fileName string, modTime time.Time) {
+	t.Helper()
+
+	dir := filepath.Join(f.root, courseId, folder)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(dir, fileName)
+	if err := os.WriteFile(filePath, []byte(name), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(filePath, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+
+	if modTime.Before(time.Now().Add(-time.Hour)) {
+		f.oldFiles[name] = filePath
+	} else {
+		f.recentFiles[name] = filePath
+	}
+}
+
+func (f *sweeperFixture) service(t *testing.T) IStorageSweeperService {
+	t.Helper()
+
+	resolver, err := storage.NewResolver(f.root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewStorageSweeperService(resolver, 24*time.Hour, f.courses, f.lessons, f.submissions)
+}
+
+func TestStorageSweeperServiceSweep(t *testing.T) {
+	tests := []struct {
+		name       string
+		dryRun     bool
+		wantExists map[string]bool
+	}{
+		{
+			name:   "dry run keeps everything",
+			dryRun: true,
+			wantExists: map[string]bool{
+				"image": true, "orphan_image": true, "recent_image": true,
+				"lesson": true, "caption": true, "trashed_lesson": true, "submission": true, "orphan_submission": true,
+				"deleted_course_image": true, "unknown_folder": true,
+			},
+		},
+		{
+			name:   "delete removes old orphans only",
+			dryRun: false,
+			wantExists: map[string]bool{
+				"image": true, "orphan_image": false, "recent_image": true,
+				"lesson": true, "caption": true, "trashed_lesson": true, "submission": true, "orphan_submission": false,
+				"deleted_course_image": false, "unknown_folder": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newSweeperFixture(t)
+
+			report, err := f.service(t).Sweep(context.Background(), tt.dryRun)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(report.DeletedFiles) != 3 {
+				t.Errorf("deleted files = %v, want 3 orphans", report.DeletedFiles)
+			}
+			if report.KeptFiles != 5 || report.InGracePeriod != 1 {
+				t.Errorf("kept = %d, in grace period = %d, want 5 and 1", report.KeptFiles, report.InGracePeriod)
+			}
+			if !containsPath(report.DeletedFolders, filepath.Join(f.root, testDeletedCourseId)) {
+				t.Errorf("deleted folders = %v, want folder of deleted course", report.DeletedFolders)
+			}
+			if !containsPath(report.SkippedPaths, filepath.Join(f.root, "not-a-course")) {
+				t.Errorf("skipped paths = %v, want non course folder", report.SkippedPaths)
+			}
+
+			for name, filePath := range f.oldFiles {
+				if exists := fileExists(t, filePath); exists != tt.wantExists[name] {
+					t.Errorf("%s exists = %v, want %v", name, exists, tt.wantExists[name])
+				}
+			}
+			for name, filePath := range f.recentFiles {
+				if !fileExists(t, filePath) {
+					t.Errorf("%s is deleted during grace period", name)
+				}
+			}
+		})
+	}
+}
+
+func TestStorageSweeperServiceSweepRepositoryError(t *testing.T) {
+	f := newSweeperFixture(t)
+	f.lessons.ReadErr = errDatabase
+
+	if _, err := f.service(t).Sweep(context.Background(), false); err == nil {
+		t.Fatal("expected error when references cannot be loaded")
+	}
+	//? tanpa referensi lengkap tidak boleh ada file yang dihapus
+	for name, filePath := range f.oldFiles {
+		if !fileExists(t, filePath) {
+			t.Errorf("%s is deleted although references failed to load", name)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/apperror"
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	jwtentity "github.com/abu-umair/be-lms-go/internal/entity/jwt"
+	"github.com/abu-umair/be-lms-go/internal/repository"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/trash"
+	"github.com/abu-umair/be-lms-go/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+// jenis item trash (root delete)
+const (
+	TrashKindCourse  = "course"
+	TrashKindChapter = "chapter"
+	TrashKindLesson  = "lesson"
+)
+
+type PurgeReport struct {
+	Courses  int
+	Chapters int64
+	Lessons  int
+}
+
+type ITrashService interface {
+	ListTrash(ctx context.Context, request *trash.ListTrashRequest) (*trash.ListTrashResponse, error)
+	PurgeTrash(ctx context.Context, request *trash.PurgeTrashRequest) (*trash.PurgeTrashResponse, error)
+	Purge(ctx context.Context) (*PurgeReport, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type trashService struct {
+	db                      *sqlx.DB
+	courseRepository        repository.ICourseRepository
+	courseChapterRepository repository.ICourseChapterRepository
+	chapterLessonRepository repository.IChapterLessonRepository
+	storageResolver         *storage.Resolver
+	retention               time.Duration
+}
+
+// ListTrash: satu item per delete (root cascade), chapter & lesson yang ikut terhapus dihitung di item root-nya
+func (ts *trashService) ListTrash(ctx context.Context, request *trash.ListTrashRequest) (*trash.ListTrashResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Admin
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, apperror.PermissionDenied("Only admin can access this resource")
+	}
+
+	courses, err := ts.courseRepository.GetDeletedCourses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chapters, err := ts.courseChapterRepository.GetDeletedCourseChapters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lessons, err := ts.chapterLessonRepository.GetDeletedChapterLessons(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* hitung chapter & lesson per root (selain root itu sendiri)
+	chapterCounts := map[string]int64{}
+	for _, chapter := range chapters {
+		if chapter.DeletedWithId != nil && !isDeleteRoot(chapter.Id, chapter.DeletedWithId) {
+			chapterCounts[*chapter.DeletedWithId]++
+		}
+	}
+	lessonCounts := map[string]int64{}
+	for _, lesson := range lessons {
+		if lesson.DeletedWithId != nil && !isDeleteRoot(lesson.Id, lesson.DeletedWithId) {
+			lessonCounts[*lesson.DeletedWithId]++
+		}
+	}
+
+	var items []*trash.TrashItem
+	add := func(kind string, id string, title string, courseId *string, deletedAt *time.Time, deletedBy *string, deletedWithId *string) {
+		if !isDeleteRoot(id, deletedWithId) || deletedAt == nil {
+			return
+		}
+		if request.Kind != nil && *request.Kind != kind {
+			return
+		}
+		if request.CourseId != nil && (courseId == nil || *courseId != *request.CourseId) {
+			return
+		}
+
+		items = append(items, &trash.TrashItem{
+			Kind:         kind,
+			Id:           id,
+			Title:        title,
+			CourseId:     courseId,
+			DeletedAt:    deletedAt.Format(time.RFC3339),
+			DeletedBy:    deletedBy,
+			PurgeAt:      deletedAt.Add(ts.retention).Format(time.RFC3339),
+			ChapterCount: chapterCounts[id],
+			LessonCount:  lessonCounts[id],
+		})
+	}
+
+	for _, c := range courses {
+		add(TrashKindCourse, c.Id, c.Name, &c.Id, c.DeletedAt, c.DeletedBy, c.DeletedWithId)
+	}
+	for _, c := range chapters {
+		add(TrashKindChapter, c.Id, c.Title, &c.CourseId, c.DeletedAt, c.DeletedBy, c.DeletedWithId)
+	}
+	for _, l := range lessons {
+		add(TrashKindLesson, l.Id, l.Title, l.CourseId, l.DeletedAt, l.DeletedBy, l.DeletedWithId)
+	}
+
+	//? yang terbaru dihapus tampil lebih dulu
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].DeletedAt != items[j].DeletedAt {
+			return items[i].DeletedAt > items[j].DeletedAt
+		}
+		return items[i].Id < items[j].Id
+	})
+
+	return &trash.ListTrashResponse{
+		Base:  utils.SuccessResponse("Get Trash Success"),
+		Items: items,
+	}, nil
+}
+
+func (ts *trashService) PurgeTrash(ctx context.Context, request *trash.PurgeTrashRequest) (*trash.PurgeTrashResponse, error) {
+	//* Get data token
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//* apakah role user adl Admin
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, apperror.PermissionDenied("Only admin can access this resource")
+	}
+
+	report, err := ts.Purge(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trash.PurgeTrashResponse{
+		Base:           utils.SuccessResponse("Purge Trash Success"),
+		PurgedCourses:  int64(report.Courses),
+		PurgedChapters: report.Chapters,
+		PurgedLessons:  int64(report.Lessons),
+	}, nil
+}
+
+// Purge menghapus permanen course / chapter / lesson yang sudah di trash lebih lama dari masa retensi.
+// Satu delete (cascade) selalu ter-purge bersamaan karena deleted_at-nya sama. File upload-nya dihapus setelah commit.
+func (ts *trashService) Purge(ctx context.Context) (report *PurgeReport, err error) {
+	deletedBefore := time.Now().Add(-ts.retention)
+
+	tx, err := database.BeginTransaction(ctx, ts.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback() //?rollback jika ada error saan runtime
+			}
+
+			panic(e) //?agar bisa nyampai ke Middleware (stack trace dicatat di sana)
+		}
+	}()
+
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback() //?rollback jika ada error
+		}
+	}()
+
+	courseRepo := ts.courseRepository.WithTransaction(tx.Tx)
+	courseChapterRepo := ts.courseChapterRepository.WithTransaction(tx.Tx)
+	chapterLessonRepo := ts.chapterLessonRepository.WithTransaction(tx.Tx)
+
+	//* urut dari bawah: lesson, chapter, lalu course
+	lessons, err := chapterLessonRepo.PurgeChapterLessons(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters, err := courseChapterRepo.PurgeCourseChapters(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	courses, err := courseRepo.PurgeCourses(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lesson := range lessons {
+		for _, filePath := range ts.purgedLessonFiles(lesson) {
+			tx.AfterCommit("remove purged lesson file", removeFileAction(filePath))
+		}
+	}
+	for _, c := range courses {
+		//? seluruh folder storage/<course_id> (image, lesson, submission) sudah tidak direferensikan lagi
+		if courseDir, pathErr := ts.storageResolver.Resolve(c.Id); pathErr == nil && storage.ValidateId(c.Id) == nil {
+			tx.AfterCommit("remove purged course folder", removeDirAction(courseDir))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PurgeReport{
+		Courses:  len(courses),
+		Chapters: chapters,
+		Lessons:  len(lessons),
+	}, nil
+}
+
+// purgedLessonFiles: file upload lesson di storage/<course_id>/lesson (file_path lama & file di content)
+func (ts *trashService) purgedLessonFiles(lesson *entity.ChapterLesson) []string {
+	if lesson.CourseId == nil {
+		return nil
+	}
+
+	var fileNames []string
+	if lesson.FilePath != nil && lesson.StorageLesson != nil && *lesson.StorageLesson == entity.LessonStorageUpload {
+		fileNames = append(fileNames, *lesson.FilePath)
+	}
+	content, err := parseLessonContent(lesson.Content)
+	if err != nil {
+		slog.Error("trash purge: parse lesson content", "lesson_id", lesson.Id, "error", err)
+	}
+	fileNames = append(fileNames, lessonContentFiles(content)...)
+
+	seen := map[string]bool{}
+	var filePaths []string
+	for _, fileName := range fileNames {
+		if seen[fileName] {
+			continue
+		}
+		seen[fileName] = true
+
+		//? nama file tidak valid tidak pernah ditulis ke storage, lewati
+		filePath, err := ts.storageResolver.CourseFile(*lesson.CourseId, storage.FolderLesson, fileName)
+		if err != nil {
+			continue
+		}
+		filePaths = append(filePaths, filePath)
+	}
+
+	return filePaths
+}
+
+// Run menjalankan Purge secara berkala sampai ctx dibatalkan
+func (ts *trashService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := ts.Purge(ctx)
+		if err != nil {
+			slog.Error("trash purge failed", "error", err)
+		} else if report.Courses > 0 || report.Chapters > 0 || report.Lessons > 0 {
+			slog.Info("trash purge finished",
+				"retention", ts.retention.String(),
+				"courses", report.Courses,
+				"chapters", report.Chapters,
+				"lessons", report.Lessons,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// removeDirAction: aksi post-commit utk menghapus folder beserta isinya
+func removeDirAction(dirPath string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return os.RemoveAll(dirPath)
+	}
+}
+
+func NewTrashService(db *sqlx.DB, courseRepository repository.ICourseRepository, courseChapterRepository repository.ICourseChapterRepository, chapterLessonRepository repository.IChapterLessonRepository, storageResolver *storage.Resolver, retention time.Duration) ITrashService {
+	return &trashService{
+		db:                      db,
+		courseRepository:        courseRepository,
+		courseChapterRepository: courseChapterRepository,
+		chapterLessonRepository: chapterLessonRepository,
+		storageResolver:         storageResolver,
+		retention:               retention,
+	}
+}
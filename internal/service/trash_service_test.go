@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/abu-umair/be-lms-go/internal/entity"
+	"github.com/abu-umair/be-lms-go/internal/fake"
+	"github.com/abu-umair/be-lms-go/internal/storage"
+	"github.com/abu-umair/be-lms-go/internal/utils"
+	"github.com/abu-umair/be-lms-go/pb/trash"
+	"google.golang.org/grpc/codes"
+)
+
+const testTrashRetention = 30 * 24 * time.Hour
+
+type trashFixture struct {
+	root     string
+	courses  *fake.CourseRepository
+	chapters *fake.CourseChapterRepository
+	lessons  *fake.ChapterLessonRepository
+}
+
+// newTrashFixture: course testCourseId dihapus (bersama 1 chapter & 1 lesson) sejak 40 hari lalu,
+// lesson testOtherLessonId di course testDeletedCourseId dihapus sendiri hari ini
+func newTrashFixture(t *testing.T) *trashFixture {
+	t.Helper()
+
+	f := &trashFixture{
+		root:     t.TempDir(),
+		courses:  fake.NewCourseRepository(),
+		chapters: fake.NewCourseChapterRepository(),
+		lessons:  fake.NewChapterLessonRepository(),
+	}
+	ctx := context.Background()
+	expired := time.Now().Add(-40 * 24 * time.Hour)
+
+	f.courses.AddCourse(entity.Course{Id: testCourseId, Name: "Go Basics", ImageFileName: "course_1.jpg"})
+	addTestCourseChapter(f.chapters)
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:            testLessonId,
+		ChapterId:     utils.StringToPtr(testChapterId),
+		CourseId:      utils.StringToPtr(testCourseId),
+		Title:         "Variables",
+		FilePath:      utils.StringToPtr("lesson_1.mp4"),
+		StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+	})
+	f.courses.DeleteCourse(ctx, testCourseId, expired, "Test User")
+	f.chapters.DeleteCourseChaptersByCourseId(ctx, testCourseId, expired, "Test User")
+	f.lessons.DeleteChapterLessonsByCourseId(ctx, testCourseId, expired, "Test User")
+
+	f.courses.AddCourse(entity.Course{Id: testDeletedCourseId, Name: "Go Advanced"})
+	f.lessons.AddChapterLesson(entity.ChapterLesson{
+		Id:            testOtherLessonId,
+		CourseId:      utils.StringToPtr(testDeletedCourseId),
+		Title:         "Goroutines",
+		FilePath:      utils.StringToPtr("lesson_2.mp4"),
+		StorageLesson: utils.StringToPtr(entity.LessonStorageUpload),
+	})
+	f.lessons.DeleteChapterLesson(ctx, testOtherLessonId, time.Now(), "Test User")
+
+	return f
+}
+
+func (f *trashFixture) service(t *testing.T, expect txExpectation) ITrashService {
+	t.Helper()
+
+	resolver, err := storage.NewResolver(f.root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewTrashService(newMockDB(t, expect), f.courses, f.chapters, f.lessons, resolver, testTrashRetention)
+}
+
+func (f *trashFixture) writeFile(t *testing.T, courseId string, folder string, fileName string) string {
+	t.Helper()
+
+	dir := filepath.Join(f.root, courseId, folder)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(dir, fileName)
+	if err := os.WriteFile(filePath, []byte(fileName), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return filePath
+}
+
+func TestTrashServiceListTrash(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		request  *trash.ListTrashRequest
+		wantCode codes.Code
+		wantIds  []string
+	}{
+		{
+			name:     "instructor role",
+			ctx:      contextInstructor,
+			request:  &trash.ListTrashRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "all roots, newest first",
+			ctx:      contextAdmin,
+			request:  &trash.ListTrashRequest{},
+			wantCode: codes.OK,
+			wantIds:  []string{testOtherLessonId, testCourseId},
+		},
+		{
+			name:     "filter by kind",
+			ctx:      contextAdmin,
+			request:  &trash.ListTrashRequest{Kind: utils.StringToPtr(TrashKindCourse)},
+			wantCode: codes.OK,
+			wantIds:  []string{testCourseId},
+		},
+		{
+			name:     "filter by course",
+			ctx:      contextAdmin,
+			request:  &trash.ListTrashRequest{CourseId: utils.StringToPtr(testDeletedCourseId)},
+			wantCode: codes.OK,
+			wantIds:  []string{testOtherLessonId},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTrashFixture(t)
+
+			res, err := f.service(t, txNone).ListTrash(tt.ctx, tt.request)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			var gotIds []string
+			for _, item := range res.Items {
+				gotIds = append(gotIds, item.Id)
+				if item.Id != testCourseId {
+					continue
+				}
+				//? chapter & lesson yang ikut terhapus tidak tampil sendiri, hanya dihitung
+				if item.Kind != TrashKindCourse || item.ChapterCount != 1 || item.LessonCount != 1 {
+					t.Errorf("course item = %+v, want 1 chapter and 1 lesson", item)
+				}
+				deletedAt, _ := time.Parse(time.RFC3339, item.DeletedAt)
+				purgeAt, _ := time.Parse(time.RFC3339, item.PurgeAt)
+				if purgeAt.Sub(deletedAt) != testTrashRetention {
+					t.Errorf("purge_at - deleted_at = %s, want %s", purgeAt.Sub(deletedAt), testTrashRetention)
+				}
+			}
+			assertOrder(t, gotIds, tt.wantIds)
+		})
+	}
+}
+
+func TestTrashServicePurgeTrash(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(f *trashFixture)
+		ctx      context.Context
+		tx       txExpectation
+		wantCode codes.Code
+	}{
+		{
+			name:     "instructor role",
+			ctx:      contextInstructor,
+			tx:       txNone,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "repository write error",
+			setup:    func(f *trashFixture) { f.chapters.WriteErr = errDatabase },
+			ctx:      contextAdmin,
+			tx:       txRollback,
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "purges expired items only",
+			ctx:      contextAdmin,
+			tx:       txCommit,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTrashFixture(t)
+			image := f.writeFile(t, testCourseId, storage.FolderCourse, "course_1.jpg")
+			expiredLesson := f.writeFile(t, testCourseId, storage.FolderLesson, "lesson_1.mp4")
+			recentLesson := f.writeFile(t, testDeletedCourseId, storage.FolderLesson, "lesson_2.mp4")
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			res, err := f.service(t, tt.tx).PurgeTrash(tt.ctx, &trash.PurgeTrashRequest{})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				if !fileExists(t, image) || !fileExists(t, expiredLesson) {
+					t.Error("files removed although nothing was purged")
+				}
+				return
+			}
+
+			if res.PurgedCourses != 1 || res.PurgedChapters != 1 || res.PurgedLessons != 1 {
+				t.Errorf("purged courses/chapters/lessons = %d/%d/%d, want 1/1/1", res.PurgedCourses, res.PurgedChapters, res.PurgedLessons)
+			}
+			if _, ok := f.courses.Course(testCourseId); ok {
+				t.Error("expired course still stored")
+			}
+			if _, ok := f.lessons.ChapterLesson(testOtherLessonId); !ok {
+				t.Error("lesson purged before retention ends")
+			}
+			if fileExists(t, filepath.Join(f.root, testCourseId)) {
+				t.Error("folder of purged course still exists")
+			}
+			if !fileExists(t, recentLesson) {
+				t.Error("file of lesson still in retention removed")
+			}
+		})
+	}
+}
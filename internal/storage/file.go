@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// CopyFile menyalin isi src ke dst (folder dst dibuat jika belum ada).
+// dst tidak boleh sudah ada, jadi file lain tidak pernah tertimpa; jika gagal, dst yang setengah jadi dihapus.
+func CopyFile(src string, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	err = os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(dst)
+		}
+	}()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCopyFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "lesson_1.mp4")
+	if err := os.WriteFile(src, []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := filepath.Join(dir, "other", FolderLesson, "lesson_2.mp4")
+	if err := CopyFile(src, dst); err != nil {
+		t.Fatal(err)
+	}
+	if content, err := os.ReadFile(dst); err != nil || string(content) != "video" {
+		t.Fatalf("copied content = %q, %v; want video", content, err)
+	}
+
+	//? file tujuan yang sudah ada tidak boleh ditimpa
+	if err := os.WriteFile(src, []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := CopyFile(src, dst); !os.IsExist(err) {
+		t.Fatalf("copy onto existing file: err = %v, want exist error", err)
+	}
+	if content, _ := os.ReadFile(dst); string(content) != "video" {
+		t.Errorf("existing file is overwritten: %q", content)
+	}
+
+	if err := CopyFile(filepath.Join(dir, "missing.mp4"), filepath.Join(dir, "copy.mp4")); !os.IsNotExist(err) {
+		t.Errorf("copy missing file: err = %v, want not exist error", err)
+	}
+}
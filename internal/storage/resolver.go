@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FolderCourse     = "course"
+	FolderLesson     = "lesson"
+	FolderSubmission = "submission" //? file jawaban assignment learner (private, butuh signed URL)
+)
+
+var (
+	ErrUnsafePath      = errors.New("storage path escapes storage root")
+	ErrInvalidId       = errors.New("invalid storage id")
+	ErrInvalidFileName = errors.New("invalid storage file name")
+)
+
+// SubmissionFilePrefix: file jawaban assignment disimpan sbg submission_<user_id>_<unixnano>.<ext>
+// sehingga pemilik file bisa dicek saat submit tanpa tabel tambahan
+const SubmissionFilePrefix = "submission"
+
+// UserFilePrefix: awalan nama file yang di-upload oleh userId, misal submission_<user_id>_
+func UserFilePrefix(prefix string, userId string) string {
+	return prefix + "_" + userId + "_"
+}
+
+// fileNamePattern: nama file hasil upload (course_123.jpg, lesson_123.mp4), tanpa separator maupun encoding
+var fileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,254}$`)
+
+// Resolver adalah satu-satunya tempat path filesystem storage disusun.
+// Semua path dikanonikalisasi dan ditolak jika keluar dari root (termasuk lewat symlink).
+type Resolver struct {
+	root string
+}
+
+func NewResolver(root string) (*Resolver, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	//? root sendiri boleh berupa symlink, yang dipakai adalah lokasi aslinya
+	realRoot, err := evalExistingSymlinks(absRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resolver{root: realRoot}, nil
+}
+
+func MustNewResolver(root string) *Resolver {
+	r, err := NewResolver(root)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+func (r *Resolver) Root() string {
+	return r.root
+}
+
+// Resolve menggabungkan elemen path di bawah root dan memastikan hasilnya tetap di dalam root
+func (r *Resolver) Resolve(elems ...string) (string, error) {
+	for _, elem := range elems {
+		if err := checkPathElement(elem); err != nil {
+			return "", err
+		}
+	}
+
+	joined := filepath.Join(append([]string{r.root}, elems...)...)
+	if !isWithin(r.root, joined) {
+		return "", ErrUnsafePath
+	}
+
+	//? cek ulang setelah symlink di-resolve, agar symlink di dalam storage tidak bisa menunjuk keluar
+	real, err := evalExistingSymlinks(joined)
+	if err != nil {
+		return "", err
+	}
+	if !isWithin(r.root, real) {
+		return "", ErrUnsafePath
+	}
+
+	return joined, nil
+}
+
+// CourseFolder: <root>/<course_id>/<folder>
+func (r *Resolver) CourseFolder(courseId string, folder string) (string, error) {
+	if err := ValidateId(courseId); err != nil {
+		return "", err
+	}
+
+	return r.Resolve(courseId, folder)
+}
+
+// CourseFile: <root>/<course_id>/<folder>/<file_name>
+func (r *Resolver) CourseFile(courseId string, folder string, fileName string) (string, error) {
+	if err := ValidateId(courseId); err != nil {
+		return "", err
+	}
+	if err := ValidateFileName(fileName); err != nil {
+		return "", err
+	}
+
+	return r.Resolve(courseId, folder, fileName)
+}
+
+// ValidateId memastikan id berupa UUID dalam bentuk kanonik (lowercase, dengan tanda "-")
+func ValidateId(id string) error {
+	parsed, err := uuid.Parse(id)
+	if err != nil || parsed.String() != id {
+		return ErrInvalidId
+	}
+
+	return nil
+}
+
+func ValidateFileName(fileName string) error {
+	if !fileNamePattern.MatchString(fileName) || strings.Contains(fileName, "..") {
+		return ErrInvalidFileName
+	}
+
+	return nil
+}
+
+func checkPathElement(elem string) error {
+	if elem == "" || strings.ContainsRune(elem, 0) {
+		return ErrUnsafePath
+	}
+
+	if filepath.IsAbs(elem) || filepath.VolumeName(elem) != "" || strings.HasPrefix(elem, "/") || strings.HasPrefix(elem, `\`) {
+		return ErrUnsafePath
+	}
+
+	//? tolak percent-encoding (%2e%2e%2f) agar tidak lolos lalu di-decode di layer lain
+	decoded, err := url.PathUnescape(elem)
+	if err != nil || decoded != elem {
+		return ErrUnsafePath
+	}
+
+	for _, part := range strings.FieldsFunc(elem, isSeparator) {
+		if part == ".." {
+			return ErrUnsafePath
+		}
+	}
+
+	return nil
+}
+
+func isSeparator(r rune) bool {
+	return r == '/' || r == '\\'
+}
+
+func isWithin(root string, target string) bool {
+	rel, err := filepath.Rel(root, target)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
+
+// evalExistingSymlinks me-resolve symlink pada bagian path yang sudah ada di disk,
+// sisa path yang belum ada (misal file yang akan di-upload) ditempel kembali apa adanya.
+func evalExistingSymlinks(p string) (string, error) {
+	existing := p
+	var rest []string
+
+	for {
+		real, err := filepath.EvalSymlinks(existing)
+		if err == nil {
+			return filepath.Join(append([]string{real}, rest...)...), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			return p, nil
+		}
+
+		rest = append([]string{filepath.Base(existing)}, rest...)
+		existing = parent
+	}
+}
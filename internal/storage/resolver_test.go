@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testCourseId = "1cc9d5f2-49af-4b7d-914a-c9d497007eed"
+
+func newTestResolver(t *testing.T) (*Resolver, string) {
+	t.Helper()
+
+	base := t.TempDir()
+	root := filepath.Join(base, "storage")
+	if err := os.MkdirAll(filepath.Join(root, testCourseId, FolderCourse), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewResolver(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r, base
+}
+
+func TestResolveRejectsTraversal(t *testing.T) {
+	r, _ := newTestResolver(t)
+
+	tests := []struct {
+		name  string
+		elems []string
+	}{
+		{"parent dir", []string{"..", "etc", "passwd"}},
+		{"nested parent dir", []string{testCourseId, FolderCourse, "../../../etc/passwd"}},
+		{"backslash parent dir", []string{testCourseId, `..\..\secret`}},
+		{"encoded dot dot slash", []string{"%2e%2e%2fetc%2fpasswd"}},
+		{"encoded slash only", []string{testCourseId, "..%2f..%2fsecret"}},
+		{"double encoded", []string{"%252e%252e%252f"}},
+		{"absolute path", []string{"/etc/passwd"}},
+		{"absolute path in middle", []string{testCourseId, "/etc/passwd"}},
+		{"windows absolute path", []string{`\windows\system32`}},
+		{"null byte", []string{testCourseId, "course_1.jpg\x00.png"}},
+		{"empty element", []string{testCourseId, ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.Resolve(tt.elems...)
+			if !errors.Is(err, ErrUnsafePath) {
+				t.Fatalf("Resolve(%q) error = %v, want ErrUnsafePath", tt.elems, err)
+			}
+		})
+	}
+}
+
+func TestResolveAllowsPathsInsideRoot(t *testing.T) {
+	r, _ := newTestResolver(t)
+
+	got, err := r.Resolve(testCourseId, FolderCourse, "course_1768892062032513700.jpg")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	want := filepath.Join(r.Root(), testCourseId, FolderCourse, "course_1768892062032513700.jpg")
+	if got != want {
+		t.Fatalf("Resolve() = %q, want %q", got, want)
+	}
+}
+
+func TestResolveRejectsSymlinkEscape(t *testing.T) {
+	r, base := newTestResolver(t)
+
+	outside := filepath.Join(base, "outside")
+	if err := os.MkdirAll(outside, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outside, "secret.txt"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	//? symlink folder: storage/<course_id>/lesson -> ../../outside
+	if err := os.Symlink(outside, filepath.Join(r.Root(), testCourseId, FolderLesson)); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+	//? symlink file: storage/<course_id>/course/link.jpg -> outside/secret.txt
+	if err := os.Symlink(filepath.Join(outside, "secret.txt"), filepath.Join(r.Root(), testCourseId, FolderCourse, "link.jpg")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		elems []string
+	}{
+		{"symlinked folder", []string{testCourseId, FolderLesson, "secret.txt"}},
+		{"symlinked folder not existing file", []string{testCourseId, FolderLesson, "new_upload.mp4"}},
+		{"symlinked file", []string{testCourseId, FolderCourse, "link.jpg"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.Resolve(tt.elems...)
+			if !errors.Is(err, ErrUnsafePath) {
+				t.Fatalf("Resolve(%q) error = %v, want ErrUnsafePath", tt.elems, err)
+			}
+		})
+	}
+}
+
+func TestResolveAllowsSymlinkInsideRoot(t *testing.T) {
+	r, _ := newTestResolver(t)
+
+	target := filepath.Join(r.Root(), testCourseId, FolderCourse)
+	if err := os.Symlink(target, filepath.Join(r.Root(), testCourseId, FolderLesson)); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+
+	if _, err := r.Resolve(testCourseId, FolderLesson, "lesson_1.mp4"); err != nil {
+		t.Fatalf("Resolve() error = %v, want nil", err)
+	}
+}
+
+func TestCourseFileValidatesIdentifiers(t *testing.T) {
+	r, _ := newTestResolver(t)
+
+	tests := []struct {
+		name     string
+		courseId string
+		fileName string
+		wantErr  error
+	}{
+		{"valid", testCourseId, "course_1768892062032513700.jpg", nil},
+		{"course id not uuid", "1234444", "course_1.jpg", ErrInvalidId},
+		{"course id traversal", "..", "course_1.jpg", ErrInvalidId},
+		{"course id uppercase", "1CC9D5F2-49AF-4B7D-914A-C9D497007EED", "course_1.jpg", ErrInvalidId},
+		{"course id without dashes", "1cc9d5f249af4b7d914ac9d497007eed", "course_1.jpg", ErrInvalidId},
+		{"course id urn form", "urn:uuid:" + testCourseId, "course_1.jpg", ErrInvalidId},
+		{"file name traversal", testCourseId, "../course_1.jpg", ErrInvalidFileName},
+		{"file name double dot", testCourseId, "course..jpg", ErrInvalidFileName},
+		{"file name hidden", testCourseId, ".env", ErrInvalidFileName},
+		{"file name encoded", testCourseId, "%2e%2e%2fpasswd", ErrInvalidFileName},
+		{"file name with slash", testCourseId, "a/b.jpg", ErrInvalidFileName},
+		{"file name absolute", testCourseId, "/etc/passwd", ErrInvalidFileName},
+		{"file name empty", testCourseId, "", ErrInvalidFileName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.CourseFile(tt.courseId, FolderCourse, tt.fileName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CourseFile(%q, %q) error = %v, want %v", tt.courseId, tt.fileName, err, tt.wantErr)
+			}
+		})
+	}
+}
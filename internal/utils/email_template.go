@@ -1,6 +1,9 @@
 package utils
 
-import "fmt"
+import (
+	"fmt"
+	"html"
+)
 
 func GetOTPEmailTemplate(code string) string {
 	otpHTML := ""
@@ -91,3 +94,61 @@ func GetOTPEmailTemplate(code string) string {
 </html>
 	`, otpHTML)
 }
+
+// GetAssignmentGradedEmailTemplate: notifikasi nilai tugas, judul & feedback dari user di-escape
+func GetAssignmentGradedEmailTemplate(title string, score int64, maxScore int64, feedback *string) string {
+	feedbackHTML := ""
+	if feedback != nil && *feedback != "" {
+		feedbackHTML = fmt.Sprintf(`<div class="feedback-box"><div class="label">Feedback Instruktur</div><p>%s</p></div>`, html.EscapeString(*feedback))
+	}
+
+	return fmt.Sprintf(`
+	<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <title>Tugas Dinilai - MyApps</title>
+  <style>
+    body { margin: 0; padding: 20px; background: #f5f7fa; font-family: 'Inter', -apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif; }
+    .container { max-width: 600px; margin: 0 auto; background: #ffffff; border-radius: 12px; overflow: hidden; box-shadow: 0 20px 25px -5px rgba(0,0,0,0.1); }
+    .header { background: #2563eb; padding: 24px 32px; text-align: center; }
+    .header h1 { color: #ffffff; font-size: 20px; font-weight: 600; margin: 0; letter-spacing: -0.02em; }
+    .content { padding: 40px 32px; }
+    .content p { color: #64748b; font-size: 16px; line-height: 1.6; margin: 0 0 24px; }
+    .content p:first-child { color: #1e293b; margin-bottom: 8px; }
+    .score-box { background: #f1f5f9; border-radius: 12px; padding: 24px; margin-bottom: 24px; text-align: center; }
+    .label { font-size: 12px; font-weight: 500; color: #64748b; text-transform: uppercase; letter-spacing: 0.1em; margin-bottom: 12px; }
+    .score { font-size: 32px; font-weight: 700; color: #2563eb; }
+    .feedback-box { border: 1px solid #e2e8f0; border-radius: 8px; padding: 16px; margin-bottom: 24px; }
+    .feedback-box p { margin: 0; font-size: 14px; color: #1e293b; white-space: pre-line; }
+    .footer { background: #f8fafc; padding: 24px 32px; border-top: 1px solid #e2e8f0; text-align: center; }
+    .footer-company { font-size: 14px; font-weight: 500; color: #1e293b; margin: 0 0 4px; }
+    .footer-auto { font-size: 12px; color: #64748b; margin: 0 0 16px; }
+    .copyright { font-size: 12px; color: #94a3b8; margin: 0; }
+  </style>
+</head>
+<body>
+  <div class="container">
+    <div class="header">
+      <h1>Tugas Sudah Dinilai</h1>
+    </div>
+    <div class="content">
+      <p>Halo,</p>
+      <p>Tugas <strong>%s</strong> yang Anda kumpulkan sudah dinilai oleh instruktur.</p>
+      <div class="score-box">
+        <div class="label">Nilai</div>
+        <div class="score">%d / %d</div>
+      </div>
+      %s
+    </div>
+    <div class="footer">
+      <p class="footer-company">MyApps</p>
+      <p class="footer-auto">Email ini dikirim secara otomatis, mohon tidak membalas email ini.</p>
+      <p class="copyright">© 2026 MyApps. All rights reserved.</p>
+    </div>
+  </div>
+</body>
+</html>
+	`, html.EscapeString(title), score, maxScore, feedbackHTML)
+}
@@ -14,31 +14,6 @@ func SuccessResponse(message string) *common.BaseResponse {
 	}
 }
 
-func BadRequestResponse(message string) *common.BaseResponse {
-	return &common.BaseResponse{
-		StatusCode: 400,
-		Message:    message,
-		IsError:    true,
-	}
-}
-
-func NotFoundResponse(message string) *common.BaseResponse {
-	return &common.BaseResponse{
-		StatusCode: 404,
-		Message:    message,
-		IsError:    true,
-	}
-}
-
 func UnauthenticatedResponse() error {
 	return status.Error(codes.Unauthenticated, "Unauthenticated")
 }
-
-func ValidationErrorResponse(validationErrors []*common.ValidationError) *common.BaseResponse {
-	return &common.BaseResponse{
-		StatusCode:       400,
-		Message:          "Validation error",
-		IsError:          true,
-		ValidationErrors: validationErrors,
-	}
-}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrSigningSecretEmpty = errors.New("storage signing secret is empty")
+	ErrSignatureInvalid   = errors.New("storage signature is invalid")
+	ErrSignatureExpired   = errors.New("storage signature is expired")
+)
+
+// SignStoragePath menghasilkan HMAC-SHA256 dari path file, waktu kadaluarsa dan user id.
+// storagePath relatif terhadap folder storage, contoh: <course_id>/lesson/lesson_123.mp4
+func SignStoragePath(storagePath string, userId string, expiresAt int64, secret string) (string, error) {
+	if secret == "" {
+		return "", ErrSigningSecretEmpty
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s\n%d\n%s", storagePath, expiresAt, userId)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// BuildSignedStorageURL membuat URL file storage yang hanya berlaku sampai expiresAt
+func BuildSignedStorageURL(baseURL string, storagePath string, userId string, expiresAt time.Time, secret string) (string, error) {
+	signature, err := SignStoragePath(storagePath, userId, expiresAt.Unix(), secret)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("uid", userId)
+	query.Set("sig", signature)
+
+	return fmt.Sprintf("%s/%s?%s", baseURL, storagePath, query.Encode()), nil
+}
+
+// VerifyStorageSignature memvalidasi query expires, uid & sig dari URL yang dibuat BuildSignedStorageURL
+func VerifyStorageSignature(storagePath string, userId string, expires string, signature string, secret string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrSignatureInvalid
+	}
+
+	expected, err := SignStoragePath(storagePath, userId, expiresAt, secret)
+	if err != nil {
+		return err
+	}
+
+	//? compare constant time agar tidak bisa ditebak lewat timing attack
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrSignatureInvalid
+	}
+
+	if time.Now().Unix() > expiresAt {
+		return ErrSignatureExpired
+	}
+
+	return nil
+}
@@ -0,0 +1,1404 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: assignment/assignment.proto
+
+package assignment
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/abu-umair/be-lms-go/pb/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AssignmentSettings: dipakai Create & Edit
+type AssignmentSettings struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Title                string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Instructions         string                 `protobuf:"bytes,2,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	DueAt                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_at,json=dueAt,proto3,oneof" json:"due_at,omitempty"` //? kosong = tanpa deadline
+	MaxScore             int64                  `protobuf:"varint,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	AllowLateSubmissions bool                   `protobuf:"varint,5,opt,name=allow_late_submissions,json=allowLateSubmissions,proto3" json:"allow_late_submissions,omitempty"` //? false = submission setelah deadline ditolak
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AssignmentSettings) Reset() {
+	*x = AssignmentSettings{}
+	mi := &file_assignment_assignment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentSettings) ProtoMessage() {}
+
+func (x *AssignmentSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentSettings.ProtoReflect.Descriptor instead.
+func (*AssignmentSettings) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AssignmentSettings) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AssignmentSettings) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *AssignmentSettings) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *AssignmentSettings) GetMaxScore() int64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *AssignmentSettings) GetAllowLateSubmissions() bool {
+	if x != nil {
+		return x.AllowLateSubmissions
+	}
+	return false
+}
+
+type CreateAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Settings      *AssignmentSettings    `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAssignmentRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetSettings() *AssignmentSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type CreateAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentResponse) Reset() {
+	*x = CreateAssignmentResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentResponse) ProtoMessage() {}
+
+func (x *CreateAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAssignmentResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateAssignmentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DetailAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailAssignmentRequest) Reset() {
+	*x = DetailAssignmentRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailAssignmentRequest) ProtoMessage() {}
+
+func (x *DetailAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*DetailAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DetailAssignmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DetailAssignmentResponse struct {
+	state                protoimpl.MessageState       `protogen:"open.v1"`
+	Base                 *common.BaseResponse         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id                   string                       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	LessonId             string                       `protobuf:"bytes,3,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	CourseId             string                       `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title                string                       `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Instructions         string                       `protobuf:"bytes,6,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	DueAt                *string                      `protobuf:"bytes,7,opt,name=due_at,json=dueAt,proto3,oneof" json:"due_at,omitempty"`
+	MaxScore             int64                        `protobuf:"varint,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	AllowLateSubmissions bool                         `protobuf:"varint,9,opt,name=allow_late_submissions,json=allowLateSubmissions,proto3" json:"allow_late_submissions,omitempty"`
+	MySubmission         *AssignmentSubmissionSummary `protobuf:"bytes,10,opt,name=my_submission,json=mySubmission,proto3,oneof" json:"my_submission,omitempty"` //? submission milik user yang login
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DetailAssignmentResponse) Reset() {
+	*x = DetailAssignmentResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailAssignmentResponse) ProtoMessage() {}
+
+func (x *DetailAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*DetailAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DetailAssignmentResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailAssignmentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailAssignmentResponse) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *DetailAssignmentResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *DetailAssignmentResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DetailAssignmentResponse) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *DetailAssignmentResponse) GetDueAt() string {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
+	}
+	return ""
+}
+
+func (x *DetailAssignmentResponse) GetMaxScore() int64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *DetailAssignmentResponse) GetAllowLateSubmissions() bool {
+	if x != nil {
+		return x.AllowLateSubmissions
+	}
+	return false
+}
+
+func (x *DetailAssignmentResponse) GetMySubmission() *AssignmentSubmissionSummary {
+	if x != nil {
+		return x.MySubmission
+	}
+	return nil
+}
+
+type EditAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Settings      *AssignmentSettings    `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditAssignmentRequest) Reset() {
+	*x = EditAssignmentRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditAssignmentRequest) ProtoMessage() {}
+
+func (x *EditAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*EditAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EditAssignmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditAssignmentRequest) GetSettings() *AssignmentSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type EditAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditAssignmentResponse) Reset() {
+	*x = EditAssignmentResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditAssignmentResponse) ProtoMessage() {}
+
+func (x *EditAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*EditAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EditAssignmentResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditAssignmentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentRequest) Reset() {
+	*x = DeleteAssignmentRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentRequest) ProtoMessage() {}
+
+func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAssignmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentResponse) Reset() {
+	*x = DeleteAssignmentResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentResponse) ProtoMessage() {}
+
+func (x *DeleteAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAssignmentResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// SubmitAssignmentRequest: file diupload dulu lewat REST (POST /assignment/upload), lalu nama filenya dikirim di sini.
+// Submit ulang sebelum dinilai menggantikan submission sebelumnya.
+type SubmitAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	FileNames     []string               `protobuf:"bytes,2,rep,name=file_names,json=fileNames,proto3" json:"file_names,omitempty"`
+	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAssignmentRequest) Reset() {
+	*x = SubmitAssignmentRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAssignmentRequest) ProtoMessage() {}
+
+func (x *SubmitAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *SubmitAssignmentRequest) GetFileNames() []string {
+	if x != nil {
+		return x.FileNames
+	}
+	return nil
+}
+
+func (x *SubmitAssignmentRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type SubmitAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Late          bool                   `protobuf:"varint,3,opt,name=late,proto3" json:"late,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAssignmentResponse) Reset() {
+	*x = SubmitAssignmentResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAssignmentResponse) ProtoMessage() {}
+
+func (x *SubmitAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubmitAssignmentResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SubmitAssignmentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmitAssignmentResponse) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+type AssignmentSubmissionSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId  string                 `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	SubmittedAt   string                 `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	Late          bool                   `protobuf:"varint,6,opt,name=late,proto3" json:"late,omitempty"`
+	Score         *int64                 `protobuf:"varint,7,opt,name=score,proto3,oneof" json:"score,omitempty"` //? kosong = belum dinilai
+	MaxScore      int64                  `protobuf:"varint,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	GradedAt      *string                `protobuf:"bytes,9,opt,name=graded_at,json=gradedAt,proto3,oneof" json:"graded_at,omitempty"`
+	GradedBy      *string                `protobuf:"bytes,10,opt,name=graded_by,json=gradedBy,proto3,oneof" json:"graded_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentSubmissionSummary) Reset() {
+	*x = AssignmentSubmissionSummary{}
+	mi := &file_assignment_assignment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentSubmissionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentSubmissionSummary) ProtoMessage() {}
+
+func (x *AssignmentSubmissionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentSubmissionSummary.ProtoReflect.Descriptor instead.
+func (*AssignmentSubmissionSummary) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AssignmentSubmissionSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignmentSubmissionSummary) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *AssignmentSubmissionSummary) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignmentSubmissionSummary) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *AssignmentSubmissionSummary) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *AssignmentSubmissionSummary) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *AssignmentSubmissionSummary) GetScore() int64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *AssignmentSubmissionSummary) GetMaxScore() int64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *AssignmentSubmissionSummary) GetGradedAt() string {
+	if x != nil && x.GradedAt != nil {
+		return *x.GradedAt
+	}
+	return ""
+}
+
+func (x *AssignmentSubmissionSummary) GetGradedBy() string {
+	if x != nil && x.GradedBy != nil {
+		return *x.GradedBy
+	}
+	return ""
+}
+
+// ListAssignmentSubmissionsRequest: instructor pemilik course melihat semua submission, learner hanya miliknya
+type ListAssignmentSubmissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentSubmissionsRequest) Reset() {
+	*x = ListAssignmentSubmissionsRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListAssignmentSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListAssignmentSubmissionsRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type ListAssignmentSubmissionsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Base          *common.BaseResponse           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*AssignmentSubmissionSummary `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentSubmissionsResponse) Reset() {
+	*x = ListAssignmentSubmissionsResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListAssignmentSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAssignmentSubmissionsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListAssignmentSubmissionsResponse) GetItems() []*AssignmentSubmissionSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DetailAssignmentSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailAssignmentSubmissionRequest) Reset() {
+	*x = DetailAssignmentSubmissionRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailAssignmentSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailAssignmentSubmissionRequest) ProtoMessage() {}
+
+func (x *DetailAssignmentSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailAssignmentSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*DetailAssignmentSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DetailAssignmentSubmissionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SubmissionFile: url berupa signed URL yang kadaluarsa
+type SubmissionFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionFile) Reset() {
+	*x = SubmissionFile{}
+	mi := &file_assignment_assignment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionFile) ProtoMessage() {}
+
+func (x *SubmissionFile) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionFile.ProtoReflect.Descriptor instead.
+func (*SubmissionFile) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubmissionFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *SubmissionFile) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type DetailAssignmentSubmissionResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Base          *common.BaseResponse         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Submission    *AssignmentSubmissionSummary `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
+	Files         []*SubmissionFile            `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	Comment       *string                      `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Feedback      *string                      `protobuf:"bytes,5,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailAssignmentSubmissionResponse) Reset() {
+	*x = DetailAssignmentSubmissionResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailAssignmentSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailAssignmentSubmissionResponse) ProtoMessage() {}
+
+func (x *DetailAssignmentSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailAssignmentSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*DetailAssignmentSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DetailAssignmentSubmissionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailAssignmentSubmissionResponse) GetSubmission() *AssignmentSubmissionSummary {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+func (x *DetailAssignmentSubmissionResponse) GetFiles() []*SubmissionFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *DetailAssignmentSubmissionResponse) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *DetailAssignmentSubmissionResponse) GetFeedback() string {
+	if x != nil && x.Feedback != nil {
+		return *x.Feedback
+	}
+	return ""
+}
+
+// GradeAssignmentSubmissionRequest: boleh dinilai ulang, learner diberi tahu setiap kali nilai disimpan
+type GradeAssignmentSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score         int64                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Feedback      *string                `protobuf:"bytes,3,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeAssignmentSubmissionRequest) Reset() {
+	*x = GradeAssignmentSubmissionRequest{}
+	mi := &file_assignment_assignment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeAssignmentSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeAssignmentSubmissionRequest) ProtoMessage() {}
+
+func (x *GradeAssignmentSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeAssignmentSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GradeAssignmentSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GradeAssignmentSubmissionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GradeAssignmentSubmissionRequest) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GradeAssignmentSubmissionRequest) GetFeedback() string {
+	if x != nil && x.Feedback != nil {
+		return *x.Feedback
+	}
+	return ""
+}
+
+type GradeAssignmentSubmissionResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Base          *common.BaseResponse         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Submission    *AssignmentSubmissionSummary `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeAssignmentSubmissionResponse) Reset() {
+	*x = GradeAssignmentSubmissionResponse{}
+	mi := &file_assignment_assignment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeAssignmentSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeAssignmentSubmissionResponse) ProtoMessage() {}
+
+func (x *GradeAssignmentSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignment_assignment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeAssignmentSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GradeAssignmentSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_assignment_assignment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GradeAssignmentSubmissionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GradeAssignmentSubmissionResponse) GetSubmission() *AssignmentSubmissionSummary {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+var File_assignment_assignment_proto protoreflect.FileDescriptor
+
+const file_assignment_assignment_proto_rawDesc = "" +
+	"\n" +
+	"\x1bassignment/assignment.proto\x12\n" +
+	"assignment\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\x89\x02\n" +
+	"\x12AssignmentSettings\x12 \n" +
+	"\x05title\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12/\n" +
+	"\finstructions\x18\x02 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\xa0\x8d\x06R\finstructions\x126\n" +
+	"\x06due_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05dueAt\x88\x01\x01\x12'\n" +
+	"\tmax_score\x18\x04 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a \x00R\bmaxScore\x124\n" +
+	"\x16allow_late_submissions\x18\x05 \x01(\bR\x14allowLateSubmissionsB\t\n" +
+	"\a_due_at\"\x84\x01\n" +
+	"\x17CreateAssignmentRequest\x12%\n" +
+	"\tlesson_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\blessonId\x12B\n" +
+	"\bsettings\x18\x02 \x01(\v2\x1e.assignment.AssignmentSettingsB\x06\xbaH\x03\xc8\x01\x01R\bsettings\"T\n" +
+	"\x18CreateAssignmentResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"3\n" +
+	"\x17DetailAssignmentRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xa7\x03\n" +
+	"\x18DetailAssignmentResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
+	"\tlesson_id\x18\x03 \x01(\tR\blessonId\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\"\n" +
+	"\finstructions\x18\x06 \x01(\tR\finstructions\x12\x1a\n" +
+	"\x06due_at\x18\a \x01(\tH\x00R\x05dueAt\x88\x01\x01\x12\x1b\n" +
+	"\tmax_score\x18\b \x01(\x03R\bmaxScore\x124\n" +
+	"\x16allow_late_submissions\x18\t \x01(\bR\x14allowLateSubmissions\x12Q\n" +
+	"\rmy_submission\x18\n" +
+	" \x01(\v2'.assignment.AssignmentSubmissionSummaryH\x01R\fmySubmission\x88\x01\x01B\t\n" +
+	"\a_due_atB\x10\n" +
+	"\x0e_my_submission\"u\n" +
+	"\x15EditAssignmentRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12B\n" +
+	"\bsettings\x18\x02 \x01(\v2\x1e.assignment.AssignmentSettingsB\x06\xbaH\x03\xc8\x01\x01R\bsettings\"R\n" +
+	"\x16EditAssignmentResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"3\n" +
+	"\x17DeleteAssignmentRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"D\n" +
+	"\x18DeleteAssignmentResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xd2\x01\n" +
+	"\x17SubmitAssignmentRequest\x12-\n" +
+	"\rassignment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fassignmentId\x12S\n" +
+	"\n" +
+	"file_names\x18\x02 \x03(\tB4\xbaH1\x92\x01.\b\x01\x10\n" +
+	"\x18\x01\"&r$2\"^[A-Za-z0-9][A-Za-z0-9._-]{0,254}$R\tfileNames\x12'\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x88'H\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"h\n" +
+	"\x18SubmitAssignmentResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04late\x18\x03 \x01(\bR\x04late\"\xe1\x02\n" +
+	"\x1bAssignmentSubmissionSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rassignment_id\x18\x02 \x01(\tR\fassignmentId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12!\n" +
+	"\fsubmitted_at\x18\x05 \x01(\tR\vsubmittedAt\x12\x12\n" +
+	"\x04late\x18\x06 \x01(\bR\x04late\x12\x19\n" +
+	"\x05score\x18\a \x01(\x03H\x00R\x05score\x88\x01\x01\x12\x1b\n" +
+	"\tmax_score\x18\b \x01(\x03R\bmaxScore\x12 \n" +
+	"\tgraded_at\x18\t \x01(\tH\x01R\bgradedAt\x88\x01\x01\x12 \n" +
+	"\tgraded_by\x18\n" +
+	" \x01(\tH\x02R\bgradedBy\x88\x01\x01B\b\n" +
+	"\x06_scoreB\f\n" +
+	"\n" +
+	"_graded_atB\f\n" +
+	"\n" +
+	"_graded_by\"Q\n" +
+	" ListAssignmentSubmissionsRequest\x12-\n" +
+	"\rassignment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fassignmentId\"\x8c\x01\n" +
+	"!ListAssignmentSubmissionsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12=\n" +
+	"\x05items\x18\x02 \x03(\v2'.assignment.AssignmentSubmissionSummaryR\x05items\"=\n" +
+	"!DetailAssignmentSubmissionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"?\n" +
+	"\x0eSubmissionFile\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\xa2\x02\n" +
+	"\"DetailAssignmentSubmissionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12G\n" +
+	"\n" +
+	"submission\x18\x02 \x01(\v2'.assignment.AssignmentSubmissionSummaryR\n" +
+	"submission\x120\n" +
+	"\x05files\x18\x03 \x03(\v2\x1a.assignment.SubmissionFileR\x05files\x12\x1d\n" +
+	"\acomment\x18\x04 \x01(\tH\x00R\acomment\x88\x01\x01\x12\x1f\n" +
+	"\bfeedback\x18\x05 \x01(\tH\x01R\bfeedback\x88\x01\x01B\n" +
+	"\n" +
+	"\b_commentB\v\n" +
+	"\t_feedback\"\x96\x01\n" +
+	" GradeAssignmentSubmissionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12 \n" +
+	"\x05score\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a(\x00R\x05score\x12)\n" +
+	"\bfeedback\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x00R\bfeedback\x88\x01\x01B\v\n" +
+	"\t_feedback\"\x96\x01\n" +
+	"!GradeAssignmentSubmissionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12G\n" +
+	"\n" +
+	"submission\x18\x02 \x01(\v2'.assignment.AssignmentSubmissionSummaryR\n" +
+	"submission2\x9c\t\n" +
+	"\x11AssignmentService\x12\x8c\x01\n" +
+	"\x10CreateAssignment\x12#.assignment.CreateAssignmentRequest\x1a$.assignment.CreateAssignmentResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/lessons/{lesson_id}/assignment\x12{\n" +
+	"\x10DetailAssignment\x12#.assignment.DetailAssignmentRequest\x1a$.assignment.DetailAssignmentResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/assignments/{id}\x12x\n" +
+	"\x0eEditAssignment\x12!.assignment.EditAssignmentRequest\x1a\".assignment.EditAssignmentResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/assignments/{id}\x12{\n" +
+	"\x10DeleteAssignment\x12#.assignment.DeleteAssignmentRequest\x1a$.assignment.DeleteAssignmentResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/assignments/{id}\x12\x95\x01\n" +
+	"\x10SubmitAssignment\x12#.assignment.SubmitAssignmentRequest\x1a$.assignment.SubmitAssignmentResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/assignments/{assignment_id}/submissions\x12\xad\x01\n" +
+	"\x19ListAssignmentSubmissions\x12,.assignment.ListAssignmentSubmissionsRequest\x1a-.assignment.ListAssignmentSubmissionsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/assignments/{assignment_id}/submissions\x12\x99\x01\n" +
+	"\x1aDetailAssignmentSubmission\x12-.assignment.DetailAssignmentSubmissionRequest\x1a..assignment.DetailAssignmentSubmissionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/submissions/{id}\x12\x9f\x01\n" +
+	"\x19GradeAssignmentSubmission\x12,.assignment.GradeAssignmentSubmissionRequest\x1a-.assignment.GradeAssignmentSubmissionResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/submissions/{id}:gradeB.Z,github.com/abu-umair/be-lms-go/pb/assignmentb\x06proto3"
+
+var (
+	file_assignment_assignment_proto_rawDescOnce sync.Once
+	file_assignment_assignment_proto_rawDescData []byte
+)
+
+func file_assignment_assignment_proto_rawDescGZIP() []byte {
+	file_assignment_assignment_proto_rawDescOnce.Do(func() {
+		file_assignment_assignment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_assignment_assignment_proto_rawDesc), len(file_assignment_assignment_proto_rawDesc)))
+	})
+	return file_assignment_assignment_proto_rawDescData
+}
+
+var file_assignment_assignment_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_assignment_assignment_proto_goTypes = []any{
+	(*AssignmentSettings)(nil),                 // 0: assignment.AssignmentSettings
+	(*CreateAssignmentRequest)(nil),            // 1: assignment.CreateAssignmentRequest
+	(*CreateAssignmentResponse)(nil),           // 2: assignment.CreateAssignmentResponse
+	(*DetailAssignmentRequest)(nil),            // 3: assignment.DetailAssignmentRequest
+	(*DetailAssignmentResponse)(nil),           // 4: assignment.DetailAssignmentResponse
+	(*EditAssignmentRequest)(nil),              // 5: assignment.EditAssignmentRequest
+	(*EditAssignmentResponse)(nil),             // 6: assignment.EditAssignmentResponse
+	(*DeleteAssignmentRequest)(nil),            // 7: assignment.DeleteAssignmentRequest
+	(*DeleteAssignmentResponse)(nil),           // 8: assignment.DeleteAssignmentResponse
+	(*SubmitAssignmentRequest)(nil),            // 9: assignment.SubmitAssignmentRequest
+	(*SubmitAssignmentResponse)(nil),           // 10: assignment.SubmitAssignmentResponse
+	(*AssignmentSubmissionSummary)(nil),        // 11: assignment.AssignmentSubmissionSummary
+	(*ListAssignmentSubmissionsRequest)(nil),   // 12: assignment.ListAssignmentSubmissionsRequest
+	(*ListAssignmentSubmissionsResponse)(nil),  // 13: assignment.ListAssignmentSubmissionsResponse
+	(*DetailAssignmentSubmissionRequest)(nil),  // 14: assignment.DetailAssignmentSubmissionRequest
+	(*SubmissionFile)(nil),                     // 15: assignment.SubmissionFile
+	(*DetailAssignmentSubmissionResponse)(nil), // 16: assignment.DetailAssignmentSubmissionResponse
+	(*GradeAssignmentSubmissionRequest)(nil),   // 17: assignment.GradeAssignmentSubmissionRequest
+	(*GradeAssignmentSubmissionResponse)(nil),  // 18: assignment.GradeAssignmentSubmissionResponse
+	(*timestamppb.Timestamp)(nil),              // 19: google.protobuf.Timestamp
+	(*common.BaseResponse)(nil),                // 20: common.BaseResponse
+}
+var file_assignment_assignment_proto_depIdxs = []int32{
+	19, // 0: assignment.AssignmentSettings.due_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: assignment.CreateAssignmentRequest.settings:type_name -> assignment.AssignmentSettings
+	20, // 2: assignment.CreateAssignmentResponse.base:type_name -> common.BaseResponse
+	20, // 3: assignment.DetailAssignmentResponse.base:type_name -> common.BaseResponse
+	11, // 4: assignment.DetailAssignmentResponse.my_submission:type_name -> assignment.AssignmentSubmissionSummary
+	0,  // 5: assignment.EditAssignmentRequest.settings:type_name -> assignment.AssignmentSettings
+	20, // 6: assignment.EditAssignmentResponse.base:type_name -> common.BaseResponse
+	20, // 7: assignment.DeleteAssignmentResponse.base:type_name -> common.BaseResponse
+	20, // 8: assignment.SubmitAssignmentResponse.base:type_name -> common.BaseResponse
+	20, // 9: assignment.ListAssignmentSubmissionsResponse.base:type_name -> common.BaseResponse
+	11, // 10: assignment.ListAssignmentSubmissionsResponse.items:type_name -> assignment.AssignmentSubmissionSummary
+	20, // 11: assignment.DetailAssignmentSubmissionResponse.base:type_name -> common.BaseResponse
+	11, // 12: assignment.DetailAssignmentSubmissionResponse.submission:type_name -> assignment.AssignmentSubmissionSummary
+	15, // 13: assignment.DetailAssignmentSubmissionResponse.files:type_name -> assignment.SubmissionFile
+	20, // 14: assignment.GradeAssignmentSubmissionResponse.base:type_name -> common.BaseResponse
+	11, // 15: assignment.GradeAssignmentSubmissionResponse.submission:type_name -> assignment.AssignmentSubmissionSummary
+	1,  // 16: assignment.AssignmentService.CreateAssignment:input_type -> assignment.CreateAssignmentRequest
+	3,  // 17: assignment.AssignmentService.DetailAssignment:input_type -> assignment.DetailAssignmentRequest
+	5,  // 18: assignment.AssignmentService.EditAssignment:input_type -> assignment.EditAssignmentRequest
+	7,  // 19: assignment.AssignmentService.DeleteAssignment:input_type -> assignment.DeleteAssignmentRequest
+	9,  // 20: assignment.AssignmentService.SubmitAssignment:input_type -> assignment.SubmitAssignmentRequest
+	12, // 21: assignment.AssignmentService.ListAssignmentSubmissions:input_type -> assignment.ListAssignmentSubmissionsRequest
+	14, // 22: assignment.AssignmentService.DetailAssignmentSubmission:input_type -> assignment.DetailAssignmentSubmissionRequest
+	17, // 23: assignment.AssignmentService.GradeAssignmentSubmission:input_type -> assignment.GradeAssignmentSubmissionRequest
+	2,  // 24: assignment.AssignmentService.CreateAssignment:output_type -> assignment.CreateAssignmentResponse
+	4,  // 25: assignment.AssignmentService.DetailAssignment:output_type -> assignment.DetailAssignmentResponse
+	6,  // 26: assignment.AssignmentService.EditAssignment:output_type -> assignment.EditAssignmentResponse
+	8,  // 27: assignment.AssignmentService.DeleteAssignment:output_type -> assignment.DeleteAssignmentResponse
+	10, // 28: assignment.AssignmentService.SubmitAssignment:output_type -> assignment.SubmitAssignmentResponse
+	13, // 29: assignment.AssignmentService.ListAssignmentSubmissions:output_type -> assignment.ListAssignmentSubmissionsResponse
+	16, // 30: assignment.AssignmentService.DetailAssignmentSubmission:output_type -> assignment.DetailAssignmentSubmissionResponse
+	18, // 31: assignment.AssignmentService.GradeAssignmentSubmission:output_type -> assignment.GradeAssignmentSubmissionResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_assignment_assignment_proto_init() }
+func file_assignment_assignment_proto_init() {
+	if File_assignment_assignment_proto != nil {
+		return
+	}
+	file_assignment_assignment_proto_msgTypes[0].OneofWrappers = []any{}
+	file_assignment_assignment_proto_msgTypes[4].OneofWrappers = []any{}
+	file_assignment_assignment_proto_msgTypes[9].OneofWrappers = []any{}
+	file_assignment_assignment_proto_msgTypes[11].OneofWrappers = []any{}
+	file_assignment_assignment_proto_msgTypes[16].OneofWrappers = []any{}
+	file_assignment_assignment_proto_msgTypes[17].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_assignment_assignment_proto_rawDesc), len(file_assignment_assignment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_assignment_assignment_proto_goTypes,
+		DependencyIndexes: file_assignment_assignment_proto_depIdxs,
+		MessageInfos:      file_assignment_assignment_proto_msgTypes,
+	}.Build()
+	File_assignment_assignment_proto = out.File
+	file_assignment_assignment_proto_goTypes = nil
+	file_assignment_assignment_proto_depIdxs = nil
+}
@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: assignment/assignment.proto
+
+package assignment
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AssignmentService_CreateAssignment_FullMethodName           = "/assignment.AssignmentService/CreateAssignment"
+	AssignmentService_DetailAssignment_FullMethodName           = "/assignment.AssignmentService/DetailAssignment"
+	AssignmentService_EditAssignment_FullMethodName             = "/assignment.AssignmentService/EditAssignment"
+	AssignmentService_DeleteAssignment_FullMethodName           = "/assignment.AssignmentService/DeleteAssignment"
+	AssignmentService_SubmitAssignment_FullMethodName           = "/assignment.AssignmentService/SubmitAssignment"
+	AssignmentService_ListAssignmentSubmissions_FullMethodName  = "/assignment.AssignmentService/ListAssignmentSubmissions"
+	AssignmentService_DetailAssignmentSubmission_FullMethodName = "/assignment.AssignmentService/DetailAssignmentSubmission"
+	AssignmentService_GradeAssignmentSubmission_FullMethodName  = "/assignment.AssignmentService/GradeAssignmentSubmission"
+)
+
+// AssignmentServiceClient is the client API for AssignmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssignmentServiceClient interface {
+	CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*CreateAssignmentResponse, error)
+	DetailAssignment(ctx context.Context, in *DetailAssignmentRequest, opts ...grpc.CallOption) (*DetailAssignmentResponse, error)
+	EditAssignment(ctx context.Context, in *EditAssignmentRequest, opts ...grpc.CallOption) (*EditAssignmentResponse, error)
+	DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*DeleteAssignmentResponse, error)
+	SubmitAssignment(ctx context.Context, in *SubmitAssignmentRequest, opts ...grpc.CallOption) (*SubmitAssignmentResponse, error)
+	ListAssignmentSubmissions(ctx context.Context, in *ListAssignmentSubmissionsRequest, opts ...grpc.CallOption) (*ListAssignmentSubmissionsResponse, error)
+	DetailAssignmentSubmission(ctx context.Context, in *DetailAssignmentSubmissionRequest, opts ...grpc.CallOption) (*DetailAssignmentSubmissionResponse, error)
+	GradeAssignmentSubmission(ctx context.Context, in *GradeAssignmentSubmissionRequest, opts ...grpc.CallOption) (*GradeAssignmentSubmissionResponse, error)
+}
+
+type assignmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignmentServiceClient(cc grpc.ClientConnInterface) AssignmentServiceClient {
+	return &assignmentServiceClient{cc}
+}
+
+func (c *assignmentServiceClient) CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*CreateAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAssignmentResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_CreateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) DetailAssignment(ctx context.Context, in *DetailAssignmentRequest, opts ...grpc.CallOption) (*DetailAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailAssignmentResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_DetailAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) EditAssignment(ctx context.Context, in *EditAssignmentRequest, opts ...grpc.CallOption) (*EditAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditAssignmentResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_EditAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*DeleteAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssignmentResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_DeleteAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) SubmitAssignment(ctx context.Context, in *SubmitAssignmentRequest, opts ...grpc.CallOption) (*SubmitAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitAssignmentResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_SubmitAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListAssignmentSubmissions(ctx context.Context, in *ListAssignmentSubmissionsRequest, opts ...grpc.CallOption) (*ListAssignmentSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentSubmissionsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListAssignmentSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) DetailAssignmentSubmission(ctx context.Context, in *DetailAssignmentSubmissionRequest, opts ...grpc.CallOption) (*DetailAssignmentSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailAssignmentSubmissionResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_DetailAssignmentSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GradeAssignmentSubmission(ctx context.Context, in *GradeAssignmentSubmissionRequest, opts ...grpc.CallOption) (*GradeAssignmentSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeAssignmentSubmissionResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_GradeAssignmentSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignmentServiceServer is the server API for AssignmentService service.
+// All implementations must embed UnimplementedAssignmentServiceServer
+// for forward compatibility.
+type AssignmentServiceServer interface {
+	CreateAssignment(context.Context, *CreateAssignmentRequest) (*CreateAssignmentResponse, error)
+	DetailAssignment(context.Context, *DetailAssignmentRequest) (*DetailAssignmentResponse, error)
+	EditAssignment(context.Context, *EditAssignmentRequest) (*EditAssignmentResponse, error)
+	DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*DeleteAssignmentResponse, error)
+	SubmitAssignment(context.Context, *SubmitAssignmentRequest) (*SubmitAssignmentResponse, error)
+	ListAssignmentSubmissions(context.Context, *ListAssignmentSubmissionsRequest) (*ListAssignmentSubmissionsResponse, error)
+	DetailAssignmentSubmission(context.Context, *DetailAssignmentSubmissionRequest) (*DetailAssignmentSubmissionResponse, error)
+	GradeAssignmentSubmission(context.Context, *GradeAssignmentSubmissionRequest) (*GradeAssignmentSubmissionResponse, error)
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+// UnimplementedAssignmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssignmentServiceServer struct{}
+
+func (UnimplementedAssignmentServiceServer) CreateAssignment(context.Context, *CreateAssignmentRequest) (*CreateAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) DetailAssignment(context.Context, *DetailAssignmentRequest) (*DetailAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) EditAssignment(context.Context, *EditAssignmentRequest) (*EditAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*DeleteAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) SubmitAssignment(context.Context, *SubmitAssignmentRequest) (*SubmitAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListAssignmentSubmissions(context.Context, *ListAssignmentSubmissionsRequest) (*ListAssignmentSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentSubmissions not implemented")
+}
+func (UnimplementedAssignmentServiceServer) DetailAssignmentSubmission(context.Context, *DetailAssignmentSubmissionRequest) (*DetailAssignmentSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailAssignmentSubmission not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GradeAssignmentSubmission(context.Context, *GradeAssignmentSubmissionRequest) (*GradeAssignmentSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeAssignmentSubmission not implemented")
+}
+func (UnimplementedAssignmentServiceServer) mustEmbedUnimplementedAssignmentServiceServer() {}
+func (UnimplementedAssignmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignmentServiceServer will
+// result in compilation errors.
+type UnsafeAssignmentServiceServer interface {
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+func RegisterAssignmentServiceServer(s grpc.ServiceRegistrar, srv AssignmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAssignmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssignmentService_ServiceDesc, srv)
+}
+
+func _AssignmentService_CreateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_CreateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, req.(*CreateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_DetailAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetailAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).DetailAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_DetailAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).DetailAssignment(ctx, req.(*DetailAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_EditAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).EditAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_EditAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).EditAssignment(ctx, req.(*EditAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_DeleteAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_DeleteAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, req.(*DeleteAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_SubmitAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).SubmitAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_SubmitAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).SubmitAssignment(ctx, req.(*SubmitAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListAssignmentSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListAssignmentSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListAssignmentSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListAssignmentSubmissions(ctx, req.(*ListAssignmentSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_DetailAssignmentSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetailAssignmentSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).DetailAssignmentSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_DetailAssignmentSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).DetailAssignmentSubmission(ctx, req.(*DetailAssignmentSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GradeAssignmentSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeAssignmentSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GradeAssignmentSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GradeAssignmentSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GradeAssignmentSubmission(ctx, req.(*GradeAssignmentSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignmentService_ServiceDesc is the grpc.ServiceDesc for AssignmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "assignment.AssignmentService",
+	HandlerType: (*AssignmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAssignment",
+			Handler:    _AssignmentService_CreateAssignment_Handler,
+		},
+		{
+			MethodName: "DetailAssignment",
+			Handler:    _AssignmentService_DetailAssignment_Handler,
+		},
+		{
+			MethodName: "EditAssignment",
+			Handler:    _AssignmentService_EditAssignment_Handler,
+		},
+		{
+			MethodName: "DeleteAssignment",
+			Handler:    _AssignmentService_DeleteAssignment_Handler,
+		},
+		{
+			MethodName: "SubmitAssignment",
+			Handler:    _AssignmentService_SubmitAssignment_Handler,
+		},
+		{
+			MethodName: "ListAssignmentSubmissions",
+			Handler:    _AssignmentService_ListAssignmentSubmissions_Handler,
+		},
+		{
+			MethodName: "DetailAssignmentSubmission",
+			Handler:    _AssignmentService_DetailAssignmentSubmission_Handler,
+		},
+		{
+			MethodName: "GradeAssignmentSubmission",
+			Handler:    _AssignmentService_GradeAssignmentSubmission_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "assignment/assignment.proto",
+}
@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/catalog.proto
+
+package catalog
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	chapter_lesson "github.com/abu-umair/be-lms-go/pb/chapter_lesson"
+	common "github.com/abu-umair/be-lms-go/pb/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPublicCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicCourseRequest) Reset() {
+	*x = GetPublicCourseRequest{}
+	mi := &file_catalog_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicCourseRequest) ProtoMessage() {}
+
+func (x *GetPublicCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicCourseRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicCourseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPublicCourseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PublicLesson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OrderLesson   int64                  `protobuf:"varint,3,opt,name=order_lesson,json=orderLesson,proto3" json:"order_lesson,omitempty"`
+	Kind          *string                `protobuf:"bytes,4,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Duration      *string                `protobuf:"bytes,5,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicLesson) Reset() {
+	*x = PublicLesson{}
+	mi := &file_catalog_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicLesson) ProtoMessage() {}
+
+func (x *PublicLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicLesson.ProtoReflect.Descriptor instead.
+func (*PublicLesson) Descriptor() ([]byte, []int) {
+	return file_catalog_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PublicLesson) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicLesson) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PublicLesson) GetOrderLesson() int64 {
+	if x != nil {
+		return x.OrderLesson
+	}
+	return 0
+}
+
+func (x *PublicLesson) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *PublicLesson) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return ""
+}
+
+// PublicChapter: hanya berisi lesson preview, chapter tanpa lesson preview tetap ditampilkan (outline course)
+type PublicChapter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OrderChapter   int64                  `protobuf:"varint,3,opt,name=order_chapter,json=orderChapter,proto3" json:"order_chapter,omitempty"`
+	PreviewLessons []*PublicLesson        `protobuf:"bytes,4,rep,name=preview_lessons,json=previewLessons,proto3" json:"preview_lessons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PublicChapter) Reset() {
+	*x = PublicChapter{}
+	mi := &file_catalog_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicChapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicChapter) ProtoMessage() {}
+
+func (x *PublicChapter) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicChapter.ProtoReflect.Descriptor instead.
+func (*PublicChapter) Descriptor() ([]byte, []int) {
+	return file_catalog_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PublicChapter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicChapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PublicChapter) GetOrderChapter() int64 {
+	if x != nil {
+		return x.OrderChapter
+	}
+	return 0
+}
+
+func (x *PublicChapter) GetPreviewLessons() []*PublicLesson {
+	if x != nil {
+		return x.PreviewLessons
+	}
+	return nil
+}
+
+type GetPublicCourseResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Base             *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id               string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug             *string                `protobuf:"bytes,4,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	CategoryId       *string                `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	CourseType       *string                `protobuf:"bytes,6,opt,name=course_type,json=courseType,proto3,oneof" json:"course_type,omitempty"`
+	SeoDescription   *string                `protobuf:"bytes,7,opt,name=seo_description,json=seoDescription,proto3,oneof" json:"seo_description,omitempty"`
+	Duration         *string                `protobuf:"bytes,8,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	Thumbnail        *string                `protobuf:"bytes,9,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
+	ImageUrl         *string                `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	DemoVideoStorage *string                `protobuf:"bytes,11,opt,name=demo_video_storage,json=demoVideoStorage,proto3,oneof" json:"demo_video_storage,omitempty"`
+	DemoVideoSource  *string                `protobuf:"bytes,12,opt,name=demo_video_source,json=demoVideoSource,proto3,oneof" json:"demo_video_source,omitempty"`
+	Description      *string                `protobuf:"bytes,13,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price            *string                `protobuf:"bytes,14,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Discount         *string                `protobuf:"bytes,15,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	Certificate      *string                `protobuf:"bytes,16,opt,name=certificate,proto3,oneof" json:"certificate,omitempty"`
+	CourseLevelId    *string                `protobuf:"bytes,17,opt,name=course_level_id,json=courseLevelId,proto3,oneof" json:"course_level_id,omitempty"`
+	CourseLanguageId *string                `protobuf:"bytes,18,opt,name=course_language_id,json=courseLanguageId,proto3,oneof" json:"course_language_id,omitempty"`
+	Chapters         []*PublicChapter       `protobuf:"bytes,19,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetPublicCourseResponse) Reset() {
+	*x = GetPublicCourseResponse{}
+	mi := &file_catalog_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicCourseResponse) ProtoMessage() {}
+
+func (x *GetPublicCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicCourseResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPublicCourseResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetPublicCourseResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetCourseType() string {
+	if x != nil && x.CourseType != nil {
+		return *x.CourseType
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetSeoDescription() string {
+	if x != nil && x.SeoDescription != nil {
+		return *x.SeoDescription
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetThumbnail() string {
+	if x != nil && x.Thumbnail != nil {
+		return *x.Thumbnail
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetDemoVideoStorage() string {
+	if x != nil && x.DemoVideoStorage != nil {
+		return *x.DemoVideoStorage
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetDemoVideoSource() string {
+	if x != nil && x.DemoVideoSource != nil {
+		return *x.DemoVideoSource
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetPrice() string {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetDiscount() string {
+	if x != nil && x.Discount != nil {
+		return *x.Discount
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetCertificate() string {
+	if x != nil && x.Certificate != nil {
+		return *x.Certificate
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetCourseLevelId() string {
+	if x != nil && x.CourseLevelId != nil {
+		return *x.CourseLevelId
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetCourseLanguageId() string {
+	if x != nil && x.CourseLanguageId != nil {
+		return *x.CourseLanguageId
+	}
+	return ""
+}
+
+func (x *GetPublicCourseResponse) GetChapters() []*PublicChapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type GetPreviewLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreviewLessonRequest) Reset() {
+	*x = GetPreviewLessonRequest{}
+	mi := &file_catalog_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreviewLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreviewLessonRequest) ProtoMessage() {}
+
+func (x *GetPreviewLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreviewLessonRequest.ProtoReflect.Descriptor instead.
+func (*GetPreviewLessonRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPreviewLessonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPreviewLessonResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Base          *common.BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                        `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId     *string                       `protobuf:"bytes,4,opt,name=chapter_id,json=chapterId,proto3,oneof" json:"chapter_id,omitempty"`
+	Title         string                        `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	OrderLesson   int64                         `protobuf:"varint,6,opt,name=order_lesson,json=orderLesson,proto3" json:"order_lesson,omitempty"`
+	Slug          *string                       `protobuf:"bytes,7,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	Description   *string                       `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FilePath      *string                       `protobuf:"bytes,9,opt,name=file_path,json=filePath,proto3,oneof" json:"file_path,omitempty"` //? file upload: signed URL yang kadaluarsa
+	StorageLesson *string                       `protobuf:"bytes,10,opt,name=storage_lesson,json=storageLesson,proto3,oneof" json:"storage_lesson,omitempty"`
+	LessonType    *string                       `protobuf:"bytes,11,opt,name=lesson_type,json=lessonType,proto3,oneof" json:"lesson_type,omitempty"`
+	Duration      *string                       `protobuf:"bytes,12,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	FileType      *string                       `protobuf:"bytes,13,opt,name=file_type,json=fileType,proto3,oneof" json:"file_type,omitempty"`
+	Kind          *string                       `protobuf:"bytes,14,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Content       *chapter_lesson.LessonContent `protobuf:"bytes,15,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreviewLessonResponse) Reset() {
+	*x = GetPreviewLessonResponse{}
+	mi := &file_catalog_catalog_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreviewLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreviewLessonResponse) ProtoMessage() {}
+
+func (x *GetPreviewLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_catalog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreviewLessonResponse.ProtoReflect.Descriptor instead.
+func (*GetPreviewLessonResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_catalog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPreviewLessonResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetPreviewLessonResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetChapterId() string {
+	if x != nil && x.ChapterId != nil {
+		return *x.ChapterId
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetOrderLesson() int64 {
+	if x != nil {
+		return x.OrderLesson
+	}
+	return 0
+}
+
+func (x *GetPreviewLessonResponse) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetFilePath() string {
+	if x != nil && x.FilePath != nil {
+		return *x.FilePath
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetStorageLesson() string {
+	if x != nil && x.StorageLesson != nil {
+		return *x.StorageLesson
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetLessonType() string {
+	if x != nil && x.LessonType != nil {
+		return *x.LessonType
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetFileType() string {
+	if x != nil && x.FileType != nil {
+		return *x.FileType
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *GetPreviewLessonResponse) GetContent() *chapter_lesson.LessonContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_catalog_catalog_proto protoreflect.FileDescriptor
+
+const file_catalog_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\x15catalog/catalog.proto\x12\acatalog\x1a\x1acommon/base_response.proto\x1a#chapter_lesson/chapter_lesson.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"2\n" +
+	"\x16GetPublicCourseRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xa7\x01\n" +
+	"\fPublicLesson\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\forder_lesson\x18\x03 \x01(\x03R\vorderLesson\x12\x17\n" +
+	"\x04kind\x18\x04 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x05 \x01(\tH\x01R\bduration\x88\x01\x01B\a\n" +
+	"\x05_kindB\v\n" +
+	"\t_duration\"\x9a\x01\n" +
+	"\rPublicChapter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
+	"\rorder_chapter\x18\x03 \x01(\x03R\forderChapter\x12>\n" +
+	"\x0fpreview_lessons\x18\x04 \x03(\v2\x15.catalog.PublicLessonR\x0epreviewLessons\"\xd7\a\n" +
+	"\x17GetPublicCourseResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x17\n" +
+	"\x04slug\x18\x04 \x01(\tH\x00R\x04slug\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x05 \x01(\tH\x01R\n" +
+	"categoryId\x88\x01\x01\x12$\n" +
+	"\vcourse_type\x18\x06 \x01(\tH\x02R\n" +
+	"courseType\x88\x01\x01\x12,\n" +
+	"\x0fseo_description\x18\a \x01(\tH\x03R\x0eseoDescription\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\b \x01(\tH\x04R\bduration\x88\x01\x01\x12!\n" +
+	"\tthumbnail\x18\t \x01(\tH\x05R\tthumbnail\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\n" +
+	" \x01(\tH\x06R\bimageUrl\x88\x01\x01\x121\n" +
+	"\x12demo_video_storage\x18\v \x01(\tH\aR\x10demoVideoStorage\x88\x01\x01\x12/\n" +
+	"\x11demo_video_source\x18\f \x01(\tH\bR\x0fdemoVideoSource\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\r \x01(\tH\tR\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x0e \x01(\tH\n" +
+	"R\x05price\x88\x01\x01\x12\x1f\n" +
+	"\bdiscount\x18\x0f \x01(\tH\vR\bdiscount\x88\x01\x01\x12%\n" +
+	"\vcertificate\x18\x10 \x01(\tH\fR\vcertificate\x88\x01\x01\x12+\n" +
+	"\x0fcourse_level_id\x18\x11 \x01(\tH\rR\rcourseLevelId\x88\x01\x01\x121\n" +
+	"\x12course_language_id\x18\x12 \x01(\tH\x0eR\x10courseLanguageId\x88\x01\x01\x122\n" +
+	"\bchapters\x18\x13 \x03(\v2\x16.catalog.PublicChapterR\bchaptersB\a\n" +
+	"\x05_slugB\x0e\n" +
+	"\f_category_idB\x0e\n" +
+	"\f_course_typeB\x12\n" +
+	"\x10_seo_descriptionB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_thumbnailB\f\n" +
+	"\n" +
+	"_image_urlB\x15\n" +
+	"\x13_demo_video_storageB\x14\n" +
+	"\x12_demo_video_sourceB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_priceB\v\n" +
+	"\t_discountB\x0e\n" +
+	"\f_certificateB\x12\n" +
+	"\x10_course_level_idB\x15\n" +
+	"\x13_course_language_id\"3\n" +
+	"\x17GetPreviewLessonRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xa5\x05\n" +
+	"\x18GetPreviewLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\"\n" +
+	"\n" +
+	"chapter_id\x18\x04 \x01(\tH\x00R\tchapterId\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12!\n" +
+	"\forder_lesson\x18\x06 \x01(\x03R\vorderLesson\x12\x17\n" +
+	"\x04slug\x18\a \x01(\tH\x01R\x04slug\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\b \x01(\tH\x02R\vdescription\x88\x01\x01\x12 \n" +
+	"\tfile_path\x18\t \x01(\tH\x03R\bfilePath\x88\x01\x01\x12*\n" +
+	"\x0estorage_lesson\x18\n" +
+	" \x01(\tH\x04R\rstorageLesson\x88\x01\x01\x12$\n" +
+	"\vlesson_type\x18\v \x01(\tH\x05R\n" +
+	"lessonType\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\f \x01(\tH\x06R\bduration\x88\x01\x01\x12 \n" +
+	"\tfile_type\x18\r \x01(\tH\aR\bfileType\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x0e \x01(\tH\bR\x04kind\x88\x01\x01\x12<\n" +
+	"\acontent\x18\x0f \x01(\v2\x1d.chapter_lesson.LessonContentH\tR\acontent\x88\x01\x01B\r\n" +
+	"\v_chapter_idB\a\n" +
+	"\x05_slugB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_file_pathB\x11\n" +
+	"\x0f_storage_lessonB\x0e\n" +
+	"\f_lesson_typeB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_file_typeB\a\n" +
+	"\x05_kindB\n" +
+	"\n" +
+	"\b_content2\x81\x02\n" +
+	"\x0eCatalogService\x12u\n" +
+	"\x0fGetPublicCourse\x12\x1f.catalog.GetPublicCourseRequest\x1a .catalog.GetPublicCourseResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/public/courses/{id}\x12x\n" +
+	"\x10GetPreviewLesson\x12 .catalog.GetPreviewLessonRequest\x1a!.catalog.GetPreviewLessonResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/public/lessons/{id}B+Z)github.com/abu-umair/be-lms-go/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_catalog_proto_rawDescOnce sync.Once
+	file_catalog_catalog_proto_rawDescData []byte
+)
+
+func file_catalog_catalog_proto_rawDescGZIP() []byte {
+	file_catalog_catalog_proto_rawDescOnce.Do(func() {
+		file_catalog_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_catalog_proto_rawDesc), len(file_catalog_catalog_proto_rawDesc)))
+	})
+	return file_catalog_catalog_proto_rawDescData
+}
+
+var file_catalog_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_catalog_catalog_proto_goTypes = []any{
+	(*GetPublicCourseRequest)(nil),       // 0: catalog.GetPublicCourseRequest
+	(*PublicLesson)(nil),                 // 1: catalog.PublicLesson
+	(*PublicChapter)(nil),                // 2: catalog.PublicChapter
+	(*GetPublicCourseResponse)(nil),      // 3: catalog.GetPublicCourseResponse
+	(*GetPreviewLessonRequest)(nil),      // 4: catalog.GetPreviewLessonRequest
+	(*GetPreviewLessonResponse)(nil),     // 5: catalog.GetPreviewLessonResponse
+	(*common.BaseResponse)(nil),          // 6: common.BaseResponse
+	(*chapter_lesson.LessonContent)(nil), // 7: chapter_lesson.LessonContent
+}
+var file_catalog_catalog_proto_depIdxs = []int32{
+	1, // 0: catalog.PublicChapter.preview_lessons:type_name -> catalog.PublicLesson
+	6, // 1: catalog.GetPublicCourseResponse.base:type_name -> common.BaseResponse
+	2, // 2: catalog.GetPublicCourseResponse.chapters:type_name -> catalog.PublicChapter
+	6, // 3: catalog.GetPreviewLessonResponse.base:type_name -> common.BaseResponse
+	7, // 4: catalog.GetPreviewLessonResponse.content:type_name -> chapter_lesson.LessonContent
+	0, // 5: catalog.CatalogService.GetPublicCourse:input_type -> catalog.GetPublicCourseRequest
+	4, // 6: catalog.CatalogService.GetPreviewLesson:input_type -> catalog.GetPreviewLessonRequest
+	3, // 7: catalog.CatalogService.GetPublicCourse:output_type -> catalog.GetPublicCourseResponse
+	5, // 8: catalog.CatalogService.GetPreviewLesson:output_type -> catalog.GetPreviewLessonResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_catalog_catalog_proto_init() }
+func file_catalog_catalog_proto_init() {
+	if File_catalog_catalog_proto != nil {
+		return
+	}
+	file_catalog_catalog_proto_msgTypes[1].OneofWrappers = []any{}
+	file_catalog_catalog_proto_msgTypes[3].OneofWrappers = []any{}
+	file_catalog_catalog_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_catalog_proto_rawDesc), len(file_catalog_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_catalog_proto_goTypes,
+		DependencyIndexes: file_catalog_catalog_proto_depIdxs,
+		MessageInfos:      file_catalog_catalog_proto_msgTypes,
+	}.Build()
+	File_catalog_catalog_proto = out.File
+	file_catalog_catalog_proto_goTypes = nil
+	file_catalog_catalog_proto_depIdxs = nil
+}
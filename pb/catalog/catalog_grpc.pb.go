@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/catalog.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CatalogService_GetPublicCourse_FullMethodName  = "/catalog.CatalogService/GetPublicCourse"
+	CatalogService_GetPreviewLesson_FullMethodName = "/catalog.CatalogService/GetPreviewLesson"
+)
+
+// CatalogServiceClient is the client API for CatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CatalogService: katalog publik (tanpa token) utk halaman marketing, dibatasi rate limit per IP.
+// Hanya course yang published & approved, dan hanya lesson preview yang isinya dikirim.
+type CatalogServiceClient interface {
+	GetPublicCourse(ctx context.Context, in *GetPublicCourseRequest, opts ...grpc.CallOption) (*GetPublicCourseResponse, error)
+	GetPreviewLesson(ctx context.Context, in *GetPreviewLessonRequest, opts ...grpc.CallOption) (*GetPreviewLessonResponse, error)
+}
+
+type catalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogServiceClient(cc grpc.ClientConnInterface) CatalogServiceClient {
+	return &catalogServiceClient{cc}
+}
+
+func (c *catalogServiceClient) GetPublicCourse(ctx context.Context, in *GetPublicCourseRequest, opts ...grpc.CallOption) (*GetPublicCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicCourseResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetPublicCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetPreviewLesson(ctx context.Context, in *GetPreviewLessonRequest, opts ...grpc.CallOption) (*GetPreviewLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreviewLessonResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetPreviewLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServiceServer is the server API for CatalogService service.
+// All implementations must embed UnimplementedCatalogServiceServer
+// for forward compatibility.
+//
+// CatalogService: katalog publik (tanpa token) utk halaman marketing, dibatasi rate limit per IP.
+// Hanya course yang published & approved, dan hanya lesson preview yang isinya dikirim.
+type CatalogServiceServer interface {
+	GetPublicCourse(context.Context, *GetPublicCourseRequest) (*GetPublicCourseResponse, error)
+	GetPreviewLesson(context.Context, *GetPreviewLessonRequest) (*GetPreviewLessonResponse, error)
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+// UnimplementedCatalogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogServiceServer struct{}
+
+func (UnimplementedCatalogServiceServer) GetPublicCourse(context.Context, *GetPublicCourseRequest) (*GetPublicCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicCourse not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetPreviewLesson(context.Context, *GetPreviewLessonRequest) (*GetPreviewLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreviewLesson not implemented")
+}
+func (UnimplementedCatalogServiceServer) mustEmbedUnimplementedCatalogServiceServer() {}
+func (UnimplementedCatalogServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogServiceServer will
+// result in compilation errors.
+type UnsafeCatalogServiceServer interface {
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+func RegisterCatalogServiceServer(s grpc.ServiceRegistrar, srv CatalogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CatalogService_ServiceDesc, srv)
+}
+
+func _CatalogService_GetPublicCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetPublicCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetPublicCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetPublicCourse(ctx, req.(*GetPublicCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetPreviewLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreviewLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetPreviewLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetPreviewLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetPreviewLesson(ctx, req.(*GetPreviewLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.CatalogService",
+	HandlerType: (*CatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPublicCourse",
+			Handler:    _CatalogService_GetPublicCourse_Handler,
+		},
+		{
+			MethodName: "GetPreviewLesson",
+			Handler:    _CatalogService_GetPreviewLesson_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/catalog.proto",
+}
@@ -9,9 +9,11 @@ package chapter_lesson
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	common "github.com/abu-umair/be-lms-go/pb/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -42,6 +44,8 @@ type CreateChapterLessonRequest struct {
 	Downloadable  *string                `protobuf:"bytes,14,opt,name=downloadable,proto3,oneof" json:"downloadable,omitempty"`
 	IsPreview     *int64                 `protobuf:"varint,15,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty"`
 	Status        *string                `protobuf:"bytes,16,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	//? jika diisi, lesson_type, file_path, storage_lesson, duration, file_type & volume diturunkan dari content (tidak boleh dikirim)
+	Content       *LessonContent `protobuf:"bytes,17,opt,name=content,proto3,oneof" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -188,6 +192,13 @@ func (x *CreateChapterLessonRequest) GetStatus() string {
 	return ""
 }
 
+func (x *CreateChapterLessonRequest) GetContent() *LessonContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
 type CreateChapterLessonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -318,6 +329,10 @@ type DetailChapterLessonResponse struct {
 	UpdatedAt     *string                `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
 	UpdatedBy     *string                `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
 	DeletedAt     *string                `protobuf:"bytes,24,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	Kind          *string                `protobuf:"bytes,25,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Content       *LessonContent         `protobuf:"bytes,26,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	//? lesson terkunci: content & file tidak dikirim
+	Release       *common.ReleaseStatus `protobuf:"bytes,27,opt,name=release,proto3" json:"release,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -520,6 +535,27 @@ func (x *DetailChapterLessonResponse) GetDeletedAt() string {
 	return ""
 }
 
+func (x *DetailChapterLessonResponse) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *DetailChapterLessonResponse) GetContent() *LessonContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DetailChapterLessonResponse) GetRelease() *common.ReleaseStatus {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
 type EditChapterLessonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -539,6 +575,8 @@ type EditChapterLessonRequest struct {
 	IsPreview     *int64                 `protobuf:"varint,15,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty"`
 	Status        *string                `protobuf:"bytes,16,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	InstructorId  *string                `protobuf:"bytes,17,opt,name=instructor_id,json=instructorId,proto3,oneof" json:"instructor_id,omitempty"`
+	//? Edit mengganti seluruh lesson: tanpa content, lesson kembali menjadi lesson tanpa jenis (field lama dipakai apa adanya)
+	Content       *LessonContent `protobuf:"bytes,18,opt,name=content,proto3,oneof" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -692,6 +730,13 @@ func (x *EditChapterLessonRequest) GetInstructorId() string {
 	return ""
 }
 
+func (x *EditChapterLessonRequest) GetContent() *LessonContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
 type EditChapterLessonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -832,184 +877,2575 @@ func (x *DeleteChapterLessonResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
-var File_chapter_lesson_chapter_lesson_proto protoreflect.FileDescriptor
+// RestoreChapterLessonRequest: hanya lesson yang dihapus langsung (bukan ikut terhapus bersama chapter / course-nya) yang bisa di-restore.
+// Jika posisinya sudah dipakai lesson lain, lesson dipindah ke urutan terakhir.
+type RestoreChapterLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_chapter_lesson_chapter_lesson_proto_rawDesc = "" +
-	"\n" +
-	"#chapter_lesson/chapter_lesson.proto\x12\x0echapter_lesson\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\"\xb3\a\n" +
-	"\x1aCreateChapterLessonRequest\x124\n" +
-	"\rinstructor_id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\finstructorId\x88\x01\x01\x12,\n" +
-	"\tcourse_id\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x01R\bcourseId\x88\x01\x01\x12.\n" +
-	"\n" +
-	"chapter_id\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x02R\tchapterId\x88\x01\x01\x12 \n" +
-	"\x05title\x18\x04 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12*\n" +
-	"\forder_lesson\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vorderLesson\x12!\n" +
-	"\x04slug\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\x04slug\x88\x01\x01\x12/\n" +
-	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\vdescription\x88\x01\x01\x12*\n" +
-	"\tfile_path\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x05R\bfilePath\x88\x01\x01\x124\n" +
-	"\x0estorage_lesson\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x06R\rstorageLesson\x88\x01\x01\x12.\n" +
-	"\vlesson_type\x18\n" +
-	" \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\aR\n" +
-	"lessonType\x88\x01\x01\x12%\n" +
-	"\x06volume\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\bR\x06volume\x88\x01\x01\x12)\n" +
-	"\bduration\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\tR\bduration\x88\x01\x01\x12*\n" +
-	"\tfile_type\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\n" +
-	"R\bfileType\x88\x01\x01\x121\n" +
-	"\fdownloadable\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\vR\fdownloadable\x88\x01\x01\x12+\n" +
-	"\n" +
-	"is_preview\x18\x0f \x01(\x03B\a\xbaH\x04\"\x02(\x00H\fR\tisPreview\x88\x01\x01\x12%\n" +
-	"\x06status\x18\x10 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\rR\x06status\x88\x01\x01B\x10\n" +
-	"\x0e_instructor_idB\f\n" +
-	"\n" +
-	"_course_idB\r\n" +
-	"\v_chapter_idB\a\n" +
-	"\x05_slugB\x0e\n" +
-	"\f_descriptionB\f\n" +
-	"\n" +
-	"_file_pathB\x11\n" +
-	"\x0f_storage_lessonB\x0e\n" +
-	"\f_lesson_typeB\t\n" +
-	"\a_volumeB\v\n" +
-	"\t_durationB\f\n" +
-	"\n" +
-	"_file_typeB\x0f\n" +
-	"\r_downloadableB\r\n" +
-	"\v_is_previewB\t\n" +
-	"\a_status\"W\n" +
-	"\x1bCreateChapterLessonResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"s\n" +
-	"\x1aDetailChapterLessonRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x129\n" +
-	"\n" +
-	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xa0\n" +
-	"\n" +
-	"\x1bDetailChapterLessonResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\x12.\n" +
-	"\n" +
-	"chapter_id\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\tchapterId\x88\x01\x01\x12 \n" +
-	"\x05title\x18\x04 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12*\n" +
-	"\forder_lesson\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vorderLesson\x12!\n" +
-	"\x04slug\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x01R\x04slug\x88\x01\x01\x12/\n" +
-	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\vdescription\x88\x01\x01\x12*\n" +
-	"\tfile_path\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\bfilePath\x88\x01\x01\x124\n" +
-	"\x0estorage_lesson\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\rstorageLesson\x88\x01\x01\x12.\n" +
-	"\vlesson_type\x18\n" +
-	" \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x05R\n" +
-	"lessonType\x88\x01\x01\x12%\n" +
-	"\x06volume\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x06R\x06volume\x88\x01\x01\x12)\n" +
-	"\bduration\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\aR\bduration\x88\x01\x01\x12*\n" +
-	"\tfile_type\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\bR\bfileType\x88\x01\x01\x121\n" +
-	"\fdownloadable\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\tR\fdownloadable\x88\x01\x01\x12+\n" +
-	"\n" +
-	"is_preview\x18\x0f \x01(\x03B\a\xbaH\x04\"\x02(\x00H\n" +
-	"R\tisPreview\x88\x01\x01\x12%\n" +
-	"\x06status\x18\x10 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\vR\x06status\x88\x01\x01\x124\n" +
-	"\rinstructor_id\x18\x11 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\fR\finstructorId\x88\x01\x01\x12,\n" +
-	"\tcourse_id\x18\x12 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\rR\bcourseId\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"created_at\x18\x13 \x01(\tH\x0eR\tcreatedAt\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"created_by\x18\x14 \x01(\tH\x0fR\tcreatedBy\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"deleted_by\x18\x15 \x01(\tH\x10R\tdeletedBy\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"updated_at\x18\x16 \x01(\tH\x11R\tupdatedAt\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"updated_by\x18\x17 \x01(\tH\x12R\tupdatedBy\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"deleted_at\x18\x18 \x01(\tH\x13R\tdeletedAt\x88\x01\x01B\r\n" +
-	"\v_chapter_idB\a\n" +
-	"\x05_slugB\x0e\n" +
-	"\f_descriptionB\f\n" +
-	"\n" +
-	"_file_pathB\x11\n" +
-	"\x0f_storage_lessonB\x0e\n" +
-	"\f_lesson_typeB\t\n" +
-	"\a_volumeB\v\n" +
-	"\t_durationB\f\n" +
-	"\n" +
-	"_file_typeB\x0f\n" +
-	"\r_downloadableB\r\n" +
-	"\v_is_previewB\t\n" +
-	"\a_statusB\x10\n" +
-	"\x0e_instructor_idB\f\n" +
-	"\n" +
-	"_course_idB\r\n" +
-	"\v_created_atB\r\n" +
-	"\v_created_byB\r\n" +
-	"\v_deleted_byB\r\n" +
-	"\v_updated_atB\r\n" +
-	"\v_updated_byB\r\n" +
-	"\v_deleted_at\"\xcd\a\n" +
-	"\x18EditChapterLessonRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12,\n" +
-	"\tcourse_id\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\bcourseId\x88\x01\x01\x12.\n" +
-	"\n" +
-	"chapter_id\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x01R\tchapterId\x88\x01\x01\x12 \n" +
-	"\x05title\x18\x04 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12*\n" +
-	"\forder_lesson\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vorderLesson\x12!\n" +
-	"\x04slug\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\x04slug\x88\x01\x01\x12/\n" +
-	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\vdescription\x88\x01\x01\x12*\n" +
-	"\tfile_path\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\bfilePath\x88\x01\x01\x124\n" +
-	"\x0estorage_lesson\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x05R\rstorageLesson\x88\x01\x01\x12.\n" +
-	"\vlesson_type\x18\n" +
-	" \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x06R\n" +
-	"lessonType\x88\x01\x01\x12%\n" +
-	"\x06volume\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\aR\x06volume\x88\x01\x01\x12)\n" +
-	"\bduration\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\bR\bduration\x88\x01\x01\x12*\n" +
-	"\tfile_type\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\tR\bfileType\x88\x01\x01\x121\n" +
-	"\fdownloadable\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\n" +
-	"R\fdownloadable\x88\x01\x01\x12+\n" +
-	"\n" +
-	"is_preview\x18\x0f \x01(\x03B\a\xbaH\x04\"\x02(\x00H\vR\tisPreview\x88\x01\x01\x12%\n" +
-	"\x06status\x18\x10 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\fR\x06status\x88\x01\x01\x124\n" +
-	"\rinstructor_id\x18\x11 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\rR\finstructorId\x88\x01\x01B\f\n" +
-	"\n" +
-	"_course_idB\r\n" +
-	"\v_chapter_idB\a\n" +
-	"\x05_slugB\x0e\n" +
-	"\f_descriptionB\f\n" +
-	"\n" +
-	"_file_pathB\x11\n" +
-	"\x0f_storage_lessonB\x0e\n" +
-	"\f_lesson_typeB\t\n" +
-	"\a_volumeB\v\n" +
-	"\t_durationB\f\n" +
-	"\n" +
-	"_file_typeB\x0f\n" +
-	"\r_downloadableB\r\n" +
-	"\v_is_previewB\t\n" +
-	"\a_statusB\x10\n" +
-	"\x0e_instructor_id\"U\n" +
-	"\x19EditChapterLessonResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"8\n" +
-	"\x1aDeleteChapterLessonRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"G\n" +
-	"\x1bDeleteChapterLessonResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xd0\x03\n" +
-	"\x14ChapterLessonService\x12n\n" +
-	"\x13CreateChapterLesson\x12*.chapter_lesson.CreateChapterLessonRequest\x1a+.chapter_lesson.CreateChapterLessonResponse\x12n\n" +
-	"\x13DetailChapterLesson\x12*.chapter_lesson.DetailChapterLessonRequest\x1a+.chapter_lesson.DetailChapterLessonResponse\x12h\n" +
-	"\x11EditChapterLesson\x12(.chapter_lesson.EditChapterLessonRequest\x1a).chapter_lesson.EditChapterLessonResponse\x12n\n" +
-	"\x13DeleteChapterLesson\x12*.chapter_lesson.DeleteChapterLessonRequest\x1a+.chapter_lesson.DeleteChapterLessonResponseB2Z0github.com/abu-umair/be-lms-go/pb/chapter_lessonb\x06proto3"
+func (x *RestoreChapterLessonRequest) Reset() {
+	*x = RestoreChapterLessonRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreChapterLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreChapterLessonRequest) ProtoMessage() {}
+
+func (x *RestoreChapterLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreChapterLessonRequest.ProtoReflect.Descriptor instead.
+func (*RestoreChapterLessonRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreChapterLessonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreChapterLessonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreChapterLessonResponse) Reset() {
+	*x = RestoreChapterLessonResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreChapterLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreChapterLessonResponse) ProtoMessage() {}
+
+func (x *RestoreChapterLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreChapterLessonResponse.ProtoReflect.Descriptor instead.
+func (*RestoreChapterLessonResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreChapterLessonResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// ReorderLessonsRequest: ordered_ids wajib berisi semua lesson (yang belum dihapus) milik chapter tsb, urutan baru = posisi di list (mulai 1)
+type ReorderLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChapterId     string                 `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	OrderedIds    []string               `protobuf:"bytes,2,rep,name=ordered_ids,json=orderedIds,proto3" json:"ordered_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderLessonsRequest) Reset() {
+	*x = ReorderLessonsRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLessonsRequest) ProtoMessage() {}
+
+func (x *ReorderLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReorderLessonsRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *ReorderLessonsRequest) GetOrderedIds() []string {
+	if x != nil {
+		return x.OrderedIds
+	}
+	return nil
+}
+
+type ReorderLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderLessonsResponse) Reset() {
+	*x = ReorderLessonsResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLessonsResponse) ProtoMessage() {}
+
+func (x *ReorderLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReorderLessonsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// MoveLessonRequest: course tujuan = course milik target_chapter_id (target_course_id opsional, hanya dicek harus sama).
+// position kosong = taruh paling akhir, chapter asal & tujuan dinomori ulang 1..n
+type MoveLessonRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetChapterId string                 `protobuf:"bytes,2,opt,name=target_chapter_id,json=targetChapterId,proto3" json:"target_chapter_id,omitempty"`
+	TargetCourseId  *string                `protobuf:"bytes,3,opt,name=target_course_id,json=targetCourseId,proto3,oneof" json:"target_course_id,omitempty"`
+	Position        *int64                 `protobuf:"varint,4,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveLessonRequest) Reset() {
+	*x = MoveLessonRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLessonRequest) ProtoMessage() {}
+
+func (x *MoveLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLessonRequest.ProtoReflect.Descriptor instead.
+func (*MoveLessonRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveLessonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveLessonRequest) GetTargetChapterId() string {
+	if x != nil {
+		return x.TargetChapterId
+	}
+	return ""
+}
+
+func (x *MoveLessonRequest) GetTargetCourseId() string {
+	if x != nil && x.TargetCourseId != nil {
+		return *x.TargetCourseId
+	}
+	return ""
+}
+
+func (x *MoveLessonRequest) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type MoveLessonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId     string                 `protobuf:"bytes,4,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	OrderLesson   int64                  `protobuf:"varint,5,opt,name=order_lesson,json=orderLesson,proto3" json:"order_lesson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveLessonResponse) Reset() {
+	*x = MoveLessonResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLessonResponse) ProtoMessage() {}
+
+func (x *MoveLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLessonResponse.ProtoReflect.Descriptor instead.
+func (*MoveLessonResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MoveLessonResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MoveLessonResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveLessonResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *MoveLessonResponse) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *MoveLessonResponse) GetOrderLesson() int64 {
+	if x != nil {
+		return x.OrderLesson
+	}
+	return 0
+}
+
+// CopyLessonRequest: lesson (beserta file upload-nya) diduplikasi ke target_chapter_id, aturan sama dgn MoveLessonRequest
+type CopyLessonRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetChapterId string                 `protobuf:"bytes,2,opt,name=target_chapter_id,json=targetChapterId,proto3" json:"target_chapter_id,omitempty"`
+	TargetCourseId  *string                `protobuf:"bytes,3,opt,name=target_course_id,json=targetCourseId,proto3,oneof" json:"target_course_id,omitempty"`
+	Position        *int64                 `protobuf:"varint,4,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CopyLessonRequest) Reset() {
+	*x = CopyLessonRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyLessonRequest) ProtoMessage() {}
+
+func (x *CopyLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyLessonRequest.ProtoReflect.Descriptor instead.
+func (*CopyLessonRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CopyLessonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CopyLessonRequest) GetTargetChapterId() string {
+	if x != nil {
+		return x.TargetChapterId
+	}
+	return ""
+}
+
+func (x *CopyLessonRequest) GetTargetCourseId() string {
+	if x != nil && x.TargetCourseId != nil {
+		return *x.TargetCourseId
+	}
+	return ""
+}
+
+func (x *CopyLessonRequest) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type CopyLessonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId     string                 `protobuf:"bytes,4,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	OrderLesson   int64                  `protobuf:"varint,5,opt,name=order_lesson,json=orderLesson,proto3" json:"order_lesson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyLessonResponse) Reset() {
+	*x = CopyLessonResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyLessonResponse) ProtoMessage() {}
+
+func (x *CopyLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyLessonResponse.ProtoReflect.Descriptor instead.
+func (*CopyLessonResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CopyLessonResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CopyLessonResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CopyLessonResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CopyLessonResponse) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *CopyLessonResponse) GetOrderLesson() int64 {
+	if x != nil {
+		return x.OrderLesson
+	}
+	return 0
+}
+
+// ListChapterLessonsRequest: status kosong = semua status, field_mask sama dgn DetailChapterLessonRequest
+type ListChapterLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChapterId     string                 `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChapterLessonsRequest) Reset() {
+	*x = ListChapterLessonsRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChapterLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChapterLessonsRequest) ProtoMessage() {}
+
+func (x *ListChapterLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChapterLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ListChapterLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListChapterLessonsRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *ListChapterLessonsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListChapterLessonsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// ChapterLessonItem: field sama dgn DetailChapterLessonResponse, file_path berisi nama file asli (utk editor instructor)
+type ChapterLessonItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChapterId     *string                `protobuf:"bytes,2,opt,name=chapter_id,json=chapterId,proto3,oneof" json:"chapter_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	OrderLesson   int64                  `protobuf:"varint,4,opt,name=order_lesson,json=orderLesson,proto3" json:"order_lesson,omitempty"`
+	Slug          *string                `protobuf:"bytes,5,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FilePath      *string                `protobuf:"bytes,7,opt,name=file_path,json=filePath,proto3,oneof" json:"file_path,omitempty"`
+	StorageLesson *string                `protobuf:"bytes,8,opt,name=storage_lesson,json=storageLesson,proto3,oneof" json:"storage_lesson,omitempty"`
+	LessonType    *string                `protobuf:"bytes,9,opt,name=lesson_type,json=lessonType,proto3,oneof" json:"lesson_type,omitempty"`
+	Volume        *string                `protobuf:"bytes,10,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	Duration      *string                `protobuf:"bytes,11,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	FileType      *string                `protobuf:"bytes,12,opt,name=file_type,json=fileType,proto3,oneof" json:"file_type,omitempty"`
+	Downloadable  *string                `protobuf:"bytes,13,opt,name=downloadable,proto3,oneof" json:"downloadable,omitempty"`
+	IsPreview     *int64                 `protobuf:"varint,14,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty"`
+	Status        *string                `protobuf:"bytes,15,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	InstructorId  *string                `protobuf:"bytes,16,opt,name=instructor_id,json=instructorId,proto3,oneof" json:"instructor_id,omitempty"`
+	CourseId      *string                `protobuf:"bytes,17,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	CreatedAt     *string                `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	CreatedBy     *string                `protobuf:"bytes,19,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	DeletedBy     *string                `protobuf:"bytes,20,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`
+	UpdatedAt     *string                `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	UpdatedBy     *string                `protobuf:"bytes,22,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	DeletedAt     *string                `protobuf:"bytes,23,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChapterLessonItem) Reset() {
+	*x = ChapterLessonItem{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChapterLessonItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChapterLessonItem) ProtoMessage() {}
+
+func (x *ChapterLessonItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChapterLessonItem.ProtoReflect.Descriptor instead.
+func (*ChapterLessonItem) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChapterLessonItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetChapterId() string {
+	if x != nil && x.ChapterId != nil {
+		return *x.ChapterId
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetOrderLesson() int64 {
+	if x != nil {
+		return x.OrderLesson
+	}
+	return 0
+}
+
+func (x *ChapterLessonItem) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetFilePath() string {
+	if x != nil && x.FilePath != nil {
+		return *x.FilePath
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetStorageLesson() string {
+	if x != nil && x.StorageLesson != nil {
+		return *x.StorageLesson
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetLessonType() string {
+	if x != nil && x.LessonType != nil {
+		return *x.LessonType
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetVolume() string {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetFileType() string {
+	if x != nil && x.FileType != nil {
+		return *x.FileType
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetDownloadable() string {
+	if x != nil && x.Downloadable != nil {
+		return *x.Downloadable
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetIsPreview() int64 {
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
+	}
+	return 0
+}
+
+func (x *ChapterLessonItem) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetInstructorId() string {
+	if x != nil && x.InstructorId != nil {
+		return *x.InstructorId
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetCreatedAt() string {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetDeletedBy() string {
+	if x != nil && x.DeletedBy != nil {
+		return *x.DeletedBy
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetUpdatedAt() string {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetUpdatedBy() string {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ChapterLessonItem) GetDeletedAt() string {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return ""
+}
+
+type ListChapterLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*ChapterLessonItem   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChapterLessonsResponse) Reset() {
+	*x = ListChapterLessonsResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChapterLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChapterLessonsResponse) ProtoMessage() {}
+
+func (x *ListChapterLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChapterLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ListChapterLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListChapterLessonsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListChapterLessonsResponse) GetItems() []*ChapterLessonItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// SetLessonReleaseRuleRequest: rule kosong = hapus aturan rilis lesson
+type SetLessonReleaseRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule          *common.ReleaseRule    `protobuf:"bytes,2,opt,name=rule,proto3,oneof" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLessonReleaseRuleRequest) Reset() {
+	*x = SetLessonReleaseRuleRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLessonReleaseRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLessonReleaseRuleRequest) ProtoMessage() {}
+
+func (x *SetLessonReleaseRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLessonReleaseRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetLessonReleaseRuleRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetLessonReleaseRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetLessonReleaseRuleRequest) GetRule() *common.ReleaseRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type SetLessonReleaseRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLessonReleaseRuleResponse) Reset() {
+	*x = SetLessonReleaseRuleResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLessonReleaseRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLessonReleaseRuleResponse) ProtoMessage() {}
+
+func (x *SetLessonReleaseRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLessonReleaseRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetLessonReleaseRuleResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetLessonReleaseRuleResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// CompleteLessonRequest: learner menandai lesson selesai (lesson quiz selesai otomatis saat quiz lulus)
+type CompleteLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteLessonRequest) Reset() {
+	*x = CompleteLessonRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteLessonRequest) ProtoMessage() {}
+
+func (x *CompleteLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteLessonRequest.ProtoReflect.Descriptor instead.
+func (*CompleteLessonRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CompleteLessonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompleteLessonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,2,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteLessonResponse) Reset() {
+	*x = CompleteLessonResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteLessonResponse) ProtoMessage() {}
+
+func (x *CompleteLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteLessonResponse.ProtoReflect.Descriptor instead.
+func (*CompleteLessonResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CompleteLessonResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CompleteLessonResponse) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type GetCourseCurriculumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseCurriculumRequest) Reset() {
+	*x = GetCourseCurriculumRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseCurriculumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseCurriculumRequest) ProtoMessage() {}
+
+func (x *GetCourseCurriculumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseCurriculumRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseCurriculumRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCourseCurriculumRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// CurriculumLesson: ringkasan lesson aktif, release_rule hanya dikirim ke instructor pemilik course / admin
+type CurriculumLesson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OrderLesson   int64                  `protobuf:"varint,3,opt,name=order_lesson,json=orderLesson,proto3" json:"order_lesson,omitempty"`
+	Kind          *string                `protobuf:"bytes,4,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Duration      *string                `protobuf:"bytes,5,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	IsPreview     bool                   `protobuf:"varint,6,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty"`
+	Completed     bool                   `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	Release       *common.ReleaseStatus  `protobuf:"bytes,8,opt,name=release,proto3" json:"release,omitempty"`
+	ReleaseRule   *common.ReleaseRule    `protobuf:"bytes,9,opt,name=release_rule,json=releaseRule,proto3,oneof" json:"release_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurriculumLesson) Reset() {
+	*x = CurriculumLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurriculumLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurriculumLesson) ProtoMessage() {}
+
+func (x *CurriculumLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurriculumLesson.ProtoReflect.Descriptor instead.
+func (*CurriculumLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CurriculumLesson) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CurriculumLesson) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CurriculumLesson) GetOrderLesson() int64 {
+	if x != nil {
+		return x.OrderLesson
+	}
+	return 0
+}
+
+func (x *CurriculumLesson) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *CurriculumLesson) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return ""
+}
+
+func (x *CurriculumLesson) GetIsPreview() bool {
+	if x != nil {
+		return x.IsPreview
+	}
+	return false
+}
+
+func (x *CurriculumLesson) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *CurriculumLesson) GetRelease() *common.ReleaseStatus {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *CurriculumLesson) GetReleaseRule() *common.ReleaseRule {
+	if x != nil {
+		return x.ReleaseRule
+	}
+	return nil
+}
+
+type CurriculumChapter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OrderChapter  int64                  `protobuf:"varint,3,opt,name=order_chapter,json=orderChapter,proto3" json:"order_chapter,omitempty"`
+	Release       *common.ReleaseStatus  `protobuf:"bytes,4,opt,name=release,proto3" json:"release,omitempty"`
+	ReleaseRule   *common.ReleaseRule    `protobuf:"bytes,5,opt,name=release_rule,json=releaseRule,proto3,oneof" json:"release_rule,omitempty"`
+	Lessons       []*CurriculumLesson    `protobuf:"bytes,6,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurriculumChapter) Reset() {
+	*x = CurriculumChapter{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurriculumChapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurriculumChapter) ProtoMessage() {}
+
+func (x *CurriculumChapter) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurriculumChapter.ProtoReflect.Descriptor instead.
+func (*CurriculumChapter) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CurriculumChapter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CurriculumChapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CurriculumChapter) GetOrderChapter() int64 {
+	if x != nil {
+		return x.OrderChapter
+	}
+	return 0
+}
+
+func (x *CurriculumChapter) GetRelease() *common.ReleaseStatus {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *CurriculumChapter) GetReleaseRule() *common.ReleaseRule {
+	if x != nil {
+		return x.ReleaseRule
+	}
+	return nil
+}
+
+func (x *CurriculumChapter) GetLessons() []*CurriculumLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+type GetCourseCurriculumResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Chapters      []*CurriculumChapter   `protobuf:"bytes,2,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseCurriculumResponse) Reset() {
+	*x = GetCourseCurriculumResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseCurriculumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseCurriculumResponse) ProtoMessage() {}
+
+func (x *GetCourseCurriculumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseCurriculumResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseCurriculumResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCourseCurriculumResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetCourseCurriculumResponse) GetChapters() []*CurriculumChapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type SearchLessonTranscriptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Language      *string                `protobuf:"bytes,3,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	Limit         *int64                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"` //? default 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLessonTranscriptsRequest) Reset() {
+	*x = SearchLessonTranscriptsRequest{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLessonTranscriptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLessonTranscriptsRequest) ProtoMessage() {}
+
+func (x *SearchLessonTranscriptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLessonTranscriptsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLessonTranscriptsRequest) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchLessonTranscriptsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *SearchLessonTranscriptsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchLessonTranscriptsRequest) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *SearchLessonTranscriptsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// TranscriptHit: satu cue caption yang cocok, start_ms dipakai player utk lompat ke posisi tsb
+type TranscriptHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	LessonTitle   string                 `protobuf:"bytes,2,opt,name=lesson_title,json=lessonTitle,proto3" json:"lesson_title,omitempty"`
+	ChapterId     *string                `protobuf:"bytes,3,opt,name=chapter_id,json=chapterId,proto3,oneof" json:"chapter_id,omitempty"`
+	Language      string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	StartMs       int64                  `protobuf:"varint,5,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"`
+	EndMs         int64                  `protobuf:"varint,6,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`
+	Text          string                 `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptHit) Reset() {
+	*x = TranscriptHit{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptHit) ProtoMessage() {}
+
+func (x *TranscriptHit) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptHit.ProtoReflect.Descriptor instead.
+func (*TranscriptHit) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TranscriptHit) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *TranscriptHit) GetLessonTitle() string {
+	if x != nil {
+		return x.LessonTitle
+	}
+	return ""
+}
+
+func (x *TranscriptHit) GetChapterId() string {
+	if x != nil && x.ChapterId != nil {
+		return *x.ChapterId
+	}
+	return ""
+}
+
+func (x *TranscriptHit) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *TranscriptHit) GetStartMs() int64 {
+	if x != nil {
+		return x.StartMs
+	}
+	return 0
+}
+
+func (x *TranscriptHit) GetEndMs() int64 {
+	if x != nil {
+		return x.EndMs
+	}
+	return 0
+}
+
+func (x *TranscriptHit) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SearchLessonTranscriptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Hits          []*TranscriptHit       `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLessonTranscriptsResponse) Reset() {
+	*x = SearchLessonTranscriptsResponse{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLessonTranscriptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLessonTranscriptsResponse) ProtoMessage() {}
+
+func (x *SearchLessonTranscriptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLessonTranscriptsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLessonTranscriptsResponse) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchLessonTranscriptsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SearchLessonTranscriptsResponse) GetHits() []*TranscriptHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// LessonContent: isi lesson sesuai jenisnya, disimpan sbg kind + content (jsonb)
+type LessonContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*LessonContent_Video
+	//	*LessonContent_Article
+	//	*LessonContent_File
+	//	*LessonContent_Link
+	//	*LessonContent_LiveSession
+	//	*LessonContent_Quiz
+	//	*LessonContent_Assignment
+	Kind          isLessonContent_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonContent) Reset() {
+	*x = LessonContent{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonContent) ProtoMessage() {}
+
+func (x *LessonContent) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonContent.ProtoReflect.Descriptor instead.
+func (*LessonContent) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LessonContent) GetKind() isLessonContent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *LessonContent) GetVideo() *VideoLesson {
+	if x != nil {
+		if x, ok := x.Kind.(*LessonContent_Video); ok {
+			return x.Video
+		}
+	}
+	return nil
+}
+
+func (x *LessonContent) GetArticle() *ArticleLesson {
+	if x != nil {
+		if x, ok := x.Kind.(*LessonContent_Article); ok {
+			return x.Article
+		}
+	}
+	return nil
+}
+
+func (x *LessonContent) GetFile() *FileLesson {
+	if x != nil {
+		if x, ok := x.Kind.(*LessonContent_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *LessonContent) GetLink() *LinkLesson {
+	if x != nil {
+		if x, ok := x.Kind.(*LessonContent_Link); ok {
+			return x.Link
+		}
+	}
+	return nil
+}
+
+func (x *LessonContent) GetLiveSession() *LiveSessionLesson {
+	if x != nil {
+		if x, ok := x.Kind.(*LessonContent_LiveSession); ok {
+			return x.LiveSession
+		}
+	}
+	return nil
+}
+
+func (x *LessonContent) GetQuiz() *QuizLesson {
+	if x != nil {
+		if x, ok := x.Kind.(*LessonContent_Quiz); ok {
+			return x.Quiz
+		}
+	}
+	return nil
+}
+
+func (x *LessonContent) GetAssignment() *AssignmentLesson {
+	if x != nil {
+		if x, ok := x.Kind.(*LessonContent_Assignment); ok {
+			return x.Assignment
+		}
+	}
+	return nil
+}
+
+type isLessonContent_Kind interface {
+	isLessonContent_Kind()
+}
+
+type LessonContent_Video struct {
+	Video *VideoLesson `protobuf:"bytes,1,opt,name=video,proto3,oneof"`
+}
+
+type LessonContent_Article struct {
+	Article *ArticleLesson `protobuf:"bytes,2,opt,name=article,proto3,oneof"`
+}
+
+type LessonContent_File struct {
+	File *FileLesson `protobuf:"bytes,3,opt,name=file,proto3,oneof"`
+}
+
+type LessonContent_Link struct {
+	Link *LinkLesson `protobuf:"bytes,4,opt,name=link,proto3,oneof"`
+}
+
+type LessonContent_LiveSession struct {
+	LiveSession *LiveSessionLesson `protobuf:"bytes,5,opt,name=live_session,json=liveSession,proto3,oneof"`
+}
+
+type LessonContent_Quiz struct {
+	Quiz *QuizLesson `protobuf:"bytes,6,opt,name=quiz,proto3,oneof"`
+}
+
+type LessonContent_Assignment struct {
+	Assignment *AssignmentLesson `protobuf:"bytes,7,opt,name=assignment,proto3,oneof"`
+}
+
+func (*LessonContent_Video) isLessonContent_Kind() {}
+
+func (*LessonContent_Article) isLessonContent_Kind() {}
+
+func (*LessonContent_File) isLessonContent_Kind() {}
+
+func (*LessonContent_Link) isLessonContent_Kind() {}
+
+func (*LessonContent_LiveSession) isLessonContent_Kind() {}
+
+func (*LessonContent_Quiz) isLessonContent_Kind() {}
+
+func (*LessonContent_Assignment) isLessonContent_Kind() {}
+
+// VideoLesson: file upload di storage/<course_id>/lesson atau url eksternal (youtube, vimeo, dll)
+type VideoLesson struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*VideoLesson_FileName
+	//	*VideoLesson_Url
+	Source          isVideoLesson_Source `protobuf_oneof:"source"`
+	DurationSeconds int64                `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Captions        []*VideoCaption      `protobuf:"bytes,4,rep,name=captions,proto3" json:"captions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VideoLesson) Reset() {
+	*x = VideoLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoLesson) ProtoMessage() {}
+
+func (x *VideoLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoLesson.ProtoReflect.Descriptor instead.
+func (*VideoLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VideoLesson) GetSource() isVideoLesson_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *VideoLesson) GetFileName() string {
+	if x != nil {
+		if x, ok := x.Source.(*VideoLesson_FileName); ok {
+			return x.FileName
+		}
+	}
+	return ""
+}
+
+func (x *VideoLesson) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*VideoLesson_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *VideoLesson) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *VideoLesson) GetCaptions() []*VideoCaption {
+	if x != nil {
+		return x.Captions
+	}
+	return nil
+}
+
+type isVideoLesson_Source interface {
+	isVideoLesson_Source()
+}
+
+type VideoLesson_FileName struct {
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3,oneof"`
+}
+
+type VideoLesson_Url struct {
+	Url string `protobuf:"bytes,2,opt,name=url,proto3,oneof"`
+}
+
+func (*VideoLesson_FileName) isVideoLesson_Source() {}
+
+func (*VideoLesson_Url) isVideoLesson_Source() {}
+
+// VideoCaption: file WebVTT hasil POST /lesson/caption/upload (SRT sudah dikonversi), satu track per bahasa
+type VideoCaption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"` //? hanya output: signed URL track dari DetailChapterLesson / GetPreviewLesson, diabaikan saat disimpan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoCaption) Reset() {
+	*x = VideoCaption{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoCaption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoCaption) ProtoMessage() {}
+
+func (x *VideoCaption) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoCaption.ProtoReflect.Descriptor instead.
+func (*VideoCaption) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VideoCaption) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *VideoCaption) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *VideoCaption) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *VideoCaption) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// ArticleLesson: body berupa rich text (HTML)
+type ArticleLesson struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Body               string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	ReadingTimeSeconds *int64                 `protobuf:"varint,2,opt,name=reading_time_seconds,json=readingTimeSeconds,proto3,oneof" json:"reading_time_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ArticleLesson) Reset() {
+	*x = ArticleLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleLesson) ProtoMessage() {}
+
+func (x *ArticleLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleLesson.ProtoReflect.Descriptor instead.
+func (*ArticleLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ArticleLesson) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ArticleLesson) GetReadingTimeSeconds() int64 {
+	if x != nil && x.ReadingTimeSeconds != nil {
+		return *x.ReadingTimeSeconds
+	}
+	return 0
+}
+
+// FileLesson: file yang bisa diunduh, selalu upload di storage/<course_id>/lesson
+type FileLesson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	DisplayName   *string                `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileLesson) Reset() {
+	*x = FileLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileLesson) ProtoMessage() {}
+
+func (x *FileLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileLesson.ProtoReflect.Descriptor instead.
+func (*FileLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FileLesson) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileLesson) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+type LinkLesson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	OpenInNewTab  bool                   `protobuf:"varint,2,opt,name=open_in_new_tab,json=openInNewTab,proto3" json:"open_in_new_tab,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkLesson) Reset() {
+	*x = LinkLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkLesson) ProtoMessage() {}
+
+func (x *LinkLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkLesson.ProtoReflect.Descriptor instead.
+func (*LinkLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LinkLesson) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkLesson) GetOpenInNewTab() bool {
+	if x != nil {
+		return x.OpenInNewTab
+	}
+	return false
+}
+
+type LiveSessionLesson struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JoinUrl         string                 `protobuf:"bytes,1,opt,name=join_url,json=joinUrl,proto3" json:"join_url,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Provider        *string                `protobuf:"bytes,4,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LiveSessionLesson) Reset() {
+	*x = LiveSessionLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveSessionLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveSessionLesson) ProtoMessage() {}
+
+func (x *LiveSessionLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveSessionLesson.ProtoReflect.Descriptor instead.
+func (*LiveSessionLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LiveSessionLesson) GetJoinUrl() string {
+	if x != nil {
+		return x.JoinUrl
+	}
+	return ""
+}
+
+func (x *LiveSessionLesson) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *LiveSessionLesson) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *LiveSessionLesson) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+// QuizLesson: soal & aturan quiz dikelola terpisah, lesson ini hanya menandai posisinya di kurikulum
+type QuizLesson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instructions  *string                `protobuf:"bytes,1,opt,name=instructions,proto3,oneof" json:"instructions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizLesson) Reset() {
+	*x = QuizLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizLesson) ProtoMessage() {}
+
+func (x *QuizLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizLesson.ProtoReflect.Descriptor instead.
+func (*QuizLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QuizLesson) GetInstructions() string {
+	if x != nil && x.Instructions != nil {
+		return *x.Instructions
+	}
+	return ""
+}
+
+// AssignmentLesson: instruksi, deadline & penilaian dikelola lewat AssignmentService
+type AssignmentLesson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *string                `protobuf:"bytes,1,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentLesson) Reset() {
+	*x = AssignmentLesson{}
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentLesson) ProtoMessage() {}
+
+func (x *AssignmentLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_chapter_lesson_chapter_lesson_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentLesson.ProtoReflect.Descriptor instead.
+func (*AssignmentLesson) Descriptor() ([]byte, []int) {
+	return file_chapter_lesson_chapter_lesson_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AssignmentLesson) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+var File_chapter_lesson_chapter_lesson_proto protoreflect.FileDescriptor
+
+const file_chapter_lesson_chapter_lesson_proto_rawDesc = "" +
+	"\n" +
+	"#chapter_lesson/chapter_lesson.proto\x12\x0echapter_lesson\x1a\x1acommon/base_response.proto\x1a\x14common/release.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xfb\a\n" +
+	"\x1aCreateChapterLessonRequest\x124\n" +
+	"\rinstructor_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\finstructorId\x88\x01\x01\x12*\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\bcourseId\x88\x01\x01\x12.\n" +
+	"\n" +
+	"chapter_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x02R\tchapterId\x88\x01\x01\x12 \n" +
+	"\x05title\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12*\n" +
+	"\forder_lesson\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vorderLesson\x12!\n" +
+	"\x04slug\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\x04slug\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\vdescription\x88\x01\x01\x12*\n" +
+	"\tfile_path\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x05R\bfilePath\x88\x01\x01\x124\n" +
+	"\x0estorage_lesson\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x06R\rstorageLesson\x88\x01\x01\x12.\n" +
+	"\vlesson_type\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\aR\n" +
+	"lessonType\x88\x01\x01\x12%\n" +
+	"\x06volume\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\bR\x06volume\x88\x01\x01\x12)\n" +
+	"\bduration\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\tR\bduration\x88\x01\x01\x12*\n" +
+	"\tfile_type\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\n" +
+	"R\bfileType\x88\x01\x01\x121\n" +
+	"\fdownloadable\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\vR\fdownloadable\x88\x01\x01\x12+\n" +
+	"\n" +
+	"is_preview\x18\x0f \x01(\x03B\a\xbaH\x04\"\x02(\x00H\fR\tisPreview\x88\x01\x01\x12%\n" +
+	"\x06status\x18\x10 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\rR\x06status\x88\x01\x01\x12<\n" +
+	"\acontent\x18\x11 \x01(\v2\x1d.chapter_lesson.LessonContentH\x0eR\acontent\x88\x01\x01B\x10\n" +
+	"\x0e_instructor_idB\f\n" +
+	"\n" +
+	"_course_idB\r\n" +
+	"\v_chapter_idB\a\n" +
+	"\x05_slugB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_file_pathB\x11\n" +
+	"\x0f_storage_lessonB\x0e\n" +
+	"\f_lesson_typeB\t\n" +
+	"\a_volumeB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_file_typeB\x0f\n" +
+	"\r_downloadableB\r\n" +
+	"\v_is_previewB\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_content\"W\n" +
+	"\x1bCreateChapterLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"s\n" +
+	"\x1aDetailChapterLessonRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x129\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xbb\v\n" +
+	"\x1bDetailChapterLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12.\n" +
+	"\n" +
+	"chapter_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\tchapterId\x88\x01\x01\x12 \n" +
+	"\x05title\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12*\n" +
+	"\forder_lesson\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vorderLesson\x12!\n" +
+	"\x04slug\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x01R\x04slug\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\vdescription\x88\x01\x01\x12*\n" +
+	"\tfile_path\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\bfilePath\x88\x01\x01\x124\n" +
+	"\x0estorage_lesson\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\rstorageLesson\x88\x01\x01\x12.\n" +
+	"\vlesson_type\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x05R\n" +
+	"lessonType\x88\x01\x01\x12%\n" +
+	"\x06volume\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x06R\x06volume\x88\x01\x01\x12)\n" +
+	"\bduration\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\aR\bduration\x88\x01\x01\x12*\n" +
+	"\tfile_type\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\bR\bfileType\x88\x01\x01\x121\n" +
+	"\fdownloadable\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\tR\fdownloadable\x88\x01\x01\x12+\n" +
+	"\n" +
+	"is_preview\x18\x0f \x01(\x03B\a\xbaH\x04\"\x02(\x00H\n" +
+	"R\tisPreview\x88\x01\x01\x12%\n" +
+	"\x06status\x18\x10 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\vR\x06status\x88\x01\x01\x124\n" +
+	"\rinstructor_id\x18\x11 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\fR\finstructorId\x88\x01\x01\x12*\n" +
+	"\tcourse_id\x18\x12 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\rR\bcourseId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\tH\x0eR\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x14 \x01(\tH\x0fR\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_by\x18\x15 \x01(\tH\x10R\tdeletedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x16 \x01(\tH\x11R\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x17 \x01(\tH\x12R\tupdatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\x18 \x01(\tH\x13R\tdeletedAt\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x19 \x01(\tH\x14R\x04kind\x88\x01\x01\x12<\n" +
+	"\acontent\x18\x1a \x01(\v2\x1d.chapter_lesson.LessonContentH\x15R\acontent\x88\x01\x01\x12/\n" +
+	"\arelease\x18\x1b \x01(\v2\x15.common.ReleaseStatusR\areleaseB\r\n" +
+	"\v_chapter_idB\a\n" +
+	"\x05_slugB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_file_pathB\x11\n" +
+	"\x0f_storage_lessonB\x0e\n" +
+	"\f_lesson_typeB\t\n" +
+	"\a_volumeB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_file_typeB\x0f\n" +
+	"\r_downloadableB\r\n" +
+	"\v_is_previewB\t\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_instructor_idB\f\n" +
+	"\n" +
+	"_course_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_deleted_byB\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_updated_byB\r\n" +
+	"\v_deleted_atB\a\n" +
+	"\x05_kindB\n" +
+	"\n" +
+	"\b_content\"\x95\b\n" +
+	"\x18EditChapterLessonRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12*\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bcourseId\x88\x01\x01\x12.\n" +
+	"\n" +
+	"chapter_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x01R\tchapterId\x88\x01\x01\x12 \n" +
+	"\x05title\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12*\n" +
+	"\forder_lesson\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vorderLesson\x12!\n" +
+	"\x04slug\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\x04slug\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\vdescription\x88\x01\x01\x12*\n" +
+	"\tfile_path\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\bfilePath\x88\x01\x01\x124\n" +
+	"\x0estorage_lesson\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x05R\rstorageLesson\x88\x01\x01\x12.\n" +
+	"\vlesson_type\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x06R\n" +
+	"lessonType\x88\x01\x01\x12%\n" +
+	"\x06volume\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\aR\x06volume\x88\x01\x01\x12)\n" +
+	"\bduration\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\bR\bduration\x88\x01\x01\x12*\n" +
+	"\tfile_type\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\tR\bfileType\x88\x01\x01\x121\n" +
+	"\fdownloadable\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\n" +
+	"R\fdownloadable\x88\x01\x01\x12+\n" +
+	"\n" +
+	"is_preview\x18\x0f \x01(\x03B\a\xbaH\x04\"\x02(\x00H\vR\tisPreview\x88\x01\x01\x12%\n" +
+	"\x06status\x18\x10 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\fR\x06status\x88\x01\x01\x124\n" +
+	"\rinstructor_id\x18\x11 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\rR\finstructorId\x88\x01\x01\x12<\n" +
+	"\acontent\x18\x12 \x01(\v2\x1d.chapter_lesson.LessonContentH\x0eR\acontent\x88\x01\x01B\f\n" +
+	"\n" +
+	"_course_idB\r\n" +
+	"\v_chapter_idB\a\n" +
+	"\x05_slugB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_file_pathB\x11\n" +
+	"\x0f_storage_lessonB\x0e\n" +
+	"\f_lesson_typeB\t\n" +
+	"\a_volumeB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_file_typeB\x0f\n" +
+	"\r_downloadableB\r\n" +
+	"\v_is_previewB\t\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_instructor_idB\n" +
+	"\n" +
+	"\b_content\"U\n" +
+	"\x19EditChapterLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"8\n" +
+	"\x1aDeleteChapterLessonRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"G\n" +
+	"\x1bDeleteChapterLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"7\n" +
+	"\x1bRestoreChapterLessonRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"H\n" +
+	"\x1cRestoreChapterLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"w\n" +
+	"\x15ReorderLessonsRequest\x12'\n" +
+	"\n" +
+	"chapter_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tchapterId\x125\n" +
+	"\vordered_ids\x18\x02 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\xe8\a\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"orderedIds\"B\n" +
+	"\x16ReorderLessonsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xe8\x01\n" +
+	"\x11MoveLessonRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x124\n" +
+	"\x11target_chapter_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftargetChapterId\x127\n" +
+	"\x10target_course_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x0etargetCourseId\x88\x01\x01\x12(\n" +
+	"\bposition\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x01R\bposition\x88\x01\x01B\x13\n" +
+	"\x11_target_course_idB\v\n" +
+	"\t_position\"\xad\x01\n" +
+	"\x12MoveLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x04 \x01(\tR\tchapterId\x12!\n" +
+	"\forder_lesson\x18\x05 \x01(\x03R\vorderLesson\"\xe8\x01\n" +
+	"\x11CopyLessonRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x124\n" +
+	"\x11target_chapter_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftargetChapterId\x127\n" +
+	"\x10target_course_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x0etargetCourseId\x88\x01\x01\x12(\n" +
+	"\bposition\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x01R\bposition\x88\x01\x01B\x13\n" +
+	"\x11_target_course_idB\v\n" +
+	"\t_position\"\xad\x01\n" +
+	"\x12CopyLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x04 \x01(\tR\tchapterId\x12!\n" +
+	"\forder_lesson\x18\x05 \x01(\x03R\vorderLesson\"\xb3\x01\n" +
+	"\x19ListChapterLessonsRequest\x12'\n" +
+	"\n" +
+	"chapter_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tchapterId\x12'\n" +
+	"\x06status\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x06status\x88\x01\x01\x129\n" +
+	"\n" +
+	"field_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\t\n" +
+	"\a_status\"\xc6\b\n" +
+	"\x11ChapterLessonItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\tH\x00R\tchapterId\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12!\n" +
+	"\forder_lesson\x18\x04 \x01(\x03R\vorderLesson\x12\x17\n" +
+	"\x04slug\x18\x05 \x01(\tH\x01R\x04slug\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x02R\vdescription\x88\x01\x01\x12 \n" +
+	"\tfile_path\x18\a \x01(\tH\x03R\bfilePath\x88\x01\x01\x12*\n" +
+	"\x0estorage_lesson\x18\b \x01(\tH\x04R\rstorageLesson\x88\x01\x01\x12$\n" +
+	"\vlesson_type\x18\t \x01(\tH\x05R\n" +
+	"lessonType\x88\x01\x01\x12\x1b\n" +
+	"\x06volume\x18\n" +
+	" \x01(\tH\x06R\x06volume\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\v \x01(\tH\aR\bduration\x88\x01\x01\x12 \n" +
+	"\tfile_type\x18\f \x01(\tH\bR\bfileType\x88\x01\x01\x12'\n" +
+	"\fdownloadable\x18\r \x01(\tH\tR\fdownloadable\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_preview\x18\x0e \x01(\x03H\n" +
+	"R\tisPreview\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x0f \x01(\tH\vR\x06status\x88\x01\x01\x12(\n" +
+	"\rinstructor_id\x18\x10 \x01(\tH\fR\finstructorId\x88\x01\x01\x12 \n" +
+	"\tcourse_id\x18\x11 \x01(\tH\rR\bcourseId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\tH\x0eR\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x13 \x01(\tH\x0fR\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_by\x18\x14 \x01(\tH\x10R\tdeletedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\tH\x11R\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x16 \x01(\tH\x12R\tupdatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\x17 \x01(\tH\x13R\tdeletedAt\x88\x01\x01B\r\n" +
+	"\v_chapter_idB\a\n" +
+	"\x05_slugB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_file_pathB\x11\n" +
+	"\x0f_storage_lessonB\x0e\n" +
+	"\f_lesson_typeB\t\n" +
+	"\a_volumeB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_file_typeB\x0f\n" +
+	"\r_downloadableB\r\n" +
+	"\v_is_previewB\t\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_instructor_idB\f\n" +
+	"\n" +
+	"_course_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_deleted_byB\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_updated_byB\r\n" +
+	"\v_deleted_at\"\x7f\n" +
+	"\x1aListChapterLessonsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x127\n" +
+	"\x05items\x18\x02 \x03(\v2!.chapter_lesson.ChapterLessonItemR\x05items\"n\n" +
+	"\x1bSetLessonReleaseRuleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\x04rule\x18\x02 \x01(\v2\x13.common.ReleaseRuleH\x00R\x04rule\x88\x01\x01B\a\n" +
+	"\x05_rule\"H\n" +
+	"\x1cSetLessonReleaseRuleResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"1\n" +
+	"\x15CompleteLessonRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"e\n" +
+	"\x16CompleteLessonResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12!\n" +
+	"\fcompleted_at\x18\x02 \x01(\tR\vcompletedAt\"C\n" +
+	"\x1aGetCourseCurriculumRequest\x12%\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bcourseId\"\xe7\x02\n" +
+	"\x10CurriculumLesson\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\forder_lesson\x18\x03 \x01(\x03R\vorderLesson\x12\x17\n" +
+	"\x04kind\x18\x04 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x05 \x01(\tH\x01R\bduration\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"is_preview\x18\x06 \x01(\bR\tisPreview\x12\x1c\n" +
+	"\tcompleted\x18\a \x01(\bR\tcompleted\x12/\n" +
+	"\arelease\x18\b \x01(\v2\x15.common.ReleaseStatusR\arelease\x12;\n" +
+	"\frelease_rule\x18\t \x01(\v2\x13.common.ReleaseRuleH\x02R\vreleaseRule\x88\x01\x01B\a\n" +
+	"\x05_kindB\v\n" +
+	"\t_durationB\x0f\n" +
+	"\r_release_rule\"\x99\x02\n" +
+	"\x11CurriculumChapter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
+	"\rorder_chapter\x18\x03 \x01(\x03R\forderChapter\x12/\n" +
+	"\arelease\x18\x04 \x01(\v2\x15.common.ReleaseStatusR\arelease\x12;\n" +
+	"\frelease_rule\x18\x05 \x01(\v2\x13.common.ReleaseRuleH\x00R\vreleaseRule\x88\x01\x01\x12:\n" +
+	"\alessons\x18\x06 \x03(\v2 .chapter_lesson.CurriculumLessonR\alessonsB\x0f\n" +
+	"\r_release_rule\"\x86\x01\n" +
+	"\x1bGetCourseCurriculumResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12=\n" +
+	"\bchapters\x18\x02 \x03(\v2!.chapter_lesson.CurriculumChapterR\bchapters\"\xf0\x01\n" +
+	"\x1eSearchLessonTranscriptsRequest\x12%\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bcourseId\x12 \n" +
+	"\x05query\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05query\x12H\n" +
+	"\blanguage\x18\x03 \x01(\tB'\xbaH$r\"2 ^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$H\x00R\blanguage\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x04 \x01(\x03B\t\xbaH\x06\"\x04\x18d \x00H\x01R\x05limit\x88\x01\x01B\v\n" +
+	"\t_languageB\b\n" +
+	"\x06_limit\"\xe4\x01\n" +
+	"\rTranscriptHit\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12!\n" +
+	"\flesson_title\x18\x02 \x01(\tR\vlessonTitle\x12\"\n" +
+	"\n" +
+	"chapter_id\x18\x03 \x01(\tH\x00R\tchapterId\x88\x01\x01\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x12\x19\n" +
+	"\bstart_ms\x18\x05 \x01(\x03R\astartMs\x12\x15\n" +
+	"\x06end_ms\x18\x06 \x01(\x03R\x05endMs\x12\x12\n" +
+	"\x04text\x18\a \x01(\tR\x04textB\r\n" +
+	"\v_chapter_id\"~\n" +
+	"\x1fSearchLessonTranscriptsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x121\n" +
+	"\x04hits\x18\x02 \x03(\v2\x1d.chapter_lesson.TranscriptHitR\x04hits\"\xb0\x03\n" +
+	"\rLessonContent\x123\n" +
+	"\x05video\x18\x01 \x01(\v2\x1b.chapter_lesson.VideoLessonH\x00R\x05video\x129\n" +
+	"\aarticle\x18\x02 \x01(\v2\x1d.chapter_lesson.ArticleLessonH\x00R\aarticle\x120\n" +
+	"\x04file\x18\x03 \x01(\v2\x1a.chapter_lesson.FileLessonH\x00R\x04file\x120\n" +
+	"\x04link\x18\x04 \x01(\v2\x1a.chapter_lesson.LinkLessonH\x00R\x04link\x12F\n" +
+	"\flive_session\x18\x05 \x01(\v2!.chapter_lesson.LiveSessionLessonH\x00R\vliveSession\x120\n" +
+	"\x04quiz\x18\x06 \x01(\v2\x1a.chapter_lesson.QuizLessonH\x00R\x04quiz\x12B\n" +
+	"\n" +
+	"assignment\x18\a \x01(\v2 .chapter_lesson.AssignmentLessonH\x00R\n" +
+	"assignmentB\r\n" +
+	"\x04kind\x12\x05\xbaH\x02\b\x01\"\x85\x02\n" +
+	"\vVideoLesson\x12H\n" +
+	"\tfile_name\x18\x01 \x01(\tB)\xbaH&r$2\"^[A-Za-z0-9][A-Za-z0-9._-]{0,254}$H\x00R\bfileName\x12\x1f\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01H\x00R\x03url\x126\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03B\v\xbaH\b\"\x06\x18\x80\xa3\x05 \x00R\x0fdurationSeconds\x12B\n" +
+	"\bcaptions\x18\x04 \x03(\v2\x1c.chapter_lesson.VideoCaptionB\b\xbaH\x05\x92\x01\x02\x10\x14R\bcaptionsB\x0f\n" +
+	"\x06source\x12\x05\xbaH\x02\b\x01\"\xd1\x01\n" +
+	"\fVideoCaption\x12C\n" +
+	"\blanguage\x18\x01 \x01(\tB'\xbaH$r\"2 ^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$R\blanguage\x12\x1d\n" +
+	"\x05label\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18dR\x05label\x12K\n" +
+	"\tfile_name\x18\x03 \x01(\tB.\xbaH+r)2'^[A-Za-z0-9][A-Za-z0-9._-]{0,254}\\.vtt$R\bfileName\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\"\x8d\x01\n" +
+	"\rArticleLesson\x12\x1f\n" +
+	"\x04body\x18\x01 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\xc0\x9a\fR\x04body\x12B\n" +
+	"\x14reading_time_seconds\x18\x02 \x01(\x03B\v\xbaH\b\"\x06\x18\x80\xa3\x05 \x00H\x00R\x12readingTimeSeconds\x88\x01\x01B\x17\n" +
+	"\x15_reading_time_seconds\"\x99\x01\n" +
+	"\n" +
+	"FileLesson\x12F\n" +
+	"\tfile_name\x18\x01 \x01(\tB)\xbaH&r$2\"^[A-Za-z0-9][A-Za-z0-9._-]{0,254}$R\bfileName\x122\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\vdisplayName\x88\x01\x01B\x0f\n" +
+	"\r_display_name\"R\n" +
+	"\n" +
+	"LinkLesson\x12\x1d\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x12%\n" +
+	"\x0fopen_in_new_tab\x18\x02 \x01(\bR\fopenInNewTab\"\xed\x01\n" +
+	"\x11LiveSessionLesson\x12&\n" +
+	"\bjoin_url\x18\x01 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\ajoinUrl\x12?\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bstartsAt\x126\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03B\v\xbaH\b\"\x06\x18\x80\xa3\x05 \x00R\x0fdurationSeconds\x12*\n" +
+	"\bprovider\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182H\x00R\bprovider\x88\x01\x01B\v\n" +
+	"\t_provider\"P\n" +
+	"\n" +
+	"QuizLesson\x121\n" +
+	"\finstructions\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x00R\finstructions\x88\x01\x01B\x0f\n" +
+	"\r_instructions\"G\n" +
+	"\x10AssignmentLesson\x12'\n" +
+	"\asummary\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x00R\asummary\x88\x01\x01B\n" +
+	"\n" +
+	"\b_summary2\xe9\x0e\n" +
+	"\x14ChapterLessonService\x12\x86\x01\n" +
+	"\x13CreateChapterLesson\x12*.chapter_lesson.CreateChapterLessonRequest\x1a+.chapter_lesson.CreateChapterLessonResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/lessons\x12\x88\x01\n" +
+	"\x13DetailChapterLesson\x12*.chapter_lesson.DetailChapterLessonRequest\x1a+.chapter_lesson.DetailChapterLessonResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/lessons/{id}\x12\x85\x01\n" +
+	"\x11EditChapterLesson\x12(.chapter_lesson.EditChapterLessonRequest\x1a).chapter_lesson.EditChapterLessonResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/lessons/{id}\x12\x88\x01\n" +
+	"\x13DeleteChapterLesson\x12*.chapter_lesson.DeleteChapterLessonRequest\x1a+.chapter_lesson.DeleteChapterLessonResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/lessons/{id}\x12\x96\x01\n" +
+	"\x14RestoreChapterLesson\x12+.chapter_lesson.RestoreChapterLessonRequest\x1a,.chapter_lesson.RestoreChapterLessonResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/lessons/{id}:restore\x12\x95\x01\n" +
+	"\x0eReorderLessons\x12%.chapter_lesson.ReorderLessonsRequest\x1a&.chapter_lesson.ReorderLessonsResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/chapters/{chapter_id}/lessons:reorder\x12u\n" +
+	"\n" +
+	"MoveLesson\x12!.chapter_lesson.MoveLessonRequest\x1a\".chapter_lesson.MoveLessonResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/lessons/{id}:move\x12u\n" +
+	"\n" +
+	"CopyLesson\x12!.chapter_lesson.CopyLessonRequest\x1a\".chapter_lesson.CopyLessonResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/lessons/{id}:copy\x12\x96\x01\n" +
+	"\x12ListChapterLessons\x12).chapter_lesson.ListChapterLessonsRequest\x1a*.chapter_lesson.ListChapterLessonsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/chapters/{chapter_id}/lessons\x12\x9b\x01\n" +
+	"\x14SetLessonReleaseRule\x12+.chapter_lesson.SetLessonReleaseRuleRequest\x1a,.chapter_lesson.SetLessonReleaseRuleResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/v1/lessons/{id}/release-rule\x12\x85\x01\n" +
+	"\x0eCompleteLesson\x12%.chapter_lesson.CompleteLessonRequest\x1a&.chapter_lesson.CompleteLessonResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/lessons/{id}:complete\x12\x9a\x01\n" +
+	"\x13GetCourseCurriculum\x12*.chapter_lesson.GetCourseCurriculumRequest\x1a+.chapter_lesson.GetCourseCurriculumResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/courses/{course_id}/curriculum\x12\xae\x01\n" +
+	"\x17SearchLessonTranscripts\x12..chapter_lesson.SearchLessonTranscriptsRequest\x1a/.chapter_lesson.SearchLessonTranscriptsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/courses/{course_id}/transcripts:searchB2Z0github.com/abu-umair/be-lms-go/pb/chapter_lessonb\x06proto3"
 
 var (
 	file_chapter_lesson_chapter_lesson_proto_rawDescOnce sync.Once
@@ -1023,38 +3459,122 @@ func file_chapter_lesson_chapter_lesson_proto_rawDescGZIP() []byte {
 	return file_chapter_lesson_chapter_lesson_proto_rawDescData
 }
 
-var file_chapter_lesson_chapter_lesson_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_chapter_lesson_chapter_lesson_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_chapter_lesson_chapter_lesson_proto_goTypes = []any{
-	(*CreateChapterLessonRequest)(nil),  // 0: chapter_lesson.CreateChapterLessonRequest
-	(*CreateChapterLessonResponse)(nil), // 1: chapter_lesson.CreateChapterLessonResponse
-	(*DetailChapterLessonRequest)(nil),  // 2: chapter_lesson.DetailChapterLessonRequest
-	(*DetailChapterLessonResponse)(nil), // 3: chapter_lesson.DetailChapterLessonResponse
-	(*EditChapterLessonRequest)(nil),    // 4: chapter_lesson.EditChapterLessonRequest
-	(*EditChapterLessonResponse)(nil),   // 5: chapter_lesson.EditChapterLessonResponse
-	(*DeleteChapterLessonRequest)(nil),  // 6: chapter_lesson.DeleteChapterLessonRequest
-	(*DeleteChapterLessonResponse)(nil), // 7: chapter_lesson.DeleteChapterLessonResponse
-	(*common.BaseResponse)(nil),         // 8: common.BaseResponse
-	(*fieldmaskpb.FieldMask)(nil),       // 9: google.protobuf.FieldMask
+	(*CreateChapterLessonRequest)(nil),      // 0: chapter_lesson.CreateChapterLessonRequest
+	(*CreateChapterLessonResponse)(nil),     // 1: chapter_lesson.CreateChapterLessonResponse
+	(*DetailChapterLessonRequest)(nil),      // 2: chapter_lesson.DetailChapterLessonRequest
+	(*DetailChapterLessonResponse)(nil),     // 3: chapter_lesson.DetailChapterLessonResponse
+	(*EditChapterLessonRequest)(nil),        // 4: chapter_lesson.EditChapterLessonRequest
+	(*EditChapterLessonResponse)(nil),       // 5: chapter_lesson.EditChapterLessonResponse
+	(*DeleteChapterLessonRequest)(nil),      // 6: chapter_lesson.DeleteChapterLessonRequest
+	(*DeleteChapterLessonResponse)(nil),     // 7: chapter_lesson.DeleteChapterLessonResponse
+	(*RestoreChapterLessonRequest)(nil),     // 8: chapter_lesson.RestoreChapterLessonRequest
+	(*RestoreChapterLessonResponse)(nil),    // 9: chapter_lesson.RestoreChapterLessonResponse
+	(*ReorderLessonsRequest)(nil),           // 10: chapter_lesson.ReorderLessonsRequest
+	(*ReorderLessonsResponse)(nil),          // 11: chapter_lesson.ReorderLessonsResponse
+	(*MoveLessonRequest)(nil),               // 12: chapter_lesson.MoveLessonRequest
+	(*MoveLessonResponse)(nil),              // 13: chapter_lesson.MoveLessonResponse
+	(*CopyLessonRequest)(nil),               // 14: chapter_lesson.CopyLessonRequest
+	(*CopyLessonResponse)(nil),              // 15: chapter_lesson.CopyLessonResponse
+	(*ListChapterLessonsRequest)(nil),       // 16: chapter_lesson.ListChapterLessonsRequest
+	(*ChapterLessonItem)(nil),               // 17: chapter_lesson.ChapterLessonItem
+	(*ListChapterLessonsResponse)(nil),      // 18: chapter_lesson.ListChapterLessonsResponse
+	(*SetLessonReleaseRuleRequest)(nil),     // 19: chapter_lesson.SetLessonReleaseRuleRequest
+	(*SetLessonReleaseRuleResponse)(nil),    // 20: chapter_lesson.SetLessonReleaseRuleResponse
+	(*CompleteLessonRequest)(nil),           // 21: chapter_lesson.CompleteLessonRequest
+	(*CompleteLessonResponse)(nil),          // 22: chapter_lesson.CompleteLessonResponse
+	(*GetCourseCurriculumRequest)(nil),      // 23: chapter_lesson.GetCourseCurriculumRequest
+	(*CurriculumLesson)(nil),                // 24: chapter_lesson.CurriculumLesson
+	(*CurriculumChapter)(nil),               // 25: chapter_lesson.CurriculumChapter
+	(*GetCourseCurriculumResponse)(nil),     // 26: chapter_lesson.GetCourseCurriculumResponse
+	(*SearchLessonTranscriptsRequest)(nil),  // 27: chapter_lesson.SearchLessonTranscriptsRequest
+	(*TranscriptHit)(nil),                   // 28: chapter_lesson.TranscriptHit
+	(*SearchLessonTranscriptsResponse)(nil), // 29: chapter_lesson.SearchLessonTranscriptsResponse
+	(*LessonContent)(nil),                   // 30: chapter_lesson.LessonContent
+	(*VideoLesson)(nil),                     // 31: chapter_lesson.VideoLesson
+	(*VideoCaption)(nil),                    // 32: chapter_lesson.VideoCaption
+	(*ArticleLesson)(nil),                   // 33: chapter_lesson.ArticleLesson
+	(*FileLesson)(nil),                      // 34: chapter_lesson.FileLesson
+	(*LinkLesson)(nil),                      // 35: chapter_lesson.LinkLesson
+	(*LiveSessionLesson)(nil),               // 36: chapter_lesson.LiveSessionLesson
+	(*QuizLesson)(nil),                      // 37: chapter_lesson.QuizLesson
+	(*AssignmentLesson)(nil),                // 38: chapter_lesson.AssignmentLesson
+	(*common.BaseResponse)(nil),             // 39: common.BaseResponse
+	(*fieldmaskpb.FieldMask)(nil),           // 40: google.protobuf.FieldMask
+	(*common.ReleaseStatus)(nil),            // 41: common.ReleaseStatus
+	(*common.ReleaseRule)(nil),              // 42: common.ReleaseRule
+	(*timestamppb.Timestamp)(nil),           // 43: google.protobuf.Timestamp
 }
 var file_chapter_lesson_chapter_lesson_proto_depIdxs = []int32{
-	8, // 0: chapter_lesson.CreateChapterLessonResponse.base:type_name -> common.BaseResponse
-	9, // 1: chapter_lesson.DetailChapterLessonRequest.field_mask:type_name -> google.protobuf.FieldMask
-	8, // 2: chapter_lesson.DetailChapterLessonResponse.base:type_name -> common.BaseResponse
-	8, // 3: chapter_lesson.EditChapterLessonResponse.base:type_name -> common.BaseResponse
-	8, // 4: chapter_lesson.DeleteChapterLessonResponse.base:type_name -> common.BaseResponse
-	0, // 5: chapter_lesson.ChapterLessonService.CreateChapterLesson:input_type -> chapter_lesson.CreateChapterLessonRequest
-	2, // 6: chapter_lesson.ChapterLessonService.DetailChapterLesson:input_type -> chapter_lesson.DetailChapterLessonRequest
-	4, // 7: chapter_lesson.ChapterLessonService.EditChapterLesson:input_type -> chapter_lesson.EditChapterLessonRequest
-	6, // 8: chapter_lesson.ChapterLessonService.DeleteChapterLesson:input_type -> chapter_lesson.DeleteChapterLessonRequest
-	1, // 9: chapter_lesson.ChapterLessonService.CreateChapterLesson:output_type -> chapter_lesson.CreateChapterLessonResponse
-	3, // 10: chapter_lesson.ChapterLessonService.DetailChapterLesson:output_type -> chapter_lesson.DetailChapterLessonResponse
-	5, // 11: chapter_lesson.ChapterLessonService.EditChapterLesson:output_type -> chapter_lesson.EditChapterLessonResponse
-	7, // 12: chapter_lesson.ChapterLessonService.DeleteChapterLesson:output_type -> chapter_lesson.DeleteChapterLessonResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	30, // 0: chapter_lesson.CreateChapterLessonRequest.content:type_name -> chapter_lesson.LessonContent
+	39, // 1: chapter_lesson.CreateChapterLessonResponse.base:type_name -> common.BaseResponse
+	40, // 2: chapter_lesson.DetailChapterLessonRequest.field_mask:type_name -> google.protobuf.FieldMask
+	39, // 3: chapter_lesson.DetailChapterLessonResponse.base:type_name -> common.BaseResponse
+	30, // 4: chapter_lesson.DetailChapterLessonResponse.content:type_name -> chapter_lesson.LessonContent
+	41, // 5: chapter_lesson.DetailChapterLessonResponse.release:type_name -> common.ReleaseStatus
+	30, // 6: chapter_lesson.EditChapterLessonRequest.content:type_name -> chapter_lesson.LessonContent
+	39, // 7: chapter_lesson.EditChapterLessonResponse.base:type_name -> common.BaseResponse
+	39, // 8: chapter_lesson.DeleteChapterLessonResponse.base:type_name -> common.BaseResponse
+	39, // 9: chapter_lesson.RestoreChapterLessonResponse.base:type_name -> common.BaseResponse
+	39, // 10: chapter_lesson.ReorderLessonsResponse.base:type_name -> common.BaseResponse
+	39, // 11: chapter_lesson.MoveLessonResponse.base:type_name -> common.BaseResponse
+	39, // 12: chapter_lesson.CopyLessonResponse.base:type_name -> common.BaseResponse
+	40, // 13: chapter_lesson.ListChapterLessonsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	39, // 14: chapter_lesson.ListChapterLessonsResponse.base:type_name -> common.BaseResponse
+	17, // 15: chapter_lesson.ListChapterLessonsResponse.items:type_name -> chapter_lesson.ChapterLessonItem
+	42, // 16: chapter_lesson.SetLessonReleaseRuleRequest.rule:type_name -> common.ReleaseRule
+	39, // 17: chapter_lesson.SetLessonReleaseRuleResponse.base:type_name -> common.BaseResponse
+	39, // 18: chapter_lesson.CompleteLessonResponse.base:type_name -> common.BaseResponse
+	41, // 19: chapter_lesson.CurriculumLesson.release:type_name -> common.ReleaseStatus
+	42, // 20: chapter_lesson.CurriculumLesson.release_rule:type_name -> common.ReleaseRule
+	41, // 21: chapter_lesson.CurriculumChapter.release:type_name -> common.ReleaseStatus
+	42, // 22: chapter_lesson.CurriculumChapter.release_rule:type_name -> common.ReleaseRule
+	24, // 23: chapter_lesson.CurriculumChapter.lessons:type_name -> chapter_lesson.CurriculumLesson
+	39, // 24: chapter_lesson.GetCourseCurriculumResponse.base:type_name -> common.BaseResponse
+	25, // 25: chapter_lesson.GetCourseCurriculumResponse.chapters:type_name -> chapter_lesson.CurriculumChapter
+	39, // 26: chapter_lesson.SearchLessonTranscriptsResponse.base:type_name -> common.BaseResponse
+	28, // 27: chapter_lesson.SearchLessonTranscriptsResponse.hits:type_name -> chapter_lesson.TranscriptHit
+	31, // 28: chapter_lesson.LessonContent.video:type_name -> chapter_lesson.VideoLesson
+	33, // 29: chapter_lesson.LessonContent.article:type_name -> chapter_lesson.ArticleLesson
+	34, // 30: chapter_lesson.LessonContent.file:type_name -> chapter_lesson.FileLesson
+	35, // 31: chapter_lesson.LessonContent.link:type_name -> chapter_lesson.LinkLesson
+	36, // 32: chapter_lesson.LessonContent.live_session:type_name -> chapter_lesson.LiveSessionLesson
+	37, // 33: chapter_lesson.LessonContent.quiz:type_name -> chapter_lesson.QuizLesson
+	38, // 34: chapter_lesson.LessonContent.assignment:type_name -> chapter_lesson.AssignmentLesson
+	32, // 35: chapter_lesson.VideoLesson.captions:type_name -> chapter_lesson.VideoCaption
+	43, // 36: chapter_lesson.LiveSessionLesson.starts_at:type_name -> google.protobuf.Timestamp
+	0,  // 37: chapter_lesson.ChapterLessonService.CreateChapterLesson:input_type -> chapter_lesson.CreateChapterLessonRequest
+	2,  // 38: chapter_lesson.ChapterLessonService.DetailChapterLesson:input_type -> chapter_lesson.DetailChapterLessonRequest
+	4,  // 39: chapter_lesson.ChapterLessonService.EditChapterLesson:input_type -> chapter_lesson.EditChapterLessonRequest
+	6,  // 40: chapter_lesson.ChapterLessonService.DeleteChapterLesson:input_type -> chapter_lesson.DeleteChapterLessonRequest
+	8,  // 41: chapter_lesson.ChapterLessonService.RestoreChapterLesson:input_type -> chapter_lesson.RestoreChapterLessonRequest
+	10, // 42: chapter_lesson.ChapterLessonService.ReorderLessons:input_type -> chapter_lesson.ReorderLessonsRequest
+	12, // 43: chapter_lesson.ChapterLessonService.MoveLesson:input_type -> chapter_lesson.MoveLessonRequest
+	14, // 44: chapter_lesson.ChapterLessonService.CopyLesson:input_type -> chapter_lesson.CopyLessonRequest
+	16, // 45: chapter_lesson.ChapterLessonService.ListChapterLessons:input_type -> chapter_lesson.ListChapterLessonsRequest
+	19, // 46: chapter_lesson.ChapterLessonService.SetLessonReleaseRule:input_type -> chapter_lesson.SetLessonReleaseRuleRequest
+	21, // 47: chapter_lesson.ChapterLessonService.CompleteLesson:input_type -> chapter_lesson.CompleteLessonRequest
+	23, // 48: chapter_lesson.ChapterLessonService.GetCourseCurriculum:input_type -> chapter_lesson.GetCourseCurriculumRequest
+	27, // 49: chapter_lesson.ChapterLessonService.SearchLessonTranscripts:input_type -> chapter_lesson.SearchLessonTranscriptsRequest
+	1,  // 50: chapter_lesson.ChapterLessonService.CreateChapterLesson:output_type -> chapter_lesson.CreateChapterLessonResponse
+	3,  // 51: chapter_lesson.ChapterLessonService.DetailChapterLesson:output_type -> chapter_lesson.DetailChapterLessonResponse
+	5,  // 52: chapter_lesson.ChapterLessonService.EditChapterLesson:output_type -> chapter_lesson.EditChapterLessonResponse
+	7,  // 53: chapter_lesson.ChapterLessonService.DeleteChapterLesson:output_type -> chapter_lesson.DeleteChapterLessonResponse
+	9,  // 54: chapter_lesson.ChapterLessonService.RestoreChapterLesson:output_type -> chapter_lesson.RestoreChapterLessonResponse
+	11, // 55: chapter_lesson.ChapterLessonService.ReorderLessons:output_type -> chapter_lesson.ReorderLessonsResponse
+	13, // 56: chapter_lesson.ChapterLessonService.MoveLesson:output_type -> chapter_lesson.MoveLessonResponse
+	15, // 57: chapter_lesson.ChapterLessonService.CopyLesson:output_type -> chapter_lesson.CopyLessonResponse
+	18, // 58: chapter_lesson.ChapterLessonService.ListChapterLessons:output_type -> chapter_lesson.ListChapterLessonsResponse
+	20, // 59: chapter_lesson.ChapterLessonService.SetLessonReleaseRule:output_type -> chapter_lesson.SetLessonReleaseRuleResponse
+	22, // 60: chapter_lesson.ChapterLessonService.CompleteLesson:output_type -> chapter_lesson.CompleteLessonResponse
+	26, // 61: chapter_lesson.ChapterLessonService.GetCourseCurriculum:output_type -> chapter_lesson.GetCourseCurriculumResponse
+	29, // 62: chapter_lesson.ChapterLessonService.SearchLessonTranscripts:output_type -> chapter_lesson.SearchLessonTranscriptsResponse
+	50, // [50:63] is the sub-list for method output_type
+	37, // [37:50] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_chapter_lesson_chapter_lesson_proto_init() }
@@ -1065,13 +3585,40 @@ func file_chapter_lesson_chapter_lesson_proto_init() {
 	file_chapter_lesson_chapter_lesson_proto_msgTypes[0].OneofWrappers = []any{}
 	file_chapter_lesson_chapter_lesson_proto_msgTypes[3].OneofWrappers = []any{}
 	file_chapter_lesson_chapter_lesson_proto_msgTypes[4].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[12].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[14].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[16].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[17].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[19].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[24].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[25].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[27].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[28].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[30].OneofWrappers = []any{
+		(*LessonContent_Video)(nil),
+		(*LessonContent_Article)(nil),
+		(*LessonContent_File)(nil),
+		(*LessonContent_Link)(nil),
+		(*LessonContent_LiveSession)(nil),
+		(*LessonContent_Quiz)(nil),
+		(*LessonContent_Assignment)(nil),
+	}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[31].OneofWrappers = []any{
+		(*VideoLesson_FileName)(nil),
+		(*VideoLesson_Url)(nil),
+	}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[33].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[34].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[36].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[37].OneofWrappers = []any{}
+	file_chapter_lesson_chapter_lesson_proto_msgTypes[38].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chapter_lesson_chapter_lesson_proto_rawDesc), len(file_chapter_lesson_chapter_lesson_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
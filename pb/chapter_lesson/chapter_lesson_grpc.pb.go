@@ -19,10 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChapterLessonService_CreateChapterLesson_FullMethodName = "/chapter_lesson.ChapterLessonService/CreateChapterLesson"
-	ChapterLessonService_DetailChapterLesson_FullMethodName = "/chapter_lesson.ChapterLessonService/DetailChapterLesson"
-	ChapterLessonService_EditChapterLesson_FullMethodName   = "/chapter_lesson.ChapterLessonService/EditChapterLesson"
-	ChapterLessonService_DeleteChapterLesson_FullMethodName = "/chapter_lesson.ChapterLessonService/DeleteChapterLesson"
+	ChapterLessonService_CreateChapterLesson_FullMethodName     = "/chapter_lesson.ChapterLessonService/CreateChapterLesson"
+	ChapterLessonService_DetailChapterLesson_FullMethodName     = "/chapter_lesson.ChapterLessonService/DetailChapterLesson"
+	ChapterLessonService_EditChapterLesson_FullMethodName       = "/chapter_lesson.ChapterLessonService/EditChapterLesson"
+	ChapterLessonService_DeleteChapterLesson_FullMethodName     = "/chapter_lesson.ChapterLessonService/DeleteChapterLesson"
+	ChapterLessonService_RestoreChapterLesson_FullMethodName    = "/chapter_lesson.ChapterLessonService/RestoreChapterLesson"
+	ChapterLessonService_ReorderLessons_FullMethodName          = "/chapter_lesson.ChapterLessonService/ReorderLessons"
+	ChapterLessonService_MoveLesson_FullMethodName              = "/chapter_lesson.ChapterLessonService/MoveLesson"
+	ChapterLessonService_CopyLesson_FullMethodName              = "/chapter_lesson.ChapterLessonService/CopyLesson"
+	ChapterLessonService_ListChapterLessons_FullMethodName      = "/chapter_lesson.ChapterLessonService/ListChapterLessons"
+	ChapterLessonService_SetLessonReleaseRule_FullMethodName    = "/chapter_lesson.ChapterLessonService/SetLessonReleaseRule"
+	ChapterLessonService_CompleteLesson_FullMethodName          = "/chapter_lesson.ChapterLessonService/CompleteLesson"
+	ChapterLessonService_GetCourseCurriculum_FullMethodName     = "/chapter_lesson.ChapterLessonService/GetCourseCurriculum"
+	ChapterLessonService_SearchLessonTranscripts_FullMethodName = "/chapter_lesson.ChapterLessonService/SearchLessonTranscripts"
 )
 
 // ChapterLessonServiceClient is the client API for ChapterLessonService service.
@@ -33,6 +42,15 @@ type ChapterLessonServiceClient interface {
 	DetailChapterLesson(ctx context.Context, in *DetailChapterLessonRequest, opts ...grpc.CallOption) (*DetailChapterLessonResponse, error)
 	EditChapterLesson(ctx context.Context, in *EditChapterLessonRequest, opts ...grpc.CallOption) (*EditChapterLessonResponse, error)
 	DeleteChapterLesson(ctx context.Context, in *DeleteChapterLessonRequest, opts ...grpc.CallOption) (*DeleteChapterLessonResponse, error)
+	RestoreChapterLesson(ctx context.Context, in *RestoreChapterLessonRequest, opts ...grpc.CallOption) (*RestoreChapterLessonResponse, error)
+	ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error)
+	MoveLesson(ctx context.Context, in *MoveLessonRequest, opts ...grpc.CallOption) (*MoveLessonResponse, error)
+	CopyLesson(ctx context.Context, in *CopyLessonRequest, opts ...grpc.CallOption) (*CopyLessonResponse, error)
+	ListChapterLessons(ctx context.Context, in *ListChapterLessonsRequest, opts ...grpc.CallOption) (*ListChapterLessonsResponse, error)
+	SetLessonReleaseRule(ctx context.Context, in *SetLessonReleaseRuleRequest, opts ...grpc.CallOption) (*SetLessonReleaseRuleResponse, error)
+	CompleteLesson(ctx context.Context, in *CompleteLessonRequest, opts ...grpc.CallOption) (*CompleteLessonResponse, error)
+	GetCourseCurriculum(ctx context.Context, in *GetCourseCurriculumRequest, opts ...grpc.CallOption) (*GetCourseCurriculumResponse, error)
+	SearchLessonTranscripts(ctx context.Context, in *SearchLessonTranscriptsRequest, opts ...grpc.CallOption) (*SearchLessonTranscriptsResponse, error)
 }
 
 type chapterLessonServiceClient struct {
@@ -83,6 +101,96 @@ func (c *chapterLessonServiceClient) DeleteChapterLesson(ctx context.Context, in
 	return out, nil
 }
 
+func (c *chapterLessonServiceClient) RestoreChapterLesson(ctx context.Context, in *RestoreChapterLessonRequest, opts ...grpc.CallOption) (*RestoreChapterLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreChapterLessonResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_RestoreChapterLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderLessonsResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_ReorderLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) MoveLesson(ctx context.Context, in *MoveLessonRequest, opts ...grpc.CallOption) (*MoveLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveLessonResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_MoveLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) CopyLesson(ctx context.Context, in *CopyLessonRequest, opts ...grpc.CallOption) (*CopyLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyLessonResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_CopyLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) ListChapterLessons(ctx context.Context, in *ListChapterLessonsRequest, opts ...grpc.CallOption) (*ListChapterLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChapterLessonsResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_ListChapterLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) SetLessonReleaseRule(ctx context.Context, in *SetLessonReleaseRuleRequest, opts ...grpc.CallOption) (*SetLessonReleaseRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLessonReleaseRuleResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_SetLessonReleaseRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) CompleteLesson(ctx context.Context, in *CompleteLessonRequest, opts ...grpc.CallOption) (*CompleteLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteLessonResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_CompleteLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) GetCourseCurriculum(ctx context.Context, in *GetCourseCurriculumRequest, opts ...grpc.CallOption) (*GetCourseCurriculumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseCurriculumResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_GetCourseCurriculum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chapterLessonServiceClient) SearchLessonTranscripts(ctx context.Context, in *SearchLessonTranscriptsRequest, opts ...grpc.CallOption) (*SearchLessonTranscriptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLessonTranscriptsResponse)
+	err := c.cc.Invoke(ctx, ChapterLessonService_SearchLessonTranscripts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChapterLessonServiceServer is the server API for ChapterLessonService service.
 // All implementations must embed UnimplementedChapterLessonServiceServer
 // for forward compatibility.
@@ -91,6 +199,15 @@ type ChapterLessonServiceServer interface {
 	DetailChapterLesson(context.Context, *DetailChapterLessonRequest) (*DetailChapterLessonResponse, error)
 	EditChapterLesson(context.Context, *EditChapterLessonRequest) (*EditChapterLessonResponse, error)
 	DeleteChapterLesson(context.Context, *DeleteChapterLessonRequest) (*DeleteChapterLessonResponse, error)
+	RestoreChapterLesson(context.Context, *RestoreChapterLessonRequest) (*RestoreChapterLessonResponse, error)
+	ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error)
+	MoveLesson(context.Context, *MoveLessonRequest) (*MoveLessonResponse, error)
+	CopyLesson(context.Context, *CopyLessonRequest) (*CopyLessonResponse, error)
+	ListChapterLessons(context.Context, *ListChapterLessonsRequest) (*ListChapterLessonsResponse, error)
+	SetLessonReleaseRule(context.Context, *SetLessonReleaseRuleRequest) (*SetLessonReleaseRuleResponse, error)
+	CompleteLesson(context.Context, *CompleteLessonRequest) (*CompleteLessonResponse, error)
+	GetCourseCurriculum(context.Context, *GetCourseCurriculumRequest) (*GetCourseCurriculumResponse, error)
+	SearchLessonTranscripts(context.Context, *SearchLessonTranscriptsRequest) (*SearchLessonTranscriptsResponse, error)
 	mustEmbedUnimplementedChapterLessonServiceServer()
 }
 
@@ -113,6 +230,33 @@ func (UnimplementedChapterLessonServiceServer) EditChapterLesson(context.Context
 func (UnimplementedChapterLessonServiceServer) DeleteChapterLesson(context.Context, *DeleteChapterLessonRequest) (*DeleteChapterLessonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChapterLesson not implemented")
 }
+func (UnimplementedChapterLessonServiceServer) RestoreChapterLesson(context.Context, *RestoreChapterLessonRequest) (*RestoreChapterLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreChapterLesson not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderLessons not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) MoveLesson(context.Context, *MoveLessonRequest) (*MoveLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveLesson not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) CopyLesson(context.Context, *CopyLessonRequest) (*CopyLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyLesson not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) ListChapterLessons(context.Context, *ListChapterLessonsRequest) (*ListChapterLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChapterLessons not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) SetLessonReleaseRule(context.Context, *SetLessonReleaseRuleRequest) (*SetLessonReleaseRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLessonReleaseRule not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) CompleteLesson(context.Context, *CompleteLessonRequest) (*CompleteLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteLesson not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) GetCourseCurriculum(context.Context, *GetCourseCurriculumRequest) (*GetCourseCurriculumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseCurriculum not implemented")
+}
+func (UnimplementedChapterLessonServiceServer) SearchLessonTranscripts(context.Context, *SearchLessonTranscriptsRequest) (*SearchLessonTranscriptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLessonTranscripts not implemented")
+}
 func (UnimplementedChapterLessonServiceServer) mustEmbedUnimplementedChapterLessonServiceServer() {}
 func (UnimplementedChapterLessonServiceServer) testEmbeddedByValue()                              {}
 
@@ -206,6 +350,168 @@ func _ChapterLessonService_DeleteChapterLesson_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChapterLessonService_RestoreChapterLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChapterLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).RestoreChapterLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_RestoreChapterLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).RestoreChapterLesson(ctx, req.(*RestoreChapterLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_ReorderLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).ReorderLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_ReorderLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).ReorderLessons(ctx, req.(*ReorderLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_MoveLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).MoveLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_MoveLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).MoveLesson(ctx, req.(*MoveLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_CopyLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).CopyLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_CopyLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).CopyLesson(ctx, req.(*CopyLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_ListChapterLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChapterLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).ListChapterLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_ListChapterLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).ListChapterLessons(ctx, req.(*ListChapterLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_SetLessonReleaseRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLessonReleaseRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).SetLessonReleaseRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_SetLessonReleaseRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).SetLessonReleaseRule(ctx, req.(*SetLessonReleaseRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_CompleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).CompleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_CompleteLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).CompleteLesson(ctx, req.(*CompleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_GetCourseCurriculum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseCurriculumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).GetCourseCurriculum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_GetCourseCurriculum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).GetCourseCurriculum(ctx, req.(*GetCourseCurriculumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChapterLessonService_SearchLessonTranscripts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLessonTranscriptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChapterLessonServiceServer).SearchLessonTranscripts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChapterLessonService_SearchLessonTranscripts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChapterLessonServiceServer).SearchLessonTranscripts(ctx, req.(*SearchLessonTranscriptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChapterLessonService_ServiceDesc is the grpc.ServiceDesc for ChapterLessonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +535,42 @@ var ChapterLessonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChapterLesson",
 			Handler:    _ChapterLessonService_DeleteChapterLesson_Handler,
 		},
+		{
+			MethodName: "RestoreChapterLesson",
+			Handler:    _ChapterLessonService_RestoreChapterLesson_Handler,
+		},
+		{
+			MethodName: "ReorderLessons",
+			Handler:    _ChapterLessonService_ReorderLessons_Handler,
+		},
+		{
+			MethodName: "MoveLesson",
+			Handler:    _ChapterLessonService_MoveLesson_Handler,
+		},
+		{
+			MethodName: "CopyLesson",
+			Handler:    _ChapterLessonService_CopyLesson_Handler,
+		},
+		{
+			MethodName: "ListChapterLessons",
+			Handler:    _ChapterLessonService_ListChapterLessons_Handler,
+		},
+		{
+			MethodName: "SetLessonReleaseRule",
+			Handler:    _ChapterLessonService_SetLessonReleaseRule_Handler,
+		},
+		{
+			MethodName: "CompleteLesson",
+			Handler:    _ChapterLessonService_CompleteLesson_Handler,
+		},
+		{
+			MethodName: "GetCourseCurriculum",
+			Handler:    _ChapterLessonService_GetCourseCurriculum_Handler,
+		},
+		{
+			MethodName: "SearchLessonTranscripts",
+			Handler:    _ChapterLessonService_SearchLessonTranscripts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chapter_lesson/chapter_lesson.proto",
@@ -25,6 +25,7 @@ type ValidationError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` //? id rule protovalidate yang dilanggar, misal string.min_len
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,6 +74,13 @@ func (x *ValidationError) GetMessage() string {
 	return ""
 }
 
+func (x *ValidationError) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
 type BaseResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode       int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -145,10 +153,11 @@ var File_common_base_response_proto protoreflect.FileDescriptor
 
 const file_common_base_response_proto_rawDesc = "" +
 	"\n" +
-	"\x1acommon/base_response.proto\x12\x06common\"A\n" +
+	"\x1acommon/base_response.proto\x12\x06common\"Z\n" +
 	"\x0fValidationError\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xaa\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\arule_id\x18\x03 \x01(\tR\x06ruleId\"\xaa\x01\n" +
 	"\fBaseResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x03R\n" +
 	"statusCode\x12\x18\n" +
@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: common/release.proto
+
+package common
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReleaseRule: aturan rilis (drip) chapter / lesson, hanya satu jenis aturan per chapter / lesson
+type ReleaseRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Rule:
+	//
+	//	*ReleaseRule_ReleaseAt
+	//	*ReleaseRule_DaysAfterEnrollment
+	//	*ReleaseRule_PrerequisiteLessonId
+	//	*ReleaseRule_PrerequisiteQuizId
+	Rule          isReleaseRule_Rule `protobuf_oneof:"rule"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRule) Reset() {
+	*x = ReleaseRule{}
+	mi := &file_common_release_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRule) ProtoMessage() {}
+
+func (x *ReleaseRule) ProtoReflect() protoreflect.Message {
+	mi := &file_common_release_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRule.ProtoReflect.Descriptor instead.
+func (*ReleaseRule) Descriptor() ([]byte, []int) {
+	return file_common_release_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReleaseRule) GetRule() isReleaseRule_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *ReleaseRule) GetReleaseAt() string {
+	if x != nil {
+		if x, ok := x.Rule.(*ReleaseRule_ReleaseAt); ok {
+			return x.ReleaseAt
+		}
+	}
+	return ""
+}
+
+func (x *ReleaseRule) GetDaysAfterEnrollment() int64 {
+	if x != nil {
+		if x, ok := x.Rule.(*ReleaseRule_DaysAfterEnrollment); ok {
+			return x.DaysAfterEnrollment
+		}
+	}
+	return 0
+}
+
+func (x *ReleaseRule) GetPrerequisiteLessonId() string {
+	if x != nil {
+		if x, ok := x.Rule.(*ReleaseRule_PrerequisiteLessonId); ok {
+			return x.PrerequisiteLessonId
+		}
+	}
+	return ""
+}
+
+func (x *ReleaseRule) GetPrerequisiteQuizId() string {
+	if x != nil {
+		if x, ok := x.Rule.(*ReleaseRule_PrerequisiteQuizId); ok {
+			return x.PrerequisiteQuizId
+		}
+	}
+	return ""
+}
+
+type isReleaseRule_Rule interface {
+	isReleaseRule_Rule()
+}
+
+type ReleaseRule_ReleaseAt struct {
+	//? waktu lokal tanpa zona (YYYY-MM-DDTHH:MM[:SS]), dibaca sesuai timezone course (kosong / tidak valid = UTC)
+	ReleaseAt string `protobuf:"bytes,1,opt,name=release_at,json=releaseAt,proto3,oneof"`
+}
+
+type ReleaseRule_DaysAfterEnrollment struct {
+	DaysAfterEnrollment int64 `protobuf:"varint,2,opt,name=days_after_enrollment,json=daysAfterEnrollment,proto3,oneof"`
+}
+
+type ReleaseRule_PrerequisiteLessonId struct {
+	//? lesson quiz dianggap selesai jika quiz-nya lulus, lesson lain lewat CompleteLesson
+	PrerequisiteLessonId string `protobuf:"bytes,3,opt,name=prerequisite_lesson_id,json=prerequisiteLessonId,proto3,oneof"`
+}
+
+type ReleaseRule_PrerequisiteQuizId struct {
+	PrerequisiteQuizId string `protobuf:"bytes,4,opt,name=prerequisite_quiz_id,json=prerequisiteQuizId,proto3,oneof"`
+}
+
+func (*ReleaseRule_ReleaseAt) isReleaseRule_Rule() {}
+
+func (*ReleaseRule_DaysAfterEnrollment) isReleaseRule_Rule() {}
+
+func (*ReleaseRule_PrerequisiteLessonId) isReleaseRule_Rule() {}
+
+func (*ReleaseRule_PrerequisiteQuizId) isReleaseRule_Rule() {}
+
+// ReleaseStatus: status rilis utk user yang login, unlock_at kosong jika masih menunggu prerequisite
+type ReleaseStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Locked               bool                   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	UnlockAt             *string                `protobuf:"bytes,2,opt,name=unlock_at,json=unlockAt,proto3,oneof" json:"unlock_at,omitempty"`
+	Reason               *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"` //? release_at, days_after_enrollment, prerequisite
+	PrerequisiteLessonId *string                `protobuf:"bytes,4,opt,name=prerequisite_lesson_id,json=prerequisiteLessonId,proto3,oneof" json:"prerequisite_lesson_id,omitempty"`
+	PrerequisiteQuizId   *string                `protobuf:"bytes,5,opt,name=prerequisite_quiz_id,json=prerequisiteQuizId,proto3,oneof" json:"prerequisite_quiz_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReleaseStatus) Reset() {
+	*x = ReleaseStatus{}
+	mi := &file_common_release_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStatus) ProtoMessage() {}
+
+func (x *ReleaseStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_common_release_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStatus.ProtoReflect.Descriptor instead.
+func (*ReleaseStatus) Descriptor() ([]byte, []int) {
+	return file_common_release_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReleaseStatus) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *ReleaseStatus) GetUnlockAt() string {
+	if x != nil && x.UnlockAt != nil {
+		return *x.UnlockAt
+	}
+	return ""
+}
+
+func (x *ReleaseStatus) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ReleaseStatus) GetPrerequisiteLessonId() string {
+	if x != nil && x.PrerequisiteLessonId != nil {
+		return *x.PrerequisiteLessonId
+	}
+	return ""
+}
+
+func (x *ReleaseStatus) GetPrerequisiteQuizId() string {
+	if x != nil && x.PrerequisiteQuizId != nil {
+		return *x.PrerequisiteQuizId
+	}
+	return ""
+}
+
+var File_common_release_proto protoreflect.FileDescriptor
+
+const file_common_release_proto_rawDesc = "" +
+	"\n" +
+	"\x14common/release.proto\x12\x06common\x1a\x1bbuf/validate/validate.proto\"\xc2\x02\n" +
+	"\vReleaseRule\x12b\n" +
+	"\n" +
+	"release_at\x18\x01 \x01(\tBA\xbaH>r<2:^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}(:[0-9]{2})?$H\x00R\treleaseAt\x12@\n" +
+	"\x15days_after_enrollment\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xc2\x1c(\x00H\x00R\x13daysAfterEnrollment\x12@\n" +
+	"\x16prerequisite_lesson_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14prerequisiteLessonId\x12<\n" +
+	"\x14prerequisite_quiz_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x12prerequisiteQuizIdB\r\n" +
+	"\x04rule\x12\x05\xbaH\x02\b\x01\"\xa5\x02\n" +
+	"\rReleaseStatus\x12\x16\n" +
+	"\x06locked\x18\x01 \x01(\bR\x06locked\x12 \n" +
+	"\tunlock_at\x18\x02 \x01(\tH\x00R\bunlockAt\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x01R\x06reason\x88\x01\x01\x129\n" +
+	"\x16prerequisite_lesson_id\x18\x04 \x01(\tH\x02R\x14prerequisiteLessonId\x88\x01\x01\x125\n" +
+	"\x14prerequisite_quiz_id\x18\x05 \x01(\tH\x03R\x12prerequisiteQuizId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_unlock_atB\t\n" +
+	"\a_reasonB\x19\n" +
+	"\x17_prerequisite_lesson_idB\x17\n" +
+	"\x15_prerequisite_quiz_idB*Z(github.com/abu-umair/be-lms-go/pb/commonb\x06proto3"
+
+var (
+	file_common_release_proto_rawDescOnce sync.Once
+	file_common_release_proto_rawDescData []byte
+)
+
+func file_common_release_proto_rawDescGZIP() []byte {
+	file_common_release_proto_rawDescOnce.Do(func() {
+		file_common_release_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_release_proto_rawDesc), len(file_common_release_proto_rawDesc)))
+	})
+	return file_common_release_proto_rawDescData
+}
+
+var file_common_release_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_common_release_proto_goTypes = []any{
+	(*ReleaseRule)(nil),   // 0: common.ReleaseRule
+	(*ReleaseStatus)(nil), // 1: common.ReleaseStatus
+}
+var file_common_release_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_common_release_proto_init() }
+func file_common_release_proto_init() {
+	if File_common_release_proto != nil {
+		return
+	}
+	file_common_release_proto_msgTypes[0].OneofWrappers = []any{
+		(*ReleaseRule_ReleaseAt)(nil),
+		(*ReleaseRule_DaysAfterEnrollment)(nil),
+		(*ReleaseRule_PrerequisiteLessonId)(nil),
+		(*ReleaseRule_PrerequisiteQuizId)(nil),
+	}
+	file_common_release_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_release_proto_rawDesc), len(file_common_release_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_release_proto_goTypes,
+		DependencyIndexes: file_common_release_proto_depIdxs,
+		MessageInfos:      file_common_release_proto_msgTypes,
+	}.Build()
+	File_common_release_proto = out.File
+	file_common_release_proto_goTypes = nil
+	file_common_release_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package course
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	common "github.com/abu-umair/be-lms-go/pb/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -1012,11 +1013,14 @@ func (x *DeleteCourseRequest) GetId() string {
 	return ""
 }
 
+// DeleteCourseResponse: chapter & lesson aktif di course ikut dihapus (cascade), bisa dikembalikan lewat RestoreCourse
 type DeleteCourseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DeletedChapters int64                  `protobuf:"varint,2,opt,name=deleted_chapters,json=deletedChapters,proto3" json:"deleted_chapters,omitempty"`
+	DeletedLessons  int64                  `protobuf:"varint,3,opt,name=deleted_lessons,json=deletedLessons,proto3" json:"deleted_lessons,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteCourseResponse) Reset() {
@@ -1056,14 +1060,133 @@ func (x *DeleteCourseResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
+func (x *DeleteCourseResponse) GetDeletedChapters() int64 {
+	if x != nil {
+		return x.DeletedChapters
+	}
+	return 0
+}
+
+func (x *DeleteCourseResponse) GetDeletedLessons() int64 {
+	if x != nil {
+		return x.DeletedLessons
+	}
+	return 0
+}
+
+// RestoreCourseRequest: hanya mengembalikan course beserta chapter & lesson yang ikut terhapus saat course dihapus,
+// chapter / lesson yang sudah dihapus sendiri sebelumnya tetap di trash
+type RestoreCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCourseRequest) Reset() {
+	*x = RestoreCourseRequest{}
+	mi := &file_course_course_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCourseRequest) ProtoMessage() {}
+
+func (x *RestoreCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_course_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCourseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCourseRequest) Descriptor() ([]byte, []int) {
+	return file_course_course_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreCourseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreCourseResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Base             *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RestoredChapters int64                  `protobuf:"varint,2,opt,name=restored_chapters,json=restoredChapters,proto3" json:"restored_chapters,omitempty"`
+	RestoredLessons  int64                  `protobuf:"varint,3,opt,name=restored_lessons,json=restoredLessons,proto3" json:"restored_lessons,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RestoreCourseResponse) Reset() {
+	*x = RestoreCourseResponse{}
+	mi := &file_course_course_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCourseResponse) ProtoMessage() {}
+
+func (x *RestoreCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_course_course_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCourseResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCourseResponse) Descriptor() ([]byte, []int) {
+	return file_course_course_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreCourseResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreCourseResponse) GetRestoredChapters() int64 {
+	if x != nil {
+		return x.RestoredChapters
+	}
+	return 0
+}
+
+func (x *RestoreCourseResponse) GetRestoredLessons() int64 {
+	if x != nil {
+		return x.RestoredLessons
+	}
+	return 0
+}
+
 var File_course_course_proto protoreflect.FileDescriptor
 
 const file_course_course_proto_rawDesc = "" +
 	"\n" +
-	"\x13course/course.proto\x12\x06course\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\"\xd7\f\n" +
-	"\x13CreateCourseRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1e\n" +
+	"\x13course/course.proto\x12\x06course\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/api/annotations.proto\"\xd5\f\n" +
+	"\x13CreateCourseRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12'\n" +
 	"\aaddress\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\aaddress\x88\x01\x01\x122\n" +
@@ -1123,10 +1246,9 @@ const file_course_course_proto_rawDesc = "" +
 	"\x0e_instructor_id\"P\n" +
 	"\x14CreateCourseResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"l\n" +
-	"\x13DetailCourseRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x129\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"j\n" +
+	"\x13DetailCourseRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x129\n" +
 	"\n" +
 	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xa6\r\n" +
 	"\x14DetailCourseResponse\x12(\n" +
@@ -1206,10 +1328,9 @@ const file_course_course_proto_rawDesc = "" +
 	"\v_updated_atB\r\n" +
 	"\v_updated_byB\r\n" +
 	"\v_deleted_atB\x12\n" +
-	"\x10_image_file_name\"\xd5\f\n" +
-	"\x11EditCourseRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1e\n" +
+	"\x10_image_file_name\"\xd3\f\n" +
+	"\x11EditCourseRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12'\n" +
 	"\aaddress\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\aaddress\x88\x01\x01\x122\n" +
@@ -1269,18 +1390,26 @@ const file_course_course_proto_rawDesc = "" +
 	"\x0e_instructor_id\"N\n" +
 	"\x12EditCourseResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"1\n" +
-	"\x13DeleteCourseRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"@\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"/\n" +
+	"\x13DeleteCourseRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x94\x01\n" +
 	"\x14DeleteCourseResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xb5\x02\n" +
-	"\rCourseService\x12I\n" +
-	"\fCreateCourse\x12\x1b.course.CreateCourseRequest\x1a\x1c.course.CreateCourseResponse\x12I\n" +
-	"\fDetailCourse\x12\x1b.course.DetailCourseRequest\x1a\x1c.course.DetailCourseResponse\x12C\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12)\n" +
+	"\x10deleted_chapters\x18\x02 \x01(\x03R\x0fdeletedChapters\x12'\n" +
+	"\x0fdeleted_lessons\x18\x03 \x01(\x03R\x0edeletedLessons\"0\n" +
+	"\x14RestoreCourseRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x99\x01\n" +
+	"\x15RestoreCourseResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12+\n" +
+	"\x11restored_chapters\x18\x02 \x01(\x03R\x10restoredChapters\x12)\n" +
+	"\x10restored_lessons\x18\x03 \x01(\x03R\x0frestoredLessons2\x91\x04\n" +
+	"\rCourseService\x12a\n" +
+	"\fCreateCourse\x12\x1b.course.CreateCourseRequest\x1a\x1c.course.CreateCourseResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/courses\x12c\n" +
+	"\fDetailCourse\x12\x1b.course.DetailCourseRequest\x1a\x1c.course.DetailCourseResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/courses/{id}\x12`\n" +
 	"\n" +
-	"EditCourse\x12\x19.course.EditCourseRequest\x1a\x1a.course.EditCourseResponse\x12I\n" +
-	"\fDeleteCourse\x12\x1b.course.DeleteCourseRequest\x1a\x1c.course.DeleteCourseResponseB*Z(github.com/abu-umair/be-lms-go/pb/courseb\x06proto3"
+	"EditCourse\x12\x19.course.EditCourseRequest\x1a\x1a.course.EditCourseResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/courses/{id}\x12c\n" +
+	"\fDeleteCourse\x12\x1b.course.DeleteCourseRequest\x1a\x1c.course.DeleteCourseResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/courses/{id}\x12q\n" +
+	"\rRestoreCourse\x12\x1c.course.RestoreCourseRequest\x1a\x1d.course.RestoreCourseResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/courses/{id}:restoreB*Z(github.com/abu-umair/be-lms-go/pb/courseb\x06proto3"
 
 var (
 	file_course_course_proto_rawDescOnce sync.Once
@@ -1294,7 +1423,7 @@ func file_course_course_proto_rawDescGZIP() []byte {
 	return file_course_course_proto_rawDescData
 }
 
-var file_course_course_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_course_course_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_course_course_proto_goTypes = []any{
 	(*CreateCourseRequest)(nil),   // 0: course.CreateCourseRequest
 	(*CreateCourseResponse)(nil),  // 1: course.CreateCourseResponse
@@ -1304,28 +1433,33 @@ var file_course_course_proto_goTypes = []any{
 	(*EditCourseResponse)(nil),    // 5: course.EditCourseResponse
 	(*DeleteCourseRequest)(nil),   // 6: course.DeleteCourseRequest
 	(*DeleteCourseResponse)(nil),  // 7: course.DeleteCourseResponse
-	(*common.BaseResponse)(nil),   // 8: common.BaseResponse
-	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
+	(*RestoreCourseRequest)(nil),  // 8: course.RestoreCourseRequest
+	(*RestoreCourseResponse)(nil), // 9: course.RestoreCourseResponse
+	(*common.BaseResponse)(nil),   // 10: common.BaseResponse
+	(*fieldmaskpb.FieldMask)(nil), // 11: google.protobuf.FieldMask
 }
 var file_course_course_proto_depIdxs = []int32{
-	8, // 0: course.CreateCourseResponse.base:type_name -> common.BaseResponse
-	9, // 1: course.DetailCourseRequest.field_mask:type_name -> google.protobuf.FieldMask
-	8, // 2: course.DetailCourseResponse.base:type_name -> common.BaseResponse
-	8, // 3: course.EditCourseResponse.base:type_name -> common.BaseResponse
-	8, // 4: course.DeleteCourseResponse.base:type_name -> common.BaseResponse
-	0, // 5: course.CourseService.CreateCourse:input_type -> course.CreateCourseRequest
-	2, // 6: course.CourseService.DetailCourse:input_type -> course.DetailCourseRequest
-	4, // 7: course.CourseService.EditCourse:input_type -> course.EditCourseRequest
-	6, // 8: course.CourseService.DeleteCourse:input_type -> course.DeleteCourseRequest
-	1, // 9: course.CourseService.CreateCourse:output_type -> course.CreateCourseResponse
-	3, // 10: course.CourseService.DetailCourse:output_type -> course.DetailCourseResponse
-	5, // 11: course.CourseService.EditCourse:output_type -> course.EditCourseResponse
-	7, // 12: course.CourseService.DeleteCourse:output_type -> course.DeleteCourseResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	10, // 0: course.CreateCourseResponse.base:type_name -> common.BaseResponse
+	11, // 1: course.DetailCourseRequest.field_mask:type_name -> google.protobuf.FieldMask
+	10, // 2: course.DetailCourseResponse.base:type_name -> common.BaseResponse
+	10, // 3: course.EditCourseResponse.base:type_name -> common.BaseResponse
+	10, // 4: course.DeleteCourseResponse.base:type_name -> common.BaseResponse
+	10, // 5: course.RestoreCourseResponse.base:type_name -> common.BaseResponse
+	0,  // 6: course.CourseService.CreateCourse:input_type -> course.CreateCourseRequest
+	2,  // 7: course.CourseService.DetailCourse:input_type -> course.DetailCourseRequest
+	4,  // 8: course.CourseService.EditCourse:input_type -> course.EditCourseRequest
+	6,  // 9: course.CourseService.DeleteCourse:input_type -> course.DeleteCourseRequest
+	8,  // 10: course.CourseService.RestoreCourse:input_type -> course.RestoreCourseRequest
+	1,  // 11: course.CourseService.CreateCourse:output_type -> course.CreateCourseResponse
+	3,  // 12: course.CourseService.DetailCourse:output_type -> course.DetailCourseResponse
+	5,  // 13: course.CourseService.EditCourse:output_type -> course.EditCourseResponse
+	7,  // 14: course.CourseService.DeleteCourse:output_type -> course.DeleteCourseResponse
+	9,  // 15: course.CourseService.RestoreCourse:output_type -> course.RestoreCourseResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_course_course_proto_init() }
@@ -1342,7 +1476,7 @@ func file_course_course_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_course_course_proto_rawDesc), len(file_course_course_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
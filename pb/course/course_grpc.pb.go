@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CourseService_CreateCourse_FullMethodName = "/course.CourseService/CreateCourse"
-	CourseService_DetailCourse_FullMethodName = "/course.CourseService/DetailCourse"
-	CourseService_EditCourse_FullMethodName   = "/course.CourseService/EditCourse"
-	CourseService_DeleteCourse_FullMethodName = "/course.CourseService/DeleteCourse"
+	CourseService_CreateCourse_FullMethodName  = "/course.CourseService/CreateCourse"
+	CourseService_DetailCourse_FullMethodName  = "/course.CourseService/DetailCourse"
+	CourseService_EditCourse_FullMethodName    = "/course.CourseService/EditCourse"
+	CourseService_DeleteCourse_FullMethodName  = "/course.CourseService/DeleteCourse"
+	CourseService_RestoreCourse_FullMethodName = "/course.CourseService/RestoreCourse"
 )
 
 // CourseServiceClient is the client API for CourseService service.
@@ -33,6 +34,7 @@ type CourseServiceClient interface {
 	DetailCourse(ctx context.Context, in *DetailCourseRequest, opts ...grpc.CallOption) (*DetailCourseResponse, error)
 	EditCourse(ctx context.Context, in *EditCourseRequest, opts ...grpc.CallOption) (*EditCourseResponse, error)
 	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error)
+	RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*RestoreCourseResponse, error)
 }
 
 type courseServiceClient struct {
@@ -83,6 +85,16 @@ func (c *courseServiceClient) DeleteCourse(ctx context.Context, in *DeleteCourse
 	return out, nil
 }
 
+func (c *courseServiceClient) RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*RestoreCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_RestoreCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CourseServiceServer is the server API for CourseService service.
 // All implementations must embed UnimplementedCourseServiceServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type CourseServiceServer interface {
 	DetailCourse(context.Context, *DetailCourseRequest) (*DetailCourseResponse, error)
 	EditCourse(context.Context, *EditCourseRequest) (*EditCourseResponse, error)
 	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
+	RestoreCourse(context.Context, *RestoreCourseRequest) (*RestoreCourseResponse, error)
 	mustEmbedUnimplementedCourseServiceServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedCourseServiceServer) EditCourse(context.Context, *EditCourseR
 func (UnimplementedCourseServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
 }
+func (UnimplementedCourseServiceServer) RestoreCourse(context.Context, *RestoreCourseRequest) (*RestoreCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCourse not implemented")
+}
 func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
 func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
 
@@ -206,6 +222,24 @@ func _CourseService_DeleteCourse_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseService_RestoreCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RestoreCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RestoreCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RestoreCourse(ctx, req.(*RestoreCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCourse",
 			Handler:    _CourseService_DeleteCourse_Handler,
 		},
+		{
+			MethodName: "RestoreCourse",
+			Handler:    _CourseService_RestoreCourse_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "course/course.proto",
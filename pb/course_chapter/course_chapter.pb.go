@@ -9,6 +9,7 @@ package course_chapter
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	common "github.com/abu-umair/be-lms-go/pb/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -524,11 +525,13 @@ func (x *DeleteCourseChapterRequest) GetId() string {
 	return ""
 }
 
+// DeleteCourseChapterResponse: lesson aktif di chapter ikut dihapus (cascade), bisa dikembalikan lewat RestoreCourseChapter
 type DeleteCourseChapterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DeletedLessons int64                  `protobuf:"varint,2,opt,name=deleted_lessons,json=deletedLessons,proto3" json:"deleted_lessons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeleteCourseChapterResponse) Reset() {
@@ -568,16 +571,576 @@ func (x *DeleteCourseChapterResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
+func (x *DeleteCourseChapterResponse) GetDeletedLessons() int64 {
+	if x != nil {
+		return x.DeletedLessons
+	}
+	return 0
+}
+
+// RestoreCourseChapterRequest: hanya chapter yang dihapus langsung (bukan ikut terhapus bersama course-nya) yang bisa di-restore.
+// Jika posisinya sudah dipakai chapter lain, chapter dipindah ke urutan terakhir.
+type RestoreCourseChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCourseChapterRequest) Reset() {
+	*x = RestoreCourseChapterRequest{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCourseChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCourseChapterRequest) ProtoMessage() {}
+
+func (x *RestoreCourseChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCourseChapterRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCourseChapterRequest) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreCourseChapterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreCourseChapterResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RestoredLessons int64                  `protobuf:"varint,2,opt,name=restored_lessons,json=restoredLessons,proto3" json:"restored_lessons,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RestoreCourseChapterResponse) Reset() {
+	*x = RestoreCourseChapterResponse{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCourseChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCourseChapterResponse) ProtoMessage() {}
+
+func (x *RestoreCourseChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCourseChapterResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCourseChapterResponse) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreCourseChapterResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreCourseChapterResponse) GetRestoredLessons() int64 {
+	if x != nil {
+		return x.RestoredLessons
+	}
+	return 0
+}
+
+// ReorderChaptersRequest: ordered_ids wajib berisi semua chapter (yang belum dihapus) milik course tsb, urutan baru = posisi di list (mulai 1)
+type ReorderChaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	OrderedIds    []string               `protobuf:"bytes,2,rep,name=ordered_ids,json=orderedIds,proto3" json:"ordered_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChaptersRequest) Reset() {
+	*x = ReorderChaptersRequest{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChaptersRequest) ProtoMessage() {}
+
+func (x *ReorderChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChaptersRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReorderChaptersRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ReorderChaptersRequest) GetOrderedIds() []string {
+	if x != nil {
+		return x.OrderedIds
+	}
+	return nil
+}
+
+type ReorderChaptersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChaptersResponse) Reset() {
+	*x = ReorderChaptersResponse{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChaptersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChaptersResponse) ProtoMessage() {}
+
+func (x *ReorderChaptersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChaptersResponse.ProtoReflect.Descriptor instead.
+func (*ReorderChaptersResponse) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReorderChaptersResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// ListCourseChaptersRequest: status kosong = semua status, field_mask sama dgn DetailCourseChapterRequest
+type ListCourseChaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseChaptersRequest) Reset() {
+	*x = ListCourseChaptersRequest{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseChaptersRequest) ProtoMessage() {}
+
+func (x *ListCourseChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseChaptersRequest.ProtoReflect.Descriptor instead.
+func (*ListCourseChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListCourseChaptersRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ListCourseChaptersRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListCourseChaptersRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// CourseChapterItem: field chapter (ikut field mask) + ringkasan lesson aktif di chapter (selalu diisi)
+type CourseChapterItem struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InstructorId         *string                `protobuf:"bytes,2,opt,name=instructor_id,json=instructorId,proto3,oneof" json:"instructor_id,omitempty"`
+	CourseId             *string                `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	Title                *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	OrderChapter         *int64                 `protobuf:"varint,5,opt,name=order_chapter,json=orderChapter,proto3,oneof" json:"order_chapter,omitempty"`
+	Status               *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	CreatedAt            *string                `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	CreatedBy            *string                `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	DeletedBy            *string                `protobuf:"bytes,9,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`
+	UpdatedAt            *string                `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	UpdatedBy            *string                `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	DeletedAt            *string                `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	LessonCount          int64                  `protobuf:"varint,13,opt,name=lesson_count,json=lessonCount,proto3" json:"lesson_count,omitempty"`
+	TotalDurationSeconds int64                  `protobuf:"varint,14,opt,name=total_duration_seconds,json=totalDurationSeconds,proto3" json:"total_duration_seconds,omitempty"` //? jumlah duration lesson yang formatnya dikenali (detik, MM:SS, HH:MM:SS, 1h30m)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CourseChapterItem) Reset() {
+	*x = CourseChapterItem{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseChapterItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseChapterItem) ProtoMessage() {}
+
+func (x *CourseChapterItem) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseChapterItem.ProtoReflect.Descriptor instead.
+func (*CourseChapterItem) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CourseChapterItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetInstructorId() string {
+	if x != nil && x.InstructorId != nil {
+		return *x.InstructorId
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetOrderChapter() int64 {
+	if x != nil && x.OrderChapter != nil {
+		return *x.OrderChapter
+	}
+	return 0
+}
+
+func (x *CourseChapterItem) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetCreatedAt() string {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetDeletedBy() string {
+	if x != nil && x.DeletedBy != nil {
+		return *x.DeletedBy
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetUpdatedAt() string {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetUpdatedBy() string {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetDeletedAt() string {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return ""
+}
+
+func (x *CourseChapterItem) GetLessonCount() int64 {
+	if x != nil {
+		return x.LessonCount
+	}
+	return 0
+}
+
+func (x *CourseChapterItem) GetTotalDurationSeconds() int64 {
+	if x != nil {
+		return x.TotalDurationSeconds
+	}
+	return 0
+}
+
+type ListCourseChaptersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*CourseChapterItem   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseChaptersResponse) Reset() {
+	*x = ListCourseChaptersResponse{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseChaptersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseChaptersResponse) ProtoMessage() {}
+
+func (x *ListCourseChaptersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseChaptersResponse.ProtoReflect.Descriptor instead.
+func (*ListCourseChaptersResponse) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCourseChaptersResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCourseChaptersResponse) GetItems() []*CourseChapterItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// SetChapterReleaseRuleRequest: aturan chapter berlaku utk semua lesson di dalamnya, rule kosong = hapus
+type SetChapterReleaseRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule          *common.ReleaseRule    `protobuf:"bytes,2,opt,name=rule,proto3,oneof" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChapterReleaseRuleRequest) Reset() {
+	*x = SetChapterReleaseRuleRequest{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChapterReleaseRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChapterReleaseRuleRequest) ProtoMessage() {}
+
+func (x *SetChapterReleaseRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChapterReleaseRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetChapterReleaseRuleRequest) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetChapterReleaseRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetChapterReleaseRuleRequest) GetRule() *common.ReleaseRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type SetChapterReleaseRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChapterReleaseRuleResponse) Reset() {
+	*x = SetChapterReleaseRuleResponse{}
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChapterReleaseRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChapterReleaseRuleResponse) ProtoMessage() {}
+
+func (x *SetChapterReleaseRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_course_chapter_course_chapter_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChapterReleaseRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetChapterReleaseRuleResponse) Descriptor() ([]byte, []int) {
+	return file_course_chapter_course_chapter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetChapterReleaseRuleResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
 var File_course_chapter_course_chapter_proto protoreflect.FileDescriptor
 
 const file_course_chapter_course_chapter_proto_rawDesc = "" +
 	"\n" +
-	"#course_chapter/course_chapter.proto\x12\x0ecourse_chapter\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\"\xe8\x01\n" +
+	"#course_chapter/course_chapter.proto\x12\x0ecourse_chapter\x1a\x1acommon/base_response.proto\x1a\x14common/release.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/api/annotations.proto\"\xe6\x01\n" +
 	"\x1aCreateCourseChapterRequest\x12/\n" +
 	"\rinstructor_id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\finstructorId\x12'\n" +
-	"\tcourse_id\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bcourseId\x12 \n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\finstructorId\x12%\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bcourseId\x12 \n" +
 	"\x05title\x18\x03 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12,\n" +
 	"\rorder_chapter\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\forderChapter\x12 \n" +
@@ -623,14 +1186,13 @@ const file_course_chapter_course_chapter_proto_rawDesc = "" +
 	"\v_deleted_byB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_updated_byB\r\n" +
-	"\v_deleted_at\"\x82\x02\n" +
+	"\v_deleted_at\"\x80\x02\n" +
 	"\x18EditCourseChapterRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12/\n" +
 	"\rinstructor_id\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\finstructorId\x12'\n" +
-	"\tcourse_id\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bcourseId\x12 \n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\finstructorId\x12%\n" +
+	"\tcourse_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bcourseId\x12 \n" +
 	"\x05title\x18\x04 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12,\n" +
 	"\rorder_chapter\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\forderChapter\x12 \n" +
@@ -640,14 +1202,81 @@ const file_course_chapter_course_chapter_proto_rawDesc = "" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"8\n" +
 	"\x1aDeleteCourseChapterRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"G\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"p\n" +
 	"\x1bDeleteCourseChapterResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xd0\x03\n" +
-	"\x14CourseChapterService\x12n\n" +
-	"\x13CreateCourseChapter\x12*.course_chapter.CreateCourseChapterRequest\x1a+.course_chapter.CreateCourseChapterResponse\x12n\n" +
-	"\x13DetailCourseChapter\x12*.course_chapter.DetailCourseChapterRequest\x1a+.course_chapter.DetailCourseChapterResponse\x12h\n" +
-	"\x11EditCourseChapter\x12(.course_chapter.EditCourseChapterRequest\x1a).course_chapter.EditCourseChapterResponse\x12n\n" +
-	"\x13DeleteCourseChapter\x12*.course_chapter.DeleteCourseChapterRequest\x1a+.course_chapter.DeleteCourseChapterResponseB2Z0github.com/abu-umair/be-lms-go/pb/course_chapterb\x06proto3"
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12'\n" +
+	"\x0fdeleted_lessons\x18\x02 \x01(\x03R\x0edeletedLessons\"7\n" +
+	"\x1bRestoreCourseChapterRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"s\n" +
+	"\x1cRestoreCourseChapterResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12)\n" +
+	"\x10restored_lessons\x18\x02 \x01(\x03R\x0frestoredLessons\"v\n" +
+	"\x16ReorderChaptersRequest\x12%\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bcourseId\x125\n" +
+	"\vordered_ids\x18\x02 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\xe8\a\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"orderedIds\"C\n" +
+	"\x17ReorderChaptersResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xb1\x01\n" +
+	"\x19ListCourseChaptersRequest\x12%\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bcourseId\x12'\n" +
+	"\x06status\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x06status\x88\x01\x01\x129\n" +
+	"\n" +
+	"field_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\t\n" +
+	"\a_status\"\xa3\x05\n" +
+	"\x11CourseChapterItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\rinstructor_id\x18\x02 \x01(\tH\x00R\finstructorId\x88\x01\x01\x12 \n" +
+	"\tcourse_id\x18\x03 \x01(\tH\x01R\bcourseId\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x04 \x01(\tH\x02R\x05title\x88\x01\x01\x12(\n" +
+	"\rorder_chapter\x18\x05 \x01(\x03H\x03R\forderChapter\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x06 \x01(\tH\x04R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tH\x05R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tH\x06R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_by\x18\t \x01(\tH\aR\tdeletedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tH\bR\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tH\tR\tupdatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\tH\n" +
+	"R\tdeletedAt\x88\x01\x01\x12!\n" +
+	"\flesson_count\x18\r \x01(\x03R\vlessonCount\x124\n" +
+	"\x16total_duration_seconds\x18\x0e \x01(\x03R\x14totalDurationSecondsB\x10\n" +
+	"\x0e_instructor_idB\f\n" +
+	"\n" +
+	"_course_idB\b\n" +
+	"\x06_titleB\x10\n" +
+	"\x0e_order_chapterB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_deleted_byB\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_updated_byB\r\n" +
+	"\v_deleted_at\"\x7f\n" +
+	"\x1aListCourseChaptersResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x127\n" +
+	"\x05items\x18\x02 \x03(\v2!.course_chapter.CourseChapterItemR\x05items\"o\n" +
+	"\x1cSetChapterReleaseRuleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\x04rule\x18\x02 \x01(\v2\x13.common.ReleaseRuleH\x00R\x04rule\x88\x01\x01B\a\n" +
+	"\x05_rule\"I\n" +
+	"\x1dSetChapterReleaseRuleResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xaf\t\n" +
+	"\x14CourseChapterService\x12\x87\x01\n" +
+	"\x13CreateCourseChapter\x12*.course_chapter.CreateCourseChapterRequest\x1a+.course_chapter.CreateCourseChapterResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/chapters\x12\x89\x01\n" +
+	"\x13DetailCourseChapter\x12*.course_chapter.DetailCourseChapterRequest\x1a+.course_chapter.DetailCourseChapterResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/chapters/{id}\x12\x86\x01\n" +
+	"\x11EditCourseChapter\x12(.course_chapter.EditCourseChapterRequest\x1a).course_chapter.EditCourseChapterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/chapters/{id}\x12\x89\x01\n" +
+	"\x13DeleteCourseChapter\x12*.course_chapter.DeleteCourseChapterRequest\x1a+.course_chapter.DeleteCourseChapterResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/chapters/{id}\x12\x97\x01\n" +
+	"\x14RestoreCourseChapter\x12+.course_chapter.RestoreCourseChapterRequest\x1a,.course_chapter.RestoreCourseChapterResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/chapters/{id}:restore\x12\x97\x01\n" +
+	"\x0fReorderChapters\x12&.course_chapter.ReorderChaptersRequest\x1a'.course_chapter.ReorderChaptersResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/courses/{course_id}/chapters:reorder\x12\x95\x01\n" +
+	"\x12ListCourseChapters\x12).course_chapter.ListCourseChaptersRequest\x1a*.course_chapter.ListCourseChaptersResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/courses/{course_id}/chapters\x12\x9f\x01\n" +
+	"\x15SetChapterReleaseRule\x12,.course_chapter.SetChapterReleaseRuleRequest\x1a-.course_chapter.SetChapterReleaseRuleResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/chapters/{id}/release-ruleB2Z0github.com/abu-umair/be-lms-go/pb/course_chapterb\x06proto3"
 
 var (
 	file_course_chapter_course_chapter_proto_rawDescOnce sync.Once
@@ -661,38 +1290,63 @@ func file_course_chapter_course_chapter_proto_rawDescGZIP() []byte {
 	return file_course_chapter_course_chapter_proto_rawDescData
 }
 
-var file_course_chapter_course_chapter_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_course_chapter_course_chapter_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_course_chapter_course_chapter_proto_goTypes = []any{
-	(*CreateCourseChapterRequest)(nil),  // 0: course_chapter.CreateCourseChapterRequest
-	(*CreateCourseChapterResponse)(nil), // 1: course_chapter.CreateCourseChapterResponse
-	(*DetailCourseChapterRequest)(nil),  // 2: course_chapter.DetailCourseChapterRequest
-	(*DetailCourseChapterResponse)(nil), // 3: course_chapter.DetailCourseChapterResponse
-	(*EditCourseChapterRequest)(nil),    // 4: course_chapter.EditCourseChapterRequest
-	(*EditCourseChapterResponse)(nil),   // 5: course_chapter.EditCourseChapterResponse
-	(*DeleteCourseChapterRequest)(nil),  // 6: course_chapter.DeleteCourseChapterRequest
-	(*DeleteCourseChapterResponse)(nil), // 7: course_chapter.DeleteCourseChapterResponse
-	(*common.BaseResponse)(nil),         // 8: common.BaseResponse
-	(*fieldmaskpb.FieldMask)(nil),       // 9: google.protobuf.FieldMask
+	(*CreateCourseChapterRequest)(nil),    // 0: course_chapter.CreateCourseChapterRequest
+	(*CreateCourseChapterResponse)(nil),   // 1: course_chapter.CreateCourseChapterResponse
+	(*DetailCourseChapterRequest)(nil),    // 2: course_chapter.DetailCourseChapterRequest
+	(*DetailCourseChapterResponse)(nil),   // 3: course_chapter.DetailCourseChapterResponse
+	(*EditCourseChapterRequest)(nil),      // 4: course_chapter.EditCourseChapterRequest
+	(*EditCourseChapterResponse)(nil),     // 5: course_chapter.EditCourseChapterResponse
+	(*DeleteCourseChapterRequest)(nil),    // 6: course_chapter.DeleteCourseChapterRequest
+	(*DeleteCourseChapterResponse)(nil),   // 7: course_chapter.DeleteCourseChapterResponse
+	(*RestoreCourseChapterRequest)(nil),   // 8: course_chapter.RestoreCourseChapterRequest
+	(*RestoreCourseChapterResponse)(nil),  // 9: course_chapter.RestoreCourseChapterResponse
+	(*ReorderChaptersRequest)(nil),        // 10: course_chapter.ReorderChaptersRequest
+	(*ReorderChaptersResponse)(nil),       // 11: course_chapter.ReorderChaptersResponse
+	(*ListCourseChaptersRequest)(nil),     // 12: course_chapter.ListCourseChaptersRequest
+	(*CourseChapterItem)(nil),             // 13: course_chapter.CourseChapterItem
+	(*ListCourseChaptersResponse)(nil),    // 14: course_chapter.ListCourseChaptersResponse
+	(*SetChapterReleaseRuleRequest)(nil),  // 15: course_chapter.SetChapterReleaseRuleRequest
+	(*SetChapterReleaseRuleResponse)(nil), // 16: course_chapter.SetChapterReleaseRuleResponse
+	(*common.BaseResponse)(nil),           // 17: common.BaseResponse
+	(*fieldmaskpb.FieldMask)(nil),         // 18: google.protobuf.FieldMask
+	(*common.ReleaseRule)(nil),            // 19: common.ReleaseRule
 }
 var file_course_chapter_course_chapter_proto_depIdxs = []int32{
-	8, // 0: course_chapter.CreateCourseChapterResponse.base:type_name -> common.BaseResponse
-	9, // 1: course_chapter.DetailCourseChapterRequest.field_mask:type_name -> google.protobuf.FieldMask
-	8, // 2: course_chapter.DetailCourseChapterResponse.base:type_name -> common.BaseResponse
-	8, // 3: course_chapter.EditCourseChapterResponse.base:type_name -> common.BaseResponse
-	8, // 4: course_chapter.DeleteCourseChapterResponse.base:type_name -> common.BaseResponse
-	0, // 5: course_chapter.CourseChapterService.CreateCourseChapter:input_type -> course_chapter.CreateCourseChapterRequest
-	2, // 6: course_chapter.CourseChapterService.DetailCourseChapter:input_type -> course_chapter.DetailCourseChapterRequest
-	4, // 7: course_chapter.CourseChapterService.EditCourseChapter:input_type -> course_chapter.EditCourseChapterRequest
-	6, // 8: course_chapter.CourseChapterService.DeleteCourseChapter:input_type -> course_chapter.DeleteCourseChapterRequest
-	1, // 9: course_chapter.CourseChapterService.CreateCourseChapter:output_type -> course_chapter.CreateCourseChapterResponse
-	3, // 10: course_chapter.CourseChapterService.DetailCourseChapter:output_type -> course_chapter.DetailCourseChapterResponse
-	5, // 11: course_chapter.CourseChapterService.EditCourseChapter:output_type -> course_chapter.EditCourseChapterResponse
-	7, // 12: course_chapter.CourseChapterService.DeleteCourseChapter:output_type -> course_chapter.DeleteCourseChapterResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	17, // 0: course_chapter.CreateCourseChapterResponse.base:type_name -> common.BaseResponse
+	18, // 1: course_chapter.DetailCourseChapterRequest.field_mask:type_name -> google.protobuf.FieldMask
+	17, // 2: course_chapter.DetailCourseChapterResponse.base:type_name -> common.BaseResponse
+	17, // 3: course_chapter.EditCourseChapterResponse.base:type_name -> common.BaseResponse
+	17, // 4: course_chapter.DeleteCourseChapterResponse.base:type_name -> common.BaseResponse
+	17, // 5: course_chapter.RestoreCourseChapterResponse.base:type_name -> common.BaseResponse
+	17, // 6: course_chapter.ReorderChaptersResponse.base:type_name -> common.BaseResponse
+	18, // 7: course_chapter.ListCourseChaptersRequest.field_mask:type_name -> google.protobuf.FieldMask
+	17, // 8: course_chapter.ListCourseChaptersResponse.base:type_name -> common.BaseResponse
+	13, // 9: course_chapter.ListCourseChaptersResponse.items:type_name -> course_chapter.CourseChapterItem
+	19, // 10: course_chapter.SetChapterReleaseRuleRequest.rule:type_name -> common.ReleaseRule
+	17, // 11: course_chapter.SetChapterReleaseRuleResponse.base:type_name -> common.BaseResponse
+	0,  // 12: course_chapter.CourseChapterService.CreateCourseChapter:input_type -> course_chapter.CreateCourseChapterRequest
+	2,  // 13: course_chapter.CourseChapterService.DetailCourseChapter:input_type -> course_chapter.DetailCourseChapterRequest
+	4,  // 14: course_chapter.CourseChapterService.EditCourseChapter:input_type -> course_chapter.EditCourseChapterRequest
+	6,  // 15: course_chapter.CourseChapterService.DeleteCourseChapter:input_type -> course_chapter.DeleteCourseChapterRequest
+	8,  // 16: course_chapter.CourseChapterService.RestoreCourseChapter:input_type -> course_chapter.RestoreCourseChapterRequest
+	10, // 17: course_chapter.CourseChapterService.ReorderChapters:input_type -> course_chapter.ReorderChaptersRequest
+	12, // 18: course_chapter.CourseChapterService.ListCourseChapters:input_type -> course_chapter.ListCourseChaptersRequest
+	15, // 19: course_chapter.CourseChapterService.SetChapterReleaseRule:input_type -> course_chapter.SetChapterReleaseRuleRequest
+	1,  // 20: course_chapter.CourseChapterService.CreateCourseChapter:output_type -> course_chapter.CreateCourseChapterResponse
+	3,  // 21: course_chapter.CourseChapterService.DetailCourseChapter:output_type -> course_chapter.DetailCourseChapterResponse
+	5,  // 22: course_chapter.CourseChapterService.EditCourseChapter:output_type -> course_chapter.EditCourseChapterResponse
+	7,  // 23: course_chapter.CourseChapterService.DeleteCourseChapter:output_type -> course_chapter.DeleteCourseChapterResponse
+	9,  // 24: course_chapter.CourseChapterService.RestoreCourseChapter:output_type -> course_chapter.RestoreCourseChapterResponse
+	11, // 25: course_chapter.CourseChapterService.ReorderChapters:output_type -> course_chapter.ReorderChaptersResponse
+	14, // 26: course_chapter.CourseChapterService.ListCourseChapters:output_type -> course_chapter.ListCourseChaptersResponse
+	16, // 27: course_chapter.CourseChapterService.SetChapterReleaseRule:output_type -> course_chapter.SetChapterReleaseRuleResponse
+	20, // [20:28] is the sub-list for method output_type
+	12, // [12:20] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_course_chapter_course_chapter_proto_init() }
@@ -701,13 +1355,16 @@ func file_course_chapter_course_chapter_proto_init() {
 		return
 	}
 	file_course_chapter_course_chapter_proto_msgTypes[3].OneofWrappers = []any{}
+	file_course_chapter_course_chapter_proto_msgTypes[12].OneofWrappers = []any{}
+	file_course_chapter_course_chapter_proto_msgTypes[13].OneofWrappers = []any{}
+	file_course_chapter_course_chapter_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_course_chapter_course_chapter_proto_rawDesc), len(file_course_chapter_course_chapter_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
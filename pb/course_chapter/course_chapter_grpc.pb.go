@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CourseChapterService_CreateCourseChapter_FullMethodName = "/course_chapter.CourseChapterService/CreateCourseChapter"
-	CourseChapterService_DetailCourseChapter_FullMethodName = "/course_chapter.CourseChapterService/DetailCourseChapter"
-	CourseChapterService_EditCourseChapter_FullMethodName   = "/course_chapter.CourseChapterService/EditCourseChapter"
-	CourseChapterService_DeleteCourseChapter_FullMethodName = "/course_chapter.CourseChapterService/DeleteCourseChapter"
+	CourseChapterService_CreateCourseChapter_FullMethodName   = "/course_chapter.CourseChapterService/CreateCourseChapter"
+	CourseChapterService_DetailCourseChapter_FullMethodName   = "/course_chapter.CourseChapterService/DetailCourseChapter"
+	CourseChapterService_EditCourseChapter_FullMethodName     = "/course_chapter.CourseChapterService/EditCourseChapter"
+	CourseChapterService_DeleteCourseChapter_FullMethodName   = "/course_chapter.CourseChapterService/DeleteCourseChapter"
+	CourseChapterService_RestoreCourseChapter_FullMethodName  = "/course_chapter.CourseChapterService/RestoreCourseChapter"
+	CourseChapterService_ReorderChapters_FullMethodName       = "/course_chapter.CourseChapterService/ReorderChapters"
+	CourseChapterService_ListCourseChapters_FullMethodName    = "/course_chapter.CourseChapterService/ListCourseChapters"
+	CourseChapterService_SetChapterReleaseRule_FullMethodName = "/course_chapter.CourseChapterService/SetChapterReleaseRule"
 )
 
 // CourseChapterServiceClient is the client API for CourseChapterService service.
@@ -33,6 +37,10 @@ type CourseChapterServiceClient interface {
 	DetailCourseChapter(ctx context.Context, in *DetailCourseChapterRequest, opts ...grpc.CallOption) (*DetailCourseChapterResponse, error)
 	EditCourseChapter(ctx context.Context, in *EditCourseChapterRequest, opts ...grpc.CallOption) (*EditCourseChapterResponse, error)
 	DeleteCourseChapter(ctx context.Context, in *DeleteCourseChapterRequest, opts ...grpc.CallOption) (*DeleteCourseChapterResponse, error)
+	RestoreCourseChapter(ctx context.Context, in *RestoreCourseChapterRequest, opts ...grpc.CallOption) (*RestoreCourseChapterResponse, error)
+	ReorderChapters(ctx context.Context, in *ReorderChaptersRequest, opts ...grpc.CallOption) (*ReorderChaptersResponse, error)
+	ListCourseChapters(ctx context.Context, in *ListCourseChaptersRequest, opts ...grpc.CallOption) (*ListCourseChaptersResponse, error)
+	SetChapterReleaseRule(ctx context.Context, in *SetChapterReleaseRuleRequest, opts ...grpc.CallOption) (*SetChapterReleaseRuleResponse, error)
 }
 
 type courseChapterServiceClient struct {
@@ -83,6 +91,46 @@ func (c *courseChapterServiceClient) DeleteCourseChapter(ctx context.Context, in
 	return out, nil
 }
 
+func (c *courseChapterServiceClient) RestoreCourseChapter(ctx context.Context, in *RestoreCourseChapterRequest, opts ...grpc.CallOption) (*RestoreCourseChapterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCourseChapterResponse)
+	err := c.cc.Invoke(ctx, CourseChapterService_RestoreCourseChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseChapterServiceClient) ReorderChapters(ctx context.Context, in *ReorderChaptersRequest, opts ...grpc.CallOption) (*ReorderChaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderChaptersResponse)
+	err := c.cc.Invoke(ctx, CourseChapterService_ReorderChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseChapterServiceClient) ListCourseChapters(ctx context.Context, in *ListCourseChaptersRequest, opts ...grpc.CallOption) (*ListCourseChaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourseChaptersResponse)
+	err := c.cc.Invoke(ctx, CourseChapterService_ListCourseChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseChapterServiceClient) SetChapterReleaseRule(ctx context.Context, in *SetChapterReleaseRuleRequest, opts ...grpc.CallOption) (*SetChapterReleaseRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChapterReleaseRuleResponse)
+	err := c.cc.Invoke(ctx, CourseChapterService_SetChapterReleaseRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CourseChapterServiceServer is the server API for CourseChapterService service.
 // All implementations must embed UnimplementedCourseChapterServiceServer
 // for forward compatibility.
@@ -91,6 +139,10 @@ type CourseChapterServiceServer interface {
 	DetailCourseChapter(context.Context, *DetailCourseChapterRequest) (*DetailCourseChapterResponse, error)
 	EditCourseChapter(context.Context, *EditCourseChapterRequest) (*EditCourseChapterResponse, error)
 	DeleteCourseChapter(context.Context, *DeleteCourseChapterRequest) (*DeleteCourseChapterResponse, error)
+	RestoreCourseChapter(context.Context, *RestoreCourseChapterRequest) (*RestoreCourseChapterResponse, error)
+	ReorderChapters(context.Context, *ReorderChaptersRequest) (*ReorderChaptersResponse, error)
+	ListCourseChapters(context.Context, *ListCourseChaptersRequest) (*ListCourseChaptersResponse, error)
+	SetChapterReleaseRule(context.Context, *SetChapterReleaseRuleRequest) (*SetChapterReleaseRuleResponse, error)
 	mustEmbedUnimplementedCourseChapterServiceServer()
 }
 
@@ -113,6 +165,18 @@ func (UnimplementedCourseChapterServiceServer) EditCourseChapter(context.Context
 func (UnimplementedCourseChapterServiceServer) DeleteCourseChapter(context.Context, *DeleteCourseChapterRequest) (*DeleteCourseChapterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourseChapter not implemented")
 }
+func (UnimplementedCourseChapterServiceServer) RestoreCourseChapter(context.Context, *RestoreCourseChapterRequest) (*RestoreCourseChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCourseChapter not implemented")
+}
+func (UnimplementedCourseChapterServiceServer) ReorderChapters(context.Context, *ReorderChaptersRequest) (*ReorderChaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChapters not implemented")
+}
+func (UnimplementedCourseChapterServiceServer) ListCourseChapters(context.Context, *ListCourseChaptersRequest) (*ListCourseChaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourseChapters not implemented")
+}
+func (UnimplementedCourseChapterServiceServer) SetChapterReleaseRule(context.Context, *SetChapterReleaseRuleRequest) (*SetChapterReleaseRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChapterReleaseRule not implemented")
+}
 func (UnimplementedCourseChapterServiceServer) mustEmbedUnimplementedCourseChapterServiceServer() {}
 func (UnimplementedCourseChapterServiceServer) testEmbeddedByValue()                              {}
 
@@ -206,6 +270,78 @@ func _CourseChapterService_DeleteCourseChapter_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseChapterService_RestoreCourseChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCourseChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseChapterServiceServer).RestoreCourseChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseChapterService_RestoreCourseChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseChapterServiceServer).RestoreCourseChapter(ctx, req.(*RestoreCourseChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseChapterService_ReorderChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseChapterServiceServer).ReorderChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseChapterService_ReorderChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseChapterServiceServer).ReorderChapters(ctx, req.(*ReorderChaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseChapterService_ListCourseChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourseChaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseChapterServiceServer).ListCourseChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseChapterService_ListCourseChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseChapterServiceServer).ListCourseChapters(ctx, req.(*ListCourseChaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseChapterService_SetChapterReleaseRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChapterReleaseRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseChapterServiceServer).SetChapterReleaseRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseChapterService_SetChapterReleaseRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseChapterServiceServer).SetChapterReleaseRule(ctx, req.(*SetChapterReleaseRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CourseChapterService_ServiceDesc is the grpc.ServiceDesc for CourseChapterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +365,22 @@ var CourseChapterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCourseChapter",
 			Handler:    _CourseChapterService_DeleteCourseChapter_Handler,
 		},
+		{
+			MethodName: "RestoreCourseChapter",
+			Handler:    _CourseChapterService_RestoreCourseChapter_Handler,
+		},
+		{
+			MethodName: "ReorderChapters",
+			Handler:    _CourseChapterService_ReorderChapters_Handler,
+		},
+		{
+			MethodName: "ListCourseChapters",
+			Handler:    _CourseChapterService_ListCourseChapters_Handler,
+		},
+		{
+			MethodName: "SetChapterReleaseRule",
+			Handler:    _CourseChapterService_SetChapterReleaseRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "course_chapter/course_chapter.proto",
@@ -0,0 +1,2879 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: quiz/quiz.proto
+
+package quiz
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/abu-umair/be-lms-go/pb/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuizSettings: aturan quiz, dipakai Create, Edit & Detail
+type QuizSettings struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Title               string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description         *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	TimeLimitSeconds    *int64                 `protobuf:"varint,3,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3,oneof" json:"time_limit_seconds,omitempty"` //? kosong = tanpa batas waktu
+	MaxAttempts         *int64                 `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3,oneof" json:"max_attempts,omitempty"`                  //? kosong = tanpa batas attempt
+	PassMarkPercent     int64                  `protobuf:"varint,5,opt,name=pass_mark_percent,json=passMarkPercent,proto3" json:"pass_mark_percent,omitempty"`
+	QuestionsPerAttempt *int64                 `protobuf:"varint,6,opt,name=questions_per_attempt,json=questionsPerAttempt,proto3,oneof" json:"questions_per_attempt,omitempty"` //? diambil acak dari bank soal, kosong = semua soal
+	ShuffleQuestions    bool                   `protobuf:"varint,7,opt,name=shuffle_questions,json=shuffleQuestions,proto3" json:"shuffle_questions,omitempty"`
+	ShuffleOptions      bool                   `protobuf:"varint,8,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *QuizSettings) Reset() {
+	*x = QuizSettings{}
+	mi := &file_quiz_quiz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizSettings) ProtoMessage() {}
+
+func (x *QuizSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizSettings.ProtoReflect.Descriptor instead.
+func (*QuizSettings) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuizSettings) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *QuizSettings) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *QuizSettings) GetTimeLimitSeconds() int64 {
+	if x != nil && x.TimeLimitSeconds != nil {
+		return *x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *QuizSettings) GetMaxAttempts() int64 {
+	if x != nil && x.MaxAttempts != nil {
+		return *x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *QuizSettings) GetPassMarkPercent() int64 {
+	if x != nil {
+		return x.PassMarkPercent
+	}
+	return 0
+}
+
+func (x *QuizSettings) GetQuestionsPerAttempt() int64 {
+	if x != nil && x.QuestionsPerAttempt != nil {
+		return *x.QuestionsPerAttempt
+	}
+	return 0
+}
+
+func (x *QuizSettings) GetShuffleQuestions() bool {
+	if x != nil {
+		return x.ShuffleQuestions
+	}
+	return false
+}
+
+func (x *QuizSettings) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
+	}
+	return false
+}
+
+type CreateQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Settings      *QuizSettings          `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuizRequest) Reset() {
+	*x = CreateQuizRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizRequest) ProtoMessage() {}
+
+func (x *CreateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateQuizRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetSettings() *QuizSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type CreateQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuizResponse) Reset() {
+	*x = CreateQuizResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizResponse) ProtoMessage() {}
+
+func (x *CreateQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuizResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateQuizResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateQuizResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DetailQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailQuizRequest) Reset() {
+	*x = DetailQuizRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailQuizRequest) ProtoMessage() {}
+
+func (x *DetailQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailQuizRequest.ProtoReflect.Descriptor instead.
+func (*DetailQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DetailQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DetailQuizResponse: questions (beserta kunci jawaban) hanya untuk instructor pemilik course
+type DetailQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	LessonId      string                 `protobuf:"bytes,3,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Settings      *QuizSettings          `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	QuestionCount int64                  `protobuf:"varint,6,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	Questions     []*QuizQuestion        `protobuf:"bytes,7,rep,name=questions,proto3" json:"questions,omitempty"`
+	AttemptsUsed  int64                  `protobuf:"varint,8,opt,name=attempts_used,json=attemptsUsed,proto3" json:"attempts_used,omitempty"` //? attempt milik user yang login
+	Passed        bool                   `protobuf:"varint,9,opt,name=passed,proto3" json:"passed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailQuizResponse) Reset() {
+	*x = DetailQuizResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailQuizResponse) ProtoMessage() {}
+
+func (x *DetailQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailQuizResponse.ProtoReflect.Descriptor instead.
+func (*DetailQuizResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DetailQuizResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailQuizResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailQuizResponse) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *DetailQuizResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *DetailQuizResponse) GetSettings() *QuizSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *DetailQuizResponse) GetQuestionCount() int64 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *DetailQuizResponse) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *DetailQuizResponse) GetAttemptsUsed() int64 {
+	if x != nil {
+		return x.AttemptsUsed
+	}
+	return 0
+}
+
+func (x *DetailQuizResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+type EditQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Settings      *QuizSettings          `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditQuizRequest) Reset() {
+	*x = EditQuizRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditQuizRequest) ProtoMessage() {}
+
+func (x *EditQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditQuizRequest.ProtoReflect.Descriptor instead.
+func (*EditQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EditQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditQuizRequest) GetSettings() *QuizSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type EditQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditQuizResponse) Reset() {
+	*x = EditQuizResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditQuizResponse) ProtoMessage() {}
+
+func (x *EditQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditQuizResponse.ProtoReflect.Descriptor instead.
+func (*EditQuizResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EditQuizResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditQuizResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuizRequest) Reset() {
+	*x = DeleteQuizRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuizRequest) ProtoMessage() {}
+
+func (x *DeleteQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuizRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuizResponse) Reset() {
+	*x = DeleteQuizResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuizResponse) ProtoMessage() {}
+
+func (x *DeleteQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuizResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuizResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteQuizResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// QuestionOption: id dibuat client (unik dalam satu soal), dipakai utk kunci jawaban & jawaban learner
+type QuestionOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionOption) Reset() {
+	*x = QuestionOption{}
+	mi := &file_quiz_quiz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionOption) ProtoMessage() {}
+
+func (x *QuestionOption) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionOption.ProtoReflect.Descriptor instead.
+func (*QuestionOption) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QuestionOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SingleChoiceQuestion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Options         []*QuestionOption      `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	CorrectOptionId string                 `protobuf:"bytes,2,opt,name=correct_option_id,json=correctOptionId,proto3" json:"correct_option_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SingleChoiceQuestion) Reset() {
+	*x = SingleChoiceQuestion{}
+	mi := &file_quiz_quiz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleChoiceQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleChoiceQuestion) ProtoMessage() {}
+
+func (x *SingleChoiceQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleChoiceQuestion.ProtoReflect.Descriptor instead.
+func (*SingleChoiceQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SingleChoiceQuestion) GetOptions() []*QuestionOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SingleChoiceQuestion) GetCorrectOptionId() string {
+	if x != nil {
+		return x.CorrectOptionId
+	}
+	return ""
+}
+
+type MultipleChoiceQuestion struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Options          []*QuestionOption      `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	CorrectOptionIds []string               `protobuf:"bytes,2,rep,name=correct_option_ids,json=correctOptionIds,proto3" json:"correct_option_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MultipleChoiceQuestion) Reset() {
+	*x = MultipleChoiceQuestion{}
+	mi := &file_quiz_quiz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleChoiceQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleChoiceQuestion) ProtoMessage() {}
+
+func (x *MultipleChoiceQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleChoiceQuestion.ProtoReflect.Descriptor instead.
+func (*MultipleChoiceQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MultipleChoiceQuestion) GetOptions() []*QuestionOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MultipleChoiceQuestion) GetCorrectOptionIds() []string {
+	if x != nil {
+		return x.CorrectOptionIds
+	}
+	return nil
+}
+
+type TrueFalseQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrectAnswer bool                   `protobuf:"varint,1,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrueFalseQuestion) Reset() {
+	*x = TrueFalseQuestion{}
+	mi := &file_quiz_quiz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrueFalseQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrueFalseQuestion) ProtoMessage() {}
+
+func (x *TrueFalseQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrueFalseQuestion.ProtoReflect.Descriptor instead.
+func (*TrueFalseQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TrueFalseQuestion) GetCorrectAnswer() bool {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return false
+}
+
+type ShortAnswerQuestion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AcceptedAnswers []string               `protobuf:"bytes,1,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+	CaseSensitive   bool                   `protobuf:"varint,2,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShortAnswerQuestion) Reset() {
+	*x = ShortAnswerQuestion{}
+	mi := &file_quiz_quiz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortAnswerQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortAnswerQuestion) ProtoMessage() {}
+
+func (x *ShortAnswerQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortAnswerQuestion.ProtoReflect.Descriptor instead.
+func (*ShortAnswerQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ShortAnswerQuestion) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *ShortAnswerQuestion) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+// OrderingQuestion: urutan benar = urutan items di sini, learner menerima items dalam urutan acak
+type OrderingQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*QuestionOption      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderingQuestion) Reset() {
+	*x = OrderingQuestion{}
+	mi := &file_quiz_quiz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderingQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderingQuestion) ProtoMessage() {}
+
+func (x *OrderingQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderingQuestion.ProtoReflect.Descriptor instead.
+func (*OrderingQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OrderingQuestion) GetItems() []*QuestionOption {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// QuestionContent: jenis soal beserta kunci jawabannya
+type QuestionContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*QuestionContent_SingleChoice
+	//	*QuestionContent_MultipleChoice
+	//	*QuestionContent_TrueFalse
+	//	*QuestionContent_ShortAnswer
+	//	*QuestionContent_Ordering
+	Type          isQuestionContent_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionContent) Reset() {
+	*x = QuestionContent{}
+	mi := &file_quiz_quiz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionContent) ProtoMessage() {}
+
+func (x *QuestionContent) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionContent.ProtoReflect.Descriptor instead.
+func (*QuestionContent) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QuestionContent) GetType() isQuestionContent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *QuestionContent) GetSingleChoice() *SingleChoiceQuestion {
+	if x != nil {
+		if x, ok := x.Type.(*QuestionContent_SingleChoice); ok {
+			return x.SingleChoice
+		}
+	}
+	return nil
+}
+
+func (x *QuestionContent) GetMultipleChoice() *MultipleChoiceQuestion {
+	if x != nil {
+		if x, ok := x.Type.(*QuestionContent_MultipleChoice); ok {
+			return x.MultipleChoice
+		}
+	}
+	return nil
+}
+
+func (x *QuestionContent) GetTrueFalse() *TrueFalseQuestion {
+	if x != nil {
+		if x, ok := x.Type.(*QuestionContent_TrueFalse); ok {
+			return x.TrueFalse
+		}
+	}
+	return nil
+}
+
+func (x *QuestionContent) GetShortAnswer() *ShortAnswerQuestion {
+	if x != nil {
+		if x, ok := x.Type.(*QuestionContent_ShortAnswer); ok {
+			return x.ShortAnswer
+		}
+	}
+	return nil
+}
+
+func (x *QuestionContent) GetOrdering() *OrderingQuestion {
+	if x != nil {
+		if x, ok := x.Type.(*QuestionContent_Ordering); ok {
+			return x.Ordering
+		}
+	}
+	return nil
+}
+
+type isQuestionContent_Type interface {
+	isQuestionContent_Type()
+}
+
+type QuestionContent_SingleChoice struct {
+	SingleChoice *SingleChoiceQuestion `protobuf:"bytes,1,opt,name=single_choice,json=singleChoice,proto3,oneof"`
+}
+
+type QuestionContent_MultipleChoice struct {
+	MultipleChoice *MultipleChoiceQuestion `protobuf:"bytes,2,opt,name=multiple_choice,json=multipleChoice,proto3,oneof"`
+}
+
+type QuestionContent_TrueFalse struct {
+	TrueFalse *TrueFalseQuestion `protobuf:"bytes,3,opt,name=true_false,json=trueFalse,proto3,oneof"`
+}
+
+type QuestionContent_ShortAnswer struct {
+	ShortAnswer *ShortAnswerQuestion `protobuf:"bytes,4,opt,name=short_answer,json=shortAnswer,proto3,oneof"`
+}
+
+type QuestionContent_Ordering struct {
+	Ordering *OrderingQuestion `protobuf:"bytes,5,opt,name=ordering,proto3,oneof"`
+}
+
+func (*QuestionContent_SingleChoice) isQuestionContent_Type() {}
+
+func (*QuestionContent_MultipleChoice) isQuestionContent_Type() {}
+
+func (*QuestionContent_TrueFalse) isQuestionContent_Type() {}
+
+func (*QuestionContent_ShortAnswer) isQuestionContent_Type() {}
+
+func (*QuestionContent_Ordering) isQuestionContent_Type() {}
+
+type QuizQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Points        int64                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	OrderQuestion int64                  `protobuf:"varint,4,opt,name=order_question,json=orderQuestion,proto3" json:"order_question,omitempty"`
+	Content       *QuestionContent       `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizQuestion) Reset() {
+	*x = QuizQuestion{}
+	mi := &file_quiz_quiz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestion) ProtoMessage() {}
+
+func (x *QuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestion.ProtoReflect.Descriptor instead.
+func (*QuizQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuizQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetOrderQuestion() int64 {
+	if x != nil {
+		return x.OrderQuestion
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetContent() *QuestionContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CreateQuizQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Points        int64                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Content       *QuestionContent       `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuizQuestionRequest) Reset() {
+	*x = CreateQuizQuestionRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuizQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuizQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateQuizQuestionRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *CreateQuizQuestionRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *CreateQuizQuestionRequest) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CreateQuizQuestionRequest) GetContent() *QuestionContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CreateQuizQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuizQuestionResponse) Reset() {
+	*x = CreateQuizQuestionResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizQuestionResponse) ProtoMessage() {}
+
+func (x *CreateQuizQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizQuestionResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuizQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateQuizQuestionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateQuizQuestionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EditQuizQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Points        int64                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Content       *QuestionContent       `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditQuizQuestionRequest) Reset() {
+	*x = EditQuizQuestionRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditQuizQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditQuizQuestionRequest) ProtoMessage() {}
+
+func (x *EditQuizQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditQuizQuestionRequest.ProtoReflect.Descriptor instead.
+func (*EditQuizQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EditQuizQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditQuizQuestionRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *EditQuizQuestionRequest) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *EditQuizQuestionRequest) GetContent() *QuestionContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type EditQuizQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditQuizQuestionResponse) Reset() {
+	*x = EditQuizQuestionResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditQuizQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditQuizQuestionResponse) ProtoMessage() {}
+
+func (x *EditQuizQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditQuizQuestionResponse.ProtoReflect.Descriptor instead.
+func (*EditQuizQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EditQuizQuestionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditQuizQuestionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteQuizQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuizQuestionRequest) Reset() {
+	*x = DeleteQuizQuestionRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuizQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuizQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteQuizQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuizQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuizQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteQuizQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteQuizQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuizQuestionResponse) Reset() {
+	*x = DeleteQuizQuestionResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuizQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuizQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteQuizQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuizQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuizQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteQuizQuestionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// AttemptQuestion: soal yang dikerjakan learner, tanpa kunci jawaban (options sudah dalam urutan tampil)
+type AttemptQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionType  string                 `protobuf:"bytes,2,opt,name=question_type,json=questionType,proto3" json:"question_type,omitempty"` //? single_choice, multiple_choice, true_false, short_answer, ordering
+	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Points        int64                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Options       []*QuestionOption      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttemptQuestion) Reset() {
+	*x = AttemptQuestion{}
+	mi := &file_quiz_quiz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttemptQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttemptQuestion) ProtoMessage() {}
+
+func (x *AttemptQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttemptQuestion.ProtoReflect.Descriptor instead.
+func (*AttemptQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AttemptQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttemptQuestion) GetQuestionType() string {
+	if x != nil {
+		return x.QuestionType
+	}
+	return ""
+}
+
+func (x *AttemptQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *AttemptQuestion) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *AttemptQuestion) GetOptions() []*QuestionOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// QuestionAnswer: option_ids utk single / multiple choice & ordering (urutan jawaban), true_false & text sesuai jenis soal
+type QuestionAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	OptionIds     []string               `protobuf:"bytes,2,rep,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	TrueFalse     *bool                  `protobuf:"varint,3,opt,name=true_false,json=trueFalse,proto3,oneof" json:"true_false,omitempty"`
+	Text          *string                `protobuf:"bytes,4,opt,name=text,proto3,oneof" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionAnswer) Reset() {
+	*x = QuestionAnswer{}
+	mi := &file_quiz_quiz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionAnswer) ProtoMessage() {}
+
+func (x *QuestionAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionAnswer.ProtoReflect.Descriptor instead.
+func (*QuestionAnswer) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuestionAnswer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionAnswer) GetOptionIds() []string {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *QuestionAnswer) GetTrueFalse() bool {
+	if x != nil && x.TrueFalse != nil {
+		return *x.TrueFalse
+	}
+	return false
+}
+
+func (x *QuestionAnswer) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+// AttemptQuestionResult: riwayat jawaban per soal, content (kunci jawaban) hanya dikirim ke instructor
+type AttemptQuestionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionType  string                 `protobuf:"bytes,2,opt,name=question_type,json=questionType,proto3" json:"question_type,omitempty"`
+	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Points        int64                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Options       []*QuestionOption      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Content       *QuestionContent       `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Answer        *QuestionAnswer        `protobuf:"bytes,7,opt,name=answer,proto3" json:"answer,omitempty"`
+	Correct       bool                   `protobuf:"varint,8,opt,name=correct,proto3" json:"correct,omitempty"`
+	PointsAwarded int64                  `protobuf:"varint,9,opt,name=points_awarded,json=pointsAwarded,proto3" json:"points_awarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttemptQuestionResult) Reset() {
+	*x = AttemptQuestionResult{}
+	mi := &file_quiz_quiz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttemptQuestionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttemptQuestionResult) ProtoMessage() {}
+
+func (x *AttemptQuestionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttemptQuestionResult.ProtoReflect.Descriptor instead.
+func (*AttemptQuestionResult) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AttemptQuestionResult) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AttemptQuestionResult) GetQuestionType() string {
+	if x != nil {
+		return x.QuestionType
+	}
+	return ""
+}
+
+func (x *AttemptQuestionResult) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *AttemptQuestionResult) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *AttemptQuestionResult) GetOptions() []*QuestionOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *AttemptQuestionResult) GetContent() *QuestionContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *AttemptQuestionResult) GetAnswer() *QuestionAnswer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *AttemptQuestionResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *AttemptQuestionResult) GetPointsAwarded() int64 {
+	if x != nil {
+		return x.PointsAwarded
+	}
+	return 0
+}
+
+// QuizAttemptSheet: snapshot soal saat attempt dimulai & jawabannya, disimpan di quiz_attempts.sheet (jsonb)
+type QuizAttemptSheet struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Questions     []*AttemptQuestionResult `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAttemptSheet) Reset() {
+	*x = QuizAttemptSheet{}
+	mi := &file_quiz_quiz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAttemptSheet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAttemptSheet) ProtoMessage() {}
+
+func (x *QuizAttemptSheet) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAttemptSheet.ProtoReflect.Descriptor instead.
+func (*QuizAttemptSheet) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QuizAttemptSheet) GetQuestions() []*AttemptQuestionResult {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type StartQuizAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartQuizAttemptRequest) Reset() {
+	*x = StartQuizAttemptRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartQuizAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartQuizAttemptRequest) ProtoMessage() {}
+
+func (x *StartQuizAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartQuizAttemptRequest.ProtoReflect.Descriptor instead.
+func (*StartQuizAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StartQuizAttemptRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+// StartQuizAttemptResponse: attempt yang masih berjalan dilanjutkan (tidak membuat attempt baru)
+type StartQuizAttemptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	AttemptNumber int64                  `protobuf:"varint,3,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
+	ExpiresAt     *string                `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	Questions     []*AttemptQuestion     `protobuf:"bytes,5,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartQuizAttemptResponse) Reset() {
+	*x = StartQuizAttemptResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartQuizAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartQuizAttemptResponse) ProtoMessage() {}
+
+func (x *StartQuizAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartQuizAttemptResponse.ProtoReflect.Descriptor instead.
+func (*StartQuizAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StartQuizAttemptResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *StartQuizAttemptResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StartQuizAttemptResponse) GetAttemptNumber() int64 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *StartQuizAttemptResponse) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *StartQuizAttemptResponse) GetQuestions() []*AttemptQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type SubmitQuizAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Answers       []*QuestionAnswer      `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitQuizAttemptRequest) Reset() {
+	*x = SubmitQuizAttemptRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitQuizAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuizAttemptRequest) ProtoMessage() {}
+
+func (x *SubmitQuizAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuizAttemptRequest.ProtoReflect.Descriptor instead.
+func (*SubmitQuizAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubmitQuizAttemptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmitQuizAttemptRequest) GetAnswers() []*QuestionAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type SubmitQuizAttemptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Attempt       *QuizAttemptSummary    `protobuf:"bytes,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitQuizAttemptResponse) Reset() {
+	*x = SubmitQuizAttemptResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitQuizAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuizAttemptResponse) ProtoMessage() {}
+
+func (x *SubmitQuizAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuizAttemptResponse.ProtoReflect.Descriptor instead.
+func (*SubmitQuizAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitQuizAttemptResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SubmitQuizAttemptResponse) GetAttempt() *QuizAttemptSummary {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+// QuizAttemptSummary: expired = dikumpulkan melewati batas waktu, semua jawaban dianggap kosong
+type QuizAttemptSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AttemptNumber int64                  `protobuf:"varint,4,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ExpiresAt     *string                `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	SubmittedAt   *string                `protobuf:"bytes,7,opt,name=submitted_at,json=submittedAt,proto3,oneof" json:"submitted_at,omitempty"`
+	Score         *int64                 `protobuf:"varint,8,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxScore      int64                  `protobuf:"varint,9,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	ScorePercent  *int64                 `protobuf:"varint,10,opt,name=score_percent,json=scorePercent,proto3,oneof" json:"score_percent,omitempty"`
+	Passed        bool                   `protobuf:"varint,11,opt,name=passed,proto3" json:"passed,omitempty"`
+	Expired       bool                   `protobuf:"varint,12,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAttemptSummary) Reset() {
+	*x = QuizAttemptSummary{}
+	mi := &file_quiz_quiz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAttemptSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAttemptSummary) ProtoMessage() {}
+
+func (x *QuizAttemptSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAttemptSummary.ProtoReflect.Descriptor instead.
+func (*QuizAttemptSummary) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QuizAttemptSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuizAttemptSummary) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizAttemptSummary) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizAttemptSummary) GetAttemptNumber() int64 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *QuizAttemptSummary) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *QuizAttemptSummary) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *QuizAttemptSummary) GetSubmittedAt() string {
+	if x != nil && x.SubmittedAt != nil {
+		return *x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *QuizAttemptSummary) GetScore() int64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *QuizAttemptSummary) GetMaxScore() int64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *QuizAttemptSummary) GetScorePercent() int64 {
+	if x != nil && x.ScorePercent != nil {
+		return *x.ScorePercent
+	}
+	return 0
+}
+
+func (x *QuizAttemptSummary) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *QuizAttemptSummary) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+// ListQuizAttemptsRequest: instructor melihat attempt semua learner (bisa difilter user_id), learner hanya attempt miliknya
+type ListQuizAttemptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizAttemptsRequest) Reset() {
+	*x = ListQuizAttemptsRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizAttemptsRequest) ProtoMessage() {}
+
+func (x *ListQuizAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuizAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListQuizAttemptsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *ListQuizAttemptsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type ListQuizAttemptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*QuizAttemptSummary  `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizAttemptsResponse) Reset() {
+	*x = ListQuizAttemptsResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizAttemptsResponse) ProtoMessage() {}
+
+func (x *ListQuizAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuizAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListQuizAttemptsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListQuizAttemptsResponse) GetItems() []*QuizAttemptSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DetailQuizAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailQuizAttemptRequest) Reset() {
+	*x = DetailQuizAttemptRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailQuizAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailQuizAttemptRequest) ProtoMessage() {}
+
+func (x *DetailQuizAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailQuizAttemptRequest.ProtoReflect.Descriptor instead.
+func (*DetailQuizAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DetailQuizAttemptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DetailQuizAttemptResponse: results hanya terisi setelah attempt dikumpulkan
+type DetailQuizAttemptResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Base          *common.BaseResponse     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Attempt       *QuizAttemptSummary      `protobuf:"bytes,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Results       []*AttemptQuestionResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailQuizAttemptResponse) Reset() {
+	*x = DetailQuizAttemptResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailQuizAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailQuizAttemptResponse) ProtoMessage() {}
+
+func (x *DetailQuizAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailQuizAttemptResponse.ProtoReflect.Descriptor instead.
+func (*DetailQuizAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DetailQuizAttemptResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailQuizAttemptResponse) GetAttempt() *QuizAttemptSummary {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *DetailQuizAttemptResponse) GetResults() []*AttemptQuestionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// GetCourseQuizProgressRequest: user_id hanya boleh diisi instructor, kosong = user yang login
+type GetCourseQuizProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseQuizProgressRequest) Reset() {
+	*x = GetCourseQuizProgressRequest{}
+	mi := &file_quiz_quiz_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseQuizProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseQuizProgressRequest) ProtoMessage() {}
+
+func (x *GetCourseQuizProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseQuizProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseQuizProgressRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCourseQuizProgressRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseQuizProgressRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type QuizProgressItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuizId           string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	LessonId         string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	PassMarkPercent  int64                  `protobuf:"varint,4,opt,name=pass_mark_percent,json=passMarkPercent,proto3" json:"pass_mark_percent,omitempty"`
+	AttemptsUsed     int64                  `protobuf:"varint,5,opt,name=attempts_used,json=attemptsUsed,proto3" json:"attempts_used,omitempty"`
+	MaxAttempts      *int64                 `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3,oneof" json:"max_attempts,omitempty"`
+	BestScorePercent *int64                 `protobuf:"varint,7,opt,name=best_score_percent,json=bestScorePercent,proto3,oneof" json:"best_score_percent,omitempty"`
+	Passed           bool                   `protobuf:"varint,8,opt,name=passed,proto3" json:"passed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuizProgressItem) Reset() {
+	*x = QuizProgressItem{}
+	mi := &file_quiz_quiz_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizProgressItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizProgressItem) ProtoMessage() {}
+
+func (x *QuizProgressItem) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizProgressItem.ProtoReflect.Descriptor instead.
+func (*QuizProgressItem) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QuizProgressItem) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizProgressItem) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *QuizProgressItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *QuizProgressItem) GetPassMarkPercent() int64 {
+	if x != nil {
+		return x.PassMarkPercent
+	}
+	return 0
+}
+
+func (x *QuizProgressItem) GetAttemptsUsed() int64 {
+	if x != nil {
+		return x.AttemptsUsed
+	}
+	return 0
+}
+
+func (x *QuizProgressItem) GetMaxAttempts() int64 {
+	if x != nil && x.MaxAttempts != nil {
+		return *x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *QuizProgressItem) GetBestScorePercent() int64 {
+	if x != nil && x.BestScorePercent != nil {
+		return *x.BestScorePercent
+	}
+	return 0
+}
+
+func (x *QuizProgressItem) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+// GetCourseQuizProgressResponse: course baru boleh dinyatakan selesai (dihitung di client) jika all_quizzes_passed
+type GetCourseQuizProgressResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items []*QuizProgressItem    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// all_quizzes_passed: true jika setiap quiz aktif di course sudah lulus pass mark (juga true jika course tanpa quiz)
+	AllQuizzesPassed bool `protobuf:"varint,3,opt,name=all_quizzes_passed,json=allQuizzesPassed,proto3" json:"all_quizzes_passed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCourseQuizProgressResponse) Reset() {
+	*x = GetCourseQuizProgressResponse{}
+	mi := &file_quiz_quiz_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseQuizProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseQuizProgressResponse) ProtoMessage() {}
+
+func (x *GetCourseQuizProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quiz_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseQuizProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseQuizProgressResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_quiz_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCourseQuizProgressResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetCourseQuizProgressResponse) GetItems() []*QuizProgressItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetCourseQuizProgressResponse) GetAllQuizzesPassed() bool {
+	if x != nil {
+		return x.AllQuizzesPassed
+	}
+	return false
+}
+
+var File_quiz_quiz_proto protoreflect.FileDescriptor
+
+const file_quiz_quiz_proto_rawDesc = "" +
+	"\n" +
+	"\x0fquiz/quiz.proto\x12\x04quiz\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xf8\x03\n" +
+	"\fQuizSettings\x12 \n" +
+	"\x05title\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12/\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x00R\vdescription\x88\x01\x01\x12>\n" +
+	"\x12time_limit_seconds\x18\x03 \x01(\x03B\v\xbaH\b\"\x06\x18\x80\xa3\x05 \x00H\x01R\x10timeLimitSeconds\x88\x01\x01\x121\n" +
+	"\fmax_attempts\x18\x04 \x01(\x03B\t\xbaH\x06\"\x04\x18d \x00H\x02R\vmaxAttempts\x88\x01\x01\x125\n" +
+	"\x11pass_mark_percent\x18\x05 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x00R\x0fpassMarkPercent\x12C\n" +
+	"\x15questions_per_attempt\x18\x06 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xf4\x03 \x00H\x03R\x13questionsPerAttempt\x88\x01\x01\x12+\n" +
+	"\x11shuffle_questions\x18\a \x01(\bR\x10shuffleQuestions\x12'\n" +
+	"\x0fshuffle_options\x18\b \x01(\bR\x0eshuffleOptionsB\x0e\n" +
+	"\f_descriptionB\x15\n" +
+	"\x13_time_limit_secondsB\x0f\n" +
+	"\r_max_attemptsB\x18\n" +
+	"\x16_questions_per_attempt\"r\n" +
+	"\x11CreateQuizRequest\x12%\n" +
+	"\tlesson_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\blessonId\x126\n" +
+	"\bsettings\x18\x02 \x01(\v2\x12.quiz.QuizSettingsB\x06\xbaH\x03\xc8\x01\x01R\bsettings\"N\n" +
+	"\x12CreateQuizResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"-\n" +
+	"\x11DetailQuizRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xce\x02\n" +
+	"\x12DetailQuizResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
+	"\tlesson_id\x18\x03 \x01(\tR\blessonId\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\tR\bcourseId\x12.\n" +
+	"\bsettings\x18\x05 \x01(\v2\x12.quiz.QuizSettingsR\bsettings\x12%\n" +
+	"\x0equestion_count\x18\x06 \x01(\x03R\rquestionCount\x120\n" +
+	"\tquestions\x18\a \x03(\v2\x12.quiz.QuizQuestionR\tquestions\x12#\n" +
+	"\rattempts_used\x18\b \x01(\x03R\fattemptsUsed\x12\x16\n" +
+	"\x06passed\x18\t \x01(\bR\x06passed\"c\n" +
+	"\x0fEditQuizRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x126\n" +
+	"\bsettings\x18\x02 \x01(\v2\x12.quiz.QuizSettingsB\x06\xbaH\x03\xc8\x01\x01R\bsettings\"L\n" +
+	"\x10EditQuizResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"-\n" +
+	"\x11DeleteQuizRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\">\n" +
+	"\x12DeleteQuizResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"^\n" +
+	"\x0eQuestionOption\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xbaH\x19r\x172\x15^[A-Za-z0-9_-]{1,36}$R\x02id\x12\x1e\n" +
+	"\x04text\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\x04text\"\x87\x01\n" +
+	"\x14SingleChoiceQuestion\x12:\n" +
+	"\aoptions\x18\x01 \x03(\v2\x14.quiz.QuestionOptionB\n" +
+	"\xbaH\a\x92\x01\x04\b\x02\x10\x14R\aoptions\x123\n" +
+	"\x11correct_option_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0fcorrectOptionId\"\x90\x01\n" +
+	"\x16MultipleChoiceQuestion\x12:\n" +
+	"\aoptions\x18\x01 \x03(\v2\x14.quiz.QuestionOptionB\n" +
+	"\xbaH\a\x92\x01\x04\b\x02\x10\x14R\aoptions\x12:\n" +
+	"\x12correct_option_ids\x18\x02 \x03(\tB\f\xbaH\t\x92\x01\x06\b\x01\x10\x14\x18\x01R\x10correctOptionIds\":\n" +
+	"\x11TrueFalseQuestion\x12%\n" +
+	"\x0ecorrect_answer\x18\x01 \x01(\bR\rcorrectAnswer\"|\n" +
+	"\x13ShortAnswerQuestion\x12>\n" +
+	"\x10accepted_answers\x18\x01 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10\x14\"\ar\x05\x10\x01\x18\xff\x01R\x0facceptedAnswers\x12%\n" +
+	"\x0ecase_sensitive\x18\x02 \x01(\bR\rcaseSensitive\"J\n" +
+	"\x10OrderingQuestion\x126\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.quiz.QuestionOptionB\n" +
+	"\xbaH\a\x92\x01\x04\b\x02\x10\x14R\x05items\"\xdc\x02\n" +
+	"\x0fQuestionContent\x12A\n" +
+	"\rsingle_choice\x18\x01 \x01(\v2\x1a.quiz.SingleChoiceQuestionH\x00R\fsingleChoice\x12G\n" +
+	"\x0fmultiple_choice\x18\x02 \x01(\v2\x1c.quiz.MultipleChoiceQuestionH\x00R\x0emultipleChoice\x128\n" +
+	"\n" +
+	"true_false\x18\x03 \x01(\v2\x17.quiz.TrueFalseQuestionH\x00R\ttrueFalse\x12>\n" +
+	"\fshort_answer\x18\x04 \x01(\v2\x19.quiz.ShortAnswerQuestionH\x00R\vshortAnswer\x124\n" +
+	"\bordering\x18\x05 \x01(\v2\x16.quiz.OrderingQuestionH\x00R\borderingB\r\n" +
+	"\x04type\x12\x05\xbaH\x02\b\x01\"\xa6\x01\n" +
+	"\fQuizQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x03R\x06points\x12%\n" +
+	"\x0eorder_question\x18\x04 \x01(\x03R\rorderQuestion\x12/\n" +
+	"\acontent\x18\x05 \x01(\v2\x15.quiz.QuestionContentR\acontent\"\xbf\x01\n" +
+	"\x19CreateQuizQuestionRequest\x12!\n" +
+	"\aquiz_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06quizId\x12\"\n" +
+	"\x06prompt\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x90NR\x06prompt\x12\"\n" +
+	"\x06points\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a \x00R\x06points\x127\n" +
+	"\acontent\x18\x04 \x01(\v2\x15.quiz.QuestionContentB\x06\xbaH\x03\xc8\x01\x01R\acontent\"V\n" +
+	"\x1aCreateQuizQuestionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xb4\x01\n" +
+	"\x17EditQuizQuestionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\"\n" +
+	"\x06prompt\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x90NR\x06prompt\x12\"\n" +
+	"\x06points\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a \x00R\x06points\x127\n" +
+	"\acontent\x18\x04 \x01(\v2\x15.quiz.QuestionContentB\x06\xbaH\x03\xc8\x01\x01R\acontent\"T\n" +
+	"\x18EditQuizQuestionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"5\n" +
+	"\x19DeleteQuizQuestionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"F\n" +
+	"\x1aDeleteQuizQuestionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xa6\x01\n" +
+	"\x0fAttemptQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rquestion_type\x18\x02 \x01(\tR\fquestionType\x12\x16\n" +
+	"\x06prompt\x18\x03 \x01(\tR\x06prompt\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x03R\x06points\x12.\n" +
+	"\aoptions\x18\x05 \x03(\v2\x14.quiz.QuestionOptionR\aoptions\"\xcc\x01\n" +
+	"\x0eQuestionAnswer\x12*\n" +
+	"\vquestion_id\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18$R\n" +
+	"questionId\x12/\n" +
+	"\n" +
+	"option_ids\x18\x02 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18$R\toptionIds\x12\"\n" +
+	"\n" +
+	"true_false\x18\x03 \x01(\bH\x00R\ttrueFalse\x88\x01\x01\x12!\n" +
+	"\x04text\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\x04text\x88\x01\x01B\r\n" +
+	"\v_true_falseB\a\n" +
+	"\x05_text\"\xdd\x02\n" +
+	"\x15AttemptQuestionResult\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12#\n" +
+	"\rquestion_type\x18\x02 \x01(\tR\fquestionType\x12\x16\n" +
+	"\x06prompt\x18\x03 \x01(\tR\x06prompt\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x03R\x06points\x12.\n" +
+	"\aoptions\x18\x05 \x03(\v2\x14.quiz.QuestionOptionR\aoptions\x12/\n" +
+	"\acontent\x18\x06 \x01(\v2\x15.quiz.QuestionContentR\acontent\x12,\n" +
+	"\x06answer\x18\a \x01(\v2\x14.quiz.QuestionAnswerR\x06answer\x12\x18\n" +
+	"\acorrect\x18\b \x01(\bR\acorrect\x12%\n" +
+	"\x0epoints_awarded\x18\t \x01(\x03R\rpointsAwarded\"M\n" +
+	"\x10QuizAttemptSheet\x129\n" +
+	"\tquestions\x18\x01 \x03(\v2\x1b.quiz.AttemptQuestionResultR\tquestions\"<\n" +
+	"\x17StartQuizAttemptRequest\x12!\n" +
+	"\aquiz_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06quizId\"\xe3\x01\n" +
+	"\x18StartQuizAttemptResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12%\n" +
+	"\x0eattempt_number\x18\x03 \x01(\x03R\rattemptNumber\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tH\x00R\texpiresAt\x88\x01\x01\x123\n" +
+	"\tquestions\x18\x05 \x03(\v2\x15.quiz.AttemptQuestionR\tquestionsB\r\n" +
+	"\v_expires_at\"o\n" +
+	"\x18SubmitQuizAttemptRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x129\n" +
+	"\aanswers\x18\x02 \x03(\v2\x14.quiz.QuestionAnswerB\t\xbaH\x06\x92\x01\x03\x10\xf4\x03R\aanswers\"y\n" +
+	"\x19SubmitQuizAttemptResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x122\n" +
+	"\aattempt\x18\x02 \x01(\v2\x18.quiz.QuizAttemptSummaryR\aattempt\"\xb8\x03\n" +
+	"\x12QuizAttemptSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12%\n" +
+	"\x0eattempt_number\x18\x04 \x01(\x03R\rattemptNumber\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tH\x00R\texpiresAt\x88\x01\x01\x12&\n" +
+	"\fsubmitted_at\x18\a \x01(\tH\x01R\vsubmittedAt\x88\x01\x01\x12\x19\n" +
+	"\x05score\x18\b \x01(\x03H\x02R\x05score\x88\x01\x01\x12\x1b\n" +
+	"\tmax_score\x18\t \x01(\x03R\bmaxScore\x12(\n" +
+	"\rscore_percent\x18\n" +
+	" \x01(\x03H\x03R\fscorePercent\x88\x01\x01\x12\x16\n" +
+	"\x06passed\x18\v \x01(\bR\x06passed\x12\x18\n" +
+	"\aexpired\x18\f \x01(\bR\aexpiredB\r\n" +
+	"\v_expires_atB\x0f\n" +
+	"\r_submitted_atB\b\n" +
+	"\x06_scoreB\x10\n" +
+	"\x0e_score_percent\"p\n" +
+	"\x17ListQuizAttemptsRequest\x12!\n" +
+	"\aquiz_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06quizId\x12&\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"t\n" +
+	"\x18ListQuizAttemptsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12.\n" +
+	"\x05items\x18\x02 \x03(\v2\x18.quiz.QuizAttemptSummaryR\x05items\"4\n" +
+	"\x18DetailQuizAttemptRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xb0\x01\n" +
+	"\x19DetailQuizAttemptResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x122\n" +
+	"\aattempt\x18\x02 \x01(\v2\x18.quiz.QuizAttemptSummaryR\aattempt\x125\n" +
+	"\aresults\x18\x03 \x03(\v2\x1b.quiz.AttemptQuestionResultR\aresults\"y\n" +
+	"\x1cGetCourseQuizProgressRequest\x12%\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bcourseId\x12&\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\xca\x02\n" +
+	"\x10QuizProgressItem\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1b\n" +
+	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12*\n" +
+	"\x11pass_mark_percent\x18\x04 \x01(\x03R\x0fpassMarkPercent\x12#\n" +
+	"\rattempts_used\x18\x05 \x01(\x03R\fattemptsUsed\x12&\n" +
+	"\fmax_attempts\x18\x06 \x01(\x03H\x00R\vmaxAttempts\x88\x01\x01\x121\n" +
+	"\x12best_score_percent\x18\a \x01(\x03H\x01R\x10bestScorePercent\x88\x01\x01\x12\x16\n" +
+	"\x06passed\x18\b \x01(\bR\x06passedB\x0f\n" +
+	"\r_max_attemptsB\x15\n" +
+	"\x13_best_score_percent\"\xa5\x01\n" +
+	"\x1dGetCourseQuizProgressResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12,\n" +
+	"\x05items\x18\x02 \x03(\v2\x16.quiz.QuizProgressItemR\x05items\x12,\n" +
+	"\x12all_quizzes_passed\x18\x03 \x01(\bR\x10allQuizzesPassed2\xe9\n" +
+	"\n" +
+	"\vQuizService\x12h\n" +
+	"\n" +
+	"CreateQuiz\x12\x17.quiz.CreateQuizRequest\x1a\x18.quiz.CreateQuizResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/lessons/{lesson_id}/quiz\x12Y\n" +
+	"\n" +
+	"DetailQuiz\x12\x17.quiz.DetailQuizRequest\x1a\x18.quiz.DetailQuizResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/quizzes/{id}\x12V\n" +
+	"\bEditQuiz\x12\x15.quiz.EditQuizRequest\x1a\x16.quiz.EditQuizResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/quizzes/{id}\x12Y\n" +
+	"\n" +
+	"DeleteQuiz\x12\x17.quiz.DeleteQuizRequest\x1a\x18.quiz.DeleteQuizResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/quizzes/{id}\x12\x83\x01\n" +
+	"\x12CreateQuizQuestion\x12\x1f.quiz.CreateQuizQuestionRequest\x1a .quiz.CreateQuizQuestionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/quizzes/{quiz_id}/questions\x12p\n" +
+	"\x10EditQuizQuestion\x12\x1d.quiz.EditQuizQuestionRequest\x1a\x1e.quiz.EditQuizQuestionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/questions/{id}\x12s\n" +
+	"\x12DeleteQuizQuestion\x12\x1f.quiz.DeleteQuizQuestionRequest\x1a .quiz.DeleteQuizQuestionResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/questions/{id}\x12|\n" +
+	"\x10StartQuizAttempt\x12\x1d.quiz.StartQuizAttemptRequest\x1a\x1e.quiz.StartQuizAttemptResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/quizzes/{quiz_id}/attempts\x12y\n" +
+	"\x11SubmitQuizAttempt\x12\x1e.quiz.SubmitQuizAttemptRequest\x1a\x1f.quiz.SubmitQuizAttemptResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/attempts/{id}:submit\x12y\n" +
+	"\x10ListQuizAttempts\x12\x1d.quiz.ListQuizAttemptsRequest\x1a\x1e.quiz.ListQuizAttemptsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/quizzes/{quiz_id}/attempts\x12o\n" +
+	"\x11DetailQuizAttempt\x12\x1e.quiz.DetailQuizAttemptRequest\x1a\x1f.quiz.DetailQuizAttemptResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/attempts/{id}\x12\x8f\x01\n" +
+	"\x15GetCourseQuizProgress\x12\".quiz.GetCourseQuizProgressRequest\x1a#.quiz.GetCourseQuizProgressResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/courses/{course_id}/quiz-progressB(Z&github.com/abu-umair/be-lms-go/pb/quizb\x06proto3"
+
+var (
+	file_quiz_quiz_proto_rawDescOnce sync.Once
+	file_quiz_quiz_proto_rawDescData []byte
+)
+
+func file_quiz_quiz_proto_rawDescGZIP() []byte {
+	file_quiz_quiz_proto_rawDescOnce.Do(func() {
+		file_quiz_quiz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_quiz_quiz_proto_rawDesc), len(file_quiz_quiz_proto_rawDesc)))
+	})
+	return file_quiz_quiz_proto_rawDescData
+}
+
+var file_quiz_quiz_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_quiz_quiz_proto_goTypes = []any{
+	(*QuizSettings)(nil),                  // 0: quiz.QuizSettings
+	(*CreateQuizRequest)(nil),             // 1: quiz.CreateQuizRequest
+	(*CreateQuizResponse)(nil),            // 2: quiz.CreateQuizResponse
+	(*DetailQuizRequest)(nil),             // 3: quiz.DetailQuizRequest
+	(*DetailQuizResponse)(nil),            // 4: quiz.DetailQuizResponse
+	(*EditQuizRequest)(nil),               // 5: quiz.EditQuizRequest
+	(*EditQuizResponse)(nil),              // 6: quiz.EditQuizResponse
+	(*DeleteQuizRequest)(nil),             // 7: quiz.DeleteQuizRequest
+	(*DeleteQuizResponse)(nil),            // 8: quiz.DeleteQuizResponse
+	(*QuestionOption)(nil),                // 9: quiz.QuestionOption
+	(*SingleChoiceQuestion)(nil),          // 10: quiz.SingleChoiceQuestion
+	(*MultipleChoiceQuestion)(nil),        // 11: quiz.MultipleChoiceQuestion
+	(*TrueFalseQuestion)(nil),             // 12: quiz.TrueFalseQuestion
+	(*ShortAnswerQuestion)(nil),           // 13: quiz.ShortAnswerQuestion
+	(*OrderingQuestion)(nil),              // 14: quiz.OrderingQuestion
+	(*QuestionContent)(nil),               // 15: quiz.QuestionContent
+	(*QuizQuestion)(nil),                  // 16: quiz.QuizQuestion
+	(*CreateQuizQuestionRequest)(nil),     // 17: quiz.CreateQuizQuestionRequest
+	(*CreateQuizQuestionResponse)(nil),    // 18: quiz.CreateQuizQuestionResponse
+	(*EditQuizQuestionRequest)(nil),       // 19: quiz.EditQuizQuestionRequest
+	(*EditQuizQuestionResponse)(nil),      // 20: quiz.EditQuizQuestionResponse
+	(*DeleteQuizQuestionRequest)(nil),     // 21: quiz.DeleteQuizQuestionRequest
+	(*DeleteQuizQuestionResponse)(nil),    // 22: quiz.DeleteQuizQuestionResponse
+	(*AttemptQuestion)(nil),               // 23: quiz.AttemptQuestion
+	(*QuestionAnswer)(nil),                // 24: quiz.QuestionAnswer
+	(*AttemptQuestionResult)(nil),         // 25: quiz.AttemptQuestionResult
+	(*QuizAttemptSheet)(nil),              // 26: quiz.QuizAttemptSheet
+	(*StartQuizAttemptRequest)(nil),       // 27: quiz.StartQuizAttemptRequest
+	(*StartQuizAttemptResponse)(nil),      // 28: quiz.StartQuizAttemptResponse
+	(*SubmitQuizAttemptRequest)(nil),      // 29: quiz.SubmitQuizAttemptRequest
+	(*SubmitQuizAttemptResponse)(nil),     // 30: quiz.SubmitQuizAttemptResponse
+	(*QuizAttemptSummary)(nil),            // 31: quiz.QuizAttemptSummary
+	(*ListQuizAttemptsRequest)(nil),       // 32: quiz.ListQuizAttemptsRequest
+	(*ListQuizAttemptsResponse)(nil),      // 33: quiz.ListQuizAttemptsResponse
+	(*DetailQuizAttemptRequest)(nil),      // 34: quiz.DetailQuizAttemptRequest
+	(*DetailQuizAttemptResponse)(nil),     // 35: quiz.DetailQuizAttemptResponse
+	(*GetCourseQuizProgressRequest)(nil),  // 36: quiz.GetCourseQuizProgressRequest
+	(*QuizProgressItem)(nil),              // 37: quiz.QuizProgressItem
+	(*GetCourseQuizProgressResponse)(nil), // 38: quiz.GetCourseQuizProgressResponse
+	(*common.BaseResponse)(nil),           // 39: common.BaseResponse
+}
+var file_quiz_quiz_proto_depIdxs = []int32{
+	0,  // 0: quiz.CreateQuizRequest.settings:type_name -> quiz.QuizSettings
+	39, // 1: quiz.CreateQuizResponse.base:type_name -> common.BaseResponse
+	39, // 2: quiz.DetailQuizResponse.base:type_name -> common.BaseResponse
+	0,  // 3: quiz.DetailQuizResponse.settings:type_name -> quiz.QuizSettings
+	16, // 4: quiz.DetailQuizResponse.questions:type_name -> quiz.QuizQuestion
+	0,  // 5: quiz.EditQuizRequest.settings:type_name -> quiz.QuizSettings
+	39, // 6: quiz.EditQuizResponse.base:type_name -> common.BaseResponse
+	39, // 7: quiz.DeleteQuizResponse.base:type_name -> common.BaseResponse
+	9,  // 8: quiz.SingleChoiceQuestion.options:type_name -> quiz.QuestionOption
+	9,  // 9: quiz.MultipleChoiceQuestion.options:type_name -> quiz.QuestionOption
+	9,  // 10: quiz.OrderingQuestion.items:type_name -> quiz.QuestionOption
+	10, // 11: quiz.QuestionContent.single_choice:type_name -> quiz.SingleChoiceQuestion
+	11, // 12: quiz.QuestionContent.multiple_choice:type_name -> quiz.MultipleChoiceQuestion
+	12, // 13: quiz.QuestionContent.true_false:type_name -> quiz.TrueFalseQuestion
+	13, // 14: quiz.QuestionContent.short_answer:type_name -> quiz.ShortAnswerQuestion
+	14, // 15: quiz.QuestionContent.ordering:type_name -> quiz.OrderingQuestion
+	15, // 16: quiz.QuizQuestion.content:type_name -> quiz.QuestionContent
+	15, // 17: quiz.CreateQuizQuestionRequest.content:type_name -> quiz.QuestionContent
+	39, // 18: quiz.CreateQuizQuestionResponse.base:type_name -> common.BaseResponse
+	15, // 19: quiz.EditQuizQuestionRequest.content:type_name -> quiz.QuestionContent
+	39, // 20: quiz.EditQuizQuestionResponse.base:type_name -> common.BaseResponse
+	39, // 21: quiz.DeleteQuizQuestionResponse.base:type_name -> common.BaseResponse
+	9,  // 22: quiz.AttemptQuestion.options:type_name -> quiz.QuestionOption
+	9,  // 23: quiz.AttemptQuestionResult.options:type_name -> quiz.QuestionOption
+	15, // 24: quiz.AttemptQuestionResult.content:type_name -> quiz.QuestionContent
+	24, // 25: quiz.AttemptQuestionResult.answer:type_name -> quiz.QuestionAnswer
+	25, // 26: quiz.QuizAttemptSheet.questions:type_name -> quiz.AttemptQuestionResult
+	39, // 27: quiz.StartQuizAttemptResponse.base:type_name -> common.BaseResponse
+	23, // 28: quiz.StartQuizAttemptResponse.questions:type_name -> quiz.AttemptQuestion
+	24, // 29: quiz.SubmitQuizAttemptRequest.answers:type_name -> quiz.QuestionAnswer
+	39, // 30: quiz.SubmitQuizAttemptResponse.base:type_name -> common.BaseResponse
+	31, // 31: quiz.SubmitQuizAttemptResponse.attempt:type_name -> quiz.QuizAttemptSummary
+	39, // 32: quiz.ListQuizAttemptsResponse.base:type_name -> common.BaseResponse
+	31, // 33: quiz.ListQuizAttemptsResponse.items:type_name -> quiz.QuizAttemptSummary
+	39, // 34: quiz.DetailQuizAttemptResponse.base:type_name -> common.BaseResponse
+	31, // 35: quiz.DetailQuizAttemptResponse.attempt:type_name -> quiz.QuizAttemptSummary
+	25, // 36: quiz.DetailQuizAttemptResponse.results:type_name -> quiz.AttemptQuestionResult
+	39, // 37: quiz.GetCourseQuizProgressResponse.base:type_name -> common.BaseResponse
+	37, // 38: quiz.GetCourseQuizProgressResponse.items:type_name -> quiz.QuizProgressItem
+	1,  // 39: quiz.QuizService.CreateQuiz:input_type -> quiz.CreateQuizRequest
+	3,  // 40: quiz.QuizService.DetailQuiz:input_type -> quiz.DetailQuizRequest
+	5,  // 41: quiz.QuizService.EditQuiz:input_type -> quiz.EditQuizRequest
+	7,  // 42: quiz.QuizService.DeleteQuiz:input_type -> quiz.DeleteQuizRequest
+	17, // 43: quiz.QuizService.CreateQuizQuestion:input_type -> quiz.CreateQuizQuestionRequest
+	19, // 44: quiz.QuizService.EditQuizQuestion:input_type -> quiz.EditQuizQuestionRequest
+	21, // 45: quiz.QuizService.DeleteQuizQuestion:input_type -> quiz.DeleteQuizQuestionRequest
+	27, // 46: quiz.QuizService.StartQuizAttempt:input_type -> quiz.StartQuizAttemptRequest
+	29, // 47: quiz.QuizService.SubmitQuizAttempt:input_type -> quiz.SubmitQuizAttemptRequest
+	32, // 48: quiz.QuizService.ListQuizAttempts:input_type -> quiz.ListQuizAttemptsRequest
+	34, // 49: quiz.QuizService.DetailQuizAttempt:input_type -> quiz.DetailQuizAttemptRequest
+	36, // 50: quiz.QuizService.GetCourseQuizProgress:input_type -> quiz.GetCourseQuizProgressRequest
+	2,  // 51: quiz.QuizService.CreateQuiz:output_type -> quiz.CreateQuizResponse
+	4,  // 52: quiz.QuizService.DetailQuiz:output_type -> quiz.DetailQuizResponse
+	6,  // 53: quiz.QuizService.EditQuiz:output_type -> quiz.EditQuizResponse
+	8,  // 54: quiz.QuizService.DeleteQuiz:output_type -> quiz.DeleteQuizResponse
+	18, // 55: quiz.QuizService.CreateQuizQuestion:output_type -> quiz.CreateQuizQuestionResponse
+	20, // 56: quiz.QuizService.EditQuizQuestion:output_type -> quiz.EditQuizQuestionResponse
+	22, // 57: quiz.QuizService.DeleteQuizQuestion:output_type -> quiz.DeleteQuizQuestionResponse
+	28, // 58: quiz.QuizService.StartQuizAttempt:output_type -> quiz.StartQuizAttemptResponse
+	30, // 59: quiz.QuizService.SubmitQuizAttempt:output_type -> quiz.SubmitQuizAttemptResponse
+	33, // 60: quiz.QuizService.ListQuizAttempts:output_type -> quiz.ListQuizAttemptsResponse
+	35, // 61: quiz.QuizService.DetailQuizAttempt:output_type -> quiz.DetailQuizAttemptResponse
+	38, // 62: quiz.QuizService.GetCourseQuizProgress:output_type -> quiz.GetCourseQuizProgressResponse
+	51, // [51:63] is the sub-list for method output_type
+	39, // [39:51] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_quiz_quiz_proto_init() }
+func file_quiz_quiz_proto_init() {
+	if File_quiz_quiz_proto != nil {
+		return
+	}
+	file_quiz_quiz_proto_msgTypes[0].OneofWrappers = []any{}
+	file_quiz_quiz_proto_msgTypes[15].OneofWrappers = []any{
+		(*QuestionContent_SingleChoice)(nil),
+		(*QuestionContent_MultipleChoice)(nil),
+		(*QuestionContent_TrueFalse)(nil),
+		(*QuestionContent_ShortAnswer)(nil),
+		(*QuestionContent_Ordering)(nil),
+	}
+	file_quiz_quiz_proto_msgTypes[24].OneofWrappers = []any{}
+	file_quiz_quiz_proto_msgTypes[28].OneofWrappers = []any{}
+	file_quiz_quiz_proto_msgTypes[31].OneofWrappers = []any{}
+	file_quiz_quiz_proto_msgTypes[32].OneofWrappers = []any{}
+	file_quiz_quiz_proto_msgTypes[36].OneofWrappers = []any{}
+	file_quiz_quiz_proto_msgTypes[37].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quiz_quiz_proto_rawDesc), len(file_quiz_quiz_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   39,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_quiz_quiz_proto_goTypes,
+		DependencyIndexes: file_quiz_quiz_proto_depIdxs,
+		MessageInfos:      file_quiz_quiz_proto_msgTypes,
+	}.Build()
+	File_quiz_quiz_proto = out.File
+	file_quiz_quiz_proto_goTypes = nil
+	file_quiz_quiz_proto_depIdxs = nil
+}
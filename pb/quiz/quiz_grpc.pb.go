@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: quiz/quiz.proto
+
+package quiz
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuizService_CreateQuiz_FullMethodName            = "/quiz.QuizService/CreateQuiz"
+	QuizService_DetailQuiz_FullMethodName            = "/quiz.QuizService/DetailQuiz"
+	QuizService_EditQuiz_FullMethodName              = "/quiz.QuizService/EditQuiz"
+	QuizService_DeleteQuiz_FullMethodName            = "/quiz.QuizService/DeleteQuiz"
+	QuizService_CreateQuizQuestion_FullMethodName    = "/quiz.QuizService/CreateQuizQuestion"
+	QuizService_EditQuizQuestion_FullMethodName      = "/quiz.QuizService/EditQuizQuestion"
+	QuizService_DeleteQuizQuestion_FullMethodName    = "/quiz.QuizService/DeleteQuizQuestion"
+	QuizService_StartQuizAttempt_FullMethodName      = "/quiz.QuizService/StartQuizAttempt"
+	QuizService_SubmitQuizAttempt_FullMethodName     = "/quiz.QuizService/SubmitQuizAttempt"
+	QuizService_ListQuizAttempts_FullMethodName      = "/quiz.QuizService/ListQuizAttempts"
+	QuizService_DetailQuizAttempt_FullMethodName     = "/quiz.QuizService/DetailQuizAttempt"
+	QuizService_GetCourseQuizProgress_FullMethodName = "/quiz.QuizService/GetCourseQuizProgress"
+)
+
+// QuizServiceClient is the client API for QuizService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuizServiceClient interface {
+	CreateQuiz(ctx context.Context, in *CreateQuizRequest, opts ...grpc.CallOption) (*CreateQuizResponse, error)
+	DetailQuiz(ctx context.Context, in *DetailQuizRequest, opts ...grpc.CallOption) (*DetailQuizResponse, error)
+	EditQuiz(ctx context.Context, in *EditQuizRequest, opts ...grpc.CallOption) (*EditQuizResponse, error)
+	DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, opts ...grpc.CallOption) (*DeleteQuizResponse, error)
+	CreateQuizQuestion(ctx context.Context, in *CreateQuizQuestionRequest, opts ...grpc.CallOption) (*CreateQuizQuestionResponse, error)
+	EditQuizQuestion(ctx context.Context, in *EditQuizQuestionRequest, opts ...grpc.CallOption) (*EditQuizQuestionResponse, error)
+	DeleteQuizQuestion(ctx context.Context, in *DeleteQuizQuestionRequest, opts ...grpc.CallOption) (*DeleteQuizQuestionResponse, error)
+	StartQuizAttempt(ctx context.Context, in *StartQuizAttemptRequest, opts ...grpc.CallOption) (*StartQuizAttemptResponse, error)
+	SubmitQuizAttempt(ctx context.Context, in *SubmitQuizAttemptRequest, opts ...grpc.CallOption) (*SubmitQuizAttemptResponse, error)
+	ListQuizAttempts(ctx context.Context, in *ListQuizAttemptsRequest, opts ...grpc.CallOption) (*ListQuizAttemptsResponse, error)
+	DetailQuizAttempt(ctx context.Context, in *DetailQuizAttemptRequest, opts ...grpc.CallOption) (*DetailQuizAttemptResponse, error)
+	// GetCourseQuizProgress: progress quiz user di satu course. Server tidak menyimpan/menandai course selesai;
+	// client menghitung course selesai = semua lesson completed (GetCourseCurriculum) DAN all_quizzes_passed
+	GetCourseQuizProgress(ctx context.Context, in *GetCourseQuizProgressRequest, opts ...grpc.CallOption) (*GetCourseQuizProgressResponse, error)
+}
+
+type quizServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuizServiceClient(cc grpc.ClientConnInterface) QuizServiceClient {
+	return &quizServiceClient{cc}
+}
+
+func (c *quizServiceClient) CreateQuiz(ctx context.Context, in *CreateQuizRequest, opts ...grpc.CallOption) (*CreateQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_CreateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DetailQuiz(ctx context.Context, in *DetailQuizRequest, opts ...grpc.CallOption) (*DetailQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_DetailQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) EditQuiz(ctx context.Context, in *EditQuizRequest, opts ...grpc.CallOption) (*EditQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_EditQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, opts ...grpc.CallOption) (*DeleteQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_DeleteQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) CreateQuizQuestion(ctx context.Context, in *CreateQuizQuestionRequest, opts ...grpc.CallOption) (*CreateQuizQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuizQuestionResponse)
+	err := c.cc.Invoke(ctx, QuizService_CreateQuizQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) EditQuizQuestion(ctx context.Context, in *EditQuizQuestionRequest, opts ...grpc.CallOption) (*EditQuizQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditQuizQuestionResponse)
+	err := c.cc.Invoke(ctx, QuizService_EditQuizQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DeleteQuizQuestion(ctx context.Context, in *DeleteQuizQuestionRequest, opts ...grpc.CallOption) (*DeleteQuizQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuizQuestionResponse)
+	err := c.cc.Invoke(ctx, QuizService_DeleteQuizQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) StartQuizAttempt(ctx context.Context, in *StartQuizAttemptRequest, opts ...grpc.CallOption) (*StartQuizAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartQuizAttemptResponse)
+	err := c.cc.Invoke(ctx, QuizService_StartQuizAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) SubmitQuizAttempt(ctx context.Context, in *SubmitQuizAttemptRequest, opts ...grpc.CallOption) (*SubmitQuizAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitQuizAttemptResponse)
+	err := c.cc.Invoke(ctx, QuizService_SubmitQuizAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ListQuizAttempts(ctx context.Context, in *ListQuizAttemptsRequest, opts ...grpc.CallOption) (*ListQuizAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuizAttemptsResponse)
+	err := c.cc.Invoke(ctx, QuizService_ListQuizAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DetailQuizAttempt(ctx context.Context, in *DetailQuizAttemptRequest, opts ...grpc.CallOption) (*DetailQuizAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailQuizAttemptResponse)
+	err := c.cc.Invoke(ctx, QuizService_DetailQuizAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetCourseQuizProgress(ctx context.Context, in *GetCourseQuizProgressRequest, opts ...grpc.CallOption) (*GetCourseQuizProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseQuizProgressResponse)
+	err := c.cc.Invoke(ctx, QuizService_GetCourseQuizProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuizServiceServer is the server API for QuizService service.
+// All implementations must embed UnimplementedQuizServiceServer
+// for forward compatibility.
+type QuizServiceServer interface {
+	CreateQuiz(context.Context, *CreateQuizRequest) (*CreateQuizResponse, error)
+	DetailQuiz(context.Context, *DetailQuizRequest) (*DetailQuizResponse, error)
+	EditQuiz(context.Context, *EditQuizRequest) (*EditQuizResponse, error)
+	DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error)
+	CreateQuizQuestion(context.Context, *CreateQuizQuestionRequest) (*CreateQuizQuestionResponse, error)
+	EditQuizQuestion(context.Context, *EditQuizQuestionRequest) (*EditQuizQuestionResponse, error)
+	DeleteQuizQuestion(context.Context, *DeleteQuizQuestionRequest) (*DeleteQuizQuestionResponse, error)
+	StartQuizAttempt(context.Context, *StartQuizAttemptRequest) (*StartQuizAttemptResponse, error)
+	SubmitQuizAttempt(context.Context, *SubmitQuizAttemptRequest) (*SubmitQuizAttemptResponse, error)
+	ListQuizAttempts(context.Context, *ListQuizAttemptsRequest) (*ListQuizAttemptsResponse, error)
+	DetailQuizAttempt(context.Context, *DetailQuizAttemptRequest) (*DetailQuizAttemptResponse, error)
+	// GetCourseQuizProgress: progress quiz user di satu course. Server tidak menyimpan/menandai course selesai;
+	// client menghitung course selesai = semua lesson completed (GetCourseCurriculum) DAN all_quizzes_passed
+	GetCourseQuizProgress(context.Context, *GetCourseQuizProgressRequest) (*GetCourseQuizProgressResponse, error)
+	mustEmbedUnimplementedQuizServiceServer()
+}
+
+// UnimplementedQuizServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuizServiceServer struct{}
+
+func (UnimplementedQuizServiceServer) CreateQuiz(context.Context, *CreateQuizRequest) (*CreateQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) DetailQuiz(context.Context, *DetailQuizRequest) (*DetailQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) EditQuiz(context.Context, *EditQuizRequest) (*EditQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) CreateQuizQuestion(context.Context, *CreateQuizQuestionRequest) (*CreateQuizQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuizQuestion not implemented")
+}
+func (UnimplementedQuizServiceServer) EditQuizQuestion(context.Context, *EditQuizQuestionRequest) (*EditQuizQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditQuizQuestion not implemented")
+}
+func (UnimplementedQuizServiceServer) DeleteQuizQuestion(context.Context, *DeleteQuizQuestionRequest) (*DeleteQuizQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuizQuestion not implemented")
+}
+func (UnimplementedQuizServiceServer) StartQuizAttempt(context.Context, *StartQuizAttemptRequest) (*StartQuizAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartQuizAttempt not implemented")
+}
+func (UnimplementedQuizServiceServer) SubmitQuizAttempt(context.Context, *SubmitQuizAttemptRequest) (*SubmitQuizAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuizAttempt not implemented")
+}
+func (UnimplementedQuizServiceServer) ListQuizAttempts(context.Context, *ListQuizAttemptsRequest) (*ListQuizAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizAttempts not implemented")
+}
+func (UnimplementedQuizServiceServer) DetailQuizAttempt(context.Context, *DetailQuizAttemptRequest) (*DetailQuizAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailQuizAttempt not implemented")
+}
+func (UnimplementedQuizServiceServer) GetCourseQuizProgress(context.Context, *GetCourseQuizProgressRequest) (*GetCourseQuizProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseQuizProgress not implemented")
+}
+func (UnimplementedQuizServiceServer) mustEmbedUnimplementedQuizServiceServer() {}
+func (UnimplementedQuizServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeQuizServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuizServiceServer will
+// result in compilation errors.
+type UnsafeQuizServiceServer interface {
+	mustEmbedUnimplementedQuizServiceServer()
+}
+
+func RegisterQuizServiceServer(s grpc.ServiceRegistrar, srv QuizServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQuizServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuizService_ServiceDesc, srv)
+}
+
+func _QuizService_CreateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).CreateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_CreateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).CreateQuiz(ctx, req.(*CreateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DetailQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetailQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DetailQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DetailQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DetailQuiz(ctx, req.(*DetailQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_EditQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).EditQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_EditQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).EditQuiz(ctx, req.(*EditQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DeleteQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DeleteQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, req.(*DeleteQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_CreateQuizQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuizQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).CreateQuizQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_CreateQuizQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).CreateQuizQuestion(ctx, req.(*CreateQuizQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_EditQuizQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditQuizQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).EditQuizQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_EditQuizQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).EditQuizQuestion(ctx, req.(*EditQuizQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DeleteQuizQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuizQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DeleteQuizQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DeleteQuizQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DeleteQuizQuestion(ctx, req.(*DeleteQuizQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_StartQuizAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartQuizAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).StartQuizAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_StartQuizAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).StartQuizAttempt(ctx, req.(*StartQuizAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_SubmitQuizAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQuizAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).SubmitQuizAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_SubmitQuizAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).SubmitQuizAttempt(ctx, req.(*SubmitQuizAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ListQuizAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuizAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ListQuizAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ListQuizAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ListQuizAttempts(ctx, req.(*ListQuizAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DetailQuizAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetailQuizAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DetailQuizAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DetailQuizAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DetailQuizAttempt(ctx, req.(*DetailQuizAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetCourseQuizProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseQuizProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetCourseQuizProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetCourseQuizProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetCourseQuizProgress(ctx, req.(*GetCourseQuizProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuizService_ServiceDesc is the grpc.ServiceDesc for QuizService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuizService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quiz.QuizService",
+	HandlerType: (*QuizServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateQuiz",
+			Handler:    _QuizService_CreateQuiz_Handler,
+		},
+		{
+			MethodName: "DetailQuiz",
+			Handler:    _QuizService_DetailQuiz_Handler,
+		},
+		{
+			MethodName: "EditQuiz",
+			Handler:    _QuizService_EditQuiz_Handler,
+		},
+		{
+			MethodName: "DeleteQuiz",
+			Handler:    _QuizService_DeleteQuiz_Handler,
+		},
+		{
+			MethodName: "CreateQuizQuestion",
+			Handler:    _QuizService_CreateQuizQuestion_Handler,
+		},
+		{
+			MethodName: "EditQuizQuestion",
+			Handler:    _QuizService_EditQuizQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuizQuestion",
+			Handler:    _QuizService_DeleteQuizQuestion_Handler,
+		},
+		{
+			MethodName: "StartQuizAttempt",
+			Handler:    _QuizService_StartQuizAttempt_Handler,
+		},
+		{
+			MethodName: "SubmitQuizAttempt",
+			Handler:    _QuizService_SubmitQuizAttempt_Handler,
+		},
+		{
+			MethodName: "ListQuizAttempts",
+			Handler:    _QuizService_ListQuizAttempts_Handler,
+		},
+		{
+			MethodName: "DetailQuizAttempt",
+			Handler:    _QuizService_DetailQuizAttempt_Handler,
+		},
+		{
+			MethodName: "GetCourseQuizProgress",
+			Handler:    _QuizService_GetCourseQuizProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "quiz/quiz.proto",
+}
@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: trash/trash.proto
+
+package trash
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/abu-umair/be-lms-go/pb/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          *string                `protobuf:"bytes,1,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	CourseId      *string                `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_trash_trash_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_trash_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_trash_trash_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListTrashRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *ListTrashRequest) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+// TrashItem: satu delete (root cascade), chapter & lesson yang ikut terhapus hanya dihitung
+type TrashItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` //? course, chapter atau lesson
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	CourseId      *string                `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     *string                `protobuf:"bytes,6,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`
+	PurgeAt       string                 `protobuf:"bytes,7,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` //? deleted_at + masa retensi, setelah itu tidak bisa di-restore lagi
+	ChapterCount  int64                  `protobuf:"varint,8,opt,name=chapter_count,json=chapterCount,proto3" json:"chapter_count,omitempty"`
+	LessonCount   int64                  `protobuf:"varint,9,opt,name=lesson_count,json=lessonCount,proto3" json:"lesson_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_trash_trash_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_trash_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_trash_trash_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrashItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrashItem) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedBy() string {
+	if x != nil && x.DeletedBy != nil {
+		return *x.DeletedBy
+	}
+	return ""
+}
+
+func (x *TrashItem) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+func (x *TrashItem) GetChapterCount() int64 {
+	if x != nil {
+		return x.ChapterCount
+	}
+	return 0
+}
+
+func (x *TrashItem) GetLessonCount() int64 {
+	if x != nil {
+		return x.LessonCount
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*TrashItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_trash_trash_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_trash_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_trash_trash_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTrashResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// PurgeTrashRequest: menjalankan purge sekarang, hanya item yang masa retensinya sudah lewat
+type PurgeTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_trash_trash_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_trash_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_trash_trash_proto_rawDescGZIP(), []int{3}
+}
+
+type PurgeTrashResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PurgedCourses  int64                  `protobuf:"varint,2,opt,name=purged_courses,json=purgedCourses,proto3" json:"purged_courses,omitempty"`
+	PurgedChapters int64                  `protobuf:"varint,3,opt,name=purged_chapters,json=purgedChapters,proto3" json:"purged_chapters,omitempty"`
+	PurgedLessons  int64                  `protobuf:"varint,4,opt,name=purged_lessons,json=purgedLessons,proto3" json:"purged_lessons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_trash_trash_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trash_trash_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_trash_trash_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PurgeTrashResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PurgeTrashResponse) GetPurgedCourses() int64 {
+	if x != nil {
+		return x.PurgedCourses
+	}
+	return 0
+}
+
+func (x *PurgeTrashResponse) GetPurgedChapters() int64 {
+	if x != nil {
+		return x.PurgedChapters
+	}
+	return 0
+}
+
+func (x *PurgeTrashResponse) GetPurgedLessons() int64 {
+	if x != nil {
+		return x.PurgedLessons
+	}
+	return 0
+}
+
+var File_trash_trash_proto protoreflect.FileDescriptor
+
+const file_trash_trash_proto_rawDesc = "" +
+	"\n" +
+	"\x11trash/trash.proto\x12\x05trash\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\x8e\x01\n" +
+	"\x10ListTrashRequest\x127\n" +
+	"\x04kind\x18\x01 \x01(\tB\x1e\xbaH\x1br\x19R\x06courseR\achapterR\x06lessonH\x00R\x04kind\x88\x01\x01\x12*\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\bcourseId\x88\x01\x01B\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_course_id\"\xaa\x02\n" +
+	"\tTrashItem\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\tcourse_id\x18\x04 \x01(\tH\x00R\bcourseId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\tR\tdeletedAt\x12\"\n" +
+	"\n" +
+	"deleted_by\x18\x06 \x01(\tH\x01R\tdeletedBy\x88\x01\x01\x12\x19\n" +
+	"\bpurge_at\x18\a \x01(\tR\apurgeAt\x12#\n" +
+	"\rchapter_count\x18\b \x01(\x03R\fchapterCount\x12!\n" +
+	"\flesson_count\x18\t \x01(\x03R\vlessonCountB\f\n" +
+	"\n" +
+	"_course_idB\r\n" +
+	"\v_deleted_by\"e\n" +
+	"\x11ListTrashResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12&\n" +
+	"\x05items\x18\x02 \x03(\v2\x10.trash.TrashItemR\x05items\"\x13\n" +
+	"\x11PurgeTrashRequest\"\xb5\x01\n" +
+	"\x12PurgeTrashResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12%\n" +
+	"\x0epurged_courses\x18\x02 \x01(\x03R\rpurgedCourses\x12'\n" +
+	"\x0fpurged_chapters\x18\x03 \x01(\x03R\x0epurgedChapters\x12%\n" +
+	"\x0epurged_lessons\x18\x04 \x01(\x03R\rpurgedLessons2\xcc\x01\n" +
+	"\fTrashService\x12W\n" +
+	"\tListTrash\x12\x17.trash.ListTrashRequest\x1a\x18.trash.ListTrashResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/trash\x12c\n" +
+	"\n" +
+	"PurgeTrash\x12\x18.trash.PurgeTrashRequest\x1a\x19.trash.PurgeTrashResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/admin/trash:purgeB)Z'github.com/abu-umair/be-lms-go/pb/trashb\x06proto3"
+
+var (
+	file_trash_trash_proto_rawDescOnce sync.Once
+	file_trash_trash_proto_rawDescData []byte
+)
+
+func file_trash_trash_proto_rawDescGZIP() []byte {
+	file_trash_trash_proto_rawDescOnce.Do(func() {
+		file_trash_trash_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_trash_trash_proto_rawDesc), len(file_trash_trash_proto_rawDesc)))
+	})
+	return file_trash_trash_proto_rawDescData
+}
+
+var file_trash_trash_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_trash_trash_proto_goTypes = []any{
+	(*ListTrashRequest)(nil),    // 0: trash.ListTrashRequest
+	(*TrashItem)(nil),           // 1: trash.TrashItem
+	(*ListTrashResponse)(nil),   // 2: trash.ListTrashResponse
+	(*PurgeTrashRequest)(nil),   // 3: trash.PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),  // 4: trash.PurgeTrashResponse
+	(*common.BaseResponse)(nil), // 5: common.BaseResponse
+}
+var file_trash_trash_proto_depIdxs = []int32{
+	5, // 0: trash.ListTrashResponse.base:type_name -> common.BaseResponse
+	1, // 1: trash.ListTrashResponse.items:type_name -> trash.TrashItem
+	5, // 2: trash.PurgeTrashResponse.base:type_name -> common.BaseResponse
+	0, // 3: trash.TrashService.ListTrash:input_type -> trash.ListTrashRequest
+	3, // 4: trash.TrashService.PurgeTrash:input_type -> trash.PurgeTrashRequest
+	2, // 5: trash.TrashService.ListTrash:output_type -> trash.ListTrashResponse
+	4, // 6: trash.TrashService.PurgeTrash:output_type -> trash.PurgeTrashResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_trash_trash_proto_init() }
+func file_trash_trash_proto_init() {
+	if File_trash_trash_proto != nil {
+		return
+	}
+	file_trash_trash_proto_msgTypes[0].OneofWrappers = []any{}
+	file_trash_trash_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_trash_trash_proto_rawDesc), len(file_trash_trash_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_trash_trash_proto_goTypes,
+		DependencyIndexes: file_trash_trash_proto_depIdxs,
+		MessageInfos:      file_trash_trash_proto_msgTypes,
+	}.Build()
+	File_trash_trash_proto = out.File
+	file_trash_trash_proto_goTypes = nil
+	file_trash_trash_proto_depIdxs = nil
+}
@@ -5,8 +5,11 @@ import (
 	"database/sql"
 )
 
+// DatabaseQuery diimplementasi oleh *sqlx.DB maupun *sqlx.Tx, sehingga repository bisa dipakai di dalam transaksi
 type DatabaseQuery interface {
 	GetContext(ctx context.Context, dest interface{}, query string, args ...any) error
 	SelectContext(ctx context.Context, dest interface{}, query string, args ...any) error
 	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
 }
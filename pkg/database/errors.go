@@ -0,0 +1,20 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolationCode adalah SQLSTATE Postgres utk unique_violation
+const uniqueViolationCode = "23505"
+
+// IsUniqueViolation true jika err berasal dari unique constraint / index bernama constraint
+func IsUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+
+	return pqErr.Code == uniqueViolationCode && pqErr.Constraint == constraint
+}
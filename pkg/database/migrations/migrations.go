@@ -0,0 +1,137 @@
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed sql/*.sql
+var embeddedFiles embed.FS
+
+// SourceDir adalah folder file migration relatif terhadap root repo (dipakai oleh perintah create)
+const SourceDir = "pkg/database/migrations/sql"
+
+// nama file: <version>_<name>.<up|down>.sql, contoh 000001_create_users_table.up.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+var ErrInvalidName = errors.New("migration name must only contain a-z, 0-9 and _")
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Embedded mengembalikan semua migration yang ikut di-compile ke binary, urut berdasarkan version
+func Embedded() ([]Migration, error) {
+	sub, err := fs.Sub(embeddedFiles, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	return Load(sub)
+}
+
+// Load membaca pasangan file up/down dari fsys. Setiap version wajib punya file up dan down.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %q: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d has different names: %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			return nil, fmt.Errorf("migration %d_%s must have non-empty up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Create membuat pasangan file up/down kosong dgn version berikutnya di dir
+func Create(dir string, name string) (upPath string, downPath string, err error) {
+	if !namePattern.MatchString(name) {
+		return "", "", ErrInvalidName
+	}
+
+	existing, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+
+	var next int64 = 1
+	if len(existing) > 0 {
+		next = existing[len(existing)-1].Version + 1
+	}
+
+	base := fmt.Sprintf("%06d_%s", next, name)
+	upPath = filepath.Join(dir, base+".up.sql")
+	downPath = filepath.Join(dir, base+".down.sql")
+
+	err = os.WriteFile(upPath, []byte("-- "+base+" (up)\n"), 0644)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = os.WriteFile(downPath, []byte("-- "+base+" (down)\n"), 0644)
+	if err != nil {
+		os.Remove(upPath)
+		return "", "", err
+	}
+
+	return upPath, downPath, nil
+}
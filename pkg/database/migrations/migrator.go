@@ -0,0 +1,236 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// advisoryLockKey: key pg_advisory_lock agar hanya satu runner (cmd/migrate, deploy paralel) yang jalan
+const advisoryLockKey int64 = 7_318_402_215_009_001
+
+const createVersionTableQuery = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT PRIMARY KEY,
+		name       VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+	)`
+
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	Missing   bool //? tercatat di schema_migrations tapi file-nya tidak ada
+}
+
+type appliedMigration struct {
+	Version   int64     `db:"version"`
+	Name      string    `db:"name"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sqlx.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// Up menjalankan semua migration yang belum diterapkan, masing-masing dalam transaksi sendiri
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		var latest int64
+		for version := range applied {
+			latest = max(latest, version)
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			//? migration lama yang baru di-merge setelah version lebih baru diterapkan harus direnumber
+			if migration.Version < latest {
+				return fmt.Errorf("migration %d_%s is pending but newer version %d is already applied", migration.Version, migration.Name, latest)
+			}
+
+			err = m.apply(ctx, conn, migration, migration.Up, func(tx *sqlx.Tx) error {
+				_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", migration.Version, migration.Name)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			slog.Info("migration applied", "version", migration.Version, "name", migration.Name)
+			done = append(done, migration)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Down me-rollback sejumlah steps migration terakhir yang sudah diterapkan (urut version terbaru dulu)
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		versions := make([]int64, 0, len(applied))
+		for version := range applied {
+			versions = append(versions, version)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+		byVersion := make(map[int64]Migration, len(m.migrations))
+		for _, migration := range m.migrations {
+			byVersion[migration.Version] = migration
+		}
+
+		for _, version := range versions[:min(steps, len(versions))] {
+			migration, ok := byVersion[version]
+			if !ok {
+				return fmt.Errorf("cannot roll back migration %d_%s: migration file not found", version, applied[version].Name)
+			}
+
+			err = m.apply(ctx, conn, migration, migration.Down, func(tx *sqlx.Tx) error {
+				_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			slog.Info("migration rolled back", "version", migration.Version, "name", migration.Name)
+			done = append(done, migration)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Status mengembalikan semua migration (file & yang tercatat di DB) beserta waktu diterapkan
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+
+	err := m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := Status{Version: migration.Version, Name: migration.Name}
+			if a, ok := applied[migration.Version]; ok {
+				status.AppliedAt = &a.AppliedAt
+				delete(applied, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+
+		for _, a := range applied {
+			statuses = append(statuses, Status{Version: a.Version, Name: a.Name, AppliedAt: &a.AppliedAt, Missing: true})
+		}
+
+		sort.Slice(statuses, func(i, j int) bool {
+			return statuses[i].Version < statuses[j].Version
+		})
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sqlx.Conn, migration Migration, query string, record func(tx *sqlx.Tx) error) (err error) {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+	}
+
+	err = record(tx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sqlx.Conn) (map[int64]appliedMigration, error) {
+	var rows []appliedMigration
+	err := conn.SelectContext(ctx, &rows, "SELECT version, name, applied_at FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]appliedMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+
+	return applied, nil
+}
+
+// withLock menjalankan fn pada satu koneksi yang memegang advisory lock (lock berlaku per session)
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sqlx.Conn) error) error {
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockKey)
+	if err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+
+	defer func() {
+		//? ctx bisa saja sudah dibatalkan, unlock tetap harus jalan
+		_, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", advisoryLockKey)
+		if err != nil {
+			slog.Error("failed to release migration lock", "error", err)
+		}
+	}()
+
+	_, err = conn.ExecContext(ctx, createVersionTableQuery)
+	if err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
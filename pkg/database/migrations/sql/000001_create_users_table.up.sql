@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS users (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    full_name   VARCHAR(255) NOT NULL,
+    email       VARCHAR(255) NOT NULL,
+    password    VARCHAR(255) NOT NULL,
+    role_code   VARCHAR(50)  NOT NULL DEFAULT 'user',
+    verified_at TIMESTAMPTZ,
+
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by  VARCHAR(255),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_by  VARCHAR(255),
+    deleted_at  TIMESTAMPTZ,
+    deleted_by  VARCHAR(255)
+);
+
+-- email hanya unik utk user yang belum di-soft delete
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_live_key ON users (email) WHERE deleted_at IS NULL;
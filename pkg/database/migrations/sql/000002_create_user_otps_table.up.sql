@@ -0,0 +1,9 @@
+-- satu OTP aktif per email (UpsertOTP memakai ON CONFLICT (email))
+CREATE TABLE IF NOT EXISTS user_otps (
+    email      VARCHAR(255) PRIMARY KEY,
+    otp_code   VARCHAR(6)   NOT NULL,
+    expired_at TIMESTAMPTZ  NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS user_otps_expired_at_idx ON user_otps (expired_at);
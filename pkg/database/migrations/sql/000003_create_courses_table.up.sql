@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS courses (
+    id                   UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name                 VARCHAR(255)  NOT NULL,
+    address              VARCHAR(255),
+    image_file_name      VARCHAR(255)  NOT NULL DEFAULT '',
+    slug                 VARCHAR(255),
+    instructor_id        UUID REFERENCES users (id),
+    category_id          VARCHAR(255),
+    course_type          VARCHAR(255),
+    seo_description      VARCHAR(255),
+    duration             VARCHAR(255),
+    timezone             VARCHAR(255),
+    thumbnail            VARCHAR(255),
+    demo_video_storage   VARCHAR(255),
+    demo_video_source    VARCHAR(255),
+    description          TEXT,
+    capacity             INTEGER,
+    price                NUMERIC(15, 2),
+    discount             NUMERIC(15, 2),
+    certificate          VARCHAR(255),
+    gna                  VARCHAR(255),
+    message_for_reviewer VARCHAR(255),
+    is_approved          VARCHAR(255),
+    status               VARCHAR(255),
+    course_level_id      VARCHAR(255),
+    course_language_id   VARCHAR(255),
+
+    created_at           TIMESTAMPTZ   NOT NULL DEFAULT now(),
+    created_by           VARCHAR(255)  NOT NULL,
+    updated_at           TIMESTAMPTZ   NOT NULL DEFAULT now(),
+    updated_by           VARCHAR(255),
+    deleted_at           TIMESTAMPTZ,
+    deleted_by           VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS courses_instructor_id_idx ON courses (instructor_id);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS course_chapters (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    instructor_id UUID         NOT NULL REFERENCES users (id),
+    course_id     UUID         NOT NULL REFERENCES courses (id),
+    title         VARCHAR(255) NOT NULL,
+    order_chapter BIGINT       NOT NULL DEFAULT 0,
+    status        VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by    VARCHAR(255) NOT NULL,
+    updated_at    TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_by    VARCHAR(255),
+    deleted_at    TIMESTAMPTZ,
+    deleted_by    VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS course_chapters_course_id_idx ON course_chapters (course_id);
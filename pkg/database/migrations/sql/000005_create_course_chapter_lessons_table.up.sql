@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS course_chapter_lessons (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    instructor_id  UUID REFERENCES users (id),
+    course_id      UUID REFERENCES courses (id),
+    chapter_id     UUID REFERENCES course_chapters (id),
+    title          VARCHAR(255) NOT NULL,
+    order_lesson   BIGINT       NOT NULL DEFAULT 0,
+    slug           VARCHAR(255),
+    description    TEXT,
+    file_path      VARCHAR(255),
+    storage_lesson VARCHAR(255),
+    lesson_type    VARCHAR(255),
+    volume         VARCHAR(255),
+    duration       VARCHAR(255),
+    file_type      VARCHAR(255),
+    downloadable   VARCHAR(255),
+    is_preview     BIGINT                DEFAULT 0,
+    status         VARCHAR(255),
+
+    created_at     TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by     VARCHAR(255) NOT NULL,
+    updated_at     TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_by     VARCHAR(255),
+    deleted_at     TIMESTAMPTZ,
+    deleted_by     VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS course_chapter_lessons_course_id_idx ON course_chapter_lessons (course_id);
+CREATE INDEX IF NOT EXISTS course_chapter_lessons_chapter_id_idx ON course_chapter_lessons (chapter_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS enrollments (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID         NOT NULL REFERENCES users (id),
+    course_id   UUID         NOT NULL REFERENCES courses (id),
+    enrolled_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by  VARCHAR(255) NOT NULL,
+    deleted_at  TIMESTAMPTZ,
+    deleted_by  VARCHAR(255)
+);
+
+-- satu enrollment aktif per user per course
+CREATE UNIQUE INDEX IF NOT EXISTS enrollments_course_user_live_key ON enrollments (course_id, user_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS enrollments_user_id_idx ON enrollments (user_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS course_chapter_lessons_chapter_order_live_key;
+DROP INDEX IF EXISTS course_chapters_course_order_live_key;
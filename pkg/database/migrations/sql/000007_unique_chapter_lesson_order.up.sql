@@ -0,0 +1,30 @@
+-- data lama bisa punya urutan ganda, rapikan dulu (hanya parent yang bentrok) dgn tetap menjaga urutan relatifnya
+UPDATE course_chapters c
+SET order_chapter = r.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY order_chapter, created_at, id) AS position
+    FROM course_chapters
+    WHERE deleted_at IS NULL
+      AND course_id IN (
+          SELECT course_id FROM course_chapters WHERE deleted_at IS NULL
+          GROUP BY course_id, order_chapter HAVING count(*) > 1
+      )
+) r
+WHERE c.id = r.id;
+
+UPDATE course_chapter_lessons l
+SET order_lesson = r.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY chapter_id ORDER BY order_lesson, created_at, id) AS position
+    FROM course_chapter_lessons
+    WHERE deleted_at IS NULL
+      AND chapter_id IN (
+          SELECT chapter_id FROM course_chapter_lessons WHERE deleted_at IS NULL AND chapter_id IS NOT NULL
+          GROUP BY chapter_id, order_lesson HAVING count(*) > 1
+      )
+) r
+WHERE l.id = r.id;
+
+-- satu posisi per parent, chapter / lesson yang sudah dihapus tidak ikut dihitung
+CREATE UNIQUE INDEX IF NOT EXISTS course_chapters_course_order_live_key ON course_chapters (course_id, order_chapter) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS course_chapter_lessons_chapter_order_live_key ON course_chapter_lessons (chapter_id, order_lesson) WHERE deleted_at IS NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_kind_content_check;
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_kind_check;
+ALTER TABLE course_chapter_lessons DROP COLUMN IF EXISTS content;
+ALTER TABLE course_chapter_lessons DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,9 @@
+-- jenis lesson & payload sesuai jenisnya (lesson lama tetap NULL, memakai kolom lesson_type dkk apa adanya)
+ALTER TABLE course_chapter_lessons ADD COLUMN IF NOT EXISTS kind VARCHAR(32);
+ALTER TABLE course_chapter_lessons ADD COLUMN IF NOT EXISTS content JSONB;
+
+ALTER TABLE course_chapter_lessons ADD CONSTRAINT course_chapter_lessons_kind_check
+    CHECK (kind IN ('video', 'article', 'file', 'link', 'live_session', 'quiz'));
+-- kind & content selalu diisi / dikosongkan bersamaan
+ALTER TABLE course_chapter_lessons ADD CONSTRAINT course_chapter_lessons_kind_content_check
+    CHECK ((kind IS NULL) = (content IS NULL));
@@ -0,0 +1,64 @@
+CREATE TABLE IF NOT EXISTS quizzes (
+    id                    UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lesson_id             UUID         NOT NULL REFERENCES course_chapter_lessons (id),
+    course_id             UUID         NOT NULL REFERENCES courses (id),
+    title                 VARCHAR(255) NOT NULL,
+    description           TEXT,
+    time_limit_seconds    BIGINT CHECK (time_limit_seconds > 0),
+    max_attempts          BIGINT CHECK (max_attempts > 0),
+    pass_mark_percent     BIGINT       NOT NULL DEFAULT 0 CHECK (pass_mark_percent BETWEEN 0 AND 100),
+    questions_per_attempt BIGINT CHECK (questions_per_attempt > 0),
+    shuffle_questions     BOOLEAN      NOT NULL DEFAULT FALSE,
+    shuffle_options       BOOLEAN      NOT NULL DEFAULT FALSE,
+
+    created_at            TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by            VARCHAR(255) NOT NULL,
+    updated_at            TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_by            VARCHAR(255),
+    deleted_at            TIMESTAMPTZ,
+    deleted_by            VARCHAR(255)
+);
+
+-- satu quiz aktif per lesson
+CREATE UNIQUE INDEX IF NOT EXISTS quizzes_lesson_live_key ON quizzes (lesson_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS quizzes_course_id_idx ON quizzes (course_id);
+
+CREATE TABLE IF NOT EXISTS quiz_questions (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    quiz_id        UUID         NOT NULL REFERENCES quizzes (id),
+    question_type  VARCHAR(32)  NOT NULL CHECK (question_type IN ('single_choice', 'multiple_choice', 'true_false', 'short_answer', 'ordering')),
+    prompt         TEXT         NOT NULL,
+    points         BIGINT       NOT NULL CHECK (points > 0),
+    order_question BIGINT       NOT NULL DEFAULT 0,
+    content        JSONB        NOT NULL, -- jenis soal beserta kunci jawaban (quiz.QuestionContent)
+
+    created_at     TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by     VARCHAR(255) NOT NULL,
+    updated_at     TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_by     VARCHAR(255),
+    deleted_at     TIMESTAMPTZ,
+    deleted_by     VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS quiz_questions_quiz_id_idx ON quiz_questions (quiz_id);
+
+CREATE TABLE IF NOT EXISTS quiz_attempts (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    quiz_id        UUID         NOT NULL REFERENCES quizzes (id),
+    course_id      UUID         NOT NULL REFERENCES courses (id),
+    user_id        UUID         NOT NULL REFERENCES users (id),
+    attempt_number BIGINT       NOT NULL,
+    sheet          JSONB        NOT NULL, -- snapshot soal yang dikerjakan & jawabannya (quiz.QuizAttemptSheet)
+    started_at     TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    expires_at     TIMESTAMPTZ,
+    submitted_at   TIMESTAMPTZ,
+    score          BIGINT,
+    max_score      BIGINT       NOT NULL,
+    passed         BOOLEAN      NOT NULL DEFAULT FALSE,
+    expired        BOOLEAN      NOT NULL DEFAULT FALSE
+);
+
+-- nomor attempt unik per learner per quiz & hanya satu attempt yang belum dikumpulkan
+CREATE UNIQUE INDEX IF NOT EXISTS quiz_attempts_quiz_user_number_key ON quiz_attempts (quiz_id, user_id, attempt_number);
+CREATE UNIQUE INDEX IF NOT EXISTS quiz_attempts_quiz_user_open_key ON quiz_attempts (quiz_id, user_id) WHERE submitted_at IS NULL;
+CREATE INDEX IF NOT EXISTS quiz_attempts_course_user_idx ON quiz_attempts (course_id, user_id);
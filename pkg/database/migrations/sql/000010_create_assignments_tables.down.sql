@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS assignment_submissions;
+DROP TABLE IF EXISTS assignments;
+
+-- lesson assignment tidak punya padanan di skema lama, kind & content dikosongkan
+UPDATE course_chapter_lessons SET kind = NULL, content = NULL WHERE kind = 'assignment';
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_kind_check;
+ALTER TABLE course_chapter_lessons ADD CONSTRAINT course_chapter_lessons_kind_check
+    CHECK (kind IN ('video', 'article', 'file', 'link', 'live_session', 'quiz'));
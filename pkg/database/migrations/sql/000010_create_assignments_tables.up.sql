@@ -0,0 +1,49 @@
+-- lesson berjenis assignment
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_kind_check;
+ALTER TABLE course_chapter_lessons ADD CONSTRAINT course_chapter_lessons_kind_check
+    CHECK (kind IN ('video', 'article', 'file', 'link', 'live_session', 'quiz', 'assignment'));
+
+CREATE TABLE IF NOT EXISTS assignments (
+    id                     UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lesson_id              UUID         NOT NULL REFERENCES course_chapter_lessons (id),
+    course_id              UUID         NOT NULL REFERENCES courses (id),
+    title                  VARCHAR(255) NOT NULL,
+    instructions           TEXT         NOT NULL,
+    due_at                 TIMESTAMPTZ,
+    max_score              BIGINT       NOT NULL CHECK (max_score > 0),
+    allow_late_submissions BOOLEAN      NOT NULL DEFAULT FALSE,
+
+    created_at             TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by             VARCHAR(255) NOT NULL,
+    updated_at             TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_by             VARCHAR(255),
+    deleted_at             TIMESTAMPTZ,
+    deleted_by             VARCHAR(255)
+);
+
+-- satu assignment aktif per lesson
+CREATE UNIQUE INDEX IF NOT EXISTS assignments_lesson_live_key ON assignments (lesson_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS assignments_course_id_idx ON assignments (course_id);
+
+CREATE TABLE IF NOT EXISTS assignment_submissions (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    assignment_id UUID         NOT NULL REFERENCES assignments (id),
+    course_id     UUID         NOT NULL REFERENCES courses (id),
+    user_id       UUID         NOT NULL REFERENCES users (id),
+    user_name     VARCHAR(255) NOT NULL,
+    user_email    VARCHAR(255) NOT NULL, -- tujuan notifikasi nilai
+    file_names    TEXT[]       NOT NULL, -- file di storage/<course_id>/submission
+    comment       TEXT,
+    submitted_at  TIMESTAMPTZ  NOT NULL,
+    late          BOOLEAN      NOT NULL DEFAULT FALSE,
+    score         BIGINT CHECK (score >= 0),
+    feedback      TEXT,
+    graded_at     TIMESTAMPTZ,
+    graded_by     VARCHAR(255),
+
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+-- satu submission per learner per assignment (submit ulang menggantikan isinya)
+CREATE UNIQUE INDEX IF NOT EXISTS assignment_submissions_assignment_user_key ON assignment_submissions (assignment_id, user_id);
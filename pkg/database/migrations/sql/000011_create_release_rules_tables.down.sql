@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS lesson_completions;
+DROP TABLE IF EXISTS release_rules;
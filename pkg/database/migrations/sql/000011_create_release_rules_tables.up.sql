@@ -0,0 +1,30 @@
+-- aturan rilis (drip) per chapter atau per lesson, satu aturan per target
+CREATE TABLE IF NOT EXISTS release_rules (
+    id                     UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    chapter_id             UUID REFERENCES course_chapters (id),
+    lesson_id              UUID REFERENCES course_chapter_lessons (id),
+    release_at             TIMESTAMP, -- waktu lokal tanpa zona, dibaca sesuai courses.timezone
+    days_after_enrollment  BIGINT CHECK (days_after_enrollment >= 0),
+    prerequisite_lesson_id UUID REFERENCES course_chapter_lessons (id),
+    prerequisite_quiz_id   UUID REFERENCES quizzes (id),
+
+    created_at             TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_by             VARCHAR(255) NOT NULL,
+
+    CONSTRAINT release_rules_target_check CHECK (num_nonnulls(chapter_id, lesson_id) = 1),
+    CONSTRAINT release_rules_rule_check CHECK (num_nonnulls(release_at, days_after_enrollment, prerequisite_lesson_id, prerequisite_quiz_id) = 1)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS release_rules_chapter_key ON release_rules (chapter_id) WHERE chapter_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS release_rules_lesson_key ON release_rules (lesson_id) WHERE lesson_id IS NOT NULL;
+
+-- lesson yang sudah diselesaikan learner (lesson quiz selesai lewat quiz_attempts yang lulus)
+CREATE TABLE IF NOT EXISTS lesson_completions (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lesson_id    UUID        NOT NULL REFERENCES course_chapter_lessons (id),
+    user_id      UUID        NOT NULL REFERENCES users (id),
+    completed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS lesson_completions_lesson_user_key ON lesson_completions (lesson_id, user_id);
+CREATE INDEX IF NOT EXISTS lesson_completions_user_id_idx ON lesson_completions (user_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS lesson_transcript_cues;
@@ -0,0 +1,17 @@
+-- transcript lesson video: satu baris per cue caption (diisi ulang setiap lesson disimpan), dipakai pencarian
+CREATE TABLE IF NOT EXISTS lesson_transcript_cues (
+    id        UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lesson_id UUID        NOT NULL REFERENCES course_chapter_lessons (id),
+    language  VARCHAR(35) NOT NULL,
+    cue_index INT         NOT NULL,
+    start_ms  BIGINT      NOT NULL CHECK (start_ms >= 0),
+    end_ms    BIGINT      NOT NULL,
+    text      TEXT        NOT NULL,
+    -- 'simple': caption bisa berbagai bahasa, tanpa stemming per bahasa
+    search    TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', text)) STORED,
+
+    CONSTRAINT lesson_transcript_cues_timing_check CHECK (end_ms > start_ms)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS lesson_transcript_cues_lesson_cue_key ON lesson_transcript_cues (lesson_id, language, cue_index);
+CREATE INDEX IF NOT EXISTS lesson_transcript_cues_search_idx ON lesson_transcript_cues USING GIN (search);
@@ -0,0 +1,47 @@
+-- cascade yang sudah terjadi (chapter / lesson ikut terhapus) tidak dikembalikan, hanya penandanya yang dibuang
+ALTER TABLE course_chapters DROP CONSTRAINT IF EXISTS course_chapters_course_id_fkey,
+    ADD CONSTRAINT course_chapters_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id);
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_course_id_fkey,
+    ADD CONSTRAINT course_chapter_lessons_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id);
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_chapter_id_fkey,
+    ADD CONSTRAINT course_chapter_lessons_chapter_id_fkey FOREIGN KEY (chapter_id) REFERENCES course_chapters (id);
+ALTER TABLE enrollments DROP CONSTRAINT IF EXISTS enrollments_course_id_fkey,
+    ADD CONSTRAINT enrollments_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id);
+ALTER TABLE quizzes DROP CONSTRAINT IF EXISTS quizzes_lesson_id_fkey,
+    ADD CONSTRAINT quizzes_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id);
+ALTER TABLE quizzes DROP CONSTRAINT IF EXISTS quizzes_course_id_fkey,
+    ADD CONSTRAINT quizzes_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id);
+ALTER TABLE quiz_questions DROP CONSTRAINT IF EXISTS quiz_questions_quiz_id_fkey,
+    ADD CONSTRAINT quiz_questions_quiz_id_fkey FOREIGN KEY (quiz_id) REFERENCES quizzes (id);
+ALTER TABLE quiz_attempts DROP CONSTRAINT IF EXISTS quiz_attempts_quiz_id_fkey,
+    ADD CONSTRAINT quiz_attempts_quiz_id_fkey FOREIGN KEY (quiz_id) REFERENCES quizzes (id);
+ALTER TABLE quiz_attempts DROP CONSTRAINT IF EXISTS quiz_attempts_course_id_fkey,
+    ADD CONSTRAINT quiz_attempts_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id);
+ALTER TABLE assignments DROP CONSTRAINT IF EXISTS assignments_lesson_id_fkey,
+    ADD CONSTRAINT assignments_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id);
+ALTER TABLE assignments DROP CONSTRAINT IF EXISTS assignments_course_id_fkey,
+    ADD CONSTRAINT assignments_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id);
+ALTER TABLE assignment_submissions DROP CONSTRAINT IF EXISTS assignment_submissions_assignment_id_fkey,
+    ADD CONSTRAINT assignment_submissions_assignment_id_fkey FOREIGN KEY (assignment_id) REFERENCES assignments (id);
+ALTER TABLE assignment_submissions DROP CONSTRAINT IF EXISTS assignment_submissions_course_id_fkey,
+    ADD CONSTRAINT assignment_submissions_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id);
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_chapter_id_fkey,
+    ADD CONSTRAINT release_rules_chapter_id_fkey FOREIGN KEY (chapter_id) REFERENCES course_chapters (id);
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_lesson_id_fkey,
+    ADD CONSTRAINT release_rules_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id);
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_prerequisite_lesson_id_fkey,
+    ADD CONSTRAINT release_rules_prerequisite_lesson_id_fkey FOREIGN KEY (prerequisite_lesson_id) REFERENCES course_chapter_lessons (id);
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_prerequisite_quiz_id_fkey,
+    ADD CONSTRAINT release_rules_prerequisite_quiz_id_fkey FOREIGN KEY (prerequisite_quiz_id) REFERENCES quizzes (id);
+ALTER TABLE lesson_completions DROP CONSTRAINT IF EXISTS lesson_completions_lesson_id_fkey,
+    ADD CONSTRAINT lesson_completions_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id);
+ALTER TABLE lesson_transcript_cues DROP CONSTRAINT IF EXISTS lesson_transcript_cues_lesson_id_fkey,
+    ADD CONSTRAINT lesson_transcript_cues_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id);
+
+DROP INDEX IF EXISTS course_chapter_lessons_deleted_with_id_idx;
+DROP INDEX IF EXISTS course_chapters_deleted_with_id_idx;
+DROP INDEX IF EXISTS courses_deleted_with_id_idx;
+
+ALTER TABLE course_chapter_lessons DROP COLUMN IF EXISTS deleted_with_id;
+ALTER TABLE course_chapters DROP COLUMN IF EXISTS deleted_with_id;
+ALTER TABLE courses DROP COLUMN IF EXISTS deleted_with_id;
@@ -0,0 +1,71 @@
+-- deleted_with_id: id row yang dihapus langsung (root cascade). Root menunjuk dirinya sendiri,
+-- chapter / lesson yang ikut terhapus menunjuk course / chapter tsb. Restore hanya mengembalikan row dgn id root yang sama.
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS deleted_with_id UUID;
+ALTER TABLE course_chapters ADD COLUMN IF NOT EXISTS deleted_with_id UUID;
+ALTER TABLE course_chapter_lessons ADD COLUMN IF NOT EXISTS deleted_with_id UUID;
+
+-- data lama: setiap row yang sudah dihapus dianggap root-nya sendiri
+UPDATE courses SET deleted_with_id = id WHERE deleted_at IS NOT NULL AND deleted_with_id IS NULL;
+UPDATE course_chapters SET deleted_with_id = id WHERE deleted_at IS NOT NULL AND deleted_with_id IS NULL;
+UPDATE course_chapter_lessons SET deleted_with_id = id WHERE deleted_at IS NOT NULL AND deleted_with_id IS NULL;
+
+-- chapter & lesson yang tertinggal aktif di bawah course / chapter yang sudah dihapus ikut dihapus
+UPDATE course_chapters ch
+SET deleted_at = c.deleted_at, deleted_by = c.deleted_by, deleted_with_id = c.id
+FROM courses c
+WHERE ch.course_id = c.id AND c.deleted_at IS NOT NULL AND ch.deleted_at IS NULL;
+
+UPDATE course_chapter_lessons l
+SET deleted_at = c.deleted_at, deleted_by = c.deleted_by, deleted_with_id = c.id
+FROM courses c
+WHERE l.course_id = c.id AND c.deleted_at IS NOT NULL AND l.deleted_at IS NULL;
+
+UPDATE course_chapter_lessons l
+SET deleted_at = ch.deleted_at, deleted_by = ch.deleted_by, deleted_with_id = ch.deleted_with_id
+FROM course_chapters ch
+WHERE l.chapter_id = ch.id AND ch.deleted_at IS NOT NULL AND l.deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS courses_deleted_with_id_idx ON courses (deleted_with_id) WHERE deleted_with_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS course_chapters_deleted_with_id_idx ON course_chapters (deleted_with_id) WHERE deleted_with_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS course_chapter_lessons_deleted_with_id_idx ON course_chapter_lessons (deleted_with_id) WHERE deleted_with_id IS NOT NULL;
+
+-- purge permanen (setelah masa retensi trash) cukup menghapus course / chapter / lesson, data turunannya ikut terhapus
+ALTER TABLE course_chapters DROP CONSTRAINT IF EXISTS course_chapters_course_id_fkey,
+    ADD CONSTRAINT course_chapters_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE;
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_course_id_fkey,
+    ADD CONSTRAINT course_chapter_lessons_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE;
+ALTER TABLE course_chapter_lessons DROP CONSTRAINT IF EXISTS course_chapter_lessons_chapter_id_fkey,
+    ADD CONSTRAINT course_chapter_lessons_chapter_id_fkey FOREIGN KEY (chapter_id) REFERENCES course_chapters (id) ON DELETE CASCADE;
+ALTER TABLE enrollments DROP CONSTRAINT IF EXISTS enrollments_course_id_fkey,
+    ADD CONSTRAINT enrollments_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE;
+ALTER TABLE quizzes DROP CONSTRAINT IF EXISTS quizzes_lesson_id_fkey,
+    ADD CONSTRAINT quizzes_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id) ON DELETE CASCADE;
+ALTER TABLE quizzes DROP CONSTRAINT IF EXISTS quizzes_course_id_fkey,
+    ADD CONSTRAINT quizzes_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE;
+ALTER TABLE quiz_questions DROP CONSTRAINT IF EXISTS quiz_questions_quiz_id_fkey,
+    ADD CONSTRAINT quiz_questions_quiz_id_fkey FOREIGN KEY (quiz_id) REFERENCES quizzes (id) ON DELETE CASCADE;
+ALTER TABLE quiz_attempts DROP CONSTRAINT IF EXISTS quiz_attempts_quiz_id_fkey,
+    ADD CONSTRAINT quiz_attempts_quiz_id_fkey FOREIGN KEY (quiz_id) REFERENCES quizzes (id) ON DELETE CASCADE;
+ALTER TABLE quiz_attempts DROP CONSTRAINT IF EXISTS quiz_attempts_course_id_fkey,
+    ADD CONSTRAINT quiz_attempts_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE;
+ALTER TABLE assignments DROP CONSTRAINT IF EXISTS assignments_lesson_id_fkey,
+    ADD CONSTRAINT assignments_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id) ON DELETE CASCADE;
+ALTER TABLE assignments DROP CONSTRAINT IF EXISTS assignments_course_id_fkey,
+    ADD CONSTRAINT assignments_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE;
+ALTER TABLE assignment_submissions DROP CONSTRAINT IF EXISTS assignment_submissions_assignment_id_fkey,
+    ADD CONSTRAINT assignment_submissions_assignment_id_fkey FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE;
+ALTER TABLE assignment_submissions DROP CONSTRAINT IF EXISTS assignment_submissions_course_id_fkey,
+    ADD CONSTRAINT assignment_submissions_course_id_fkey FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE;
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_chapter_id_fkey,
+    ADD CONSTRAINT release_rules_chapter_id_fkey FOREIGN KEY (chapter_id) REFERENCES course_chapters (id) ON DELETE CASCADE;
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_lesson_id_fkey,
+    ADD CONSTRAINT release_rules_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id) ON DELETE CASCADE;
+-- aturan yang syaratnya lesson / quiz yang di-purge tidak bisa dipenuhi lagi, ikut dihapus
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_prerequisite_lesson_id_fkey,
+    ADD CONSTRAINT release_rules_prerequisite_lesson_id_fkey FOREIGN KEY (prerequisite_lesson_id) REFERENCES course_chapter_lessons (id) ON DELETE CASCADE;
+ALTER TABLE release_rules DROP CONSTRAINT IF EXISTS release_rules_prerequisite_quiz_id_fkey,
+    ADD CONSTRAINT release_rules_prerequisite_quiz_id_fkey FOREIGN KEY (prerequisite_quiz_id) REFERENCES quizzes (id) ON DELETE CASCADE;
+ALTER TABLE lesson_completions DROP CONSTRAINT IF EXISTS lesson_completions_lesson_id_fkey,
+    ADD CONSTRAINT lesson_completions_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id) ON DELETE CASCADE;
+ALTER TABLE lesson_transcript_cues DROP CONSTRAINT IF EXISTS lesson_transcript_cues_lesson_id_fkey,
+    ADD CONSTRAINT lesson_transcript_cues_lesson_id_fkey FOREIGN KEY (lesson_id) REFERENCES course_chapter_lessons (id) ON DELETE CASCADE;
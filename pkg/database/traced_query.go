@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/abu-umair/be-lms-go/pkg/database"
+
+// tracedQuery membuat satu span per query (berisi SQL statement-nya), nilai argumen tidak ikut dicatat
+type tracedQuery struct {
+	next   DatabaseQuery
+	tracer trace.Tracer
+}
+
+// NewTracedQuery membungkus *sqlx.DB / *sqlx.Tx. Jika tracing tidak aktif, tracer global adalah no-op.
+func NewTracedQuery(next DatabaseQuery) DatabaseQuery {
+	return &tracedQuery{
+		next:   next,
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+func (t *tracedQuery) GetContext(ctx context.Context, dest interface{}, query string, args ...any) error {
+	ctx, span := t.start(ctx, "GetContext", query)
+	err := t.next.GetContext(ctx, dest, query, args...)
+	//? sql.ErrNoRows bukan error query (data memang tidak ada)
+	end(span, err, sql.ErrNoRows)
+
+	return err
+}
+
+func (t *tracedQuery) SelectContext(ctx context.Context, dest interface{}, query string, args ...any) error {
+	ctx, span := t.start(ctx, "SelectContext", query)
+	err := t.next.SelectContext(ctx, dest, query, args...)
+	end(span, err)
+
+	return err
+}
+
+func (t *tracedQuery) NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error) {
+	ctx, span := t.start(ctx, "NamedExecContext", query)
+	res, err := t.next.NamedExecContext(ctx, query, arg)
+	end(span, err)
+
+	return res, err
+}
+
+func (t *tracedQuery) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span := t.start(ctx, "ExecContext", query)
+	res, err := t.next.ExecContext(ctx, query, args...)
+	end(span, err)
+
+	return res, err
+}
+
+func (t *tracedQuery) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	ctx, span := t.start(ctx, "QueryRowContext", query)
+	row := t.next.QueryRowContext(ctx, query, args...)
+	//? query sudah dieksekusi di sini, error-nya (selain no rows) sudah bisa dibaca lewat row.Err()
+	end(span, row.Err(), sql.ErrNoRows)
+
+	return row
+}
+
+func (t *tracedQuery) start(ctx context.Context, method string, query string) (context.Context, trace.Span) {
+	operation := queryOperation(query)
+
+	return t.tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(strings.TrimSpace(query)),
+			attribute.String("db.sqlx.method", method),
+		),
+	)
+}
+
+func end(span trace.Span, err error, ignored ...error) {
+	defer span.End()
+
+	if err == nil {
+		return
+	}
+	for _, ignoredErr := range ignored {
+		if err == ignoredErr {
+			return
+		}
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// queryOperation: kata pertama SQL (SELECT, INSERT, UPDATE, DELETE, WITH, ...)
+func queryOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "QUERY"
+	}
+
+	return strings.ToUpper(fields[0])
+}
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	postCommitMaxAttempts = 5
+	postCommitBaseDelay   = time.Second
+)
+
+// pendingActions menghitung aksi post-commit yang masih berjalan/di-retry (utk graceful shutdown)
+var pendingActions sync.WaitGroup
+
+// PostCommitAction adalah side effect (hapus file, kirim email, dll) yang hanya boleh
+// dijalankan jika data di DB benar-benar tersimpan. Run harus idempotent karena bisa di-retry.
+type PostCommitAction struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Transaction membungkus sqlx.Tx dengan antrian aksi yang dijalankan setelah Commit berhasil.
+// Jika Rollback (atau Commit gagal), antrian dibuang tanpa dijalankan.
+type Transaction struct {
+	*sqlx.Tx
+
+	ctx         context.Context
+	mu          sync.Mutex
+	afterCommit []PostCommitAction
+}
+
+func BeginTransaction(ctx context.Context, db *sqlx.DB) (*Transaction, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transaction{
+		Tx:  tx,
+		ctx: ctx,
+	}, nil
+}
+
+// AfterCommit mendaftarkan aksi yang dijalankan (berurutan) setelah Commit berhasil
+func (t *Transaction) AfterCommit(name string, run func(ctx context.Context) error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.afterCommit = append(t.afterCommit, PostCommitAction{Name: name, Run: run})
+}
+
+func (t *Transaction) Commit() error {
+	actions := t.takeActions()
+
+	if err := t.Tx.Commit(); err != nil {
+		return err
+	}
+
+	//? context request bisa saja sudah selesai saat retry, jadi jangan ikut dibatalkan
+	runPostCommitActions(context.WithoutCancel(t.ctx), actions)
+
+	return nil
+}
+
+func (t *Transaction) Rollback() error {
+	t.takeActions()
+
+	return t.Tx.Rollback()
+}
+
+func (t *Transaction) takeActions() []PostCommitAction {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	actions := t.afterCommit
+	t.afterCommit = nil
+
+	return actions
+}
+
+// runPostCommitActions menjalankan aksi langsung, yang gagal di-retry di background dengan exponential backoff
+func runPostCommitActions(ctx context.Context, actions []PostCommitAction) {
+	for _, action := range actions {
+		err := action.Run(ctx)
+		if err == nil {
+			continue
+		}
+
+		slog.WarnContext(ctx, "post-commit action failed", "action", action.Name, "attempt", 1, "max_attempts", postCommitMaxAttempts, "error", err)
+
+		pendingActions.Add(1)
+		go retryPostCommitAction(ctx, action)
+	}
+}
+
+func retryPostCommitAction(ctx context.Context, action PostCommitAction) {
+	defer pendingActions.Done()
+
+	delay := postCommitBaseDelay
+	for attempt := 2; attempt <= postCommitMaxAttempts; attempt++ {
+		time.Sleep(delay)
+		delay *= 2
+
+		err := action.Run(ctx)
+		if err == nil {
+			return
+		}
+
+		slog.WarnContext(ctx, "post-commit action failed", "action", action.Name, "attempt", attempt, "max_attempts", postCommitMaxAttempts, "error", err)
+	}
+
+	slog.ErrorContext(ctx, "post-commit action gave up", "action", action.Name, "attempts", postCommitMaxAttempts)
+}
+
+// WaitPostCommitActions menunggu aksi post-commit yang sedang di-retry selesai, atau ctx habis
+func WaitPostCommitActions(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		pendingActions.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}